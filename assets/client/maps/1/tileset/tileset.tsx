@@ -59,4 +59,18 @@
    <property name="sound" value="sand"/>
   </properties>
  </tile>
+ <tile id="293">
+  <properties>
+   <property name="sound" value="rock"/>
+   <property name="spawnable" type="bool" value="true"/>
+   <property name="spawnable_team" type="int" value="1"/>
+  </properties>
+ </tile>
+ <tile id="295">
+  <properties>
+   <property name="sound" value="rock"/>
+   <property name="spawnable" type="bool" value="true"/>
+   <property name="spawnable_team" type="int" value="2"/>
+  </properties>
+ </tile>
 </tileset>
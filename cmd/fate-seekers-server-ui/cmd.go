@@ -1,11 +1,16 @@
 package main
 
 import (
+	"github.com/YarikRevich/fate-seekers/services/fate-seekers-server/pkg/shared/audit"
 	"github.com/YarikRevich/fate-seekers/services/fate-seekers-server/pkg/shared/config"
 	"github.com/YarikRevich/fate-seekers/services/fate-seekers-server/pkg/shared/db"
 	"github.com/YarikRevich/fate-seekers/services/fate-seekers-server/pkg/shared/logging"
+	"github.com/YarikRevich/fate-seekers/services/fate-seekers-server/pkg/shared/monitoring/server"
 	"github.com/YarikRevich/fate-seekers/services/fate-seekers-server/pkg/shared/networking/metadata/events"
+	"github.com/YarikRevich/fate-seekers/services/fate-seekers-server/pkg/shared/networking/metadata/matchmaking"
+	"github.com/YarikRevich/fate-seekers/services/fate-seekers-server/pkg/shared/replay"
 	"github.com/YarikRevich/fate-seekers/services/fate-seekers-server/pkg/shared/repository/sync"
+	"github.com/YarikRevich/fate-seekers/services/fate-seekers-server/pkg/shared/tracing"
 	"github.com/YarikRevich/fate-seekers/services/fate-seekers-server/pkg/ui/runtime"
 	"github.com/hajimehoshi/ebiten/v2"
 )
@@ -15,15 +20,42 @@ func init() {
 	config.SetupDefaultConfig()
 	config.Init()
 
+	config.Watch()
+
+	if err := tracing.Init(); err != nil {
+		logging.GetInstance().Fatal(err.Error())
+	}
+
 	db.Init()
 
 	sync.Run()
 
 	events.Run()
+
+	matchmaking.Run()
+
+	audit.GetInstance().Run()
+
+	replay.GetInstance().Run()
+
+	// Monitoring server failure doesn't affect the gaming server, so it is only logged.
+	server.GetInstance().Start(func() {})
 }
 
 func main() {
 	if err := ebiten.RunGame(runtime.NewRuntime()); err != nil {
 		logging.GetInstance().Fatal(err.Error())
 	}
+
+	if err := replay.GetInstance().Close(); err != nil {
+		logging.GetInstance().Error(err.Error())
+	}
+
+	if err := audit.GetInstance().Close(); err != nil {
+		logging.GetInstance().Error(err.Error())
+	}
+
+	if err := tracing.Shutdown(); err != nil {
+		logging.GetInstance().Error(err.Error())
+	}
 }
@@ -6,4 +6,5 @@ const (
 	UPDATE_USER_METADATA_POSITIONS = "0"
 	UPDATE_USER_METADATA_STATIC    = "1"
 	HIT_PLAYER_WITH_FIST_REQUEST   = "2"
+	SEND_EMOTE_REQUEST             = "3"
 )
@@ -3,6 +3,7 @@ package call
 import (
 	contentv1 "github.com/YarikRevich/fate-seekers/services/fate-seekers-client/pkg/core/networking/content/api"
 	"github.com/YarikRevich/fate-seekers/services/fate-seekers-client/pkg/core/networking/content/handler"
+	"github.com/YarikRevich/fate-seekers/services/fate-seekers-client/pkg/dto"
 	"github.com/YarikRevich/fate-seekers/services/fate-seekers-client/pkg/state/store"
 	"google.golang.org/protobuf/proto"
 )
@@ -30,3 +31,33 @@ func PerformHitPlayerWithFist(sessionID int64, callback func(err error)) {
 		callback(nil)
 	}()
 }
+
+// PerformSendEmote performs emote or ping send operation request. Position is only used for pings.
+func PerformSendEmote(sessionID int64, emote uint64, ping bool, position dto.Position, callback func(err error)) {
+	go func() {
+		message, err := proto.Marshal(&contentv1.SendEmoteRequest{
+			Issuer:    store.GetRepositoryUUID(),
+			SessionId: sessionID,
+			Emote:     emote,
+			Ping:      ping,
+			Position: &contentv1.Position{
+				X: position.X,
+				Y: position.Y,
+			},
+		})
+		if err != nil {
+			callback(err)
+
+			return
+		}
+
+		err = handler.GetInstance().Send(contentv1.SEND_EMOTE_REQUEST, message)
+		if err != nil {
+			callback(err)
+
+			return
+		}
+
+		callback(nil)
+	}()
+}
@@ -22,6 +22,8 @@ const (
 	MetadataService_PingConnection_FullMethodName        = "/metadata.v1.MetadataService/PingConnection"
 	MetadataService_UpdateSessionActivity_FullMethodName = "/metadata.v1.MetadataService/UpdateSessionActivity"
 	MetadataService_CreateUserIfNotExists_FullMethodName = "/metadata.v1.MetadataService/CreateUserIfNotExists"
+	MetadataService_GetProfile_FullMethodName            = "/metadata.v1.MetadataService/GetProfile"
+	MetadataService_UpdateProfile_FullMethodName         = "/metadata.v1.MetadataService/UpdateProfile"
 	MetadataService_GetUserSessions_FullMethodName       = "/metadata.v1.MetadataService/GetUserSessions"
 	MetadataService_GetFilteredSession_FullMethodName    = "/metadata.v1.MetadataService/GetFilteredSession"
 	MetadataService_CreateSession_FullMethodName         = "/metadata.v1.MetadataService/CreateSession"
@@ -41,6 +43,22 @@ const (
 	MetadataService_OpenHealthPack_FullMethodName        = "/metadata.v1.MetadataService/OpenHealthPack"
 	MetadataService_GetHealthPacks_FullMethodName        = "/metadata.v1.MetadataService/GetHealthPacks"
 	MetadataService_GetEvents_FullMethodName             = "/metadata.v1.MetadataService/GetEvents"
+	MetadataService_GetReplay_FullMethodName             = "/metadata.v1.MetadataService/GetReplay"
+	MetadataService_CreateSessionInvite_FullMethodName   = "/metadata.v1.MetadataService/CreateSessionInvite"
+	MetadataService_ListPublicSessions_FullMethodName    = "/metadata.v1.MetadataService/ListPublicSessions"
+	MetadataService_JoinMatchmaking_FullMethodName       = "/metadata.v1.MetadataService/JoinMatchmaking"
+	MetadataService_LeaveMatchmaking_FullMethodName      = "/metadata.v1.MetadataService/LeaveMatchmaking"
+	MetadataService_SetLobbyReady_FullMethodName         = "/metadata.v1.MetadataService/SetLobbyReady"
+	MetadataService_SetLobbyTeam_FullMethodName          = "/metadata.v1.MetadataService/SetLobbyTeam"
+	MetadataService_SendChatMessage_FullMethodName       = "/metadata.v1.MetadataService/SendChatMessage"
+	MetadataService_GetChatMessages_FullMethodName       = "/metadata.v1.MetadataService/GetChatMessages"
+	MetadataService_GetEmotes_FullMethodName             = "/metadata.v1.MetadataService/GetEmotes"
+	MetadataService_GetFriends_FullMethodName            = "/metadata.v1.MetadataService/GetFriends"
+	MetadataService_SendFriendRequest_FullMethodName     = "/metadata.v1.MetadataService/SendFriendRequest"
+	MetadataService_AcceptFriendRequest_FullMethodName   = "/metadata.v1.MetadataService/AcceptFriendRequest"
+	MetadataService_RemoveFriend_FullMethodName          = "/metadata.v1.MetadataService/RemoveFriend"
+	MetadataService_InviteFriend_FullMethodName          = "/metadata.v1.MetadataService/InviteFriend"
+	MetadataService_GetInvitations_FullMethodName        = "/metadata.v1.MetadataService/GetInvitations"
 )
 
 // MetadataServiceClient is the client API for MetadataService service.
@@ -57,6 +75,10 @@ type MetadataServiceClient interface {
 	UpdateSessionActivity(ctx context.Context, opts ...grpc.CallOption) (grpc.ClientStreamingClient[UpdateSessionActivityRequest, UpdateSessionActivityResponse], error)
 	// CreateUserIfNotExists performs attempt to create a user, if it hasn't been create yet.
 	CreateUserIfNotExists(ctx context.Context, in *CreateUserIfNotExistsRequest, opts ...grpc.CallOption) (*CreateUserIfNotExistsResponse, error)
+	// GetProfile performs profile retrieval operation by the configured user.
+	GetProfile(ctx context.Context, in *GetProfileRequest, opts ...grpc.CallOption) (*GetProfileResponse, error)
+	// UpdateProfile performs profile modification operation by the configured user. Display name is required to be unique.
+	UpdateProfile(ctx context.Context, in *UpdateProfileRequest, opts ...grpc.CallOption) (*UpdateProfileResponse, error)
 	// GetUserSessions performs existing sessions retrieval operation by the configured user.
 	GetUserSessions(ctx context.Context, in *GetUserSessionsRequest, opts ...grpc.CallOption) (*GetUserSessionsResponse, error)
 	// GetFilteredSession performs existing filtered session retrieval operation by the configured user.
@@ -95,6 +117,38 @@ type MetadataServiceClient interface {
 	GetHealthPacks(ctx context.Context, in *GetHealthPacksRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[GetHealthPacksResponse], error)
 	// GetEvents performs weather events retrieval for the selected session by the configured user.
 	GetEvents(ctx context.Context, in *GetEventsRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[GetEventsResponse], error)
+	// GetReplay performs recorded replay retrieval for the selected session by the configured user.
+	GetReplay(ctx context.Context, in *GetReplayRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[GetReplayResponse], error)
+	// CreateSessionInvite performs invite code creation for the private session owned by the configured user.
+	CreateSessionInvite(ctx context.Context, in *CreateSessionInviteRequest, opts ...grpc.CallOption) (*CreateSessionInviteResponse, error)
+	// ListPublicSessions performs paginated public sessions retrieval with the provided filters.
+	ListPublicSessions(ctx context.Context, in *ListPublicSessionsRequest, opts ...grpc.CallOption) (*ListPublicSessionsResponse, error)
+	// JoinMatchmaking performs matchmaking queue join by the configured user, reporting queue position and estimated wait until a match is found.
+	JoinMatchmaking(ctx context.Context, in *JoinMatchmakingRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[JoinMatchmakingResponse], error)
+	// LeaveMatchmaking performs matchmaking queue leave by the configured user.
+	LeaveMatchmaking(ctx context.Context, in *LeaveMatchmakingRequest, opts ...grpc.CallOption) (*LeaveMatchmakingResponse, error)
+	// SetLobbyReady performs lobby ready flag update by the configured user, allowing host to start session start countdown.
+	SetLobbyReady(ctx context.Context, in *SetLobbyReadyRequest, opts ...grpc.CallOption) (*SetLobbyReadyResponse, error)
+	// SetLobbyTeam performs lobby team update, or auto-balancing of all the lobby teams requested by the host.
+	SetLobbyTeam(ctx context.Context, in *SetLobbyTeamRequest, opts ...grpc.CallOption) (*SetLobbyTeamResponse, error)
+	// SendChatMessage performs chat message send to the session by the configured user.
+	SendChatMessage(ctx context.Context, in *SendChatMessageRequest, opts ...grpc.CallOption) (*SendChatMessageResponse, error)
+	// GetChatMessages performs chat messages retrieval of the session, streaming newly sent messages.
+	GetChatMessages(ctx context.Context, in *GetChatMessagesRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[GetChatMessagesResponse], error)
+	// GetEmotes performs retrieval of emotes and pings relayed to the configured user, streaming newly relayed ones.
+	GetEmotes(ctx context.Context, in *GetEmotesRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[GetEmotesResponse], error)
+	// GetFriends performs friends and pending friend requests retrieval by the configured user.
+	GetFriends(ctx context.Context, in *GetFriendsRequest, opts ...grpc.CallOption) (*GetFriendsResponse, error)
+	// SendFriendRequest performs friend request send to the user with the provided display name by the configured user.
+	SendFriendRequest(ctx context.Context, in *SendFriendRequestRequest, opts ...grpc.CallOption) (*SendFriendRequestResponse, error)
+	// AcceptFriendRequest performs incoming friend request accept by the configured user.
+	AcceptFriendRequest(ctx context.Context, in *AcceptFriendRequestRequest, opts ...grpc.CallOption) (*AcceptFriendRequestResponse, error)
+	// RemoveFriend performs friend or pending friend request removal by the configured user.
+	RemoveFriend(ctx context.Context, in *RemoveFriendRequest, opts ...grpc.CallOption) (*RemoveFriendResponse, error)
+	// InviteFriend performs invitation of the friend to the session, which configured user is member of.
+	InviteFriend(ctx context.Context, in *InviteFriendRequest, opts ...grpc.CallOption) (*InviteFriendResponse, error)
+	// GetInvitations performs retrieval of session invitations sent to the configured user, streaming newly sent ones.
+	GetInvitations(ctx context.Context, in *GetInvitationsRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[GetInvitationsResponse], error)
 }
 
 type metadataServiceClient struct {
@@ -138,6 +192,26 @@ func (c *metadataServiceClient) CreateUserIfNotExists(ctx context.Context, in *C
 	return out, nil
 }
 
+func (c *metadataServiceClient) GetProfile(ctx context.Context, in *GetProfileRequest, opts ...grpc.CallOption) (*GetProfileResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetProfileResponse)
+	err := c.cc.Invoke(ctx, MetadataService_GetProfile_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *metadataServiceClient) UpdateProfile(ctx context.Context, in *UpdateProfileRequest, opts ...grpc.CallOption) (*UpdateProfileResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdateProfileResponse)
+	err := c.cc.Invoke(ctx, MetadataService_UpdateProfile_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *metadataServiceClient) GetUserSessions(ctx context.Context, in *GetUserSessionsRequest, opts ...grpc.CallOption) (*GetUserSessionsResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(GetUserSessionsResponse)
@@ -382,6 +456,211 @@ func (c *metadataServiceClient) GetEvents(ctx context.Context, in *GetEventsRequ
 // This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
 type MetadataService_GetEventsClient = grpc.ServerStreamingClient[GetEventsResponse]
 
+func (c *metadataServiceClient) GetReplay(ctx context.Context, in *GetReplayRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[GetReplayResponse], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &MetadataService_ServiceDesc.Streams[7], MetadataService_GetReplay_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[GetReplayRequest, GetReplayResponse]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type MetadataService_GetReplayClient = grpc.ServerStreamingClient[GetReplayResponse]
+
+func (c *metadataServiceClient) CreateSessionInvite(ctx context.Context, in *CreateSessionInviteRequest, opts ...grpc.CallOption) (*CreateSessionInviteResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateSessionInviteResponse)
+	err := c.cc.Invoke(ctx, MetadataService_CreateSessionInvite_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *metadataServiceClient) ListPublicSessions(ctx context.Context, in *ListPublicSessionsRequest, opts ...grpc.CallOption) (*ListPublicSessionsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListPublicSessionsResponse)
+	err := c.cc.Invoke(ctx, MetadataService_ListPublicSessions_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *metadataServiceClient) JoinMatchmaking(ctx context.Context, in *JoinMatchmakingRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[JoinMatchmakingResponse], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &MetadataService_ServiceDesc.Streams[8], MetadataService_JoinMatchmaking_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[JoinMatchmakingRequest, JoinMatchmakingResponse]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type MetadataService_JoinMatchmakingClient = grpc.ServerStreamingClient[JoinMatchmakingResponse]
+
+func (c *metadataServiceClient) LeaveMatchmaking(ctx context.Context, in *LeaveMatchmakingRequest, opts ...grpc.CallOption) (*LeaveMatchmakingResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(LeaveMatchmakingResponse)
+	err := c.cc.Invoke(ctx, MetadataService_LeaveMatchmaking_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *metadataServiceClient) SetLobbyReady(ctx context.Context, in *SetLobbyReadyRequest, opts ...grpc.CallOption) (*SetLobbyReadyResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SetLobbyReadyResponse)
+	err := c.cc.Invoke(ctx, MetadataService_SetLobbyReady_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *metadataServiceClient) SetLobbyTeam(ctx context.Context, in *SetLobbyTeamRequest, opts ...grpc.CallOption) (*SetLobbyTeamResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SetLobbyTeamResponse)
+	err := c.cc.Invoke(ctx, MetadataService_SetLobbyTeam_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *metadataServiceClient) SendChatMessage(ctx context.Context, in *SendChatMessageRequest, opts ...grpc.CallOption) (*SendChatMessageResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SendChatMessageResponse)
+	err := c.cc.Invoke(ctx, MetadataService_SendChatMessage_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *metadataServiceClient) GetChatMessages(ctx context.Context, in *GetChatMessagesRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[GetChatMessagesResponse], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &MetadataService_ServiceDesc.Streams[9], MetadataService_GetChatMessages_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[GetChatMessagesRequest, GetChatMessagesResponse]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type MetadataService_GetChatMessagesClient = grpc.ServerStreamingClient[GetChatMessagesResponse]
+
+func (c *metadataServiceClient) GetEmotes(ctx context.Context, in *GetEmotesRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[GetEmotesResponse], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &MetadataService_ServiceDesc.Streams[10], MetadataService_GetEmotes_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[GetEmotesRequest, GetEmotesResponse]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type MetadataService_GetEmotesClient = grpc.ServerStreamingClient[GetEmotesResponse]
+
+func (c *metadataServiceClient) GetFriends(ctx context.Context, in *GetFriendsRequest, opts ...grpc.CallOption) (*GetFriendsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetFriendsResponse)
+	err := c.cc.Invoke(ctx, MetadataService_GetFriends_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *metadataServiceClient) SendFriendRequest(ctx context.Context, in *SendFriendRequestRequest, opts ...grpc.CallOption) (*SendFriendRequestResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SendFriendRequestResponse)
+	err := c.cc.Invoke(ctx, MetadataService_SendFriendRequest_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *metadataServiceClient) AcceptFriendRequest(ctx context.Context, in *AcceptFriendRequestRequest, opts ...grpc.CallOption) (*AcceptFriendRequestResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AcceptFriendRequestResponse)
+	err := c.cc.Invoke(ctx, MetadataService_AcceptFriendRequest_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *metadataServiceClient) RemoveFriend(ctx context.Context, in *RemoveFriendRequest, opts ...grpc.CallOption) (*RemoveFriendResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RemoveFriendResponse)
+	err := c.cc.Invoke(ctx, MetadataService_RemoveFriend_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *metadataServiceClient) InviteFriend(ctx context.Context, in *InviteFriendRequest, opts ...grpc.CallOption) (*InviteFriendResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(InviteFriendResponse)
+	err := c.cc.Invoke(ctx, MetadataService_InviteFriend_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *metadataServiceClient) GetInvitations(ctx context.Context, in *GetInvitationsRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[GetInvitationsResponse], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &MetadataService_ServiceDesc.Streams[11], MetadataService_GetInvitations_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[GetInvitationsRequest, GetInvitationsResponse]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type MetadataService_GetInvitationsClient = grpc.ServerStreamingClient[GetInvitationsResponse]
+
 // MetadataServiceServer is the server API for MetadataService service.
 // All implementations must embed UnimplementedMetadataServiceServer
 // for forward compatibility.
@@ -396,6 +675,10 @@ type MetadataServiceServer interface {
 	UpdateSessionActivity(grpc.ClientStreamingServer[UpdateSessionActivityRequest, UpdateSessionActivityResponse]) error
 	// CreateUserIfNotExists performs attempt to create a user, if it hasn't been create yet.
 	CreateUserIfNotExists(context.Context, *CreateUserIfNotExistsRequest) (*CreateUserIfNotExistsResponse, error)
+	// GetProfile performs profile retrieval operation by the configured user.
+	GetProfile(context.Context, *GetProfileRequest) (*GetProfileResponse, error)
+	// UpdateProfile performs profile modification operation by the configured user. Display name is required to be unique.
+	UpdateProfile(context.Context, *UpdateProfileRequest) (*UpdateProfileResponse, error)
 	// GetUserSessions performs existing sessions retrieval operation by the configured user.
 	GetUserSessions(context.Context, *GetUserSessionsRequest) (*GetUserSessionsResponse, error)
 	// GetFilteredSession performs existing filtered session retrieval operation by the configured user.
@@ -434,6 +717,38 @@ type MetadataServiceServer interface {
 	GetHealthPacks(*GetHealthPacksRequest, grpc.ServerStreamingServer[GetHealthPacksResponse]) error
 	// GetEvents performs weather events retrieval for the selected session by the configured user.
 	GetEvents(*GetEventsRequest, grpc.ServerStreamingServer[GetEventsResponse]) error
+	// GetReplay performs recorded replay retrieval for the selected session by the configured user.
+	GetReplay(*GetReplayRequest, grpc.ServerStreamingServer[GetReplayResponse]) error
+	// CreateSessionInvite performs invite code creation for the private session owned by the configured user.
+	CreateSessionInvite(context.Context, *CreateSessionInviteRequest) (*CreateSessionInviteResponse, error)
+	// ListPublicSessions performs paginated public sessions retrieval with the provided filters.
+	ListPublicSessions(context.Context, *ListPublicSessionsRequest) (*ListPublicSessionsResponse, error)
+	// JoinMatchmaking performs matchmaking queue join by the configured user, reporting queue position and estimated wait until a match is found.
+	JoinMatchmaking(*JoinMatchmakingRequest, grpc.ServerStreamingServer[JoinMatchmakingResponse]) error
+	// LeaveMatchmaking performs matchmaking queue leave by the configured user.
+	LeaveMatchmaking(context.Context, *LeaveMatchmakingRequest) (*LeaveMatchmakingResponse, error)
+	// SetLobbyReady performs lobby ready flag update by the configured user, allowing host to start session start countdown.
+	SetLobbyReady(context.Context, *SetLobbyReadyRequest) (*SetLobbyReadyResponse, error)
+	// SetLobbyTeam performs lobby team update, or auto-balancing of all the lobby teams requested by the host.
+	SetLobbyTeam(context.Context, *SetLobbyTeamRequest) (*SetLobbyTeamResponse, error)
+	// SendChatMessage performs chat message send to the session by the configured user.
+	SendChatMessage(context.Context, *SendChatMessageRequest) (*SendChatMessageResponse, error)
+	// GetChatMessages performs chat messages retrieval of the session, streaming newly sent messages.
+	GetChatMessages(*GetChatMessagesRequest, grpc.ServerStreamingServer[GetChatMessagesResponse]) error
+	// GetEmotes performs retrieval of emotes and pings relayed to the configured user, streaming newly relayed ones.
+	GetEmotes(*GetEmotesRequest, grpc.ServerStreamingServer[GetEmotesResponse]) error
+	// GetFriends performs friends and pending friend requests retrieval by the configured user.
+	GetFriends(context.Context, *GetFriendsRequest) (*GetFriendsResponse, error)
+	// SendFriendRequest performs friend request send to the user with the provided display name by the configured user.
+	SendFriendRequest(context.Context, *SendFriendRequestRequest) (*SendFriendRequestResponse, error)
+	// AcceptFriendRequest performs incoming friend request accept by the configured user.
+	AcceptFriendRequest(context.Context, *AcceptFriendRequestRequest) (*AcceptFriendRequestResponse, error)
+	// RemoveFriend performs friend or pending friend request removal by the configured user.
+	RemoveFriend(context.Context, *RemoveFriendRequest) (*RemoveFriendResponse, error)
+	// InviteFriend performs invitation of the friend to the session, which configured user is member of.
+	InviteFriend(context.Context, *InviteFriendRequest) (*InviteFriendResponse, error)
+	// GetInvitations performs retrieval of session invitations sent to the configured user, streaming newly sent ones.
+	GetInvitations(*GetInvitationsRequest, grpc.ServerStreamingServer[GetInvitationsResponse]) error
 	mustEmbedUnimplementedMetadataServiceServer()
 }
 
@@ -453,6 +768,12 @@ func (UnimplementedMetadataServiceServer) UpdateSessionActivity(grpc.ClientStrea
 func (UnimplementedMetadataServiceServer) CreateUserIfNotExists(context.Context, *CreateUserIfNotExistsRequest) (*CreateUserIfNotExistsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CreateUserIfNotExists not implemented")
 }
+func (UnimplementedMetadataServiceServer) GetProfile(context.Context, *GetProfileRequest) (*GetProfileResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetProfile not implemented")
+}
+func (UnimplementedMetadataServiceServer) UpdateProfile(context.Context, *UpdateProfileRequest) (*UpdateProfileResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateProfile not implemented")
+}
 func (UnimplementedMetadataServiceServer) GetUserSessions(context.Context, *GetUserSessionsRequest) (*GetUserSessionsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetUserSessions not implemented")
 }
@@ -510,6 +831,54 @@ func (UnimplementedMetadataServiceServer) GetHealthPacks(*GetHealthPacksRequest,
 func (UnimplementedMetadataServiceServer) GetEvents(*GetEventsRequest, grpc.ServerStreamingServer[GetEventsResponse]) error {
 	return status.Errorf(codes.Unimplemented, "method GetEvents not implemented")
 }
+func (UnimplementedMetadataServiceServer) GetReplay(*GetReplayRequest, grpc.ServerStreamingServer[GetReplayResponse]) error {
+	return status.Errorf(codes.Unimplemented, "method GetReplay not implemented")
+}
+func (UnimplementedMetadataServiceServer) CreateSessionInvite(context.Context, *CreateSessionInviteRequest) (*CreateSessionInviteResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateSessionInvite not implemented")
+}
+func (UnimplementedMetadataServiceServer) ListPublicSessions(context.Context, *ListPublicSessionsRequest) (*ListPublicSessionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListPublicSessions not implemented")
+}
+func (UnimplementedMetadataServiceServer) JoinMatchmaking(*JoinMatchmakingRequest, grpc.ServerStreamingServer[JoinMatchmakingResponse]) error {
+	return status.Errorf(codes.Unimplemented, "method JoinMatchmaking not implemented")
+}
+func (UnimplementedMetadataServiceServer) LeaveMatchmaking(context.Context, *LeaveMatchmakingRequest) (*LeaveMatchmakingResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LeaveMatchmaking not implemented")
+}
+func (UnimplementedMetadataServiceServer) SetLobbyReady(context.Context, *SetLobbyReadyRequest) (*SetLobbyReadyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetLobbyReady not implemented")
+}
+func (UnimplementedMetadataServiceServer) SetLobbyTeam(context.Context, *SetLobbyTeamRequest) (*SetLobbyTeamResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetLobbyTeam not implemented")
+}
+func (UnimplementedMetadataServiceServer) SendChatMessage(context.Context, *SendChatMessageRequest) (*SendChatMessageResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SendChatMessage not implemented")
+}
+func (UnimplementedMetadataServiceServer) GetChatMessages(*GetChatMessagesRequest, grpc.ServerStreamingServer[GetChatMessagesResponse]) error {
+	return status.Errorf(codes.Unimplemented, "method GetChatMessages not implemented")
+}
+func (UnimplementedMetadataServiceServer) GetEmotes(*GetEmotesRequest, grpc.ServerStreamingServer[GetEmotesResponse]) error {
+	return status.Errorf(codes.Unimplemented, "method GetEmotes not implemented")
+}
+func (UnimplementedMetadataServiceServer) GetFriends(context.Context, *GetFriendsRequest) (*GetFriendsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetFriends not implemented")
+}
+func (UnimplementedMetadataServiceServer) SendFriendRequest(context.Context, *SendFriendRequestRequest) (*SendFriendRequestResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SendFriendRequest not implemented")
+}
+func (UnimplementedMetadataServiceServer) AcceptFriendRequest(context.Context, *AcceptFriendRequestRequest) (*AcceptFriendRequestResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AcceptFriendRequest not implemented")
+}
+func (UnimplementedMetadataServiceServer) RemoveFriend(context.Context, *RemoveFriendRequest) (*RemoveFriendResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveFriend not implemented")
+}
+func (UnimplementedMetadataServiceServer) InviteFriend(context.Context, *InviteFriendRequest) (*InviteFriendResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method InviteFriend not implemented")
+}
+func (UnimplementedMetadataServiceServer) GetInvitations(*GetInvitationsRequest, grpc.ServerStreamingServer[GetInvitationsResponse]) error {
+	return status.Errorf(codes.Unimplemented, "method GetInvitations not implemented")
+}
 func (UnimplementedMetadataServiceServer) mustEmbedUnimplementedMetadataServiceServer() {}
 func (UnimplementedMetadataServiceServer) testEmbeddedByValue()                         {}
 
@@ -574,6 +943,42 @@ func _MetadataService_CreateUserIfNotExists_Handler(srv interface{}, ctx context
 	return interceptor(ctx, in, info, handler)
 }
 
+func _MetadataService_GetProfile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetProfileRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MetadataServiceServer).GetProfile(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MetadataService_GetProfile_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MetadataServiceServer).GetProfile(ctx, req.(*GetProfileRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MetadataService_UpdateProfile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateProfileRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MetadataServiceServer).UpdateProfile(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MetadataService_UpdateProfile_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MetadataServiceServer).UpdateProfile(ctx, req.(*UpdateProfileRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _MetadataService_GetUserSessions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(GetUserSessionsRequest)
 	if err := dec(in); err != nil {
@@ -874,6 +1279,259 @@ func _MetadataService_GetEvents_Handler(srv interface{}, stream grpc.ServerStrea
 // This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
 type MetadataService_GetEventsServer = grpc.ServerStreamingServer[GetEventsResponse]
 
+func _MetadataService_GetReplay_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(GetReplayRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(MetadataServiceServer).GetReplay(m, &grpc.GenericServerStream[GetReplayRequest, GetReplayResponse]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type MetadataService_GetReplayServer = grpc.ServerStreamingServer[GetReplayResponse]
+
+func _MetadataService_CreateSessionInvite_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateSessionInviteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MetadataServiceServer).CreateSessionInvite(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MetadataService_CreateSessionInvite_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MetadataServiceServer).CreateSessionInvite(ctx, req.(*CreateSessionInviteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MetadataService_ListPublicSessions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListPublicSessionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MetadataServiceServer).ListPublicSessions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MetadataService_ListPublicSessions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MetadataServiceServer).ListPublicSessions(ctx, req.(*ListPublicSessionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MetadataService_JoinMatchmaking_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(JoinMatchmakingRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(MetadataServiceServer).JoinMatchmaking(m, &grpc.GenericServerStream[JoinMatchmakingRequest, JoinMatchmakingResponse]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type MetadataService_JoinMatchmakingServer = grpc.ServerStreamingServer[JoinMatchmakingResponse]
+
+func _MetadataService_LeaveMatchmaking_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LeaveMatchmakingRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MetadataServiceServer).LeaveMatchmaking(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MetadataService_LeaveMatchmaking_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MetadataServiceServer).LeaveMatchmaking(ctx, req.(*LeaveMatchmakingRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MetadataService_SetLobbyReady_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetLobbyReadyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MetadataServiceServer).SetLobbyReady(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MetadataService_SetLobbyReady_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MetadataServiceServer).SetLobbyReady(ctx, req.(*SetLobbyReadyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MetadataService_SetLobbyTeam_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetLobbyTeamRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MetadataServiceServer).SetLobbyTeam(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MetadataService_SetLobbyTeam_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MetadataServiceServer).SetLobbyTeam(ctx, req.(*SetLobbyTeamRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MetadataService_SendChatMessage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SendChatMessageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MetadataServiceServer).SendChatMessage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MetadataService_SendChatMessage_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MetadataServiceServer).SendChatMessage(ctx, req.(*SendChatMessageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MetadataService_GetChatMessages_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(GetChatMessagesRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(MetadataServiceServer).GetChatMessages(m, &grpc.GenericServerStream[GetChatMessagesRequest, GetChatMessagesResponse]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type MetadataService_GetChatMessagesServer = grpc.ServerStreamingServer[GetChatMessagesResponse]
+
+func _MetadataService_GetEmotes_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(GetEmotesRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(MetadataServiceServer).GetEmotes(m, &grpc.GenericServerStream[GetEmotesRequest, GetEmotesResponse]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type MetadataService_GetEmotesServer = grpc.ServerStreamingServer[GetEmotesResponse]
+
+func _MetadataService_GetFriends_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetFriendsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MetadataServiceServer).GetFriends(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MetadataService_GetFriends_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MetadataServiceServer).GetFriends(ctx, req.(*GetFriendsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MetadataService_SendFriendRequest_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SendFriendRequestRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MetadataServiceServer).SendFriendRequest(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MetadataService_SendFriendRequest_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MetadataServiceServer).SendFriendRequest(ctx, req.(*SendFriendRequestRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MetadataService_AcceptFriendRequest_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AcceptFriendRequestRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MetadataServiceServer).AcceptFriendRequest(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MetadataService_AcceptFriendRequest_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MetadataServiceServer).AcceptFriendRequest(ctx, req.(*AcceptFriendRequestRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MetadataService_RemoveFriend_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveFriendRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MetadataServiceServer).RemoveFriend(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MetadataService_RemoveFriend_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MetadataServiceServer).RemoveFriend(ctx, req.(*RemoveFriendRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MetadataService_InviteFriend_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(InviteFriendRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MetadataServiceServer).InviteFriend(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MetadataService_InviteFriend_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MetadataServiceServer).InviteFriend(ctx, req.(*InviteFriendRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MetadataService_GetInvitations_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(GetInvitationsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(MetadataServiceServer).GetInvitations(m, &grpc.GenericServerStream[GetInvitationsRequest, GetInvitationsResponse]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type MetadataService_GetInvitationsServer = grpc.ServerStreamingServer[GetInvitationsResponse]
+
 // MetadataService_ServiceDesc is the grpc.ServiceDesc for MetadataService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -889,6 +1547,14 @@ var MetadataService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "CreateUserIfNotExists",
 			Handler:    _MetadataService_CreateUserIfNotExists_Handler,
 		},
+		{
+			MethodName: "GetProfile",
+			Handler:    _MetadataService_GetProfile_Handler,
+		},
+		{
+			MethodName: "UpdateProfile",
+			Handler:    _MetadataService_UpdateProfile_Handler,
+		},
 		{
 			MethodName: "GetUserSessions",
 			Handler:    _MetadataService_GetUserSessions_Handler,
@@ -941,6 +1607,50 @@ var MetadataService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "OpenHealthPack",
 			Handler:    _MetadataService_OpenHealthPack_Handler,
 		},
+		{
+			MethodName: "CreateSessionInvite",
+			Handler:    _MetadataService_CreateSessionInvite_Handler,
+		},
+		{
+			MethodName: "ListPublicSessions",
+			Handler:    _MetadataService_ListPublicSessions_Handler,
+		},
+		{
+			MethodName: "LeaveMatchmaking",
+			Handler:    _MetadataService_LeaveMatchmaking_Handler,
+		},
+		{
+			MethodName: "SetLobbyReady",
+			Handler:    _MetadataService_SetLobbyReady_Handler,
+		},
+		{
+			MethodName: "SetLobbyTeam",
+			Handler:    _MetadataService_SetLobbyTeam_Handler,
+		},
+		{
+			MethodName: "SendChatMessage",
+			Handler:    _MetadataService_SendChatMessage_Handler,
+		},
+		{
+			MethodName: "GetFriends",
+			Handler:    _MetadataService_GetFriends_Handler,
+		},
+		{
+			MethodName: "SendFriendRequest",
+			Handler:    _MetadataService_SendFriendRequest_Handler,
+		},
+		{
+			MethodName: "AcceptFriendRequest",
+			Handler:    _MetadataService_AcceptFriendRequest_Handler,
+		},
+		{
+			MethodName: "RemoveFriend",
+			Handler:    _MetadataService_RemoveFriend_Handler,
+		},
+		{
+			MethodName: "InviteFriend",
+			Handler:    _MetadataService_InviteFriend_Handler,
+		},
 	},
 	Streams: []grpc.StreamDesc{
 		{
@@ -978,6 +1688,31 @@ var MetadataService_ServiceDesc = grpc.ServiceDesc{
 			Handler:       _MetadataService_GetEvents_Handler,
 			ServerStreams: true,
 		},
+		{
+			StreamName:    "GetReplay",
+			Handler:       _MetadataService_GetReplay_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "JoinMatchmaking",
+			Handler:       _MetadataService_JoinMatchmaking_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "GetChatMessages",
+			Handler:       _MetadataService_GetChatMessages_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "GetEmotes",
+			Handler:       _MetadataService_GetEmotes_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "GetInvitations",
+			Handler:       _MetadataService_GetInvitations_Handler,
+			ServerStreams: true,
+		},
 	},
 	Metadata: "metadata/v1/metadata.proto",
 }
@@ -38,7 +38,9 @@ func (nmc *NetworkingMetadataConnector) Connect() error {
 		config.GetSettingsNetworkingServerHost(),
 		grpc.WithTransportCredentials(insecure.NewCredentials()),
 		grpc.WithPerRPCCredentials(&middleware.AuthenticationMiddleware{}),
-		grpc.WithUnaryInterceptor(middleware.CheckValidationMiddleware))
+		grpc.WithChainUnaryInterceptor(
+			middleware.CheckValidationMiddleware,
+			middleware.CheckModerationMiddleware))
 	if err != nil {
 		return errors.Wrap(err, networking.ErrConnectorHostIsInvalid.Error())
 	}
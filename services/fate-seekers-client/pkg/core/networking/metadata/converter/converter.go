@@ -87,6 +87,27 @@ func ConvertGetUserSessionsResponseToListEntries(
 	return output
 }
 
+// ConvertListPublicSessionsResponseToListEntries converts provided metadatav1.ListPublicSessionsResponse
+// instance to an array of dto.RetrievedPublicSession list entries used by UI component.
+func ConvertListPublicSessionsResponseToListEntries(
+	input *metadatav1.ListPublicSessionsResponse) []interface{} {
+	var output []interface{}
+
+	for _, session := range input.GetSessions() {
+		output = append(output, dto.RetrievedPublicSession{
+			Name:       session.GetName(),
+			Owner:      session.GetOwner(),
+			Players:    session.GetPlayers(),
+			MaxPlayers: session.GetMaxPlayers(),
+			Started:    session.GetStarted(),
+			Rules:      session.GetRules(),
+			CreatedAt:  session.GetCreatedAt().AsTime(),
+		})
+	}
+
+	return output
+}
+
 // ConvertGetLobbySetResponseToRetrievedLobbySetMetadata converts provided metadatav1.GetLobbySetResponse
 // instance to an array of dto.RetrievedLobbySetMetadata instances.
 func ConvertGetLobbySetResponseToRetrievedLobbySetMetadata(
@@ -95,9 +116,11 @@ func ConvertGetLobbySetResponseToRetrievedLobbySetMetadata(
 
 	for _, lobby := range input.GetLobbySet() {
 		output = append(output, dto.RetrievedLobbySetMetadata{
-			Issuer: lobby.GetIssuer(),
-			Skin:   lobby.GetSkin(),
-			Host:   lobby.GetHost(),
+			Issuer:      lobby.GetIssuer(),
+			DisplayName: lobby.GetDisplayName(),
+			Skin:        lobby.GetSkin(),
+			Host:        lobby.GetHost(),
+			Team:        lobby.GetTeam(),
 		})
 	}
 
@@ -111,21 +134,109 @@ func ConvertGetLobbySetResponseToListEntries(
 	var output []interface{}
 
 	for _, lobby := range input {
-		output = append(output, lobby.Skin)
+		output = append(output, dto.LobbySetListEntry{
+			DisplayName: lobby.GetDisplayName(),
+			Skin:        lobby.GetSkin(),
+		})
+	}
+
+	return output
+}
+
+// ConvertGetFriendsResponseToListEntries converts provided metadatav1.GetFriendsResponse instance
+// to an array of dto.FriendsListEntry list entries used by UI component.
+func ConvertGetFriendsResponseToListEntries(
+	input *metadatav1.GetFriendsResponse) []interface{} {
+	var output []interface{}
+
+	for _, friend := range input.GetFriends() {
+		output = append(output, dto.FriendsListEntry{
+			FriendshipID: friend.GetFriendshipId(),
+			DisplayName:  friend.GetDisplayName(),
+			Accepted:     friend.GetAccepted(),
+			Incoming:     friend.GetIncoming(),
+		})
+	}
+
+	return output
+}
+
+// ConvertGetFriendsResponseToAcceptedListEntries converts provided metadatav1.GetFriendsResponse instance
+// to an array of dto.FriendsListEntry list entries used by UI component, skipping pending friend requests.
+func ConvertGetFriendsResponseToAcceptedListEntries(
+	input *metadatav1.GetFriendsResponse) []interface{} {
+	var output []interface{}
+
+	for _, friend := range input.GetFriends() {
+		if !friend.GetAccepted() {
+			continue
+		}
+
+		output = append(output, dto.FriendsListEntry{
+			FriendshipID: friend.GetFriendshipId(),
+			DisplayName:  friend.GetDisplayName(),
+			Accepted:     friend.GetAccepted(),
+			Incoming:     friend.GetIncoming(),
+		})
 	}
 
 	return output
 }
 
-// ConvertPositionsToStartSessionSpawnables converts provided dto.Position array
-// to an array of metadatav1.Positions used as spawnables.
-func ConvertPositionsToStartSessionSpawnables(input []dto.Position) []*metadatav1.Position {
+// ConvertGetInvitationsResponseToRetrievedInvitations converts provided metadatav1.GetInvitationsResponse
+// instance to an array of dto.RetrievedInvitation.
+func ConvertGetInvitationsResponseToRetrievedInvitations(
+	input *metadatav1.GetInvitationsResponse) []dto.RetrievedInvitation {
+	var output []dto.RetrievedInvitation
+
+	for _, invitation := range input.GetInvitations() {
+		output = append(output, dto.RetrievedInvitation{
+			DisplayName: invitation.GetDisplayName(),
+			SessionID:   invitation.GetSessionId(),
+			SessionName: invitation.GetSessionName(),
+			Seed:        invitation.GetSeed(),
+			InviteCode:  invitation.GetInviteCode(),
+		})
+	}
+
+	return output
+}
+
+// ConvertSpawnableTilesToStartSessionSpawnables converts provided dto.SpawnableTile array
+// to an array of metadatav1.Positions used as spawnables, skipping team tagged ones.
+func ConvertSpawnableTilesToStartSessionSpawnables(input []dto.SpawnableTile) []*metadatav1.Position {
 	var output []*metadatav1.Position
 
-	for _, lobby := range input {
+	for _, spawnable := range input {
+		if spawnable.Team != 0 {
+			continue
+		}
+
 		output = append(output, &metadatav1.Position{
-			X: lobby.X,
-			Y: lobby.Y,
+			X: spawnable.Position.X,
+			Y: spawnable.Position.Y,
+		})
+	}
+
+	return output
+}
+
+// ConvertSpawnableTilesToStartSessionTeamSpawnables converts team tagged tiles of the provided
+// dto.SpawnableTile array to an array of metadatav1.Spawnable used as team spawnables.
+func ConvertSpawnableTilesToStartSessionTeamSpawnables(input []dto.SpawnableTile) []*metadatav1.Spawnable {
+	var output []*metadatav1.Spawnable
+
+	for _, spawnable := range input {
+		if spawnable.Team == 0 {
+			continue
+		}
+
+		output = append(output, &metadatav1.Spawnable{
+			Position: &metadatav1.Position{
+				X: spawnable.Position.X,
+				Y: spawnable.Position.Y,
+			},
+			Team: spawnable.Team,
 		})
 	}
 
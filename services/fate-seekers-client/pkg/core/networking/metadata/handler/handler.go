@@ -3,6 +3,8 @@ package handler
 import (
 	"context"
 	"errors"
+	"io"
+	"time"
 
 	metadatav1 "github.com/YarikRevich/fate-seekers/services/fate-seekers-client/pkg/core/networking/metadata/api"
 	"github.com/YarikRevich/fate-seekers/services/fate-seekers-client/pkg/core/networking/metadata/common"
@@ -21,12 +23,13 @@ var (
 	ErrLobbyAlreadyStarted         = errors.New("err happened lobby already started")
 	ErrLobbyAlreadyExists          = errors.New("err happened lobby already exists")
 	ErrFilteredSessionDoesNotExist = errors.New("err happened filtered session does not exist")
+	ErrSessionAccessDenied         = errors.New("err happened session access denied")
 )
 
 // PerformPingConnection performs ping connection request.
-func PerformPingConnection(callback func(err error)) {
+func PerformPingConnection(callback func(response *metadatav1.PingConnectionResponse, err error)) {
 	go func() {
-		_, err := connector.
+		response, err := connector.
 			GetInstance().
 			GetClient().
 			PingConnection(
@@ -37,24 +40,24 @@ func PerformPingConnection(callback func(err error)) {
 
 		if err != nil {
 			if status.Code(err) == codes.Unavailable {
-				callback(common.ErrConnectionLost)
+				callback(nil, common.ErrConnectionLost)
 
 				return
 			}
 
 			errRaw, ok := status.FromError(err)
 			if !ok {
-				callback(err)
+				callback(nil, err)
 
 				return
 			}
 
-			callback(errors.New(errRaw.Message()))
+			callback(nil, errors.New(errRaw.Message()))
 
 			return
 		}
 
-		callback(nil)
+		callback(response, nil)
 	}()
 }
 
@@ -102,6 +105,97 @@ func PerformCreateUserIfNotExists(callback func(err error)) {
 	}()
 }
 
+// PerformGetProfile performs user profile retrieval request.
+func PerformGetProfile(callback func(response *metadatav1.GetProfileResponse, err error)) {
+	go func() {
+		response, err := connector.
+			GetInstance().
+			GetClient().
+			GetProfile(
+				context.Background(),
+				&metadatav1.GetProfileRequest{
+					Issuer: store.GetRepositoryUUID(),
+				})
+
+		if err != nil {
+			if status.Code(err) == codes.Unavailable {
+				dispatcher.
+					GetInstance().
+					Dispatch(
+						action.NewSetStateResetApplicationAction(
+							value.STATE_RESET_APPLICATION_FALSE_VALUE))
+
+				dispatcher.GetInstance().Dispatch(
+					action.NewSetActiveScreenAction(value.ACTIVE_SCREEN_MENU_VALUE))
+
+				callback(nil, common.ErrConnectionLost)
+
+				return
+			}
+
+			errRaw, ok := status.FromError(err)
+			if !ok {
+				callback(nil, err)
+
+				return
+			}
+
+			callback(nil, errors.New(errRaw.Message()))
+
+			return
+		}
+
+		callback(response, nil)
+	}()
+}
+
+// PerformUpdateProfile performs user profile modification request.
+func PerformUpdateProfile(displayName string, skin, avatar uint64, callback func(err error)) {
+	go func() {
+		_, err := connector.
+			GetInstance().
+			GetClient().
+			UpdateProfile(
+				context.Background(),
+				&metadatav1.UpdateProfileRequest{
+					Issuer:      store.GetRepositoryUUID(),
+					DisplayName: displayName,
+					Skin:        skin,
+					Avatar:      avatar,
+				})
+
+		if err != nil {
+			if status.Code(err) == codes.Unavailable {
+				dispatcher.
+					GetInstance().
+					Dispatch(
+						action.NewSetStateResetApplicationAction(
+							value.STATE_RESET_APPLICATION_FALSE_VALUE))
+
+				dispatcher.GetInstance().Dispatch(
+					action.NewSetActiveScreenAction(value.ACTIVE_SCREEN_MENU_VALUE))
+
+				callback(common.ErrConnectionLost)
+
+				return
+			}
+
+			errRaw, ok := status.FromError(err)
+			if !ok {
+				callback(err)
+
+				return
+			}
+
+			callback(errors.New(errRaw.Message()))
+
+			return
+		}
+
+		callback(nil)
+	}()
+}
+
 // PerformGetUserSessions performs user sessions retrieval request.
 func PerformGetUserSessions(callback func(response *metadatav1.GetUserSessionsResponse, err error)) {
 	go func() {
@@ -196,8 +290,103 @@ func PerformGetFilteredSessions(request dto.GetFilteredSessionsRequest, callback
 	}()
 }
 
-// PerformCreateSession performs session creation request.
-func PerformCreateSession(name string, seed uint64, callback func(err error)) {
+// PerformListPublicSessions performs public sessions page retrieval request with the given filters.
+func PerformListPublicSessions(
+	request dto.ListPublicSessionsRequest,
+	callback func(response *metadatav1.ListPublicSessionsResponse, err error)) {
+	go func() {
+		response, err := connector.
+			GetInstance().
+			GetClient().
+			ListPublicSessions(
+				context.Background(),
+				&metadatav1.ListPublicSessionsRequest{
+					Issuer:    store.GetRepositoryUUID(),
+					Page:      request.Page,
+					Sort:      request.Sort,
+					Rules:     request.Rules,
+					FreeSlots: request.FreeSlots,
+				})
+
+		if err != nil {
+			if status.Code(err) == codes.Unavailable {
+				dispatcher.
+					GetInstance().
+					Dispatch(
+						action.NewSetStateResetApplicationAction(
+							value.STATE_RESET_APPLICATION_FALSE_VALUE))
+
+				dispatcher.GetInstance().Dispatch(
+					action.NewSetActiveScreenAction(value.ACTIVE_SCREEN_MENU_VALUE))
+
+				callback(nil, common.ErrConnectionLost)
+
+				return
+			}
+
+			errRaw, ok := status.FromError(err)
+			if !ok {
+				callback(nil, err)
+
+				return
+			}
+
+			callback(nil, errors.New(errRaw.Message()))
+
+			return
+		}
+
+		callback(response, nil)
+	}()
+}
+
+// PerformLeaveMatchmaking performs matchmaking queue leave request.
+func PerformLeaveMatchmaking(callback func(err error)) {
+	go func() {
+		_, err := connector.
+			GetInstance().
+			GetClient().
+			LeaveMatchmaking(
+				context.Background(),
+				&metadatav1.LeaveMatchmakingRequest{
+					Issuer: store.GetRepositoryUUID(),
+				})
+
+		if err != nil {
+			if status.Code(err) == codes.Unavailable {
+				dispatcher.
+					GetInstance().
+					Dispatch(
+						action.NewSetStateResetApplicationAction(
+							value.STATE_RESET_APPLICATION_FALSE_VALUE))
+
+				dispatcher.GetInstance().Dispatch(
+					action.NewSetActiveScreenAction(value.ACTIVE_SCREEN_MENU_VALUE))
+
+				callback(common.ErrConnectionLost)
+
+				return
+			}
+
+			errRaw, ok := status.FromError(err)
+			if !ok {
+				callback(err)
+
+				return
+			}
+
+			callback(errors.New(errRaw.Message()))
+
+			return
+		}
+
+		callback(nil)
+	}()
+}
+
+// PerformCreateSession performs session creation request with the given rules preset.
+// Session is created private, when the given password is not empty.
+func PerformCreateSession(name string, seed uint64, rules, password string, callback func(err error)) {
 	go func() {
 		_, err := connector.
 			GetInstance().
@@ -205,9 +394,11 @@ func PerformCreateSession(name string, seed uint64, callback func(err error)) {
 			CreateSession(
 				context.Background(),
 				&metadatav1.CreateSessionRequest{
-					Name:   name,
-					Issuer: store.GetRepositoryUUID(),
-					Seed:   &seed,
+					Name:     name,
+					Issuer:   store.GetRepositoryUUID(),
+					Seed:     &seed,
+					Rules:    rules,
+					Password: password,
 				})
 
 		if err != nil {
@@ -294,6 +485,7 @@ func PerformStartSession(
 	spawnables,
 	chestLocations,
 	healthPackLocations []*metadatav1.Position,
+	teamSpawnables []*metadatav1.Spawnable,
 	callback func(err error)) {
 	go func() {
 		_, err := connector.
@@ -308,6 +500,7 @@ func PerformStartSession(
 					Spawnables:          spawnables,
 					ChestLocations:      chestLocations,
 					HealthPackLocations: healthPackLocations,
+					TeamSpawnables:      teamSpawnables,
 				})
 
 		if err != nil {
@@ -342,8 +535,10 @@ func PerformStartSession(
 	}()
 }
 
-// PerformCreateLobby performs lobby creation request.
-func PerformCreateLobby(sessionID int64, callback func(err error)) {
+// PerformCreateLobby performs lobby creation request. Password or invite code
+// are required only to join private sessions.
+func PerformCreateLobby(
+	sessionID int64, spectate bool, password, inviteCode string, callback func(err error)) {
 	go func() {
 		_, err := connector.
 			GetInstance().
@@ -351,8 +546,11 @@ func PerformCreateLobby(sessionID int64, callback func(err error)) {
 			CreateLobby(
 				context.Background(),
 				&metadatav1.CreateLobbyRequest{
-					Issuer:    store.GetRepositoryUUID(),
-					SessionId: sessionID,
+					Issuer:     store.GetRepositoryUUID(),
+					SessionId:  sessionID,
+					Spectate:   spectate,
+					Password:   password,
+					InviteCode: inviteCode,
 				})
 
 		if err != nil {
@@ -383,6 +581,12 @@ func PerformCreateLobby(sessionID int64, callback func(err error)) {
 				return
 			}
 
+			if status.Code(err) == codes.PermissionDenied {
+				callback(ErrSessionAccessDenied)
+
+				return
+			}
+
 			errRaw, ok := status.FromError(err)
 			if !ok {
 				callback(err)
@@ -399,17 +603,21 @@ func PerformCreateLobby(sessionID int64, callback func(err error)) {
 	}()
 }
 
-// PerformRemoveLobby performs lobby removal request.
-func PerformRemoveLobby(sessionID int64, callback func(err error)) {
+// PerformSetLobbyReady performs lobby ready flag update request. Session start countdown in seconds
+// is started, when the given countdown is not zero, which is allowed only for the host.
+func PerformSetLobbyReady(sessionID, lobbyID int64, ready bool, countdown uint64, callback func(err error)) {
 	go func() {
 		_, err := connector.
 			GetInstance().
 			GetClient().
-			RemoveLobby(
+			SetLobbyReady(
 				context.Background(),
-				&metadatav1.RemoveLobbyRequest{
+				&metadatav1.SetLobbyReadyRequest{
 					SessionId: sessionID,
+					LobbyId:   lobbyID,
 					Issuer:    store.GetRepositoryUUID(),
+					Ready:     ready,
+					Countdown: countdown,
 				})
 
 		if err != nil {
@@ -444,17 +652,21 @@ func PerformRemoveLobby(sessionID int64, callback func(err error)) {
 	}()
 }
 
-// PerformLeaveLobby performs lobby leave request.
-func PerformLeaveLobby(sessionID int64, callback func(err error)) {
+// PerformSetLobbyTeam performs lobby team update request. All the lobby teams are auto-balanced
+// instead, when balance is requested, which is allowed only for the host.
+func PerformSetLobbyTeam(sessionID, lobbyID int64, team uint64, balance bool, callback func(err error)) {
 	go func() {
 		_, err := connector.
 			GetInstance().
 			GetClient().
-			LeaveLobby(
+			SetLobbyTeam(
 				context.Background(),
-				&metadatav1.LeaveLobbyRequest{
+				&metadatav1.SetLobbyTeamRequest{
 					SessionId: sessionID,
+					LobbyId:   lobbyID,
 					Issuer:    store.GetRepositoryUUID(),
+					Team:      team,
+					Balance:   balance,
 				})
 
 		if err != nil {
@@ -489,20 +701,18 @@ func PerformLeaveLobby(sessionID int64, callback func(err error)) {
 	}()
 }
 
-// PerformTakeChestItem performs chest item take request.
-func PerformTakeChestItem(sessionID, lobbyID, chestID, chestItemID int64, callback func(err error)) {
+// PerformSendChatMessage performs chat message send request to the session with the given id.
+func PerformSendChatMessage(sessionID int64, text string, callback func(err error)) {
 	go func() {
 		_, err := connector.
 			GetInstance().
 			GetClient().
-			TakeChestItem(
+			SendChatMessage(
 				context.Background(),
-				&metadatav1.TakeChestItemRequest{
-					SessionId:     sessionID,
-					LobbyId:       lobbyID,
-					GenerationId:  chestID,
-					AssociationId: chestItemID,
-					Issuer:        store.GetRepositoryUUID(),
+				&metadatav1.SendChatMessageRequest{
+					SessionId: sessionID,
+					Issuer:    store.GetRepositoryUUID(),
+					Text:      text,
 				})
 
 		if err != nil {
@@ -537,18 +747,17 @@ func PerformTakeChestItem(sessionID, lobbyID, chestID, chestItemID int64, callba
 	}()
 }
 
-// PerformTakeHealthPack performs health pack take request.
-func PerformTakeHealthPack(sessionID, healthPackID int64, callback func(err error)) {
+// PerformRemoveLobby performs lobby removal request.
+func PerformRemoveLobby(sessionID int64, callback func(err error)) {
 	go func() {
 		_, err := connector.
 			GetInstance().
 			GetClient().
-			TakeHealthPack(
+			RemoveLobby(
 				context.Background(),
-				&metadatav1.TakeHealthPackRequest{
-					SessionId:    sessionID,
-					GenerationId: healthPackID,
-					Issuer:       store.GetRepositoryUUID(),
+				&metadatav1.RemoveLobbyRequest{
+					SessionId: sessionID,
+					Issuer:    store.GetRepositoryUUID(),
 				})
 
 		if err != nil {
@@ -583,18 +792,17 @@ func PerformTakeHealthPack(sessionID, healthPackID int64, callback func(err erro
 	}()
 }
 
-// PerformOpenHealthPack performs health pack open operation request.
-func PerformOpenHealthPack(sessionID, inventoryID int64, callback func(err error)) {
+// PerformLeaveLobby performs lobby leave request.
+func PerformLeaveLobby(sessionID int64, callback func(err error)) {
 	go func() {
 		_, err := connector.
 			GetInstance().
 			GetClient().
-			OpenHealthPack(
+			LeaveLobby(
 				context.Background(),
-				&metadatav1.OpenHealthPackRequest{
-					SessionId:   sessionID,
-					InventoryId: inventoryID,
-					Issuer:      store.GetRepositoryUUID(),
+				&metadatav1.LeaveLobbyRequest{
+					SessionId: sessionID,
+					Issuer:    store.GetRepositoryUUID(),
 				})
 
 		if err != nil {
@@ -629,17 +837,486 @@ func PerformOpenHealthPack(sessionID, inventoryID int64, callback func(err error
 	}()
 }
 
-// PerformDropInventoryItem performs drop inventory item operation request.
-func PerformDropInventoryItem(inventoryID int64, callback func(err error)) {
+// PerformTakeChestItem performs chest item take request.
+func PerformTakeChestItem(sessionID, lobbyID, chestID, chestItemID int64, callback func(err error)) {
 	go func() {
 		_, err := connector.
 			GetInstance().
 			GetClient().
-			DropInventoryItem(
+			TakeChestItem(
 				context.Background(),
-				&metadatav1.DropInventoryItemRequest{
-					InventoryId: inventoryID,
-					Issuer:      store.GetRepositoryUUID(),
+				&metadatav1.TakeChestItemRequest{
+					SessionId:     sessionID,
+					LobbyId:       lobbyID,
+					GenerationId:  chestID,
+					AssociationId: chestItemID,
+					Issuer:        store.GetRepositoryUUID(),
+				})
+
+		if err != nil {
+			if status.Code(err) == codes.Unavailable {
+				dispatcher.
+					GetInstance().
+					Dispatch(
+						action.NewSetStateResetApplicationAction(
+							value.STATE_RESET_APPLICATION_FALSE_VALUE))
+
+				dispatcher.GetInstance().Dispatch(
+					action.NewSetActiveScreenAction(value.ACTIVE_SCREEN_MENU_VALUE))
+
+				callback(common.ErrConnectionLost)
+
+				return
+			}
+
+			errRaw, ok := status.FromError(err)
+			if !ok {
+				callback(err)
+
+				return
+			}
+
+			callback(errors.New(errRaw.Message()))
+
+			return
+		}
+
+		callback(nil)
+	}()
+}
+
+// PerformTakeHealthPack performs health pack take request.
+func PerformTakeHealthPack(sessionID, healthPackID int64, callback func(err error)) {
+	go func() {
+		_, err := connector.
+			GetInstance().
+			GetClient().
+			TakeHealthPack(
+				context.Background(),
+				&metadatav1.TakeHealthPackRequest{
+					SessionId:    sessionID,
+					GenerationId: healthPackID,
+					Issuer:       store.GetRepositoryUUID(),
+				})
+
+		if err != nil {
+			if status.Code(err) == codes.Unavailable {
+				dispatcher.
+					GetInstance().
+					Dispatch(
+						action.NewSetStateResetApplicationAction(
+							value.STATE_RESET_APPLICATION_FALSE_VALUE))
+
+				dispatcher.GetInstance().Dispatch(
+					action.NewSetActiveScreenAction(value.ACTIVE_SCREEN_MENU_VALUE))
+
+				callback(common.ErrConnectionLost)
+
+				return
+			}
+
+			errRaw, ok := status.FromError(err)
+			if !ok {
+				callback(err)
+
+				return
+			}
+
+			callback(errors.New(errRaw.Message()))
+
+			return
+		}
+
+		callback(nil)
+	}()
+}
+
+// PerformOpenHealthPack performs health pack open operation request.
+func PerformOpenHealthPack(sessionID, inventoryID int64, callback func(err error)) {
+	go func() {
+		_, err := connector.
+			GetInstance().
+			GetClient().
+			OpenHealthPack(
+				context.Background(),
+				&metadatav1.OpenHealthPackRequest{
+					SessionId:   sessionID,
+					InventoryId: inventoryID,
+					Issuer:      store.GetRepositoryUUID(),
+				})
+
+		if err != nil {
+			if status.Code(err) == codes.Unavailable {
+				dispatcher.
+					GetInstance().
+					Dispatch(
+						action.NewSetStateResetApplicationAction(
+							value.STATE_RESET_APPLICATION_FALSE_VALUE))
+
+				dispatcher.GetInstance().Dispatch(
+					action.NewSetActiveScreenAction(value.ACTIVE_SCREEN_MENU_VALUE))
+
+				callback(common.ErrConnectionLost)
+
+				return
+			}
+
+			errRaw, ok := status.FromError(err)
+			if !ok {
+				callback(err)
+
+				return
+			}
+
+			callback(errors.New(errRaw.Message()))
+
+			return
+		}
+
+		callback(nil)
+	}()
+}
+
+// PerformDropInventoryItem performs drop inventory item operation request.
+func PerformDropInventoryItem(inventoryID int64, callback func(err error)) {
+	go func() {
+		_, err := connector.
+			GetInstance().
+			GetClient().
+			DropInventoryItem(
+				context.Background(),
+				&metadatav1.DropInventoryItemRequest{
+					InventoryId: inventoryID,
+					Issuer:      store.GetRepositoryUUID(),
+				})
+
+		if err != nil {
+			if status.Code(err) == codes.Unavailable {
+				dispatcher.
+					GetInstance().
+					Dispatch(
+						action.NewSetStateResetApplicationAction(
+							value.STATE_RESET_APPLICATION_FALSE_VALUE))
+
+				dispatcher.GetInstance().Dispatch(
+					action.NewSetActiveScreenAction(value.ACTIVE_SCREEN_MENU_VALUE))
+
+				callback(common.ErrConnectionLost)
+
+				return
+			}
+
+			errRaw, ok := status.FromError(err)
+			if !ok {
+				callback(err)
+
+				return
+			}
+
+			callback(errors.New(errRaw.Message()))
+
+			return
+		}
+
+		callback(nil)
+	}()
+}
+
+// PerformGetReplay performs replay retrieval request, collecting all the streamed replay frames.
+func PerformGetReplay(sessionID int64, callback func(frames []*metadatav1.ReplayFrame, err error)) {
+	go func() {
+		var frames []*metadatav1.ReplayFrame
+
+		stream, err := connector.
+			GetInstance().
+			GetClient().
+			GetReplay(
+				context.Background(),
+				&metadatav1.GetReplayRequest{
+					SessionId: sessionID,
+					Issuer:    store.GetRepositoryUUID(),
+				})
+
+		for err == nil {
+			var response *metadatav1.GetReplayResponse
+
+			response, err = stream.Recv()
+			if err == nil {
+				frames = append(frames, response.GetFrames()...)
+			}
+		}
+
+		if err != io.EOF {
+			if status.Code(err) == codes.Unavailable {
+				dispatcher.
+					GetInstance().
+					Dispatch(
+						action.NewSetStateResetApplicationAction(
+							value.STATE_RESET_APPLICATION_FALSE_VALUE))
+
+				dispatcher.GetInstance().Dispatch(
+					action.NewSetActiveScreenAction(value.ACTIVE_SCREEN_MENU_VALUE))
+
+				callback(nil, common.ErrConnectionLost)
+
+				return
+			}
+
+			errRaw, ok := status.FromError(err)
+			if !ok {
+				callback(nil, err)
+
+				return
+			}
+
+			callback(nil, errors.New(errRaw.Message()))
+
+			return
+		}
+
+		callback(frames, nil)
+	}()
+}
+
+// PerformCreateSessionInvite performs invite code creation request for the private session.
+func PerformCreateSessionInvite(
+	sessionID int64, singleUse bool, expiration time.Duration, callback func(code string, err error)) {
+	go func() {
+		response, err := connector.
+			GetInstance().
+			GetClient().
+			CreateSessionInvite(
+				context.Background(),
+				&metadatav1.CreateSessionInviteRequest{
+					SessionId:  sessionID,
+					Issuer:     store.GetRepositoryUUID(),
+					SingleUse:  singleUse,
+					Expiration: uint64(expiration.Seconds()),
+				})
+
+		if err != nil {
+			if status.Code(err) == codes.Unavailable {
+				dispatcher.
+					GetInstance().
+					Dispatch(
+						action.NewSetStateResetApplicationAction(
+							value.STATE_RESET_APPLICATION_FALSE_VALUE))
+
+				dispatcher.GetInstance().Dispatch(
+					action.NewSetActiveScreenAction(value.ACTIVE_SCREEN_MENU_VALUE))
+
+				callback("", common.ErrConnectionLost)
+
+				return
+			}
+
+			errRaw, ok := status.FromError(err)
+			if !ok {
+				callback("", err)
+
+				return
+			}
+
+			callback("", errors.New(errRaw.Message()))
+
+			return
+		}
+
+		callback(response.GetCode(), nil)
+	}()
+}
+
+// PerformGetFriends performs friends and pending friend requests retrieval request.
+func PerformGetFriends(callback func(response *metadatav1.GetFriendsResponse, err error)) {
+	go func() {
+		response, err := connector.
+			GetInstance().
+			GetClient().
+			GetFriends(
+				context.Background(),
+				&metadatav1.GetFriendsRequest{
+					Issuer: store.GetRepositoryUUID(),
+				})
+
+		if err != nil {
+			if status.Code(err) == codes.Unavailable {
+				dispatcher.
+					GetInstance().
+					Dispatch(
+						action.NewSetStateResetApplicationAction(
+							value.STATE_RESET_APPLICATION_FALSE_VALUE))
+
+				dispatcher.GetInstance().Dispatch(
+					action.NewSetActiveScreenAction(value.ACTIVE_SCREEN_MENU_VALUE))
+
+				callback(nil, common.ErrConnectionLost)
+
+				return
+			}
+
+			errRaw, ok := status.FromError(err)
+			if !ok {
+				callback(nil, err)
+
+				return
+			}
+
+			callback(nil, errors.New(errRaw.Message()))
+
+			return
+		}
+
+		callback(response, nil)
+	}()
+}
+
+// PerformSendFriendRequest performs friend request send to the user with the provided display name.
+func PerformSendFriendRequest(displayName string, callback func(err error)) {
+	go func() {
+		_, err := connector.
+			GetInstance().
+			GetClient().
+			SendFriendRequest(
+				context.Background(),
+				&metadatav1.SendFriendRequestRequest{
+					Issuer:      store.GetRepositoryUUID(),
+					DisplayName: displayName,
+				})
+
+		if err != nil {
+			if status.Code(err) == codes.Unavailable {
+				dispatcher.
+					GetInstance().
+					Dispatch(
+						action.NewSetStateResetApplicationAction(
+							value.STATE_RESET_APPLICATION_FALSE_VALUE))
+
+				dispatcher.GetInstance().Dispatch(
+					action.NewSetActiveScreenAction(value.ACTIVE_SCREEN_MENU_VALUE))
+
+				callback(common.ErrConnectionLost)
+
+				return
+			}
+
+			errRaw, ok := status.FromError(err)
+			if !ok {
+				callback(err)
+
+				return
+			}
+
+			callback(errors.New(errRaw.Message()))
+
+			return
+		}
+
+		callback(nil)
+	}()
+}
+
+// PerformAcceptFriendRequest performs incoming friend request accept request.
+func PerformAcceptFriendRequest(friendshipID int64, callback func(err error)) {
+	go func() {
+		_, err := connector.
+			GetInstance().
+			GetClient().
+			AcceptFriendRequest(
+				context.Background(),
+				&metadatav1.AcceptFriendRequestRequest{
+					Issuer:       store.GetRepositoryUUID(),
+					FriendshipId: friendshipID,
+				})
+
+		if err != nil {
+			if status.Code(err) == codes.Unavailable {
+				dispatcher.
+					GetInstance().
+					Dispatch(
+						action.NewSetStateResetApplicationAction(
+							value.STATE_RESET_APPLICATION_FALSE_VALUE))
+
+				dispatcher.GetInstance().Dispatch(
+					action.NewSetActiveScreenAction(value.ACTIVE_SCREEN_MENU_VALUE))
+
+				callback(common.ErrConnectionLost)
+
+				return
+			}
+
+			errRaw, ok := status.FromError(err)
+			if !ok {
+				callback(err)
+
+				return
+			}
+
+			callback(errors.New(errRaw.Message()))
+
+			return
+		}
+
+		callback(nil)
+	}()
+}
+
+// PerformRemoveFriend performs friend or pending friend request removal request.
+func PerformRemoveFriend(friendshipID int64, callback func(err error)) {
+	go func() {
+		_, err := connector.
+			GetInstance().
+			GetClient().
+			RemoveFriend(
+				context.Background(),
+				&metadatav1.RemoveFriendRequest{
+					Issuer:       store.GetRepositoryUUID(),
+					FriendshipId: friendshipID,
+				})
+
+		if err != nil {
+			if status.Code(err) == codes.Unavailable {
+				dispatcher.
+					GetInstance().
+					Dispatch(
+						action.NewSetStateResetApplicationAction(
+							value.STATE_RESET_APPLICATION_FALSE_VALUE))
+
+				dispatcher.GetInstance().Dispatch(
+					action.NewSetActiveScreenAction(value.ACTIVE_SCREEN_MENU_VALUE))
+
+				callback(common.ErrConnectionLost)
+
+				return
+			}
+
+			errRaw, ok := status.FromError(err)
+			if !ok {
+				callback(err)
+
+				return
+			}
+
+			callback(errors.New(errRaw.Message()))
+
+			return
+		}
+
+		callback(nil)
+	}()
+}
+
+// PerformInviteFriend performs friend invitation to the session with the provided id.
+func PerformInviteFriend(sessionID, friendshipID int64, callback func(err error)) {
+	go func() {
+		_, err := connector.
+			GetInstance().
+			GetClient().
+			InviteFriend(
+				context.Background(),
+				&metadatav1.InviteFriendRequest{
+					Issuer:       store.GetRepositoryUUID(),
+					SessionId:    sessionID,
+					FriendshipId: friendshipID,
 				})
 
 		if err != nil {
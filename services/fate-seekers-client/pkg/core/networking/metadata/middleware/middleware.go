@@ -2,10 +2,14 @@ package middleware
 
 import (
 	"context"
+	"fmt"
+	"time"
 
 	"buf.build/go/protovalidate"
 	"github.com/YarikRevich/fate-seekers/services/fate-seekers-client/pkg/config"
+	"github.com/YarikRevich/fate-seekers/services/fate-seekers-client/pkg/core/ui/manager/translation"
 	"github.com/pkg/errors"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
@@ -17,6 +21,13 @@ const (
 	AuthenticationHeader = "authentication"
 )
 
+// Represents all the error details used for ban description.
+const (
+	BanErrorReason          = "ISSUER_BANNED"
+	BanReasonMetadataKey    = "reason"
+	BanExpiresAtMetadataKey = "expires_at"
+)
+
 var (
 	ErrMessageValidationFailed = errors.New("err happened message validation failed")
 )
@@ -52,3 +63,49 @@ func CheckValidationMiddleware(
 
 	return invoker(ctx, method, req, reply, cc, opts...)
 }
+
+// CheckModerationMiddleware represents moderation middleware, which converts ban rejection to a translated message.
+func CheckModerationMiddleware(
+	ctx context.Context,
+	method string,
+	req, reply interface{},
+	cc *grpc.ClientConn,
+	invoker grpc.UnaryInvoker,
+	opts ...grpc.CallOption,
+) error {
+	err := invoker(ctx, method, req, reply, cc, opts...)
+	if status.Code(err) != codes.PermissionDenied {
+		return err
+	}
+
+	errRaw, ok := status.FromError(err)
+	if !ok {
+		return err
+	}
+
+	for _, detail := range errRaw.Details() {
+		info, ok := detail.(*errdetails.ErrorInfo)
+		if !ok || info.GetReason() != BanErrorReason {
+			continue
+		}
+
+		message := translation.GetInstance().GetTranslation("client.networking.banned")
+
+		if reason := info.GetMetadata()[BanReasonMetadataKey]; reason != "" {
+			message = fmt.Sprintf("%s: %s", message, reason)
+		}
+
+		if expiresAt, err := time.Parse(
+			time.RFC3339, info.GetMetadata()[BanExpiresAtMetadataKey]); err == nil {
+			message = fmt.Sprintf(
+				"%s (%s %s)",
+				message,
+				translation.GetInstance().GetTranslation("client.networking.banned-until"),
+				expiresAt.Local().Format(time.DateTime))
+		}
+
+		return status.Error(codes.PermissionDenied, message)
+	}
+
+	return err
+}
@@ -4,7 +4,11 @@ import (
 	"sync"
 	"time"
 
+	metadatav1 "github.com/YarikRevich/fate-seekers/services/fate-seekers-client/pkg/core/networking/metadata/api"
 	"github.com/YarikRevich/fate-seekers/services/fate-seekers-client/pkg/core/networking/metadata/handler"
+	"github.com/YarikRevich/fate-seekers/services/fate-seekers-client/pkg/core/ui/component/common"
+	"github.com/YarikRevich/fate-seekers/services/fate-seekers-client/pkg/core/ui/manager/notification"
+	"github.com/YarikRevich/fate-seekers/services/fate-seekers-client/pkg/core/ui/manager/translation"
 	"github.com/YarikRevich/fate-seekers/services/fate-seekers-client/pkg/logging"
 	"github.com/YarikRevich/fate-seekers/services/fate-seekers-client/pkg/state/action"
 	"github.com/YarikRevich/fate-seekers/services/fate-seekers-client/pkg/state/dispatcher"
@@ -18,6 +22,9 @@ var (
 const (
 	// Describes metadata ping worker ticker duration.
 	metadataPingTimer = time.Second
+
+	// Describes metadata broadcast notification duration.
+	metadataBroadcastDuration = time.Second * 5
 )
 
 // NetworkingMetadataPing represents networking metadata ping worker.
@@ -44,7 +51,7 @@ func (nmp *NetworkingMetadataPing) Run() {
 
 				start := time.Now()
 
-				handler.PerformPingConnection(func(err error) {
+				handler.PerformPingConnection(func(response *metadatav1.PingConnectionResponse, err error) {
 					if err != nil {
 						logging.GetInstance().Error(err.Error())
 
@@ -57,6 +64,15 @@ func (nmp *NetworkingMetadataPing) Run() {
 						action.NewSetStatisticsMetadataPing(
 							time.Since(start).Milliseconds()))
 
+					for _, message := range response.GetMessages() {
+						notification.GetInstance().Push(
+							common.ComposeMessage(
+								translation.GetInstance().GetTranslation("client.networking.broadcast"),
+								message),
+							metadataBroadcastDuration,
+							common.NotificationInfoTextColor)
+					}
+
 					wg.Done()
 				})
 
@@ -3,6 +3,7 @@ package stream
 import (
 	"context"
 	"errors"
+	"io"
 	"sync"
 
 	metadatav1 "github.com/YarikRevich/fate-seekers/services/fate-seekers-client/pkg/core/networking/metadata/api"
@@ -37,6 +38,18 @@ var (
 
 	// GetGetHealthPacksSubmitter retrieves instance of the health packs retrieval submitter, performing initial creation if needed.
 	GetGetHealthPacksSubmitter = sync.OnceValue[*getHealthPacksSubmitter](newGetHealthPacksSubmitter)
+
+	// GetJoinMatchmakingSubmitter retrieves instance of the matchmaking queue submitter, performing initial creation if needed.
+	GetJoinMatchmakingSubmitter = sync.OnceValue[*joinMatchmakingSubmitter](newJoinMatchmakingSubmitter)
+
+	// GetGetChatMessagesSubmitter retrieves instance of the get chat messages submitter, performing initial creation if needed.
+	GetGetChatMessagesSubmitter = sync.OnceValue[*getChatMessagesSubmitter](newGetChatMessagesSubmitter)
+
+	// GetGetEmotesSubmitter retrieves instance of the get emotes submitter, performing initial creation if needed.
+	GetGetEmotesSubmitter = sync.OnceValue[*getEmotesSubmitter](newGetEmotesSubmitter)
+
+	// GetGetInvitationsSubmitter retrieves instance of the get invitations submitter, performing initial creation if needed.
+	GetGetInvitationsSubmitter = sync.OnceValue[*getInvitationsSubmitter](newGetInvitationsSubmitter)
 )
 
 // updateSessionsActivitySubmitter represents update sessions activity submitter.
@@ -700,3 +713,426 @@ func (ghps *getHealthPacksSubmitter) Clean(callback func()) {
 func newGetHealthPacksSubmitter() *getHealthPacksSubmitter {
 	return new(getHealthPacksSubmitter)
 }
+
+// joinMatchmakingSubmitter represents matchmaking queue submitter.
+type joinMatchmakingSubmitter struct {
+	// Represents general context used to manage submitted context.
+	ctx context.Context
+
+	// Represents channel, which is used to close the submitted action.
+	cancel context.CancelFunc
+}
+
+// close performs stream submitter close operation.
+func (jms *joinMatchmakingSubmitter) close() {
+	if jms.ctx != nil {
+		select {
+		case <-jms.ctx.Done():
+		default:
+			jms.cancel()
+		}
+	}
+}
+
+// Submit performs a submittion of matchmaking queue join action with the given rules preset. Stream
+// is finished by the server, when match is found. Callback is required to return boolean value,
+// which defines whether submitter should be closed or not.
+func (jms *joinMatchmakingSubmitter) Submit(rules string, callback func(response *metadatav1.JoinMatchmakingResponse, err error) bool) {
+	jms.ctx, jms.cancel = context.WithCancel(context.Background())
+
+	go func() {
+		stream, err := connector.
+			GetInstance().
+			GetClient().
+			JoinMatchmaking(
+				jms.ctx,
+				&metadatav1.JoinMatchmakingRequest{
+					Issuer: store.GetRepositoryUUID(),
+					Rules:  rules,
+				})
+		if err != nil {
+			if callback(nil, err) {
+				jms.close()
+			}
+
+			return
+		}
+
+		for {
+			response, err := stream.Recv()
+			if err != nil {
+				if errors.Is(err, io.EOF) || status.Code(err) == codes.Canceled {
+					return
+				}
+
+				if status.Code(err) == codes.Unavailable {
+					dispatcher.
+						GetInstance().
+						Dispatch(
+							action.NewSetStateResetApplicationAction(
+								value.STATE_RESET_APPLICATION_FALSE_VALUE))
+
+					dispatcher.GetInstance().Dispatch(
+						action.NewSetActiveScreenAction(value.ACTIVE_SCREEN_MENU_VALUE))
+
+					if callback(nil, common.ErrConnectionLost) {
+						jms.close()
+					}
+
+					return
+				}
+
+				errRaw, ok := status.FromError(err)
+				if !ok {
+					if callback(nil, err) {
+						jms.close()
+					}
+
+					return
+				}
+
+				if callback(nil, errors.New(errRaw.Message())) {
+					jms.close()
+				}
+
+				break
+			}
+
+			if callback(response, nil) {
+				jms.close()
+			}
+		}
+	}()
+}
+
+// Clean perform delayed submitter close operation, which results in a called
+// provided callback when operation is finished.
+func (jms *joinMatchmakingSubmitter) Clean(callback func()) {
+	go func() {
+		jms.close()
+
+		callback()
+	}()
+}
+
+// newJoinMatchmakingSubmitter initializes joinMatchmakingSubmitter.
+func newJoinMatchmakingSubmitter() *joinMatchmakingSubmitter {
+	return new(joinMatchmakingSubmitter)
+}
+
+// getChatMessagesSubmitter represents chat messages retrieval submitter.
+type getChatMessagesSubmitter struct {
+	// Represents general context used to manage submitted context.
+	ctx context.Context
+
+	// Represents channel, which is used to close the submitted action.
+	cancel context.CancelFunc
+}
+
+// close performs stream submitter close operation.
+func (gcms *getChatMessagesSubmitter) close() {
+	if gcms.ctx != nil {
+		select {
+		case <-gcms.ctx.Done():
+		default:
+			gcms.cancel()
+		}
+	}
+}
+
+// Submit performs a submittion of chat messages retrieval action. Callback is
+// required to return boolean value, which defines whether submitter should be closed
+// or not.
+func (gcms *getChatMessagesSubmitter) Submit(sessionID int64, callback func(response *metadatav1.GetChatMessagesResponse, err error) bool) {
+	gcms.ctx, gcms.cancel = context.WithCancel(context.Background())
+
+	go func() {
+		stream, err := connector.
+			GetInstance().
+			GetClient().
+			GetChatMessages(
+				gcms.ctx,
+				&metadatav1.GetChatMessagesRequest{
+					SessionId: sessionID,
+					Issuer:    store.GetRepositoryUUID(),
+				})
+		if err != nil {
+			if callback(nil, err) {
+				gcms.close()
+			}
+
+			return
+		}
+
+		for {
+			response, err := stream.Recv()
+			if err != nil {
+				if errors.Is(err, io.EOF) || status.Code(err) == codes.Canceled {
+					return
+				}
+
+				if status.Code(err) == codes.Unavailable {
+					dispatcher.
+						GetInstance().
+						Dispatch(
+							action.NewSetStateResetApplicationAction(
+								value.STATE_RESET_APPLICATION_FALSE_VALUE))
+
+					dispatcher.GetInstance().Dispatch(
+						action.NewSetActiveScreenAction(value.ACTIVE_SCREEN_MENU_VALUE))
+
+					if callback(nil, common.ErrConnectionLost) {
+						gcms.close()
+					}
+
+					return
+				}
+
+				errRaw, ok := status.FromError(err)
+				if !ok {
+					if callback(nil, err) {
+						gcms.close()
+					}
+
+					return
+				}
+
+				if callback(nil, errors.New(errRaw.Message())) {
+					gcms.close()
+				}
+
+				break
+			}
+
+			if callback(response, nil) {
+				gcms.close()
+			}
+		}
+	}()
+}
+
+// Clean perform delayed submitter close operation, which results in a called
+// provided callback when operation is finished.
+func (gcms *getChatMessagesSubmitter) Clean(callback func()) {
+	go func() {
+		gcms.close()
+
+		callback()
+	}()
+}
+
+// newGetChatMessagesSubmitter initializes getChatMessagesSubmitter.
+func newGetChatMessagesSubmitter() *getChatMessagesSubmitter {
+	return new(getChatMessagesSubmitter)
+}
+
+// getEmotesSubmitter represents emotes retrieval submitter.
+type getEmotesSubmitter struct {
+	// Represents general context used to manage submitted context.
+	ctx context.Context
+
+	// Represents channel, which is used to close the submitted action.
+	cancel context.CancelFunc
+}
+
+// close performs stream submitter close operation.
+func (ges *getEmotesSubmitter) close() {
+	if ges.ctx != nil {
+		select {
+		case <-ges.ctx.Done():
+		default:
+			ges.cancel()
+		}
+	}
+}
+
+// Submit performs a submittion of emotes retrieval action. Callback is
+// required to return boolean value, which defines whether submitter should be closed
+// or not.
+func (ges *getEmotesSubmitter) Submit(sessionID int64, callback func(response *metadatav1.GetEmotesResponse, err error) bool) {
+	ges.ctx, ges.cancel = context.WithCancel(context.Background())
+
+	go func() {
+		stream, err := connector.
+			GetInstance().
+			GetClient().
+			GetEmotes(
+				ges.ctx,
+				&metadatav1.GetEmotesRequest{
+					SessionId: sessionID,
+					Issuer:    store.GetRepositoryUUID(),
+				})
+		if err != nil {
+			if callback(nil, err) {
+				ges.close()
+			}
+
+			return
+		}
+
+		for {
+			response, err := stream.Recv()
+			if err != nil {
+				if errors.Is(err, io.EOF) || status.Code(err) == codes.Canceled {
+					return
+				}
+
+				if status.Code(err) == codes.Unavailable {
+					dispatcher.
+						GetInstance().
+						Dispatch(
+							action.NewSetStateResetApplicationAction(
+								value.STATE_RESET_APPLICATION_FALSE_VALUE))
+
+					dispatcher.GetInstance().Dispatch(
+						action.NewSetActiveScreenAction(value.ACTIVE_SCREEN_MENU_VALUE))
+
+					if callback(nil, common.ErrConnectionLost) {
+						ges.close()
+					}
+
+					return
+				}
+
+				errRaw, ok := status.FromError(err)
+				if !ok {
+					if callback(nil, err) {
+						ges.close()
+					}
+
+					return
+				}
+
+				if callback(nil, errors.New(errRaw.Message())) {
+					ges.close()
+				}
+
+				break
+			}
+
+			if callback(response, nil) {
+				ges.close()
+			}
+		}
+	}()
+}
+
+// Clean perform delayed submitter close operation, which results in a called
+// provided callback when operation is finished.
+func (ges *getEmotesSubmitter) Clean(callback func()) {
+	go func() {
+		ges.close()
+
+		callback()
+	}()
+}
+
+// newGetEmotesSubmitter initializes getEmotesSubmitter.
+func newGetEmotesSubmitter() *getEmotesSubmitter {
+	return new(getEmotesSubmitter)
+}
+
+// getInvitationsSubmitter represents invitations retrieval submitter.
+type getInvitationsSubmitter struct {
+	// Represents general context used to manage submitted context.
+	ctx context.Context
+
+	// Represents channel, which is used to close the submitted action.
+	cancel context.CancelFunc
+}
+
+// close performs stream submitter close operation.
+func (gis *getInvitationsSubmitter) close() {
+	if gis.ctx != nil {
+		select {
+		case <-gis.ctx.Done():
+		default:
+			gis.cancel()
+		}
+	}
+}
+
+// Submit performs a submittion of invitations retrieval action. Callback is
+// required to return boolean value, which defines whether submitter should be closed
+// or not.
+func (gis *getInvitationsSubmitter) Submit(callback func(response *metadatav1.GetInvitationsResponse, err error) bool) {
+	gis.ctx, gis.cancel = context.WithCancel(context.Background())
+
+	go func() {
+		stream, err := connector.
+			GetInstance().
+			GetClient().
+			GetInvitations(
+				gis.ctx,
+				&metadatav1.GetInvitationsRequest{
+					Issuer: store.GetRepositoryUUID(),
+				})
+		if err != nil {
+			if callback(nil, err) {
+				gis.close()
+			}
+
+			return
+		}
+
+		for {
+			response, err := stream.Recv()
+			if err != nil {
+				if errors.Is(err, io.EOF) || status.Code(err) == codes.Canceled {
+					return
+				}
+
+				if status.Code(err) == codes.Unavailable {
+					dispatcher.
+						GetInstance().
+						Dispatch(
+							action.NewSetStateResetApplicationAction(
+								value.STATE_RESET_APPLICATION_FALSE_VALUE))
+
+					dispatcher.GetInstance().Dispatch(
+						action.NewSetActiveScreenAction(value.ACTIVE_SCREEN_MENU_VALUE))
+
+					if callback(nil, common.ErrConnectionLost) {
+						gis.close()
+					}
+
+					return
+				}
+
+				errRaw, ok := status.FromError(err)
+				if !ok {
+					if callback(nil, err) {
+						gis.close()
+					}
+
+					return
+				}
+
+				if callback(nil, errors.New(errRaw.Message())) {
+					gis.close()
+				}
+
+				break
+			}
+
+			if callback(response, nil) {
+				gis.close()
+			}
+		}
+	}()
+}
+
+// Clean perform delayed submitter close operation, which results in a called
+// provided callback when operation is finished.
+func (gis *getInvitationsSubmitter) Clean(callback func()) {
+	go func() {
+		gis.close()
+
+		callback()
+	}()
+}
+
+// newGetInvitationsSubmitter initializes getInvitationsSubmitter.
+func newGetInvitationsSubmitter() *getInvitationsSubmitter {
+	return new(getInvitationsSubmitter)
+}
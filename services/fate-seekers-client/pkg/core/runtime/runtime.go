@@ -10,13 +10,17 @@ import (
 	"github.com/YarikRevich/fate-seekers/services/fate-seekers-client/pkg/core/effect/transition/transparent"
 	"github.com/YarikRevich/fate-seekers/services/fate-seekers-client/pkg/core/screen"
 	"github.com/YarikRevich/fate-seekers/services/fate-seekers-client/pkg/core/screen/answerinput"
+	"github.com/YarikRevich/fate-seekers/services/fate-seekers-client/pkg/core/screen/browser"
 	"github.com/YarikRevich/fate-seekers/services/fate-seekers-client/pkg/core/screen/collections"
 	"github.com/YarikRevich/fate-seekers/services/fate-seekers-client/pkg/core/screen/creator"
 	"github.com/YarikRevich/fate-seekers/services/fate-seekers-client/pkg/core/screen/credits"
 	"github.com/YarikRevich/fate-seekers/services/fate-seekers-client/pkg/core/screen/death"
 	"github.com/YarikRevich/fate-seekers/services/fate-seekers-client/pkg/core/screen/entry"
+	"github.com/YarikRevich/fate-seekers/services/fate-seekers-client/pkg/core/screen/friends"
 	"github.com/YarikRevich/fate-seekers/services/fate-seekers-client/pkg/core/screen/lobby"
 	"github.com/YarikRevich/fate-seekers/services/fate-seekers-client/pkg/core/screen/menu"
+	"github.com/YarikRevich/fate-seekers/services/fate-seekers-client/pkg/core/screen/profile"
+	"github.com/YarikRevich/fate-seekers/services/fate-seekers-client/pkg/core/screen/replay"
 	"github.com/YarikRevich/fate-seekers/services/fate-seekers-client/pkg/core/screen/resume"
 	"github.com/YarikRevich/fate-seekers/services/fate-seekers-client/pkg/core/screen/selector"
 	"github.com/YarikRevich/fate-seekers/services/fate-seekers-client/pkg/core/screen/session"
@@ -300,6 +304,15 @@ func (r *Runtime) Update() error {
 	case value.ACTIVE_SCREEN_CREATOR_VALUE:
 		r.activeScreen = creator.GetInstance()
 
+	case value.ACTIVE_SCREEN_BROWSER_VALUE:
+		r.activeScreen = browser.GetInstance()
+
+	case value.ACTIVE_SCREEN_PROFILE_VALUE:
+		r.activeScreen = profile.GetInstance()
+
+	case value.ACTIVE_SCREEN_FRIENDS_VALUE:
+		r.activeScreen = friends.GetInstance()
+
 	case value.ACTIVE_SCREEN_LOBBY_VALUE:
 		r.activeScreen = lobby.GetInstance()
 
@@ -317,6 +330,9 @@ func (r *Runtime) Update() error {
 
 	case value.ACTIVE_SCREEN_DEATH_VALUE:
 		r.activeScreen = death.GetInstance()
+
+	case value.ACTIVE_SCREEN_REPLAY_VALUE:
+		r.activeScreen = replay.GetInstance()
 	}
 
 	if store.GetLetterImage() != value.LETTER_IMAGE_EMPTY_VALUE {
@@ -0,0 +1,207 @@
+package browser
+
+import (
+	"sync"
+	"time"
+
+	"github.com/YarikRevich/fate-seekers/services/fate-seekers-client/pkg/config"
+	"github.com/YarikRevich/fate-seekers/services/fate-seekers-client/pkg/core/effect/transition"
+	"github.com/YarikRevich/fate-seekers/services/fate-seekers-client/pkg/core/effect/transition/transparent"
+	metadatav1 "github.com/YarikRevich/fate-seekers/services/fate-seekers-client/pkg/core/networking/metadata/api"
+	"github.com/YarikRevich/fate-seekers/services/fate-seekers-client/pkg/core/networking/metadata/converter"
+	"github.com/YarikRevich/fate-seekers/services/fate-seekers-client/pkg/core/networking/metadata/handler"
+	"github.com/YarikRevich/fate-seekers/services/fate-seekers-client/pkg/core/screen"
+	"github.com/YarikRevich/fate-seekers/services/fate-seekers-client/pkg/core/tools/options"
+	"github.com/YarikRevich/fate-seekers/services/fate-seekers-client/pkg/core/tools/scaler"
+	"github.com/YarikRevich/fate-seekers/services/fate-seekers-client/pkg/core/ui/builder"
+	"github.com/YarikRevich/fate-seekers/services/fate-seekers-client/pkg/core/ui/component/browser"
+	"github.com/YarikRevich/fate-seekers/services/fate-seekers-client/pkg/core/ui/component/common"
+	"github.com/YarikRevich/fate-seekers/services/fate-seekers-client/pkg/core/ui/component/selector"
+	"github.com/YarikRevich/fate-seekers/services/fate-seekers-client/pkg/core/ui/manager/notification"
+	"github.com/YarikRevich/fate-seekers/services/fate-seekers-client/pkg/core/ui/manager/translation"
+	"github.com/YarikRevich/fate-seekers/services/fate-seekers-client/pkg/dto"
+	"github.com/YarikRevich/fate-seekers/services/fate-seekers-client/pkg/state/action"
+	"github.com/YarikRevich/fate-seekers/services/fate-seekers-client/pkg/state/dispatcher"
+	"github.com/YarikRevich/fate-seekers/services/fate-seekers-client/pkg/state/store"
+	"github.com/YarikRevich/fate-seekers/services/fate-seekers-client/pkg/state/value"
+	"github.com/YarikRevich/fate-seekers/services/fate-seekers-client/pkg/storage/shared"
+	"github.com/ebitenui/ebitenui"
+	"github.com/hajimehoshi/ebiten/v2"
+)
+
+var (
+	// GetInstance retrieves instance of the browser screen, performing initilization if needed.
+	GetInstance = sync.OnceValue[screen.Screen](newBrowserScreen)
+)
+
+const (
+	// Represents frequency of the public sessions list refresh.
+	publicSessionsRetrievalFrequency = time.Second * 5
+
+	// Represents amount of public sessions per page, which matches the server default one.
+	publicSessionsPageSize = 20
+)
+
+// BrowserScreen represents public sessions browser screen implementation.
+type BrowserScreen struct {
+	// Represents attached user interface.
+	ui *ebitenui.UI
+
+	// Represents currently browsed page.
+	page uint32
+
+	// Represents total amount of pages retrieved with the latest request.
+	pages uint32
+
+	// Represents time of the latest public sessions retrieval, zero value forces immediate refresh.
+	lastRetrieval time.Time
+
+	// Represents transparent transition effect.
+	transparentTransitionEffect transition.TransitionEffect
+
+	// Represents global world view.
+	world *ebiten.Image
+
+	// Represents interface world view.
+	interfaceWorld *ebiten.Image
+}
+
+func (bs *BrowserScreen) HandleInput() error {
+	if store.GetPublicSessionsRetrievalStartedNetworking() ==
+		value.PUBLIC_SESSIONS_RETRIEVAL_STARTED_NETWORKING_FALSE_VALUE &&
+		time.Since(bs.lastRetrieval) >= publicSessionsRetrievalFrequency {
+		dispatcher.GetInstance().Dispatch(
+			action.NewSetPublicSessionsRetrievalStartedNetworkingAction(
+				value.PUBLIC_SESSIONS_RETRIEVAL_STARTED_NETWORKING_TRUE_VALUE))
+
+		bs.lastRetrieval = time.Now()
+
+		page := bs.page
+
+		handler.PerformListPublicSessions(dto.ListPublicSessionsRequest{
+			Page:      page,
+			Sort:      browser.GetInstance().GetSort(),
+			Rules:     browser.GetInstance().GetRules(),
+			FreeSlots: browser.GetInstance().GetFreeSlots(),
+		}, func(response *metadatav1.ListPublicSessionsResponse, err error) {
+			dispatcher.GetInstance().Dispatch(
+				action.NewSetPublicSessionsRetrievalStartedNetworkingAction(
+					value.PUBLIC_SESSIONS_RETRIEVAL_STARTED_NETWORKING_FALSE_VALUE))
+
+			if err != nil {
+				notification.GetInstance().Push(
+					common.ComposeMessage(
+						translation.GetInstance().GetTranslation("client.networking.list-public-sessions-failure"),
+						err.Error()),
+					time.Second*3,
+					common.NotificationErrorTextColor)
+
+				return
+			}
+
+			bs.pages = uint32(
+				(response.GetTotal() + publicSessionsPageSize - 1) / publicSessionsPageSize)
+
+			browser.GetInstance().SetListsEntries(
+				converter.ConvertListPublicSessionsResponseToListEntries(response))
+
+			browser.GetInstance().SetPagination(page, bs.pages)
+		})
+	}
+
+	if !bs.transparentTransitionEffect.Done() {
+		if !bs.transparentTransitionEffect.OnEnd() {
+			bs.transparentTransitionEffect.Update()
+		} else {
+			bs.transparentTransitionEffect.Clean()
+		}
+	}
+
+	shared.GetInstance().GetBackgroundAnimation().Update()
+
+	bs.ui.Update()
+
+	return nil
+}
+
+func (bs *BrowserScreen) HandleRender(screen *ebiten.Image) {
+	bs.world.Clear()
+
+	bs.interfaceWorld.Clear()
+
+	var backgroundAnimationGeometry ebiten.GeoM
+
+	backgroundAnimationGeometry.Scale(
+		scaler.GetScaleFactor(config.GetMinStaticWidth(), config.GetWorldWidth()),
+		scaler.GetScaleFactor(config.GetMinStaticHeight(), config.GetWorldHeight()))
+
+	shared.GetInstance().GetBackgroundAnimation().DrawTo(bs.world, &ebiten.DrawImageOptions{
+		GeoM: backgroundAnimationGeometry,
+	})
+
+	bs.ui.Draw(bs.interfaceWorld)
+
+	bs.world.DrawImage(bs.interfaceWorld, &ebiten.DrawImageOptions{
+		ColorM: options.GetTransparentDrawOptions(
+			bs.transparentTransitionEffect.GetValue()).ColorM})
+
+	screen.DrawImage(bs.world, &ebiten.DrawImageOptions{})
+}
+
+// newBrowserScreen initializes BrowserScreen.
+func newBrowserScreen() screen.Screen {
+	transparentTransitionEffect := transparent.NewTransparentTransitionEffect(true, 255, 0, 5, time.Microsecond*10)
+
+	result := &BrowserScreen{
+		ui:                          builder.Build(browser.GetInstance().GetContainer()),
+		transparentTransitionEffect: transparentTransitionEffect,
+		world:                       ebiten.NewImage(config.GetWorldWidth(), config.GetWorldHeight()),
+		interfaceWorld: ebiten.NewImage(
+			config.GetWorldWidth(), config.GetWorldHeight()),
+	}
+
+	browser.GetInstance().SetFiltersCallback(func() {
+		result.page = 0
+		result.lastRetrieval = time.Time{}
+	})
+
+	browser.GetInstance().SetPreviousCallback(func() {
+		if result.page > 0 {
+			result.page--
+			result.lastRetrieval = time.Time{}
+		}
+	})
+
+	browser.GetInstance().SetNextCallback(func() {
+		if result.page+1 < result.pages {
+			result.page++
+			result.lastRetrieval = time.Time{}
+		}
+	})
+
+	browser.GetInstance().SetSelectCallback(func(sessionName string) {
+		transparentTransitionEffect.Reset()
+
+		result.lastRetrieval = time.Time{}
+
+		browser.GetInstance().ResetActionButtons()
+
+		selector.GetInstance().SetSessionName(sessionName)
+
+		dispatcher.GetInstance().Dispatch(
+			action.NewSetActiveScreenAction(value.ACTIVE_SCREEN_SELECTOR_VALUE))
+	})
+
+	browser.GetInstance().SetBackCallback(func() {
+		transparentTransitionEffect.Reset()
+
+		result.lastRetrieval = time.Time{}
+
+		browser.GetInstance().ResetActionButtons()
+
+		dispatcher.GetInstance().Dispatch(
+			action.NewSetActiveScreenAction(value.ACTIVE_SCREEN_SELECTOR_VALUE))
+	})
+
+	return result
+}
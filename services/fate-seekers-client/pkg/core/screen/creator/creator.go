@@ -91,7 +91,7 @@ func (cs *CreatorScreen) HandleRender(screen *ebiten.Image) {
 func newCreatorScreen() screen.Screen {
 	transparentTransitionEffect := transparent.NewTransparentTransitionEffect(true, 255, 0, 5, time.Microsecond*10)
 
-	creator.GetInstance().SetSubmitCallback(func(name, seed string) {
+	creator.GetInstance().SetSubmitCallback(func(name, seed, rules, password string) {
 		if creatormanager.ProcessChanges(name, seed) {
 			if store.GetSessionCreationStartedNetworking() == value.SESSION_CREATION_STARTED_NETWORKING_FALSE_VALUE {
 				dispatcher.GetInstance().Dispatch(
@@ -105,7 +105,7 @@ func newCreatorScreen() screen.Screen {
 						time.Second*3,
 						common.NotificationErrorTextColor)
 				} else {
-					handler.PerformCreateSession(name, seedUint, func(err error) {
+					handler.PerformCreateSession(name, seedUint, rules, password, func(err error) {
 						dispatcher.GetInstance().Dispatch(
 							action.NewSetSessionCreationStartedNetworkingAction(
 								value.SESSION_CREATION_STARTED_NETWORKING_FALSE_VALUE))
@@ -44,12 +44,20 @@ func (ds *DeathScreen) HandleInput() error {
 				action.NewSetStateResetApplicationAction(
 					value.STATE_RESET_APPLICATION_FALSE_VALUE))
 
-		prompt.GetInstance().HideSubmitButton()
+		prompt.GetInstance().ShowSubmitButton()
 
 		dispatcher.GetInstance().Dispatch(
 			action.NewSetPromptText(
 				translation.GetInstance().GetTranslation("client.prompt.death")))
 
+		dispatcher.GetInstance().Dispatch(
+			action.NewSetPromptSubmitCallback(func() {
+				ds.transparentTransitionEffect.Reset()
+
+				dispatcher.GetInstance().Dispatch(
+					action.NewSetActiveScreenAction(value.ACTIVE_SCREEN_REPLAY_VALUE))
+			}))
+
 		dispatcher.GetInstance().Dispatch(
 			action.NewSetPromptCancelCallback(func() {
 				ds.transparentTransitionEffect.Reset()
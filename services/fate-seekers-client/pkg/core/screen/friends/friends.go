@@ -0,0 +1,207 @@
+package friends
+
+import (
+	"sync"
+	"time"
+
+	"github.com/YarikRevich/fate-seekers/services/fate-seekers-client/pkg/config"
+	"github.com/YarikRevich/fate-seekers/services/fate-seekers-client/pkg/core/effect/transition"
+	"github.com/YarikRevich/fate-seekers/services/fate-seekers-client/pkg/core/effect/transition/transparent"
+	metadatav1 "github.com/YarikRevich/fate-seekers/services/fate-seekers-client/pkg/core/networking/metadata/api"
+	"github.com/YarikRevich/fate-seekers/services/fate-seekers-client/pkg/core/networking/metadata/converter"
+	"github.com/YarikRevich/fate-seekers/services/fate-seekers-client/pkg/core/networking/metadata/handler"
+	"github.com/YarikRevich/fate-seekers/services/fate-seekers-client/pkg/core/screen"
+	"github.com/YarikRevich/fate-seekers/services/fate-seekers-client/pkg/core/tools/options"
+	"github.com/YarikRevich/fate-seekers/services/fate-seekers-client/pkg/core/tools/scaler"
+	"github.com/YarikRevich/fate-seekers/services/fate-seekers-client/pkg/core/ui/builder"
+	"github.com/YarikRevich/fate-seekers/services/fate-seekers-client/pkg/core/ui/component/common"
+	"github.com/YarikRevich/fate-seekers/services/fate-seekers-client/pkg/core/ui/component/friends"
+	friendsmanager "github.com/YarikRevich/fate-seekers/services/fate-seekers-client/pkg/core/ui/manager/friends"
+	"github.com/YarikRevich/fate-seekers/services/fate-seekers-client/pkg/core/ui/manager/notification"
+	"github.com/YarikRevich/fate-seekers/services/fate-seekers-client/pkg/core/ui/manager/translation"
+	"github.com/YarikRevich/fate-seekers/services/fate-seekers-client/pkg/state/action"
+	"github.com/YarikRevich/fate-seekers/services/fate-seekers-client/pkg/state/dispatcher"
+	"github.com/YarikRevich/fate-seekers/services/fate-seekers-client/pkg/state/store"
+	"github.com/YarikRevich/fate-seekers/services/fate-seekers-client/pkg/state/value"
+	"github.com/YarikRevich/fate-seekers/services/fate-seekers-client/pkg/storage/shared"
+	"github.com/ebitenui/ebitenui"
+	"github.com/hajimehoshi/ebiten/v2"
+)
+
+var (
+	// GetInstance retrieves instance of the friends screen, performing initilization if needed.
+	GetInstance = sync.OnceValue[screen.Screen](newFriendsScreen)
+)
+
+// FriendsScreen represents friends screen implementation.
+type FriendsScreen struct {
+	// Represents attached user interface.
+	ui *ebitenui.UI
+
+	// Represents transparent transition effect.
+	transparentTransitionEffect transition.TransitionEffect
+
+	// Represents global world view.
+	world *ebiten.Image
+
+	// Represents interface world view.
+	interfaceWorld *ebiten.Image
+}
+
+func (fs *FriendsScreen) HandleInput() error {
+	if store.GetFriendsRetrievalStartedNetworking() == value.FRIENDS_RETRIEVAL_STARTED_NETWORKING_FALSE_VALUE {
+		dispatcher.GetInstance().Dispatch(
+			action.NewSetFriendsRetrievalStartedNetworkingAction(
+				value.FRIENDS_RETRIEVAL_STARTED_NETWORKING_TRUE_VALUE))
+
+		handler.PerformGetFriends(func(response *metadatav1.GetFriendsResponse, err error) {
+			if err != nil {
+				notification.GetInstance().Push(
+					common.ComposeMessage(
+						translation.GetInstance().GetTranslation("client.networking.get-friends-failure"),
+						err.Error()),
+					time.Second*3,
+					common.NotificationErrorTextColor)
+
+				return
+			}
+
+			friends.GetInstance().SetListsEntries(
+				converter.ConvertGetFriendsResponseToListEntries(response))
+
+			friends.GetInstance().ResetActionButtons()
+		})
+	}
+
+	if !fs.transparentTransitionEffect.Done() {
+		if !fs.transparentTransitionEffect.OnEnd() {
+			fs.transparentTransitionEffect.Update()
+		} else {
+			fs.transparentTransitionEffect.Clean()
+		}
+	}
+
+	shared.GetInstance().GetBackgroundAnimation().Update()
+
+	fs.ui.Update()
+
+	return nil
+}
+
+func (fs *FriendsScreen) HandleRender(screen *ebiten.Image) {
+	fs.world.Clear()
+
+	fs.interfaceWorld.Clear()
+
+	var backgroundAnimationGeometry ebiten.GeoM
+
+	backgroundAnimationGeometry.Scale(
+		scaler.GetScaleFactor(config.GetMinStaticWidth(), config.GetWorldWidth()),
+		scaler.GetScaleFactor(config.GetMinStaticHeight(), config.GetWorldHeight()))
+
+	shared.GetInstance().GetBackgroundAnimation().DrawTo(fs.world, &ebiten.DrawImageOptions{
+		GeoM: backgroundAnimationGeometry,
+	})
+
+	fs.ui.Draw(fs.interfaceWorld)
+
+	fs.world.DrawImage(fs.interfaceWorld, &ebiten.DrawImageOptions{
+		ColorM: options.GetTransparentDrawOptions(
+			fs.transparentTransitionEffect.GetValue()).ColorM})
+
+	screen.DrawImage(fs.world, &ebiten.DrawImageOptions{})
+}
+
+// newFriendsScreen initializes FriendsScreen.
+func newFriendsScreen() screen.Screen {
+	transparentTransitionEffect := transparent.NewTransparentTransitionEffect(true, 255, 0, 5, time.Microsecond*10)
+
+	friends.GetInstance().SetAddCallback(func(displayName string) {
+		if friendsmanager.ProcessChanges(displayName) {
+			handler.PerformSendFriendRequest(displayName, func(err error) {
+				if err != nil {
+					notification.GetInstance().Push(
+						common.ComposeMessage(
+							translation.GetInstance().GetTranslation("client.networking.send-friend-request-failure"),
+							err.Error()),
+						time.Second*3,
+						common.NotificationErrorTextColor)
+
+					return
+				}
+
+				friends.GetInstance().CleanInputs()
+
+				notification.GetInstance().Push(
+					translation.GetInstance().GetTranslation("client.friendsmanager.request-sent"),
+					time.Second*3,
+					common.NotificationInfoTextColor)
+
+				dispatcher.GetInstance().Dispatch(
+					action.NewSetFriendsRetrievalStartedNetworkingAction(
+						value.FRIENDS_RETRIEVAL_STARTED_NETWORKING_FALSE_VALUE))
+			})
+		}
+	})
+
+	friends.GetInstance().SetAcceptCallback(func(friendshipID int64) {
+		handler.PerformAcceptFriendRequest(friendshipID, func(err error) {
+			if err != nil {
+				notification.GetInstance().Push(
+					common.ComposeMessage(
+						translation.GetInstance().GetTranslation("client.networking.accept-friend-request-failure"),
+						err.Error()),
+					time.Second*3,
+					common.NotificationErrorTextColor)
+
+				return
+			}
+
+			dispatcher.GetInstance().Dispatch(
+				action.NewSetFriendsRetrievalStartedNetworkingAction(
+					value.FRIENDS_RETRIEVAL_STARTED_NETWORKING_FALSE_VALUE))
+		})
+	})
+
+	friends.GetInstance().SetRemoveCallback(func(friendshipID int64) {
+		handler.PerformRemoveFriend(friendshipID, func(err error) {
+			if err != nil {
+				notification.GetInstance().Push(
+					common.ComposeMessage(
+						translation.GetInstance().GetTranslation("client.networking.remove-friend-failure"),
+						err.Error()),
+					time.Second*3,
+					common.NotificationErrorTextColor)
+
+				return
+			}
+
+			dispatcher.GetInstance().Dispatch(
+				action.NewSetFriendsRetrievalStartedNetworkingAction(
+					value.FRIENDS_RETRIEVAL_STARTED_NETWORKING_FALSE_VALUE))
+		})
+	})
+
+	friends.GetInstance().SetBackCallback(func() {
+		transparentTransitionEffect.Reset()
+
+		friends.GetInstance().CleanInputs()
+
+		friends.GetInstance().ResetActionButtons()
+
+		dispatcher.GetInstance().Dispatch(
+			action.NewSetFriendsRetrievalStartedNetworkingAction(
+				value.FRIENDS_RETRIEVAL_STARTED_NETWORKING_FALSE_VALUE))
+
+		dispatcher.GetInstance().Dispatch(
+			action.NewSetActiveScreenAction(value.ACTIVE_SCREEN_SELECTOR_VALUE))
+	})
+
+	return &FriendsScreen{
+		ui:                          builder.Build(friends.GetInstance().GetContainer()),
+		transparentTransitionEffect: transparentTransitionEffect,
+		world:                       ebiten.NewImage(config.GetWorldWidth(), config.GetWorldHeight()),
+		interfaceWorld: ebiten.NewImage(
+			config.GetWorldWidth(), config.GetWorldHeight()),
+	}
+}
@@ -15,8 +15,11 @@ import (
 	"github.com/YarikRevich/fate-seekers/services/fate-seekers-client/pkg/core/tools/options"
 	"github.com/YarikRevich/fate-seekers/services/fate-seekers-client/pkg/core/tools/scaler"
 	"github.com/YarikRevich/fate-seekers/services/fate-seekers-client/pkg/core/ui/builder"
+	"github.com/YarikRevich/fate-seekers/services/fate-seekers-client/pkg/core/ui/component/chat"
 	"github.com/YarikRevich/fate-seekers/services/fate-seekers-client/pkg/core/ui/component/common"
 	"github.com/YarikRevich/fate-seekers/services/fate-seekers-client/pkg/core/ui/component/lobby"
+	"github.com/YarikRevich/fate-seekers/services/fate-seekers-client/pkg/core/ui/component/lobbydetails"
+	chatmanager "github.com/YarikRevich/fate-seekers/services/fate-seekers-client/pkg/core/ui/manager/chat"
 	"github.com/YarikRevich/fate-seekers/services/fate-seekers-client/pkg/core/ui/manager/notification"
 	"github.com/YarikRevich/fate-seekers/services/fate-seekers-client/pkg/core/ui/manager/translation"
 	"github.com/YarikRevich/fate-seekers/services/fate-seekers-client/pkg/dto"
@@ -54,6 +57,22 @@ func (ls *LobbyScreen) HandleInput() error {
 		dispatcher.GetInstance().Dispatch(
 			action.NewSetLobbySetRetrievalStartedNetworkingAction(value.LOBBY_SET_RETRIEVAL_STARTED_NETWORKING_TRUE_VALUE))
 
+		handler.PerformGetFriends(func(response *metadatav1.GetFriendsResponse, err error) {
+			if err != nil {
+				notification.GetInstance().Push(
+					common.ComposeMessage(
+						translation.GetInstance().GetTranslation("client.networking.get-friends-failure"),
+						err.Error()),
+					time.Second*3,
+					common.NotificationErrorTextColor)
+
+				return
+			}
+
+			lobbydetails.GetInstance().SetFriends(
+				converter.ConvertGetFriendsResponseToAcceptedListEntries(response))
+		})
+
 		stream.GetGetLobbySetSubmitter().Clean(func() {
 			stream.GetGetLobbySetSubmitter().Submit(
 				store.GetSelectedSessionMetadata().ID, func(response *metadatav1.GetLobbySetResponse, err error) bool {
@@ -104,7 +123,8 @@ func (ls *LobbyScreen) HandleInput() error {
 						isLobbySetUpdated = true
 					} else {
 						for index, value := range store.GetRetrievedLobbySetMetadata() {
-							if value.Issuer != response.GetLobbySet()[index].GetIssuer() {
+							if value.Issuer != response.GetLobbySet()[index].GetIssuer() ||
+								value.DisplayName != response.GetLobbySet()[index].GetDisplayName() {
 								isLobbySetUpdated = true
 							}
 						}
@@ -148,8 +168,8 @@ func (ls *LobbyScreen) HandleInput() error {
 							}
 						}
 
-						lobby.GetInstance().SetSelectionBySkin(
-							selectedPlayer.GetSkin())
+						lobby.GetInstance().SetSelection(
+							selectedPlayer.GetDisplayName(), selectedPlayer.GetSkin())
 
 						lobby.GetInstance().SetListsEntries(
 							converter.ConvertGetLobbySetResponseToListEntries(otherPlayers))
@@ -169,6 +189,80 @@ func (ls *LobbyScreen) HandleInput() error {
 						}
 					}
 
+					var (
+						readyPlayers, totalPlayers int
+						ready, host, spectator     bool
+						team                       uint64
+						teamPlayers                = make(map[uint64]int)
+						hostName                   string
+					)
+
+					for _, lobbySetUnit := range response.GetLobbySet() {
+						if lobbySetUnit.GetHost() {
+							hostName = lobbySetUnit.GetDisplayName()
+						}
+
+						if lobbySetUnit.GetSpectator() {
+							if lobbySetUnit.GetIssuer() == store.GetRepositoryUUID() {
+								spectator = true
+							}
+
+							continue
+						}
+
+						totalPlayers++
+
+						if lobbySetUnit.GetReady() {
+							readyPlayers++
+						}
+
+						teamPlayers[lobbySetUnit.GetTeam()]++
+
+						if lobbySetUnit.GetIssuer() == store.GetRepositoryUUID() {
+							ready = lobbySetUnit.GetReady()
+							host = lobbySetUnit.GetHost()
+							team = lobbySetUnit.GetTeam()
+						}
+					}
+
+					lobby.GetInstance().SetReady(ready)
+
+					lobbydetails.GetInstance().SetHostName(hostName)
+
+					lobbydetails.GetInstance().SetReadyPlayers(readyPlayers, totalPlayers)
+
+					lobbydetails.GetInstance().SetTeamPlayers(teamPlayers[1], teamPlayers[2])
+
+					lobbydetails.GetInstance().SetTeam(team)
+
+					if spectator {
+						lobbydetails.GetInstance().HideTeamSelection()
+					} else {
+						lobbydetails.GetInstance().ShowTeamSelection()
+					}
+
+					lobbydetails.GetInstance().SetCountdown(response.Countdown)
+
+					if host {
+						lobbydetails.GetInstance().ShowCountdownSelection()
+					} else {
+						lobbydetails.GetInstance().HideCountdownSelection()
+					}
+
+					if host &&
+						store.GetSessionAlreadyStartedMetadata() == value.SESSION_ALREADY_STARTED_METADATA_STATE_FALSE_VALUE &&
+						(readyPlayers == totalPlayers || (response.Countdown != nil && response.GetCountdown() == 0)) {
+						lobby.GetInstance().HideStartButton()
+
+						dispatcher.GetInstance().Dispatch(
+							action.NewSetStartSessionTravel(value.START_SESSION_TRAVEL_TRUE_VALUE))
+
+						dispatcher.
+							GetInstance().
+							Dispatch(
+								action.NewSetActiveScreenAction(value.ACTIVE_SCREEN_TRAVEL_VALUE))
+					}
+
 					return false
 				})
 		})
@@ -240,6 +334,10 @@ func (ls *LobbyScreen) HandleInput() error {
 
 						lobby.GetInstance().HideStartButton()
 
+						lobby.GetInstance().SetReady(false)
+
+						lobbydetails.GetInstance().Clean()
+
 						dispatcher.GetInstance().Dispatch(
 							action.NewSetLobbySetRetrievalStartedNetworkingAction(value.LOBBY_SET_RETRIEVAL_STARTED_NETWORKING_FALSE_VALUE))
 
@@ -268,6 +366,8 @@ func (ls *LobbyScreen) HandleInput() error {
 		}
 	}
 
+	chatmanager.GetInstance().Update()
+
 	shared.GetInstance().GetBackgroundAnimation().Update()
 
 	ls.ui.Update()
@@ -298,18 +398,121 @@ func newLobbyScreen() screen.Screen {
 	transparentTransitionEffect := transparent.NewTransparentTransitionEffect(true, 255, 0, 5, time.Microsecond*10)
 
 	lobby.GetInstance().SetStartCallback(func() {
-		dispatcher.GetInstance().Dispatch(
-			action.NewSetStartSessionTravel(value.START_SESSION_TRAVEL_TRUE_VALUE))
+		if store.GetSelectedLobbySetUnitMetadata() == nil {
+			return
+		}
 
-		dispatcher.
-			GetInstance().
-			Dispatch(
-				action.NewSetActiveScreenAction(value.ACTIVE_SCREEN_TRAVEL_VALUE))
+		handler.PerformSetLobbyReady(
+			store.GetSelectedSessionMetadata().ID,
+			store.GetSelectedLobbySetUnitMetadata().ID,
+			true,
+			lobbydetails.GetInstance().GetCountdown(),
+			func(err error) {
+				if err != nil {
+					notification.GetInstance().Push(
+						common.ComposeMessage(
+							translation.GetInstance().GetTranslation("client.networking.set-lobby-ready-failure"),
+							err.Error()),
+						time.Second*3,
+						common.NotificationErrorTextColor)
+				}
+			})
+	})
+
+	lobby.GetInstance().SetReadyCallback(func() {
+		if store.GetSelectedLobbySetUnitMetadata() == nil {
+			return
+		}
+
+		handler.PerformSetLobbyReady(
+			store.GetSelectedSessionMetadata().ID,
+			store.GetSelectedLobbySetUnitMetadata().ID,
+			!lobby.GetInstance().IsReady(),
+			0,
+			func(err error) {
+				if err != nil {
+					notification.GetInstance().Push(
+						common.ComposeMessage(
+							translation.GetInstance().GetTranslation("client.networking.set-lobby-ready-failure"),
+							err.Error()),
+						time.Second*3,
+						common.NotificationErrorTextColor)
+				}
+			})
+	})
+
+	lobbydetails.GetInstance().SetTeamCallback(func(team uint64) {
+		if store.GetSelectedLobbySetUnitMetadata() == nil {
+			return
+		}
+
+		handler.PerformSetLobbyTeam(
+			store.GetSelectedSessionMetadata().ID,
+			store.GetSelectedLobbySetUnitMetadata().ID,
+			team,
+			false,
+			func(err error) {
+				if err != nil {
+					notification.GetInstance().Push(
+						common.ComposeMessage(
+							translation.GetInstance().GetTranslation("client.networking.set-lobby-team-failure"),
+							err.Error()),
+						time.Second*3,
+						common.NotificationErrorTextColor)
+				}
+			})
+	})
+
+	lobbydetails.GetInstance().SetBalanceCallback(func() {
+		if store.GetSelectedLobbySetUnitMetadata() == nil {
+			return
+		}
+
+		handler.PerformSetLobbyTeam(
+			store.GetSelectedSessionMetadata().ID,
+			store.GetSelectedLobbySetUnitMetadata().ID,
+			0,
+			true,
+			func(err error) {
+				if err != nil {
+					notification.GetInstance().Push(
+						common.ComposeMessage(
+							translation.GetInstance().GetTranslation("client.networking.set-lobby-team-failure"),
+							err.Error()),
+						time.Second*3,
+						common.NotificationErrorTextColor)
+				}
+			})
+	})
+
+	lobbydetails.GetInstance().SetInviteCallback(func(friendshipID int64) {
+		handler.PerformInviteFriend(
+			store.GetSelectedSessionMetadata().ID,
+			friendshipID,
+			func(err error) {
+				if err != nil {
+					notification.GetInstance().Push(
+						common.ComposeMessage(
+							translation.GetInstance().GetTranslation("client.networking.invite-friend-failure"),
+							err.Error()),
+						time.Second*3,
+						common.NotificationErrorTextColor)
+
+					return
+				}
+
+				notification.GetInstance().Push(
+					translation.GetInstance().GetTranslation("client.lobbydetails.invite-sent"),
+					time.Second*3,
+					common.NotificationInfoTextColor)
+			})
 	})
 
 	instance := &LobbyScreen{
 		ui: builder.Build(
-			lobby.GetInstance().GetContainer()),
+			lobby.GetInstance().GetContainer(),
+			lobbydetails.GetInstance().GetContainer(),
+			chat.GetInstance().GetContainer()),
 		transparentTransitionEffect: transparentTransitionEffect,
 		world:                       ebiten.NewImage(config.GetWorldWidth(), config.GetWorldHeight()),
 	}
@@ -326,6 +529,12 @@ func newLobbyScreen() screen.Screen {
 
 			lobby.GetInstance().HideStartButton()
 
+			lobby.GetInstance().SetReady(false)
+
+			lobbydetails.GetInstance().Clean()
+
+			chatmanager.GetInstance().Reset()
+
 			if err != nil {
 				notification.GetInstance().Push(
 					common.ComposeMessage(
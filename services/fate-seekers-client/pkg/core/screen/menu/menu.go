@@ -8,10 +8,12 @@ import (
 	"github.com/YarikRevich/fate-seekers/services/fate-seekers-client/pkg/core/effect/transition"
 	"github.com/YarikRevich/fate-seekers/services/fate-seekers-client/pkg/core/effect/transition/transparent"
 	"github.com/YarikRevich/fate-seekers/services/fate-seekers-client/pkg/core/networking/connector"
+	metadatav1 "github.com/YarikRevich/fate-seekers/services/fate-seekers-client/pkg/core/networking/metadata/api"
 	"github.com/YarikRevich/fate-seekers/services/fate-seekers-client/pkg/core/networking/metadata/handler"
 	"github.com/YarikRevich/fate-seekers/services/fate-seekers-client/pkg/core/networking/metadata/ping"
 	"github.com/YarikRevich/fate-seekers/services/fate-seekers-client/pkg/core/screen"
 	"github.com/YarikRevich/fate-seekers/services/fate-seekers-client/pkg/core/tools/collision"
+	"github.com/YarikRevich/fate-seekers/services/fate-seekers-client/pkg/core/tools/marker"
 	"github.com/YarikRevich/fate-seekers/services/fate-seekers-client/pkg/core/tools/options"
 	"github.com/YarikRevich/fate-seekers/services/fate-seekers-client/pkg/core/tools/renderer"
 	"github.com/YarikRevich/fate-seekers/services/fate-seekers-client/pkg/core/tools/scaler"
@@ -82,6 +84,12 @@ func (ms *MenuScreen) HandleInput() error {
 		dispatcher.GetInstance().Dispatch(
 			action.NewSetStartSessionTravel(value.START_SESSION_TRAVEL_FALSE_VALUE))
 
+		dispatcher.GetInstance().Dispatch(
+			action.NewSetSpectatingSession(value.SPECTATING_SESSION_FALSE_VALUE))
+
+		dispatcher.GetInstance().Dispatch(
+			action.NewSetFinishedSession(value.FINISHED_SESSION_FALSE_VALUE))
+
 		clear(store.GetRetrievedUsersMetadataSession())
 
 		selected.GetInstance().Clean()
@@ -92,6 +100,8 @@ func (ms *MenuScreen) HandleInput() error {
 
 		renderer.GetInstance().Clean()
 
+		marker.GetInstance().Clean()
+
 		prompt.GetInstance().ShowSubmitButton()
 	}
 
@@ -193,7 +203,7 @@ func newMenuScreen() screen.Screen {
 										return
 									}
 
-									handler.PerformPingConnection(func(err2 error) {
+									handler.PerformPingConnection(func(_ *metadatav1.PingConnectionResponse, err2 error) {
 										if err2 != nil {
 											dispatcher.GetInstance().Dispatch(
 												action.NewDecrementLoadingApplicationAction())
@@ -279,7 +289,7 @@ func newMenuScreen() screen.Screen {
 						dispatcher.GetInstance().Dispatch(
 							action.NewSetPingConnectionStartedNetworkingAction(value.PING_CONNECTION_STARTED_NETWORKING_TRUE_VALUE))
 
-						handler.PerformPingConnection(func(err error) {
+						handler.PerformPingConnection(func(_ *metadatav1.PingConnectionResponse, err error) {
 							dispatcher.GetInstance().Dispatch(
 								action.NewDecrementLoadingApplicationAction())
 
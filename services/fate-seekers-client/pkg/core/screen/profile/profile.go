@@ -0,0 +1,148 @@
+package profile
+
+import (
+	"sync"
+	"time"
+
+	"github.com/YarikRevich/fate-seekers/services/fate-seekers-client/pkg/config"
+	"github.com/YarikRevich/fate-seekers/services/fate-seekers-client/pkg/core/effect/transition"
+	"github.com/YarikRevich/fate-seekers/services/fate-seekers-client/pkg/core/effect/transition/transparent"
+	"github.com/YarikRevich/fate-seekers/services/fate-seekers-client/pkg/core/networking/metadata/handler"
+	"github.com/YarikRevich/fate-seekers/services/fate-seekers-client/pkg/core/screen"
+	"github.com/YarikRevich/fate-seekers/services/fate-seekers-client/pkg/core/tools/options"
+	"github.com/YarikRevich/fate-seekers/services/fate-seekers-client/pkg/core/tools/scaler"
+	"github.com/YarikRevich/fate-seekers/services/fate-seekers-client/pkg/core/ui/builder"
+	"github.com/YarikRevich/fate-seekers/services/fate-seekers-client/pkg/core/ui/component/common"
+	"github.com/YarikRevich/fate-seekers/services/fate-seekers-client/pkg/core/ui/component/profile"
+	"github.com/YarikRevich/fate-seekers/services/fate-seekers-client/pkg/core/ui/manager/notification"
+	profilemanager "github.com/YarikRevich/fate-seekers/services/fate-seekers-client/pkg/core/ui/manager/profile"
+	"github.com/YarikRevich/fate-seekers/services/fate-seekers-client/pkg/core/ui/manager/translation"
+	"github.com/YarikRevich/fate-seekers/services/fate-seekers-client/pkg/state/action"
+	"github.com/YarikRevich/fate-seekers/services/fate-seekers-client/pkg/state/dispatcher"
+	"github.com/YarikRevich/fate-seekers/services/fate-seekers-client/pkg/state/store"
+	"github.com/YarikRevich/fate-seekers/services/fate-seekers-client/pkg/state/value"
+	"github.com/YarikRevich/fate-seekers/services/fate-seekers-client/pkg/storage/shared"
+	"github.com/ebitenui/ebitenui"
+	"github.com/hajimehoshi/ebiten/v2"
+)
+
+var (
+	// GetInstance retrieves instance of the profile screen, performing initilization if needed.
+	GetInstance = sync.OnceValue[screen.Screen](newProfileScreen)
+)
+
+// ProfileScreen represents profile screen implementation.
+type ProfileScreen struct {
+	// Represents attached user interface.
+	ui *ebitenui.UI
+
+	// Represents transparent transition effect.
+	transparentTransitionEffect transition.TransitionEffect
+
+	// Represents global world view.
+	world *ebiten.Image
+
+	// Represents interface world view.
+	interfaceWorld *ebiten.Image
+}
+
+func (ps *ProfileScreen) HandleInput() error {
+	if !ps.transparentTransitionEffect.Done() {
+		if !ps.transparentTransitionEffect.OnEnd() {
+			ps.transparentTransitionEffect.Update()
+		} else {
+			ps.transparentTransitionEffect.Clean()
+		}
+	}
+
+	shared.GetInstance().GetBackgroundAnimation().Update()
+
+	ps.ui.Update()
+
+	return nil
+}
+
+func (ps *ProfileScreen) HandleRender(screen *ebiten.Image) {
+	ps.world.Clear()
+
+	ps.interfaceWorld.Clear()
+
+	var backgroundAnimationGeometry ebiten.GeoM
+
+	backgroundAnimationGeometry.Scale(
+		scaler.GetScaleFactor(config.GetMinStaticWidth(), config.GetWorldWidth()),
+		scaler.GetScaleFactor(config.GetMinStaticHeight(), config.GetWorldHeight()))
+
+	shared.GetInstance().GetBackgroundAnimation().DrawTo(ps.world, &ebiten.DrawImageOptions{
+		GeoM: backgroundAnimationGeometry,
+	})
+
+	ps.ui.Draw(ps.interfaceWorld)
+
+	ps.world.DrawImage(ps.interfaceWorld, &ebiten.DrawImageOptions{
+		ColorM: options.GetTransparentDrawOptions(
+			ps.transparentTransitionEffect.GetValue()).ColorM})
+
+	screen.DrawImage(ps.world, &ebiten.DrawImageOptions{})
+}
+
+// newProfileScreen initializes ProfileScreen.
+func newProfileScreen() screen.Screen {
+	transparentTransitionEffect := transparent.NewTransparentTransitionEffect(true, 255, 0, 5, time.Microsecond*10)
+
+	profile.GetInstance().SetSubmitCallback(func(displayName string, skin, avatar uint64) {
+		if profilemanager.ProcessChanges(displayName) {
+			if store.GetProfileUpdateStartedNetworking() == value.PROFILE_UPDATE_STARTED_NETWORKING_FALSE_VALUE {
+				dispatcher.GetInstance().Dispatch(
+					action.NewSetProfileUpdateStartedNetworkingAction(
+						value.PROFILE_UPDATE_STARTED_NETWORKING_TRUE_VALUE))
+
+				handler.PerformUpdateProfile(displayName, skin, avatar, func(err error) {
+					dispatcher.GetInstance().Dispatch(
+						action.NewSetProfileUpdateStartedNetworkingAction(
+							value.PROFILE_UPDATE_STARTED_NETWORKING_FALSE_VALUE))
+
+					if err != nil {
+						notification.GetInstance().Push(
+							common.ComposeMessage(
+								translation.GetInstance().GetTranslation("client.profilemanager.failure"),
+								err.Error()),
+							time.Second*3,
+							common.NotificationErrorTextColor)
+
+						return
+					}
+
+					transparentTransitionEffect.Reset()
+
+					notification.GetInstance().Push(
+						translation.GetInstance().GetTranslation("client.profilemanager.success"),
+						time.Second*3,
+						common.NotificationInfoTextColor)
+
+					dispatcher.GetInstance().Dispatch(
+						action.NewSetActiveScreenAction(value.ACTIVE_SCREEN_SELECTOR_VALUE))
+				})
+			}
+		}
+	})
+
+	profile.GetInstance().SetBackCallback(func() {
+		if store.GetProfileUpdateStartedNetworking() == value.PROFILE_UPDATE_STARTED_NETWORKING_FALSE_VALUE {
+			transparentTransitionEffect.Reset()
+
+			profile.GetInstance().CleanInputs()
+
+			dispatcher.GetInstance().Dispatch(
+				action.NewSetActiveScreenAction(value.ACTIVE_SCREEN_SELECTOR_VALUE))
+		}
+	})
+
+	return &ProfileScreen{
+		ui:                          builder.Build(profile.GetInstance().GetContainer()),
+		transparentTransitionEffect: transparentTransitionEffect,
+		world:                       ebiten.NewImage(config.GetWorldWidth(), config.GetWorldHeight()),
+		interfaceWorld: ebiten.NewImage(
+			config.GetWorldWidth(), config.GetWorldHeight()),
+	}
+}
@@ -0,0 +1,505 @@
+package replay
+
+import (
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/YarikRevich/fate-seekers/services/fate-seekers-client/pkg/config"
+	"github.com/YarikRevich/fate-seekers/services/fate-seekers-client/pkg/core/effect/transition"
+	"github.com/YarikRevich/fate-seekers/services/fate-seekers-client/pkg/core/effect/transition/transparent"
+	metadatav1 "github.com/YarikRevich/fate-seekers/services/fate-seekers-client/pkg/core/networking/metadata/api"
+	"github.com/YarikRevich/fate-seekers/services/fate-seekers-client/pkg/core/networking/metadata/handler"
+	"github.com/YarikRevich/fate-seekers/services/fate-seekers-client/pkg/core/screen"
+	"github.com/YarikRevich/fate-seekers/services/fate-seekers-client/pkg/core/tools/animation/direction"
+	"github.com/YarikRevich/fate-seekers/services/fate-seekers-client/pkg/core/tools/options"
+	"github.com/YarikRevich/fate-seekers/services/fate-seekers-client/pkg/core/tools/renderer"
+	"github.com/YarikRevich/fate-seekers/services/fate-seekers-client/pkg/core/tools/renderer/movable"
+	"github.com/YarikRevich/fate-seekers/services/fate-seekers-client/pkg/core/tools/renderer/static"
+	"github.com/YarikRevich/fate-seekers/services/fate-seekers-client/pkg/core/tools/replay"
+	"github.com/YarikRevich/fate-seekers/services/fate-seekers-client/pkg/core/ui/builder"
+	"github.com/YarikRevich/fate-seekers/services/fate-seekers-client/pkg/core/ui/component/common"
+	replaycomponent "github.com/YarikRevich/fate-seekers/services/fate-seekers-client/pkg/core/ui/component/replay"
+	"github.com/YarikRevich/fate-seekers/services/fate-seekers-client/pkg/core/ui/manager/notification"
+	"github.com/YarikRevich/fate-seekers/services/fate-seekers-client/pkg/core/ui/manager/translation"
+	"github.com/YarikRevich/fate-seekers/services/fate-seekers-client/pkg/dto"
+	"github.com/YarikRevich/fate-seekers/services/fate-seekers-client/pkg/loader"
+	"github.com/YarikRevich/fate-seekers/services/fate-seekers-client/pkg/loader/utils"
+	"github.com/YarikRevich/fate-seekers/services/fate-seekers-client/pkg/state/action"
+	"github.com/YarikRevich/fate-seekers/services/fate-seekers-client/pkg/state/dispatcher"
+	"github.com/YarikRevich/fate-seekers/services/fate-seekers-client/pkg/state/store"
+	"github.com/YarikRevich/fate-seekers/services/fate-seekers-client/pkg/state/value"
+	"github.com/ebitenui/ebitenui"
+	"github.com/hajimehoshi/ebiten/v2"
+	"github.com/hajimehoshi/ebiten/v2/inpututil"
+	"github.com/setanarut/kamera/v2"
+)
+
+const (
+	// Represents playback offset change performed by a single seek.
+	seekStep = time.Second * 5
+
+	// Represents distance free camera is moved by during a single update.
+	freeCameraStep = 4.0
+
+	// Represents duration event name is shown for after event start.
+	eventDisplayDuration = time.Second * 10
+)
+
+var (
+	// GetInstance retrieves instance of the replay screen, performing initilization if needed.
+	GetInstance = sync.OnceValue[screen.Screen](newReplayScreen)
+)
+
+// ReplayScreen represents replay screen implementation, which plays back recorded session
+// using session renderer with a spectator camera.
+type ReplayScreen struct {
+	// Represents attached user interface.
+	ui *ebitenui.UI
+
+	// Represents attached spectator camera instance.
+	camera *kamera.Camera
+
+	// Represents transparent transition effect.
+	transparentTransitionEffect transition.TransitionEffect
+
+	// Represents mutex used for replay player access.
+	mu sync.Mutex
+
+	// Represents replay player, which is set when replay is retrieved.
+	player *replay.Player
+
+	// Represents time of the previous playback update.
+	updatedAt time.Time
+
+	// Represents if positions should be set without interpolation during the next update.
+	snap bool
+
+	// Represents if free camera mode is enabled, otherwise camera follows selected issuer.
+	free bool
+
+	// Represents index of the followed issuer.
+	followed int
+
+	// Represents free camera position.
+	freePosition dto.Position
+
+	// Represents previously applied positions of all the issuers.
+	positions map[string]dto.Position
+
+	// Represents previously applied health of all the issuers.
+	health map[string]uint64
+
+	// Represents names of the static objects added to the renderer.
+	statics []string
+
+	// Represents interface world view.
+	interfaceWorld *ebiten.Image
+
+	// Represents global world view.
+	world *ebiten.Image
+}
+
+func (rs *ReplayScreen) HandleInput() error {
+	if store.GetResetReplay() == value.RESET_REPLAY_TRUE_VALUE {
+		dispatcher.GetInstance().Dispatch(
+			action.NewSetResetReplay(value.RESET_REPLAY_FALSE_VALUE))
+
+		rs.reset()
+
+		replaycomponent.GetInstance().SetText(
+			translation.GetInstance().GetTranslation("client.replay.loading"))
+
+		sessionID := store.GetSelectedSessionMetadata().ID
+
+		utils.PerformLoadMap(loader.GetInstance().GetMap(loader.FirstMap), func(_ []dto.SpawnableTile, _, _ []dto.Position) {
+			handler.PerformGetReplay(sessionID, func(frames []*metadatav1.ReplayFrame, err error) {
+				if err != nil {
+					notification.GetInstance().Push(
+						common.ComposeMessage(
+							translation.GetInstance().GetTranslation("client.networking.get-replay-failure"),
+							err.Error()),
+						time.Second*3,
+						common.NotificationErrorTextColor)
+
+					rs.exit()
+
+					return
+				}
+
+				player := replay.NewPlayer(frames)
+
+				rs.addStatics(player.GetStart())
+
+				rs.mu.Lock()
+
+				rs.player = player
+				rs.updatedAt = time.Now()
+
+				rs.mu.Unlock()
+			})
+		})
+	}
+
+	if !rs.transparentTransitionEffect.Done() {
+		if !rs.transparentTransitionEffect.OnEnd() {
+			rs.transparentTransitionEffect.Update()
+		} else {
+			rs.transparentTransitionEffect.Clean()
+		}
+	}
+
+	rs.ui.Update()
+
+	if ebiten.IsKeyPressed(ebiten.KeyEscape) {
+		rs.exit()
+
+		return nil
+	}
+
+	rs.mu.Lock()
+	defer rs.mu.Unlock()
+
+	if rs.player == nil {
+		return nil
+	}
+
+	rs.handlePlayback()
+
+	rs.handleCamera()
+
+	rs.apply()
+
+	renderer.GetInstance().Update(rs.camera)
+
+	replaycomponent.GetInstance().SetText(rs.getStatusText())
+
+	return nil
+}
+
+// handlePlayback handles playback controls and advances playback.
+func (rs *ReplayScreen) handlePlayback() {
+	if inpututil.IsKeyJustPressed(ebiten.KeySpace) {
+		rs.player.TogglePause()
+	}
+
+	if inpututil.IsKeyJustPressed(ebiten.KeyArrowUp) {
+		rs.player.IncreaseSpeed()
+	}
+
+	if inpututil.IsKeyJustPressed(ebiten.KeyArrowDown) {
+		rs.player.DecreaseSpeed()
+	}
+
+	if inpututil.IsKeyJustPressed(ebiten.KeyArrowLeft) {
+		rs.player.Seek(rs.player.GetOffset() - seekStep)
+
+		rs.snap = true
+	}
+
+	if inpututil.IsKeyJustPressed(ebiten.KeyArrowRight) {
+		rs.player.Seek(rs.player.GetOffset() + seekStep)
+
+		rs.snap = true
+	}
+
+	now := time.Now()
+
+	rs.player.Update(now.Sub(rs.updatedAt))
+
+	rs.updatedAt = now
+}
+
+// handleCamera handles camera mode controls and moves camera accordingly.
+func (rs *ReplayScreen) handleCamera() {
+	issuers := rs.player.GetStart().GetIssuers()
+
+	if inpututil.IsKeyJustPressed(ebiten.KeyF) {
+		rs.free = !rs.free
+	}
+
+	if inpututil.IsKeyJustPressed(ebiten.KeyTab) && len(issuers) != 0 {
+		rs.followed = (rs.followed + 1) % len(issuers)
+
+		rs.free = false
+	}
+
+	if rs.free {
+		if ebiten.IsKeyPressed(ebiten.KeyW) {
+			rs.freePosition.Y -= freeCameraStep
+		}
+
+		if ebiten.IsKeyPressed(ebiten.KeyS) {
+			rs.freePosition.Y += freeCameraStep
+		}
+
+		if ebiten.IsKeyPressed(ebiten.KeyA) {
+			rs.freePosition.X -= freeCameraStep
+		}
+
+		if ebiten.IsKeyPressed(ebiten.KeyD) {
+			rs.freePosition.X += freeCameraStep
+		}
+	} else if rs.followed < len(issuers) {
+		if position, ok := rs.player.GetState().Positions[issuers[rs.followed]]; ok {
+			rs.freePosition = position
+		}
+	}
+
+	dispatcher.GetInstance().Dispatch(
+		action.NewSetPositionSession(rs.freePosition))
+
+	rs.camera.LookAt(rs.freePosition.X, -rs.freePosition.Y)
+}
+
+// apply applies current playback state to the renderer.
+func (rs *ReplayScreen) apply() {
+	state := rs.player.GetState()
+
+	issuers := make(map[string]bool)
+
+	for issuer := range state.Positions {
+		if !state.Eliminated[issuer] {
+			issuers[issuer] = true
+		}
+	}
+
+	renderer.GetInstance().PruneSecondaryExternalMovableObjects(issuers)
+
+	for issuer := range issuers {
+		position := state.Positions[issuer]
+
+		var movableUnit *movable.Movable
+
+		if !renderer.GetInstance().SecondaryExternalMovableObjectExists(issuer) {
+			movableUnit = movable.NewMovable(
+				loader.GetMovableSkinsPath(rs.player.GetStart().GetSkins()[issuer]))
+
+			movableUnit.SetDirection(dto.RightMovableRotation)
+			movableUnit.SetPosition(position)
+
+			renderer.GetInstance().AddSecondaryExternalMovableObject(issuer, movableUnit)
+		} else {
+			movableUnit = renderer.GetInstance().GetSecondaryExternalMovableObject(issuer)
+
+			previousPosition := rs.positions[issuer]
+
+			if previousPosition != position {
+				movableUnit.SetDirection(direction.GetAnimationDirection(
+					previousPosition.X, previousPosition.Y, position.X, position.Y))
+			}
+
+			if rs.snap {
+				movableUnit.SetPosition(position)
+			} else {
+				movableUnit.AddPosition(position)
+			}
+		}
+
+		movableUnit.SetStatic(state.Statics[issuer])
+
+		rs.positions[issuer] = position
+
+		if health, ok := state.Health[issuer]; ok {
+			if previousHealth, ok := rs.health[issuer]; ok && previousHealth > health && !rs.snap {
+				movableUnit.TriggerNormalHit()
+			}
+
+			rs.health[issuer] = health
+		}
+	}
+
+	for _, healthPack := range rs.player.GetStart().GetHealthPacks() {
+		if state.TakenHealthPacks[healthPack.GetHealthPackId()] {
+			if renderer.GetInstance().SecondaryLocalStaticObjectExists(healthPack.GetInstance()) {
+				renderer.GetInstance().RemoveSecondaryLocalStaticObject(healthPack.GetInstance())
+			}
+		} else if !renderer.GetInstance().SecondaryLocalStaticObjectExists(healthPack.GetInstance()) {
+			renderer.GetInstance().AddSecondaryLocalStaticObject(
+				healthPack.GetInstance(),
+				static.NewStatic(
+					loader.GetInstance().GetMapTilesetFrogHealthPack(loader.FirstMap),
+					dto.Position{
+						X: healthPack.GetPosition().GetX(),
+						Y: healthPack.GetPosition().GetY(),
+					}))
+		}
+	}
+
+	rs.snap = false
+}
+
+// addStatics adds all the chests and health packs of the given replay to the renderer.
+func (rs *ReplayScreen) addStatics(start *metadatav1.ReplayStart) {
+	for _, chest := range start.GetChests() {
+		renderer.GetInstance().AddSecondaryLocalStaticObject(
+			chest.GetInstance(),
+			static.NewStatic(
+				loader.GetInstance().GetMapTilesetStandardChest(loader.FirstMap),
+				dto.Position{
+					X: chest.GetPosition().GetX(),
+					Y: chest.GetPosition().GetY(),
+				}))
+
+		rs.statics = append(rs.statics, chest.GetInstance())
+	}
+
+	for _, healthPack := range start.GetHealthPacks() {
+		renderer.GetInstance().AddSecondaryLocalStaticObject(
+			healthPack.GetInstance(),
+			static.NewStatic(
+				loader.GetInstance().GetMapTilesetFrogHealthPack(loader.FirstMap),
+				dto.Position{
+					X: healthPack.GetPosition().GetX(),
+					Y: healthPack.GetPosition().GetY(),
+				}))
+
+		rs.statics = append(rs.statics, healthPack.GetInstance())
+	}
+}
+
+// getStatusText composes playback status text.
+func (rs *ReplayScreen) getStatusText() string {
+	var playback string
+
+	if rs.player.IsPaused() {
+		playback = translation.GetInstance().GetTranslation("client.replay.paused")
+	} else {
+		playback = translation.GetInstance().GetTranslation("client.replay.playing")
+	}
+
+	var camera string
+
+	issuers := rs.player.GetStart().GetIssuers()
+
+	if rs.free || rs.followed >= len(issuers) {
+		camera = translation.GetInstance().GetTranslation("client.replay.free-camera")
+	} else {
+		camera = fmt.Sprintf(
+			"%s %s", translation.GetInstance().GetTranslation("client.replay.following"), issuers[rs.followed])
+	}
+
+	result := fmt.Sprintf(
+		"%s %s / %s x%.1f | %s",
+		playback,
+		formatDuration(rs.player.GetOffset()),
+		formatDuration(rs.player.GetDuration()),
+		rs.player.GetSpeed(),
+		camera)
+
+	state := rs.player.GetState()
+
+	if state.Event != "" && rs.player.GetOffset()-state.EventOffset < eventDisplayDuration {
+		result = fmt.Sprintf("%s | %s", result, state.Event)
+	}
+
+	if state.Chest != nil && rs.player.GetOffset()-state.ChestOffset < eventDisplayDuration {
+		var action string
+
+		if state.Chest.GetItemId() == 0 {
+			action = translation.GetInstance().GetTranslation("client.replay.chest-opened")
+		} else {
+			action = translation.GetInstance().GetTranslation("client.replay.chest-item-taken")
+		}
+
+		result = fmt.Sprintf("%s | %s %s", result, state.Chest.GetIssuer(), action)
+	}
+
+	return result
+}
+
+// reset resets replay screen state and cleans the renderer.
+func (rs *ReplayScreen) reset() {
+	rs.mu.Lock()
+	defer rs.mu.Unlock()
+
+	rs.transparentTransitionEffect.Reset()
+
+	rs.cleanStatics()
+
+	renderer.GetInstance().Clean()
+
+	rs.player = nil
+	rs.snap = true
+	rs.free = false
+	rs.followed = 0
+	rs.freePosition = dto.Position{}
+
+	clear(rs.positions)
+	clear(rs.health)
+}
+
+// exit leaves replay screen, cleaning all the added objects.
+func (rs *ReplayScreen) exit() {
+	rs.mu.Lock()
+
+	rs.player = nil
+
+	rs.cleanStatics()
+
+	rs.mu.Unlock()
+
+	renderer.GetInstance().Clean()
+
+	dispatcher.GetInstance().Dispatch(
+		action.NewSetResetReplay(value.RESET_REPLAY_TRUE_VALUE))
+
+	dispatcher.GetInstance().Dispatch(
+		action.NewSetActiveScreenAction(value.ACTIVE_SCREEN_MENU_VALUE))
+}
+
+// cleanStatics removes all the static objects added to the renderer.
+func (rs *ReplayScreen) cleanStatics() {
+	for _, name := range rs.statics {
+		if renderer.GetInstance().SecondaryLocalStaticObjectExists(name) {
+			renderer.GetInstance().RemoveSecondaryLocalStaticObject(name)
+		}
+	}
+
+	rs.statics = rs.statics[:0]
+}
+
+func (rs *ReplayScreen) HandleRender(screen *ebiten.Image) {
+	rs.world.Clear()
+
+	rs.interfaceWorld.Clear()
+
+	rs.mu.Lock()
+
+	if rs.player != nil {
+		renderer.GetInstance().Draw(rs.world, rs.camera)
+	}
+
+	rs.mu.Unlock()
+
+	screen.DrawImage(rs.world, &ebiten.DrawImageOptions{})
+
+	rs.ui.Draw(rs.interfaceWorld)
+
+	screen.DrawImage(rs.interfaceWorld, &ebiten.DrawImageOptions{
+		ColorM: options.GetTransparentDrawOptions(rs.transparentTransitionEffect.GetValue()).ColorM})
+}
+
+// formatDuration formats the given duration as minutes and seconds.
+func formatDuration(value time.Duration) string {
+	return fmt.Sprintf("%02d:%02d", int(value.Minutes()), int(value.Seconds())%60)
+}
+
+// newReplayScreen initializes ReplayScreen.
+func newReplayScreen() screen.Screen {
+	camera := kamera.NewCamera(0, 0, float64(config.GetWorldWidth()), float64(config.GetWorldHeight()))
+
+	camera.SmoothType = kamera.Lerp
+
+	return &ReplayScreen{
+		ui: builder.Build(
+			replaycomponent.GetInstance().GetContainer()),
+		camera: camera,
+		transparentTransitionEffect: transparent.NewTransparentTransitionEffect(
+			true, 255, 0, 5, time.Microsecond*10),
+		positions:      make(map[string]dto.Position),
+		health:         make(map[string]uint64),
+		interfaceWorld: ebiten.NewImage(config.GetWorldWidth(), config.GetWorldHeight()),
+		world:          ebiten.NewImage(config.GetWorldWidth(), config.GetWorldHeight()),
+	}
+}
@@ -11,12 +11,16 @@ import (
 	metadatav1 "github.com/YarikRevich/fate-seekers/services/fate-seekers-client/pkg/core/networking/metadata/api"
 	"github.com/YarikRevich/fate-seekers/services/fate-seekers-client/pkg/core/networking/metadata/converter"
 	"github.com/YarikRevich/fate-seekers/services/fate-seekers-client/pkg/core/networking/metadata/handler"
+	"github.com/YarikRevich/fate-seekers/services/fate-seekers-client/pkg/core/networking/metadata/stream"
 	"github.com/YarikRevich/fate-seekers/services/fate-seekers-client/pkg/core/screen"
 	"github.com/YarikRevich/fate-seekers/services/fate-seekers-client/pkg/core/tools/options"
 	"github.com/YarikRevich/fate-seekers/services/fate-seekers-client/pkg/core/tools/scaler"
 	"github.com/YarikRevich/fate-seekers/services/fate-seekers-client/pkg/core/ui/builder"
 	"github.com/YarikRevich/fate-seekers/services/fate-seekers-client/pkg/core/ui/component/common"
+	"github.com/YarikRevich/fate-seekers/services/fate-seekers-client/pkg/core/ui/component/profile"
+	"github.com/YarikRevich/fate-seekers/services/fate-seekers-client/pkg/core/ui/component/prompt"
 	"github.com/YarikRevich/fate-seekers/services/fate-seekers-client/pkg/core/ui/component/selector"
+	invitationmanager "github.com/YarikRevich/fate-seekers/services/fate-seekers-client/pkg/core/ui/manager/invitation"
 	"github.com/YarikRevich/fate-seekers/services/fate-seekers-client/pkg/core/ui/manager/notification"
 	selectormanager "github.com/YarikRevich/fate-seekers/services/fate-seekers-client/pkg/core/ui/manager/selector"
 	"github.com/YarikRevich/fate-seekers/services/fate-seekers-client/pkg/core/ui/manager/translation"
@@ -36,6 +40,14 @@ var (
 	GetInstance = sync.OnceValue[screen.Screen](newSelectorScreen)
 )
 
+const (
+	// Represents expiration of the single use invite codes created for private sessions.
+	inviteExpiration = time.Hour * 24
+
+	// Represents rules preset of the sessions created by matchmaking.
+	matchmakingRulesPreset = "classic"
+)
+
 // SelectorScreen represents selector screen implementation.
 type SelectorScreen struct {
 	// Represents attached user interface.
@@ -49,6 +61,9 @@ type SelectorScreen struct {
 
 	// Represents interface world view.
 	interfaceWorld *ebiten.Image
+
+	// Represents callback used to join the session of the accepted invitation.
+	acceptInvitation func(invitation dto.RetrievedInvitation)
 }
 
 func (ss *SelectorScreen) HandleInput() error {
@@ -80,6 +95,27 @@ func (ss *SelectorScreen) HandleInput() error {
 		})
 	}
 
+	invitationmanager.GetInstance().Update()
+
+	if store.GetPromptText() == value.TEXT_PROMPT_EMPTY_VALUE &&
+		store.GetLobbyCreationStartedNetworking() == value.LOBBY_CREATION_STARTED_NETWORKING_FALSE_VALUE {
+		if invitation, ok := invitationmanager.GetInstance().Take(); ok {
+			prompt.GetInstance().ShowSubmitButton()
+
+			dispatcher.GetInstance().Dispatch(
+				action.NewSetPromptText(
+					invitationmanager.GetInvitationText(invitation)))
+
+			dispatcher.GetInstance().Dispatch(
+				action.NewSetPromptSubmitCallback(func() {
+					ss.acceptInvitation(invitation)
+				}))
+
+			dispatcher.GetInstance().Dispatch(
+				action.NewSetPromptCancelCallback(func() {}))
+		}
+	}
+
 	if !ss.transparentTransitionEffect.Done() {
 		if !ss.transparentTransitionEffect.OnEnd() {
 			ss.transparentTransitionEffect.Update()
@@ -123,7 +159,7 @@ func (ss *SelectorScreen) HandleRender(screen *ebiten.Image) {
 func newSelectorScreen() screen.Screen {
 	transparentTransitionEffect := transparent.NewTransparentTransitionEffect(true, 255, 0, 5, time.Microsecond*10)
 
-	selector.GetInstance().SetSubmitCallback(func(sessionName string) {
+	join := func(sessionName, password, inviteCode string, spectate bool) {
 		if selectormanager.ProcessChanges(sessionName) {
 			if store.GetLobbyCreationStartedNetworking() == value.LOBBY_CREATION_STARTED_NETWORKING_FALSE_VALUE {
 				dispatcher.GetInstance().Dispatch(
@@ -156,7 +192,7 @@ func newSelectorScreen() screen.Screen {
 							Seed: sessionSeed,
 						}))
 
-					handler.PerformCreateLobby(sessionID, func(err error) {
+					handler.PerformCreateLobby(sessionID, spectate, password, inviteCode, func(err error) {
 						if errors.Is(err, handler.ErrLobbyAlreadyStarted) {
 							notification.GetInstance().Push(
 								translation.GetInstance().GetTranslation("client.networking.joining-existing-lobby"),
@@ -173,6 +209,17 @@ func newSelectorScreen() screen.Screen {
 								time.Second*3,
 								common.NotificationInfoTextColor)
 
+						} else if errors.Is(err, handler.ErrSessionAccessDenied) {
+							notification.GetInstance().Push(
+								translation.GetInstance().GetTranslation("client.networking.session-access-denied"),
+								time.Second*3,
+								common.NotificationErrorTextColor)
+
+							dispatcher.GetInstance().Dispatch(
+								action.NewSetLobbyCreationStartedNetworkingAction(
+									value.LOBBY_CREATION_STARTED_NETWORKING_FALSE_VALUE))
+
+							return
 						} else if err != nil {
 							notification.GetInstance().Push(
 								common.ComposeMessage(
@@ -202,7 +249,7 @@ func newSelectorScreen() screen.Screen {
 
 						selector.GetInstance().CleanInputs()
 
-						selector.GetInstance().ResetDeleteButton()
+						selector.GetInstance().ResetActionButtons()
 					})
 				} else {
 					handler.PerformGetUserSessions(func(response *metadatav1.GetUserSessionsResponse, err error) {
@@ -257,7 +304,7 @@ func newSelectorScreen() screen.Screen {
 									Seed: sessionSeed,
 								}))
 
-							handler.PerformCreateLobby(sessionID, func(err error) {
+							handler.PerformCreateLobby(sessionID, spectate, password, inviteCode, func(err error) {
 								if errors.Is(err, handler.ErrLobbyAlreadyStarted) {
 									notification.GetInstance().Push(
 										translation.GetInstance().GetTranslation("client.networking.joining-existing-lobby"),
@@ -274,6 +321,17 @@ func newSelectorScreen() screen.Screen {
 										time.Second*3,
 										common.NotificationInfoTextColor)
 
+								} else if errors.Is(err, handler.ErrSessionAccessDenied) {
+									notification.GetInstance().Push(
+										translation.GetInstance().GetTranslation("client.networking.session-access-denied"),
+										time.Second*3,
+										common.NotificationErrorTextColor)
+
+									dispatcher.GetInstance().Dispatch(
+										action.NewSetLobbyCreationStartedNetworkingAction(
+											value.LOBBY_CREATION_STARTED_NETWORKING_FALSE_VALUE))
+
+									return
 								} else if err != nil {
 									notification.GetInstance().Push(
 										common.ComposeMessage(
@@ -303,7 +361,7 @@ func newSelectorScreen() screen.Screen {
 
 								selector.GetInstance().CleanInputs()
 
-								selector.GetInstance().ResetDeleteButton()
+								selector.GetInstance().ResetActionButtons()
 							})
 						} else {
 							handler.PerformGetFilteredSessions(dto.GetFilteredSessionsRequest{
@@ -342,7 +400,7 @@ func newSelectorScreen() screen.Screen {
 										Seed: response.Session.GetSeed(),
 									}))
 
-								handler.PerformCreateLobby(response.GetSession().GetSessionId(), func(err error) {
+								handler.PerformCreateLobby(response.GetSession().GetSessionId(), spectate, password, inviteCode, func(err error) {
 									if errors.Is(err, handler.ErrLobbyAlreadyStarted) {
 										notification.GetInstance().Push(
 											translation.GetInstance().GetTranslation("client.networking.joining-existing-lobby"),
@@ -359,6 +417,17 @@ func newSelectorScreen() screen.Screen {
 											time.Second*3,
 											common.NotificationInfoTextColor)
 
+									} else if errors.Is(err, handler.ErrSessionAccessDenied) {
+										notification.GetInstance().Push(
+											translation.GetInstance().GetTranslation("client.networking.session-access-denied"),
+											time.Second*3,
+											common.NotificationErrorTextColor)
+
+										dispatcher.GetInstance().Dispatch(
+											action.NewSetLobbyCreationStartedNetworkingAction(
+												value.LOBBY_CREATION_STARTED_NETWORKING_FALSE_VALUE))
+
+										return
 									} else if err != nil {
 										notification.GetInstance().Push(
 											common.ComposeMessage(
@@ -388,7 +457,7 @@ func newSelectorScreen() screen.Screen {
 
 									selector.GetInstance().CleanInputs()
 
-									selector.GetInstance().ResetDeleteButton()
+									selector.GetInstance().ResetActionButtons()
 								})
 							})
 						}
@@ -396,6 +465,14 @@ func newSelectorScreen() screen.Screen {
 				}
 			}
 		}
+	}
+
+	selector.GetInstance().SetSubmitCallback(func(sessionName, password, inviteCode string) {
+		join(sessionName, password, inviteCode, false)
+	})
+
+	selector.GetInstance().SetSpectateCallback(func(sessionName, password, inviteCode string) {
+		join(sessionName, password, inviteCode, true)
 	})
 
 	selector.GetInstance().SetCreateCallback(func() {
@@ -403,7 +480,7 @@ func newSelectorScreen() screen.Screen {
 
 		selector.GetInstance().CleanInputs()
 
-		selector.GetInstance().ResetDeleteButton()
+		selector.GetInstance().ResetActionButtons()
 
 		dispatcher.GetInstance().Dispatch(
 			action.NewSetSessionRetrievalStartedNetworkingAction(value.SESSION_RETRIEVAL_STARTED_NETWORKING_FALSE_VALUE))
@@ -447,7 +524,7 @@ func newSelectorScreen() screen.Screen {
 
 					selector.GetInstance().CleanInputs()
 
-					selector.GetInstance().ResetDeleteButton()
+					selector.GetInstance().ResetActionButtons()
 
 					notification.GetInstance().Push(
 						translation.GetInstance().GetTranslation("client.selectormanager.session-removal-in-progress"),
@@ -506,7 +583,7 @@ func newSelectorScreen() screen.Screen {
 
 						selector.GetInstance().CleanInputs()
 
-						selector.GetInstance().ResetDeleteButton()
+						selector.GetInstance().ResetActionButtons()
 
 						notification.GetInstance().Push(
 							translation.GetInstance().GetTranslation("client.selectormanager.session-removal-in-progress"),
@@ -521,12 +598,206 @@ func newSelectorScreen() screen.Screen {
 		}
 	})
 
+	selector.GetInstance().SetInviteCallback(func(sessionName string) {
+		var (
+			found     bool
+			sessionID int64
+		)
+
+		for _, session := range store.GetRetrievedSessionsMetadata() {
+			if session.Name == sessionName {
+				found = true
+				sessionID = session.SessionID
+
+				break
+			}
+		}
+
+		if !found {
+			return
+		}
+
+		handler.PerformCreateSessionInvite(sessionID, true, inviteExpiration, func(code string, err error) {
+			if err != nil {
+				notification.GetInstance().Push(
+					common.ComposeMessage(
+						translation.GetInstance().GetTranslation("client.networking.create-session-invite-failure"),
+						err.Error()),
+					time.Second*3,
+					common.NotificationErrorTextColor)
+
+				return
+			}
+
+			notification.GetInstance().Push(
+				common.ComposeMessage(
+					translation.GetInstance().GetTranslation("client.selectormanager.session-invite-created"),
+					code),
+				time.Second*15,
+				common.NotificationInfoTextColor)
+		})
+	})
+
+	selector.GetInstance().SetProfileCallback(func() {
+		handler.PerformGetProfile(func(response *metadatav1.GetProfileResponse, err error) {
+			if err != nil {
+				notification.GetInstance().Push(
+					common.ComposeMessage(
+						translation.GetInstance().GetTranslation("client.networking.get-profile-failure"),
+						err.Error()),
+					time.Second*3,
+					common.NotificationErrorTextColor)
+
+				return
+			}
+
+			profile.GetInstance().SetProfile(
+				response.GetDisplayName(), response.GetSkin(), response.GetAvatar())
+
+			transparentTransitionEffect.Reset()
+
+			selector.GetInstance().ResetActionButtons()
+
+			dispatcher.GetInstance().Dispatch(
+				action.NewSetSessionRetrievalStartedNetworkingAction(value.SESSION_RETRIEVAL_STARTED_NETWORKING_FALSE_VALUE))
+
+			dispatcher.GetInstance().Dispatch(
+				action.NewSetActiveScreenAction(value.ACTIVE_SCREEN_PROFILE_VALUE))
+		})
+	})
+
+	selector.GetInstance().SetFriendsCallback(func() {
+		transparentTransitionEffect.Reset()
+
+		selector.GetInstance().ResetActionButtons()
+
+		dispatcher.GetInstance().Dispatch(
+			action.NewSetSessionRetrievalStartedNetworkingAction(value.SESSION_RETRIEVAL_STARTED_NETWORKING_FALSE_VALUE))
+
+		dispatcher.GetInstance().Dispatch(
+			action.NewSetActiveScreenAction(value.ACTIVE_SCREEN_FRIENDS_VALUE))
+	})
+
+	selector.GetInstance().SetBrowseCallback(func() {
+		transparentTransitionEffect.Reset()
+
+		selector.GetInstance().ResetActionButtons()
+
+		dispatcher.GetInstance().Dispatch(
+			action.NewSetSessionRetrievalStartedNetworkingAction(value.SESSION_RETRIEVAL_STARTED_NETWORKING_FALSE_VALUE))
+
+		dispatcher.GetInstance().Dispatch(
+			action.NewSetActiveScreenAction(value.ACTIVE_SCREEN_BROWSER_VALUE))
+	})
+
+	leaveMatchmaking := func() {
+		dispatcher.GetInstance().Dispatch(
+			action.NewSetMatchmakingStartedNetworkingAction(
+				value.MATCHMAKING_STARTED_NETWORKING_FALSE_VALUE))
+
+		selector.GetInstance().ResetMatchmakingStatus()
+
+		stream.GetJoinMatchmakingSubmitter().Clean(func() {
+			handler.PerformLeaveMatchmaking(func(err error) {
+				if err != nil {
+					notification.GetInstance().Push(
+						common.ComposeMessage(
+							translation.GetInstance().GetTranslation("client.networking.leave-matchmaking-failure"),
+							err.Error()),
+						time.Second*3,
+						common.NotificationErrorTextColor)
+				}
+			})
+		})
+	}
+
+	selector.GetInstance().SetMatchmakingCallback(func() {
+		if store.GetMatchmakingStartedNetworking() == value.MATCHMAKING_STARTED_NETWORKING_TRUE_VALUE {
+			leaveMatchmaking()
+
+			return
+		}
+
+		dispatcher.GetInstance().Dispatch(
+			action.NewSetMatchmakingStartedNetworkingAction(
+				value.MATCHMAKING_STARTED_NETWORKING_TRUE_VALUE))
+
+		stream.GetJoinMatchmakingSubmitter().Submit(
+			matchmakingRulesPreset, func(response *metadatav1.JoinMatchmakingResponse, err error) bool {
+				if store.GetMatchmakingStartedNetworking() == value.MATCHMAKING_STARTED_NETWORKING_FALSE_VALUE {
+					return true
+				}
+
+				if err != nil {
+					notification.GetInstance().Push(
+						common.ComposeMessage(
+							translation.GetInstance().GetTranslation("client.networking.join-matchmaking-failure"),
+							err.Error()),
+						time.Second*3,
+						common.NotificationErrorTextColor)
+
+					dispatcher.GetInstance().Dispatch(
+						action.NewSetMatchmakingStartedNetworkingAction(
+							value.MATCHMAKING_STARTED_NETWORKING_FALSE_VALUE))
+
+					selector.GetInstance().ResetMatchmakingStatus()
+
+					return true
+				}
+
+				if response.Match == nil {
+					selector.GetInstance().SetMatchmakingStatus(
+						response.GetPosition(), response.GetEstimatedWait())
+
+					return false
+				}
+
+				dispatcher.GetInstance().Dispatch(
+					action.NewSetMatchmakingStartedNetworkingAction(
+						value.MATCHMAKING_STARTED_NETWORKING_FALSE_VALUE))
+
+				selector.GetInstance().ResetMatchmakingStatus()
+
+				notification.GetInstance().Push(
+					translation.GetInstance().GetTranslation("client.selector.matchmaking-found"),
+					time.Second*3,
+					common.NotificationInfoTextColor)
+
+				dispatcher.GetInstance().Dispatch(
+					action.NewSetSelectedSessionMetadata(&dto.SelectedSessionMetadata{
+						ID:   response.GetMatch().GetSessionId(),
+						Name: response.GetMatch().GetName(),
+						Seed: response.GetMatch().GetSeed(),
+					}))
+
+				transparentTransitionEffect.Reset()
+
+				selector.GetInstance().CleanInputs()
+
+				selector.GetInstance().ResetActionButtons()
+
+				dispatcher.GetInstance().Dispatch(
+					action.NewSetSessionRetrievalStartedNetworkingAction(value.SESSION_RETRIEVAL_STARTED_NETWORKING_FALSE_VALUE))
+
+				dispatcher.GetInstance().Dispatch(
+					action.NewSetActiveScreenAction(value.ACTIVE_SCREEN_LOBBY_VALUE))
+
+				return true
+			})
+	})
+
 	selector.GetInstance().SetBackCallback(func() {
 		transparentTransitionEffect.Reset()
 
+		if store.GetMatchmakingStartedNetworking() == value.MATCHMAKING_STARTED_NETWORKING_TRUE_VALUE {
+			leaveMatchmaking()
+		}
+
+		invitationmanager.GetInstance().Clean()
+
 		selector.GetInstance().CleanInputs()
 
-		selector.GetInstance().ResetDeleteButton()
+		selector.GetInstance().ResetActionButtons()
 
 		dispatcher.GetInstance().Dispatch(
 			action.NewSetSessionRetrievalStartedNetworkingAction(value.SESSION_RETRIEVAL_STARTED_NETWORKING_FALSE_VALUE))
@@ -535,11 +806,93 @@ func newSelectorScreen() screen.Screen {
 			action.NewSetActiveScreenAction(value.ACTIVE_SCREEN_MENU_VALUE))
 	})
 
+	acceptInvitation := func(invitation dto.RetrievedInvitation) {
+		if store.GetLobbyCreationStartedNetworking() == value.LOBBY_CREATION_STARTED_NETWORKING_TRUE_VALUE {
+			return
+		}
+
+		if store.GetMatchmakingStartedNetworking() == value.MATCHMAKING_STARTED_NETWORKING_TRUE_VALUE {
+			leaveMatchmaking()
+		}
+
+		dispatcher.GetInstance().Dispatch(
+			action.NewSetLobbyCreationStartedNetworkingAction(
+				value.LOBBY_CREATION_STARTED_NETWORKING_TRUE_VALUE))
+
+		dispatcher.GetInstance().Dispatch(
+			action.NewSetSelectedSessionMetadata(&dto.SelectedSessionMetadata{
+				ID:   invitation.SessionID,
+				Name: invitation.SessionName,
+				Seed: invitation.Seed,
+			}))
+
+		handler.PerformCreateLobby(invitation.SessionID, false, "", invitation.InviteCode, func(err error) {
+			if errors.Is(err, handler.ErrLobbyAlreadyStarted) {
+				notification.GetInstance().Push(
+					translation.GetInstance().GetTranslation("client.networking.joining-existing-lobby"),
+					time.Second*3,
+					common.NotificationInfoTextColor)
+
+				dispatcher.GetInstance().Dispatch(
+					action.NewSetSessionAlreadyStartedMetadata(
+						value.SESSION_ALREADY_STARTED_METADATA_STATE_TRUE_VALUE))
+
+			} else if errors.Is(err, handler.ErrLobbyAlreadyExists) {
+				notification.GetInstance().Push(
+					translation.GetInstance().GetTranslation("client.networking.joining-existing-lobby"),
+					time.Second*3,
+					common.NotificationInfoTextColor)
+
+			} else if errors.Is(err, handler.ErrSessionAccessDenied) {
+				notification.GetInstance().Push(
+					translation.GetInstance().GetTranslation("client.networking.session-access-denied"),
+					time.Second*3,
+					common.NotificationErrorTextColor)
+
+				dispatcher.GetInstance().Dispatch(
+					action.NewSetLobbyCreationStartedNetworkingAction(
+						value.LOBBY_CREATION_STARTED_NETWORKING_FALSE_VALUE))
+
+				return
+			} else if err != nil {
+				notification.GetInstance().Push(
+					common.ComposeMessage(
+						translation.GetInstance().GetTranslation("client.networking.create-lobby-failure"),
+						err.Error()),
+					time.Second*3,
+					common.NotificationErrorTextColor)
+
+				dispatcher.GetInstance().Dispatch(
+					action.NewSetLobbyCreationStartedNetworkingAction(
+						value.LOBBY_CREATION_STARTED_NETWORKING_FALSE_VALUE))
+
+				return
+			}
+
+			transparentTransitionEffect.Reset()
+
+			dispatcher.GetInstance().Dispatch(
+				action.NewSetSessionRetrievalStartedNetworkingAction(value.SESSION_RETRIEVAL_STARTED_NETWORKING_FALSE_VALUE))
+
+			dispatcher.GetInstance().Dispatch(
+				action.NewSetActiveScreenAction(value.ACTIVE_SCREEN_LOBBY_VALUE))
+
+			dispatcher.GetInstance().Dispatch(
+				action.NewSetLobbyCreationStartedNetworkingAction(
+					value.LOBBY_CREATION_STARTED_NETWORKING_FALSE_VALUE))
+
+			selector.GetInstance().CleanInputs()
+
+			selector.GetInstance().ResetActionButtons()
+		})
+	}
+
 	return &SelectorScreen{
 		ui:                          builder.Build(selector.GetInstance().GetContainer()),
 		transparentTransitionEffect: transparentTransitionEffect,
 		world:                       ebiten.NewImage(config.GetWorldWidth(), config.GetWorldHeight()),
 		interfaceWorld: ebiten.NewImage(
 			config.GetWorldWidth(), config.GetWorldHeight()),
+		acceptInvitation: acceptInvitation,
 	}
 }
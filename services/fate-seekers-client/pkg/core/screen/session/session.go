@@ -1,6 +1,9 @@
 package session
 
 import (
+	"fmt"
+	"slices"
+	"strings"
 	"sync"
 	"time"
 
@@ -19,6 +22,7 @@ import (
 	"github.com/YarikRevich/fate-seekers/services/fate-seekers-client/pkg/core/tools/animation/direction"
 	"github.com/YarikRevich/fate-seekers/services/fate-seekers-client/pkg/core/tools/collision"
 	"github.com/YarikRevich/fate-seekers/services/fate-seekers-client/pkg/core/tools/gamepad"
+	"github.com/YarikRevich/fate-seekers/services/fate-seekers-client/pkg/core/tools/marker"
 	"github.com/YarikRevich/fate-seekers/services/fate-seekers-client/pkg/core/tools/options"
 	"github.com/YarikRevich/fate-seekers/services/fate-seekers-client/pkg/core/tools/renderer"
 	"github.com/YarikRevich/fate-seekers/services/fate-seekers-client/pkg/core/tools/renderer/movable"
@@ -27,10 +31,14 @@ import (
 	"github.com/YarikRevich/fate-seekers/services/fate-seekers-client/pkg/core/tools/sounder"
 	"github.com/YarikRevich/fate-seekers/services/fate-seekers-client/pkg/core/ui/builder"
 	"github.com/YarikRevich/fate-seekers/services/fate-seekers-client/pkg/core/ui/component/bar"
+	"github.com/YarikRevich/fate-seekers/services/fate-seekers-client/pkg/core/ui/component/chat"
 	"github.com/YarikRevich/fate-seekers/services/fate-seekers-client/pkg/core/ui/component/chest"
 	"github.com/YarikRevich/fate-seekers/services/fate-seekers-client/pkg/core/ui/component/common"
+	"github.com/YarikRevich/fate-seekers/services/fate-seekers-client/pkg/core/ui/component/emote"
 	"github.com/YarikRevich/fate-seekers/services/fate-seekers-client/pkg/core/ui/component/inventory"
 	"github.com/YarikRevich/fate-seekers/services/fate-seekers-client/pkg/core/ui/component/press"
+	chatmanager "github.com/YarikRevich/fate-seekers/services/fate-seekers-client/pkg/core/ui/manager/chat"
+	emotemanager "github.com/YarikRevich/fate-seekers/services/fate-seekers-client/pkg/core/ui/manager/emote"
 	"github.com/YarikRevich/fate-seekers/services/fate-seekers-client/pkg/core/ui/manager/notification"
 	"github.com/YarikRevich/fate-seekers/services/fate-seekers-client/pkg/core/ui/manager/translation"
 	"github.com/YarikRevich/fate-seekers/services/fate-seekers-client/pkg/dto"
@@ -105,10 +113,14 @@ type SessionScreen struct {
 
 	// Represents session toxic rain event shader effect.
 	toxicRainEventShaderEffect *toxicrain.ToxicRainEventEffect
+
+	// Represents issuer of the surviving player followed by the camera, when spectating.
+	spectatedIssuer string
 }
 
 func (ss *SessionScreen) HandleInput() error {
-	if store.GetUpdateUserMetadataPositionsStartedNetworking() == value.UPDATE_USER_METADATA_POSITIONS_STARTED_NETWORKING_FALSE_VALUE {
+	if store.GetUpdateUserMetadataPositionsStartedNetworking() == value.UPDATE_USER_METADATA_POSITIONS_STARTED_NETWORKING_FALSE_VALUE &&
+		store.GetSpectatingSession() == value.SPECTATING_SESSION_FALSE_VALUE {
 		dispatcher.GetInstance().Dispatch(
 			action.NewSetUpdateUserMetadataPositionsStartedNetworking(
 				value.UPDATE_USER_METADATA_POSITIONS_STARTED_NETWORKING_TRUE_VALUE))
@@ -118,7 +130,8 @@ func (ss *SessionScreen) HandleInput() error {
 				store.GetSelectedSessionMetadata().ID,
 				store.GetSelectedLobbySetUnitMetadata().ID,
 				func(err error) bool {
-					if store.GetActiveScreen() != value.ACTIVE_SCREEN_SESSION_VALUE {
+					if store.GetActiveScreen() != value.ACTIVE_SCREEN_SESSION_VALUE ||
+						store.GetSpectatingSession() == value.SPECTATING_SESSION_TRUE_VALUE {
 						dispatcher.GetInstance().Dispatch(
 							action.NewSetUpdateUserMetadataPositionsStartedNetworking(
 								value.UPDATE_USER_METADATA_POSITIONS_STARTED_NETWORKING_FALSE_VALUE))
@@ -235,36 +248,88 @@ func (ss *SessionScreen) HandleInput() error {
 
 					clear(sharedUsersMetadataHealthHitsIssuers)
 
-					var animationDirection string
+					var (
+						animationDirection string
+						team               uint64
+						spectator          bool
+					)
 
 					for _, userMetadata := range response.GetUserMetadata() {
-						if userMetadata.Issuer == store.GetRepositoryUUID() && userMetadata.Eliminated {
-							dispatcher.GetInstance().Dispatch(
-								action.NewSetEventRetrievalStartedNetworking(
-									value.EVENT_RETRIEVAL_STARTED_NETWORKING_FALSE_STATE))
+						if userMetadata.GetIssuer() == store.GetRepositoryUUID() {
+							team = userMetadata.GetTeam()
+							spectator = userMetadata.GetSpectator()
+						}
+					}
 
-							dispatcher.GetInstance().Dispatch(
-								action.NewSetUsersMetadataRetrievalStartedNetworking(
-									value.USERS_METADATA_RETRIEVAL_STARTED_NETWORKING_FALSE_STATE))
+					if response.GetFinished() &&
+						store.GetFinishedSession() == value.FINISHED_SESSION_FALSE_VALUE {
+						dispatcher.GetInstance().Dispatch(
+							action.NewSetFinishedSession(value.FINISHED_SESSION_TRUE_VALUE))
+
+						var message string
+
+						switch {
+						case len(response.GetWinners()) == 0:
+							message = "client.session.draw"
+						case slices.Contains(response.GetWinners(), store.GetRepositoryUUID()):
+							message = "client.session.victory"
+						case spectator:
+							message = "client.session.finished"
+						default:
+							message = "client.session.defeat"
+						}
 
-							dispatcher.GetInstance().Dispatch(
-								action.NewSetChestsRetrievalStartedNetworking(
-									value.CHESTS_RETRIEVAL_STARTED_NETWORKING_FALSE_STATE))
+						notification.GetInstance().Push(
+							translation.GetInstance().GetTranslation(message),
+							time.Second*5,
+							common.NotificationInfoTextColor)
 
-							dispatcher.GetInstance().Dispatch(
-								action.NewSetHealthPacksRetrievalStartedNetworking(
-									value.HEALTH_PACKS_RETRIEVAL_STARTED_NETWORKING_FALSE_STATE))
+						if len(response.GetWinners()) != 0 {
+							var winners []string
 
-							dispatcher.GetInstance().Dispatch(
-								action.NewSetResetDeath(value.RESET_DEATH_TRUE_VALUE))
+							for _, winner := range response.GetWinners() {
+								winners = append(winners, chatmanager.GetAuthor(winner))
+							}
 
-							dispatcher.GetInstance().Dispatch(
-								action.NewSetActiveScreenAction(value.ACTIVE_SCREEN_DEATH_VALUE))
+							notification.GetInstance().Push(
+								fmt.Sprintf(
+									"%s: %s",
+									translation.GetInstance().GetTranslation("client.session.winners"),
+									strings.Join(winners, ", ")),
+								time.Second*5,
+								common.NotificationInfoTextColor)
+						}
+					}
+
+					for _, userMetadata := range response.GetUserMetadata() {
+						if userMetadata.Issuer == store.GetRepositoryUUID() &&
+							(userMetadata.Eliminated || userMetadata.Spectator) {
+							if store.GetSpectatingSession() == value.SPECTATING_SESSION_FALSE_VALUE {
+								notification.GetInstance().Push(
+									translation.GetInstance().GetTranslation("client.session.spectating"),
+									time.Second*5,
+									common.NotificationInfoTextColor)
 
-							return true
+								dispatcher.GetInstance().Dispatch(
+									action.NewSetSpectatingSession(value.SPECTATING_SESSION_TRUE_VALUE))
+							}
+
+							continue
 						}
 
 						if userMetadata.Eliminated {
+							store.RetrievedUsersMetadataSessionSyncHelper.Lock()
+
+							retrievedUsersMetadataSession := store.GetRetrievedUsersMetadataSession()
+
+							if previousUsersMetadata, ok := retrievedUsersMetadataSession[userMetadata.GetIssuer()]; ok {
+								previousUsersMetadata.Eliminated = true
+
+								retrievedUsersMetadataSession[userMetadata.GetIssuer()] = previousUsersMetadata
+							}
+
+							store.RetrievedUsersMetadataSessionSyncHelper.Unlock()
+
 							continue
 						}
 
@@ -326,10 +391,12 @@ func (ss *SessionScreen) HandleInput() error {
 
 						retrievedUsersMetadataSession[userMetadata.GetIssuer()] =
 							dto.RetrievedUsersMetadataSessionUnit{
+								DisplayName:        userMetadata.GetDisplayName(),
 								Health:             userMetadata.GetHealth(),
 								Skin:               userMetadata.GetSkin(),
 								Active:             userMetadata.GetActive(),
 								Eliminated:         userMetadata.GetEliminated(),
+								Team:               userMetadata.GetTeam(),
 								AnimationDirection: animationDirection,
 								AnimationStatic:    userMetadata.GetStatic(),
 								Position: dto.Position{
@@ -374,6 +441,10 @@ func (ss *SessionScreen) HandleInput() error {
 							movableUnit.AddPosition(retrievedUsersMetadata.Position)
 						}
 
+						movableUnit.SetTeammate(team != 0 && retrievedUsersMetadata.Team == team)
+
+						marker.GetInstance().SetNameMarker(issuer, retrievedUsersMetadata.DisplayName)
+
 						if retrievedUsersMetadata.Active {
 							if !retrievedUsersMetadata.AnimationStatic {
 								shiftWidth, shiftHeight := movableUnit.GetShiftBounds()
@@ -564,99 +635,109 @@ func (ss *SessionScreen) HandleInput() error {
 
 	retrievedUsersMetadataSession := store.GetRetrievedUsersMetadataSession()
 
-	if _, ok := retrievedUsersMetadataSession[store.GetRepositoryUUID()]; ok {
+	spectating := store.GetSpectatingSession() == value.SPECTATING_SESSION_TRUE_VALUE
+
+	overlayShown := chat.GetInstance().IsShown() || emote.GetInstance().IsShown()
+
+	chatmanager.GetInstance().Update()
+
+	emotemanager.GetInstance().Update(ss.camera, !spectating)
+
+	if _, ok := retrievedUsersMetadataSession[store.GetRepositoryUUID()]; ok && !spectating {
 		var (
 			spacePressed  bool
 			iKeyPressed   bool
 			escapePressed bool
 		)
 
-		if store.GetApplicationStateGamepadEnabled() == value.GAMEPAD_ENABLED_APPLICATION_TRUE_VALUE && ebiten.IsFocused() {
-			gamepadID := ebiten.GamepadIDs()[0]
+		if !overlayShown {
+			if store.GetApplicationStateGamepadEnabled() == value.GAMEPAD_ENABLED_APPLICATION_TRUE_VALUE && ebiten.IsFocused() {
+				gamepadID := ebiten.GamepadIDs()[0]
 
-			if inpututil.IsStandardGamepadButtonJustPressed(
-				ebiten.GamepadIDs()[0], ebiten.StandardGamepadButtonFrontTopLeft) {
-				iKeyPressed = true
-			}
+				if inpututil.IsStandardGamepadButtonJustPressed(
+					ebiten.GamepadIDs()[0], ebiten.StandardGamepadButtonFrontTopLeft) {
+					iKeyPressed = true
+				}
 
-			if inpututil.IsStandardGamepadButtonJustPressed(
-				ebiten.GamepadIDs()[0], ebiten.StandardGamepadButtonFrontBottomRight) {
-				spacePressed = true
-			}
+				if inpututil.IsStandardGamepadButtonJustPressed(
+					ebiten.GamepadIDs()[0], ebiten.StandardGamepadButtonFrontBottomRight) {
+					spacePressed = true
+				}
 
-			if inpututil.IsStandardGamepadButtonJustPressed(
-				ebiten.GamepadIDs()[0], ebiten.StandardGamepadButtonRightTop) {
-				escapePressed = true
-			}
+				if inpututil.IsStandardGamepadButtonJustPressed(
+					ebiten.GamepadIDs()[0], ebiten.StandardGamepadButtonRightTop) {
+					escapePressed = true
+				}
 
-			direction := gamepad.GetGamepadLeftStickDirection(gamepadID)
+				direction := gamepad.GetGamepadLeftStickDirection(gamepadID)
 
-			if store.GetChestOpenedSession() == value.CHEST_OPENED_FALSE_VALUE {
-				switch direction {
-				case dto.DirUp:
-					dispatcher.GetInstance().Dispatch(action.NewIncrementYPositionSession())
+				if store.GetChestOpenedSession() == value.CHEST_OPENED_FALSE_VALUE {
+					switch direction {
+					case dto.DirUp:
+						dispatcher.GetInstance().Dispatch(action.NewIncrementYPositionSession())
 
-				case dto.DirDown:
-					dispatcher.GetInstance().Dispatch(action.NewDecrementYPositionSession())
+					case dto.DirDown:
+						dispatcher.GetInstance().Dispatch(action.NewDecrementYPositionSession())
 
-				case dto.DirLeft:
-					dispatcher.GetInstance().Dispatch(action.NewDecrementXPositionSession())
+					case dto.DirLeft:
+						dispatcher.GetInstance().Dispatch(action.NewDecrementXPositionSession())
 
-				case dto.DirRight:
-					dispatcher.GetInstance().Dispatch(action.NewIncrementXPositionSession())
+					case dto.DirRight:
+						dispatcher.GetInstance().Dispatch(action.NewIncrementXPositionSession())
 
-				case dto.DirUpLeft:
-					dispatcher.GetInstance().Dispatch(action.NewDiagonalUpLeftPositionSession())
+					case dto.DirUpLeft:
+						dispatcher.GetInstance().Dispatch(action.NewDiagonalUpLeftPositionSession())
 
-				case dto.DirUpRight:
-					dispatcher.GetInstance().Dispatch(action.NewDiagonalUpRightPositionSession())
+					case dto.DirUpRight:
+						dispatcher.GetInstance().Dispatch(action.NewDiagonalUpRightPositionSession())
 
-				case dto.DirDownLeft:
-					dispatcher.GetInstance().Dispatch(action.NewDiagonalDownLeftPositionSession())
+					case dto.DirDownLeft:
+						dispatcher.GetInstance().Dispatch(action.NewDiagonalDownLeftPositionSession())
 
-				case dto.DirDownRight:
-					dispatcher.GetInstance().Dispatch(action.NewDiagonalDownRightPositionSession())
+					case dto.DirDownRight:
+						dispatcher.GetInstance().Dispatch(action.NewDiagonalDownRightPositionSession())
 
+					}
+				}
+			} else {
+				if inpututil.IsKeyJustPressed(ebiten.KeyEscape) {
+					escapePressed = true
 				}
-			}
-		} else {
-			if ebiten.IsKeyPressed(ebiten.KeyEscape) {
-				escapePressed = true
-			}
 
-			if inpututil.IsKeyJustPressed(ebiten.KeySpace) {
-				spacePressed = true
-			}
+				if inpututil.IsKeyJustPressed(ebiten.KeySpace) {
+					spacePressed = true
+				}
 
-			if inpututil.IsKeyJustPressed(ebiten.KeyI) {
-				iKeyPressed = true
-			}
+				if inpututil.IsKeyJustPressed(ebiten.KeyI) {
+					iKeyPressed = true
+				}
 
-			if store.GetChestOpenedSession() == value.CHEST_OPENED_FALSE_VALUE {
-				if ebiten.IsKeyPressed(ebiten.KeyW) && ebiten.IsKeyPressed(ebiten.KeyA) {
-					dispatcher.GetInstance().Dispatch(action.NewDiagonalUpLeftPositionSession())
+				if store.GetChestOpenedSession() == value.CHEST_OPENED_FALSE_VALUE {
+					if ebiten.IsKeyPressed(ebiten.KeyW) && ebiten.IsKeyPressed(ebiten.KeyA) {
+						dispatcher.GetInstance().Dispatch(action.NewDiagonalUpLeftPositionSession())
 
-				} else if ebiten.IsKeyPressed(ebiten.KeyW) && ebiten.IsKeyPressed(ebiten.KeyD) {
-					dispatcher.GetInstance().Dispatch(action.NewDiagonalUpRightPositionSession())
+					} else if ebiten.IsKeyPressed(ebiten.KeyW) && ebiten.IsKeyPressed(ebiten.KeyD) {
+						dispatcher.GetInstance().Dispatch(action.NewDiagonalUpRightPositionSession())
 
-				} else if ebiten.IsKeyPressed(ebiten.KeyS) && ebiten.IsKeyPressed(ebiten.KeyA) {
-					dispatcher.GetInstance().Dispatch(action.NewDiagonalDownLeftPositionSession())
+					} else if ebiten.IsKeyPressed(ebiten.KeyS) && ebiten.IsKeyPressed(ebiten.KeyA) {
+						dispatcher.GetInstance().Dispatch(action.NewDiagonalDownLeftPositionSession())
 
-				} else if ebiten.IsKeyPressed(ebiten.KeyS) && ebiten.IsKeyPressed(ebiten.KeyD) {
-					dispatcher.GetInstance().Dispatch(action.NewDiagonalDownRightPositionSession())
+					} else if ebiten.IsKeyPressed(ebiten.KeyS) && ebiten.IsKeyPressed(ebiten.KeyD) {
+						dispatcher.GetInstance().Dispatch(action.NewDiagonalDownRightPositionSession())
 
-				} else if ebiten.IsKeyPressed(ebiten.KeyA) {
-					dispatcher.GetInstance().Dispatch(action.NewDecrementXPositionSession())
+					} else if ebiten.IsKeyPressed(ebiten.KeyA) {
+						dispatcher.GetInstance().Dispatch(action.NewDecrementXPositionSession())
 
-				} else if ebiten.IsKeyPressed(ebiten.KeyW) {
-					dispatcher.GetInstance().Dispatch(action.NewIncrementYPositionSession())
+					} else if ebiten.IsKeyPressed(ebiten.KeyW) {
+						dispatcher.GetInstance().Dispatch(action.NewIncrementYPositionSession())
 
-				} else if ebiten.IsKeyPressed(ebiten.KeyS) {
-					dispatcher.GetInstance().Dispatch(action.NewDecrementYPositionSession())
+					} else if ebiten.IsKeyPressed(ebiten.KeyS) {
+						dispatcher.GetInstance().Dispatch(action.NewDecrementYPositionSession())
 
-				} else if ebiten.IsKeyPressed(ebiten.KeyD) {
-					dispatcher.GetInstance().Dispatch(action.NewIncrementXPositionSession())
+					} else if ebiten.IsKeyPressed(ebiten.KeyD) {
+						dispatcher.GetInstance().Dispatch(action.NewIncrementXPositionSession())
 
+					}
 				}
 			}
 		}
@@ -850,43 +931,59 @@ func (ss *SessionScreen) HandleInput() error {
 		} else {
 			sounder.GetInstance().InterruptStepsTrackableObject()
 		}
+	} else if spectating && !overlayShown {
+		ss.handleSpectatingInput(retrievedUsersMetadataSession)
 	}
 
-	var movableUnit *movable.Movable
+	var shiftWidth, shiftHeight float64
 
-	if !renderer.GetInstance().MainCenteredMovableObjectExists(selectedLobbySet.Issuer) {
-		movableUnit = movable.NewMovable(
-			loader.GetMovableSkinsPath(selectedLobbySet.Skin))
+	if !spectating {
+		var movableUnit *movable.Movable
 
-		movableUnit.SetDirection(dto.RightMovableRotation)
-		movableUnit.SetStatic(true)
+		if !renderer.GetInstance().MainCenteredMovableObjectExists(selectedLobbySet.Issuer) {
+			movableUnit = movable.NewMovable(
+				loader.GetMovableSkinsPath(selectedLobbySet.Skin))
 
-		renderer.GetInstance().AddMainCenteredMovableObject(selectedLobbySet.Issuer, movableUnit)
-	} else {
-		movableUnit = renderer.GetInstance().GetMainCenteredMovableObject(selectedLobbySet.Issuer)
-
-		if store.GetPreviousPositionSession().X != store.GetPositionSession().X ||
-			store.GetPreviousPositionSession().Y != store.GetPositionSession().Y {
-			movableUnit.SetDirection(direction.GetAnimationDirection(
-				store.GetPreviousPositionSession().X,
-				store.GetPreviousPositionSession().Y,
-				store.GetPositionSession().X,
-				store.GetPositionSession().Y))
-			movableUnit.SetStatic(false)
+			movableUnit.SetDirection(dto.RightMovableRotation)
+			movableUnit.SetStatic(true)
 
+			renderer.GetInstance().AddMainCenteredMovableObject(selectedLobbySet.Issuer, movableUnit)
 		} else {
-			movableUnit.SetStatic(true)
+			movableUnit = renderer.GetInstance().GetMainCenteredMovableObject(selectedLobbySet.Issuer)
+
+			if store.GetPreviousPositionSession().X != store.GetPositionSession().X ||
+				store.GetPreviousPositionSession().Y != store.GetPositionSession().Y {
+				movableUnit.SetDirection(direction.GetAnimationDirection(
+					store.GetPreviousPositionSession().X,
+					store.GetPreviousPositionSession().Y,
+					store.GetPositionSession().X,
+					store.GetPositionSession().Y))
+				movableUnit.SetStatic(false)
+
+			} else {
+				movableUnit.SetStatic(true)
+			}
 		}
-	}
 
-	movableUnit.SetPosition(store.GetPositionSession())
+		movableUnit.SetPosition(store.GetPositionSession())
 
-	shiftWidth, shiftHeight := movableUnit.GetShiftBounds()
+		shiftWidth, shiftHeight = movableUnit.GetShiftBounds()
+
+		if _, ok := retrievedUsersMetadataSession[store.GetRepositoryUUID()]; ok {
+			if !selected.GetInstance().MainTrackableObjectExists() {
+				selected.GetInstance().SetMainTrackableObject(
+					store.GetPositionSession(), shiftWidth, shiftHeight)
+			}
+		}
+	} else {
+		if renderer.GetInstance().MainCenteredMovableObjectExists(selectedLobbySet.Issuer) {
+			renderer.GetInstance().RemoveMainCenteredMovableObject(selectedLobbySet.Issuer)
+		}
 
-	if _, ok := retrievedUsersMetadataSession[store.GetRepositoryUUID()]; ok {
-		if !selected.GetInstance().MainTrackableObjectExists() {
-			selected.GetInstance().SetMainTrackableObject(
-				store.GetPositionSession(), shiftWidth, shiftHeight)
+		if renderer.GetInstance().SecondaryExternalMovableObjectExists(ss.spectatedIssuer) {
+			shiftWidth, shiftHeight = renderer.GetInstance().
+				GetSecondaryExternalMovableObject(ss.spectatedIssuer).
+				GetShiftBounds()
 		}
 	}
 
@@ -1074,7 +1171,7 @@ func (ss *SessionScreen) HandleInput() error {
 	}
 
 	selectedPosition, ok := selected.GetInstance().Scan(ss.camera)
-	if ok {
+	if ok && !spectating && !overlayShown {
 		ss.pressTransparentTransitionEffect.Reset()
 
 		renderer.GetInstance().SetSelectedObject(selectedPosition.Position)
@@ -1132,6 +1229,8 @@ func (ss *SessionScreen) HandleInput() error {
 
 	sounder.GetInstance().Update(ss.camera)
 
+	marker.GetInstance().Update(ss.camera)
+
 	if !ss.transparentTransitionEffect.Done() {
 		if !ss.transparentTransitionEffect.OnEnd() {
 			ss.transparentTransitionEffect.Update()
@@ -1192,7 +1291,8 @@ func (ss *SessionScreen) HandleRender(screen *ebiten.Image) {
 
 	retrievedUsersMetadataSession := store.GetRetrievedUsersMetadataSession()
 
-	if _, ok := retrievedUsersMetadataSession[store.GetRepositoryUUID()]; ok {
+	if _, ok := retrievedUsersMetadataSession[store.GetRepositoryUUID()]; ok ||
+		store.GetSpectatingSession() == value.SPECTATING_SESSION_TRUE_VALUE {
 		ss.passiveTransitionInterfaceWorld.Clear()
 
 		ss.passiveInterfaceWorld.Clear()
@@ -1207,6 +1307,8 @@ func (ss *SessionScreen) HandleRender(screen *ebiten.Image) {
 
 		renderer.GetInstance().Draw(ss.internalWorld, ss.camera)
 
+		marker.GetInstance().Draw(ss.internalWorld, ss.camera)
+
 		screen.DrawImage(ss.internalWorld, &ebiten.DrawImageOptions{})
 
 		ss.passiveTransitionUI.Draw(ss.passiveTransitionInterfaceWorld)
@@ -1246,6 +1348,94 @@ func (ss *SessionScreen) HandleRender(screen *ebiten.Image) {
 	store.RetrievedUsersMetadataSessionSyncHelper.Unlock()
 }
 
+// handleSpectatingInput performs camera management for the spectating player, following one of the surviving players.
+func (ss *SessionScreen) handleSpectatingInput(retrievedUsersMetadataSession dto.RetrievedUsersMetadataSessionSet) {
+	var (
+		tabPressed    bool
+		escapePressed bool
+	)
+
+	if store.GetApplicationStateGamepadEnabled() == value.GAMEPAD_ENABLED_APPLICATION_TRUE_VALUE && ebiten.IsFocused() {
+		if inpututil.IsStandardGamepadButtonJustPressed(
+			ebiten.GamepadIDs()[0], ebiten.StandardGamepadButtonFrontTopLeft) {
+			tabPressed = true
+		}
+
+		if inpututil.IsStandardGamepadButtonJustPressed(
+			ebiten.GamepadIDs()[0], ebiten.StandardGamepadButtonRightTop) {
+			escapePressed = true
+		}
+	} else {
+		if inpututil.IsKeyJustPressed(ebiten.KeyTab) {
+			tabPressed = true
+		}
+
+		if inpututil.IsKeyJustPressed(ebiten.KeyEscape) {
+			escapePressed = true
+		}
+	}
+
+	if escapePressed {
+		dispatcher.GetInstance().Dispatch(
+			action.NewSetEventRetrievalStartedNetworking(
+				value.EVENT_RETRIEVAL_STARTED_NETWORKING_FALSE_STATE))
+
+		dispatcher.GetInstance().Dispatch(
+			action.NewSetUsersMetadataRetrievalStartedNetworking(
+				value.USERS_METADATA_RETRIEVAL_STARTED_NETWORKING_FALSE_STATE))
+
+		dispatcher.GetInstance().Dispatch(
+			action.NewSetChestsRetrievalStartedNetworking(
+				value.CHESTS_RETRIEVAL_STARTED_NETWORKING_FALSE_STATE))
+
+		dispatcher.GetInstance().Dispatch(
+			action.NewSetHealthPacksRetrievalStartedNetworking(
+				value.HEALTH_PACKS_RETRIEVAL_STARTED_NETWORKING_FALSE_STATE))
+
+		dispatcher.GetInstance().Dispatch(
+			action.NewSetSpectatingSession(value.SPECTATING_SESSION_FALSE_VALUE))
+
+		dispatcher.GetInstance().Dispatch(
+			action.NewSetResetDeath(value.RESET_DEATH_TRUE_VALUE))
+
+		dispatcher.GetInstance().Dispatch(
+			action.NewSetActiveScreenAction(value.ACTIVE_SCREEN_DEATH_VALUE))
+
+		return
+	}
+
+	var survivors []string
+
+	for issuer, retrievedUsersMetadata := range retrievedUsersMetadataSession {
+		if issuer != store.GetRepositoryUUID() && !retrievedUsersMetadata.Eliminated {
+			survivors = append(survivors, issuer)
+		}
+	}
+
+	if len(survivors) == 0 {
+		return
+	}
+
+	slices.Sort(survivors)
+
+	index := slices.Index(survivors, ss.spectatedIssuer)
+
+	if index == -1 || tabPressed {
+		ss.spectatedIssuer = survivors[(index+1)%len(survivors)]
+
+		notification.GetInstance().Push(
+			fmt.Sprintf(
+				"%s %s",
+				translation.GetInstance().GetTranslation("client.session.spectating-following"),
+				ss.spectatedIssuer),
+			time.Second*2,
+			common.NotificationInfoTextColor)
+	}
+
+	dispatcher.GetInstance().Dispatch(
+		action.NewSetPositionSession(retrievedUsersMetadataSession[ss.spectatedIssuer].Position))
+}
+
 // newSessionScreen initializes SessionScreen.
 func newSessionScreen() screen.Screen {
 	camera := kamera.NewCamera(0, 0, float64(config.GetWorldWidth()), float64(config.GetWorldHeight()))
@@ -1275,7 +1465,9 @@ func newSessionScreen() screen.Screen {
 			bar.GetInstance().GetContainer()),
 		activeUI: builder.Build(
 			chest.GetInstance().GetContainer(),
-			inventory.GetInstance().GetContainer()),
+			inventory.GetInstance().GetContainer(),
+			chat.GetInstance().GetContainer(),
+			emote.GetInstance().GetContainer()),
 		camera: camera,
 		transparentTransitionEffect: transparent.NewTransparentTransitionEffect(
 			true, 255, 0, 5, time.Microsecond*10),
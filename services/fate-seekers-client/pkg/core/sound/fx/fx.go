@@ -67,6 +67,8 @@ func (svm *SoundFXManager) Init() {
 
 					svm.currentPlayer.Load().Player.SetVolume(0)
 
+					maxVolume := float64(config.GetSettingsSoundFX()) * svm.currentPlayer.Load().Gain
+
 					svm.playerStarter.Add(1)
 
 					go func() {
@@ -74,15 +76,15 @@ func (svm *SoundFXManager) Init() {
 
 						var volume float64
 
-						for svm.currentPlayer.Load() != nil && svm.currentPlayer.Load().Player.Volume() < float64(config.GetSettingsSoundFX())/100 {
+						for svm.currentPlayer.Load() != nil && svm.currentPlayer.Load().Player.Volume() < maxVolume/100 {
 							select {
 							case <-tempTicker.C:
 								tempTicker.Stop()
 
-								if volume+volumeShift <= float64(config.GetSettingsSoundFX()) {
+								if volume+volumeShift <= maxVolume {
 									volume += volumeShift
 								} else {
-									volume = float64(config.GetSettingsSoundFX())
+									volume = maxVolume
 								}
 
 								svm.currentPlayer.Load().Player.SetVolume(volume / 100)
@@ -162,6 +164,12 @@ func (svm *SoundFXManager) StopFXPlaying() {
 // PushWithHandbrake pushes a new track immediately to the queue at the highest priority
 // stopping previously playing track.
 func (svm *SoundFXManager) PushWithHandbrake(name string) {
+	svm.PushWithHandbrakeAndGain(name, 1)
+}
+
+// PushWithHandbrakeAndGain pushes a new track immediately to the queue at the highest priority
+// stopping previously playing track, which is played with the provided gain applied.
+func (svm *SoundFXManager) PushWithHandbrakeAndGain(name string, gain float64) {
 	go func() {
 		if svm.currentPlayer.Load() != nil {
 			svm.handbrake <- true
@@ -179,6 +187,7 @@ func (svm *SoundFXManager) PushWithHandbrake(name string) {
 		svm.processingBatch = append([]*dto.FXSoundUnit{{
 			Duration: time.Second * time.Duration(sound.Length()) / common.BytesPerSample / common.SampleRate,
 			Player:   player,
+			Gain:     gain,
 		}}, svm.processingBatch...)
 	}()
 }
@@ -195,6 +204,7 @@ func (svm *SoundFXManager) PushImmediately(name string) {
 	svm.processingBatch = append([]*dto.FXSoundUnit{{
 		Duration: time.Second * time.Duration(sound.Length()) / common.BytesPerSample / common.SampleRate,
 		Player:   player,
+		Gain:     1,
 	}}, svm.processingBatch...)
 }
 
@@ -210,6 +220,7 @@ func (svm *SoundFXManager) Push(name string) {
 	svm.processingBatch = append(svm.processingBatch, &dto.FXSoundUnit{
 		Duration: time.Second * time.Duration(sound.Length()) / common.BytesPerSample / common.SampleRate,
 		Player:   player,
+		Gain:     1,
 	})
 }
 
@@ -0,0 +1,271 @@
+package marker
+
+import (
+	"image/color"
+	"math"
+	"sync"
+	"time"
+
+	"github.com/YarikRevich/fate-seekers/services/fate-seekers-client/pkg/config"
+	"github.com/YarikRevich/fate-seekers/services/fate-seekers-client/pkg/core/tools/renderer"
+	"github.com/YarikRevich/fate-seekers/services/fate-seekers-client/pkg/core/tools/sounder"
+	"github.com/YarikRevich/fate-seekers/services/fate-seekers-client/pkg/dto"
+	"github.com/YarikRevich/fate-seekers/services/fate-seekers-client/pkg/loader"
+	"github.com/YarikRevich/fate-seekers/services/fate-seekers-client/pkg/state/store"
+	"github.com/hajimehoshi/ebiten/v2"
+	"github.com/hajimehoshi/ebiten/v2/text/v2"
+	"github.com/hajimehoshi/ebiten/v2/vector"
+	"github.com/setanarut/kamera/v2"
+)
+
+var (
+	// GetInstance retrieves instance of the marker, performing initial creation if needed.
+	GetInstance = sync.OnceValue[*Marker](newMarker)
+)
+
+// Represents static marker options.
+const (
+	// Represents duration, during which emote marker is shown.
+	EMOTE_MARKER_DURATION = time.Second * 3
+
+	// Represents duration, during which ping marker is shown.
+	PING_MARKER_DURATION = time.Second * 5
+
+	// Represents vertical offset of the emote marker above the movable object.
+	emoteMarkerOffset = 10
+
+	// Represents padding of the emote marker background.
+	emoteMarkerPadding = 6
+
+	// Represents radius of the ping marker.
+	pingMarkerRadius = 14
+
+	// Represents vertical offset of the name marker above the movable object.
+	nameMarkerOffset = 4
+)
+
+var (
+	// Represents emote marker background color.
+	emoteMarkerBackgroundColor = color.RGBA{R: 11, G: 16, B: 37, A: 200}
+
+	// Represents ping marker color.
+	pingMarkerColor = color.RGBA{R: 255, G: 214, B: 10, A: 255}
+)
+
+// Marker represents world marker manager, which shows names and emotes above movable objects of their
+// issuers and pings at the pinged world positions, playing their sounds positionally.
+type Marker struct {
+	// Represents markers mutex.
+	mu sync.Mutex
+
+	// Represents emote markers of each of the issuers.
+	emoteMarkers map[string]*dto.Marker
+
+	// Represents ping markers of each of the issuers.
+	pingMarkers map[string]*dto.Marker
+
+	// Represents display names shown above movable objects of each of the issuers.
+	nameMarkers map[string]string
+
+	// Represents font used for markers labels.
+	font *text.GoTextFace
+}
+
+// AddEmoteMarker adds emote marker with the provided label for the provided issuer, replacing the previous one.
+func (m *Marker) AddEmoteMarker(issuer, label string, value dto.Position) {
+	m.mu.Lock()
+
+	m.emoteMarkers[issuer] = &dto.Marker{
+		Label:     label,
+		Position:  value,
+		CreatedAt: time.Now(),
+	}
+
+	m.mu.Unlock()
+}
+
+// AddPingMarker adds ping marker with the provided label at the provided position for the provided issuer,
+// replacing the previous one.
+func (m *Marker) AddPingMarker(issuer, label string, value dto.Position) {
+	m.mu.Lock()
+
+	m.pingMarkers[issuer] = &dto.Marker{
+		Label:     label,
+		Position:  value,
+		CreatedAt: time.Now(),
+	}
+
+	m.mu.Unlock()
+}
+
+// SetNameMarker sets display name shown above the movable object of the provided issuer, empty display name
+// removes it.
+func (m *Marker) SetNameMarker(issuer, displayName string) {
+	m.mu.Lock()
+
+	if displayName == "" {
+		delete(m.nameMarkers, issuer)
+	} else {
+		m.nameMarkers[issuer] = displayName
+	}
+
+	m.mu.Unlock()
+}
+
+// Update performs update operation for all the markers, removing expired ones and playing sounds of
+// the newly added ones.
+func (m *Marker) Update(camera *kamera.Camera) {
+	m.mu.Lock()
+
+	for issuer, marker := range m.emoteMarkers {
+		if time.Since(marker.CreatedAt) > EMOTE_MARKER_DURATION {
+			delete(m.emoteMarkers, issuer)
+
+			continue
+		}
+
+		if !marker.Sounded {
+			marker.Sounded = true
+
+			sounder.GetInstance().PushPositionalSound(issuer, marker.Position, loader.ButtonFXSound, camera)
+		}
+	}
+
+	for issuer, marker := range m.pingMarkers {
+		if time.Since(marker.CreatedAt) > PING_MARKER_DURATION {
+			delete(m.pingMarkers, issuer)
+
+			continue
+		}
+
+		if !marker.Sounded {
+			marker.Sounded = true
+
+			sounder.GetInstance().PushPositionalSound(
+				issuer, marker.Position, loader.LetterScrollActivationFxSound, camera)
+		}
+	}
+
+	m.mu.Unlock()
+}
+
+// Draw performs draw operation for all the markers.
+func (m *Marker) Draw(screen *ebiten.Image, camera *kamera.Camera) {
+	m.mu.Lock()
+
+	for _, marker := range m.pingMarkers {
+		x, y := camera.ApplyCameraTransformToPoint(marker.Position.X, -marker.Position.Y)
+
+		pulse := (math.Sin(float64(time.Since(marker.CreatedAt).Milliseconds())/150.0) + 1.0) / 2.0
+
+		vector.StrokeCircle(
+			screen, float32(x), float32(y), float32(pingMarkerRadius+pingMarkerRadius*pulse/2), 3, pingMarkerColor, true)
+
+		vector.DrawFilledCircle(screen, float32(x), float32(y), 4, pingMarkerColor, true)
+
+		m.drawLabel(screen, marker.Label, x, y-pingMarkerRadius*2)
+	}
+
+	for issuer, displayName := range m.nameMarkers {
+		if x, y, ok := m.getMovablePosition(issuer, camera); ok {
+			m.drawLabel(screen, displayName, x, y-nameMarkerOffset)
+		}
+	}
+
+	for issuer, marker := range m.emoteMarkers {
+		x, y, ok := m.getMovablePosition(issuer, camera)
+		if !ok {
+			x, y = camera.ApplyCameraTransformToPoint(marker.Position.X, -marker.Position.Y)
+		}
+
+		offset := float64(emoteMarkerOffset)
+
+		if displayName, ok := m.nameMarkers[issuer]; ok {
+			offset += nameMarkerOffset + m.getLabelHeight(displayName)
+		}
+
+		m.drawLabel(screen, marker.Label, x, y-offset)
+	}
+
+	m.mu.Unlock()
+}
+
+// getMovablePosition retrieves screen position of the top center of the movable object of the provided issuer,
+// returning false, when such movable object is not rendered.
+func (m *Marker) getMovablePosition(issuer string, camera *kamera.Camera) (float64, float64, bool) {
+	if issuer == store.GetRepositoryUUID() &&
+		renderer.GetInstance().MainCenteredMovableObjectExists(issuer) {
+		_, shiftHeight := renderer.GetInstance().GetMainCenteredMovableObject(issuer).GetShiftBounds()
+
+		return float64(config.GetWorldWidth()) / 2, float64(config.GetWorldHeight())/2 - shiftHeight/2, true
+	}
+
+	if renderer.GetInstance().SecondaryExternalMovableObjectExists(issuer) {
+		movableUnit := renderer.GetInstance().GetSecondaryExternalMovableObject(issuer)
+
+		shiftWidth, _ := movableUnit.GetShiftBounds()
+
+		position := movableUnit.GetPosition()
+
+		x, y := camera.ApplyCameraTransformToPoint(position.X+shiftWidth/2, -position.Y)
+
+		return x, y, true
+	}
+
+	return 0, 0, false
+}
+
+// getLabelHeight retrieves height of the provided label with background.
+func (m *Marker) getLabelHeight(label string) float64 {
+	_, height := text.Measure(label, m.font, 0)
+
+	return height + emoteMarkerPadding*2
+}
+
+// drawLabel draws the provided label with background, bottom center of which is placed at the provided position.
+func (m *Marker) drawLabel(screen *ebiten.Image, label string, x, y float64) {
+	width, height := text.Measure(label, m.font, 0)
+
+	vector.DrawFilledRect(
+		screen,
+		float32(x-width/2-emoteMarkerPadding),
+		float32(y-height-emoteMarkerPadding*2),
+		float32(width+emoteMarkerPadding*2),
+		float32(height+emoteMarkerPadding*2),
+		emoteMarkerBackgroundColor,
+		true)
+
+	var opts text.DrawOptions
+
+	opts.GeoM.Translate(x, y-height-emoteMarkerPadding)
+	opts.PrimaryAlign = text.AlignCenter
+	opts.ColorScale.ScaleWithColor(color.White)
+
+	text.Draw(screen, label, m.font, &opts)
+}
+
+// Clean performs clean operation for all the markers.
+func (m *Marker) Clean() {
+	m.mu.Lock()
+
+	clear(m.emoteMarkers)
+
+	clear(m.pingMarkers)
+
+	clear(m.nameMarkers)
+
+	m.mu.Unlock()
+}
+
+// newMarker initializes Marker.
+func newMarker() *Marker {
+	return &Marker{
+		emoteMarkers: make(map[string]*dto.Marker),
+		pingMarkers:  make(map[string]*dto.Marker),
+		nameMarkers:  make(map[string]string),
+		font: &text.GoTextFace{
+			Source: loader.GetInstance().GetFont(loader.KyivRegularFont),
+			Size:   16,
+		},
+	}
+}
@@ -24,6 +24,11 @@ const (
 	delayedPositionsLimit = 10
 )
 
+var (
+	// Represents tint color applied to the teammates.
+	teammateTintColor = color.RGBA{R: 170, G: 255, B: 190, A: 255}
+)
+
 // Represents movable object to be rendered.
 type Movable struct {
 	// Represents metadata selected for the exact movable object.
@@ -35,6 +40,9 @@ type Movable struct {
 	// Represents if selected animation is static.
 	static bool
 
+	// Represents if movable object belongs to the teammate, which makes it tinted.
+	teammate bool
+
 	// Represents current frame to be updated.
 	frame int
 
@@ -85,6 +93,11 @@ func (m *Movable) SetStatic(value bool) {
 	}
 }
 
+// SetTeammate sets if movable object belongs to the teammate.
+func (m *Movable) SetTeammate(value bool) {
+	m.teammate = value
+}
+
 // AddPosition adds position value for the movable unit.
 func (m *Movable) AddPosition(value dto.Position) {
 	if m.position == value {
@@ -202,6 +215,10 @@ func (m *Movable) Draw(screen *ebiten.Image, resetDelayedPositions, selected, ce
 			(float64(config.GetWorldHeight())/2)-(shiftHeight/2))
 	}
 
+	if m.teammate {
+		m.opts.ColorM.ScaleWithColor(teammateTintColor)
+	}
+
 	if !m.normalHitTransparentTransitionEffect.Done() {
 		m.opts.ColorM.ScaleWithColor(
 			color.RGBA{
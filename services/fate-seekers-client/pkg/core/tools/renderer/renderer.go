@@ -245,6 +245,15 @@ func (r *Renderer) GetMainCenteredMovableObject(name string) *movable.Movable {
 	return result
 }
 
+// RemoveMainCenteredMovableObject removes main centered movable object with the provided name.
+func (r *Renderer) RemoveMainCenteredMovableObject(name string) {
+	r.mainCenteredMovableObjectsMutex.Lock()
+
+	delete(r.mainCenteredMovableObjects, name)
+
+	r.mainCenteredMovableObjectsMutex.Unlock()
+}
+
 // SetSelectedObject sets selected object position.
 func (r *Renderer) SetSelectedObject(value dto.Position) {
 	r.selectedObjectEnabled = true
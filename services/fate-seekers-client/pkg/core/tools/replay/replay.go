@@ -0,0 +1,243 @@
+package replay
+
+import (
+	"time"
+
+	metadatav1 "github.com/YarikRevich/fate-seekers/services/fate-seekers-client/pkg/core/networking/metadata/api"
+	"github.com/YarikRevich/fate-seekers/services/fate-seekers-client/pkg/dto"
+)
+
+var (
+	// Represents all the available playback speeds.
+	speeds = []float64{0.5, 1, 2, 4}
+)
+
+const (
+	// Represents index of the default playback speed.
+	defaultSpeedIndex = 1
+)
+
+// State represents recorded session state at the current playback offset.
+type State struct {
+	// Represents positions of all the issuers.
+	Positions map[string]dto.Position
+
+	// Represents static state of all the issuers.
+	Statics map[string]bool
+
+	// Represents health of all the issuers.
+	Health map[string]uint64
+
+	// Represents eliminated issuers.
+	Eliminated map[string]bool
+
+	// Represents health packs, which have already been taken.
+	TakenHealthPacks map[int64]bool
+
+	// Represents chests, which have already been opened.
+	OpenedChests map[int64]bool
+
+	// Represents chest items, which have already been taken.
+	TakenChestItems map[int64]bool
+
+	// Represents the latest chest state change.
+	Chest *metadatav1.ReplayChest
+
+	// Represents offset the latest chest state change was performed at.
+	ChestOffset time.Duration
+
+	// Represents name of the latest started event.
+	Event string
+
+	// Represents offset the latest event was started at.
+	EventOffset time.Duration
+}
+
+// Player represents replay player, which applies recorded frames according to the playback offset.
+type Player struct {
+	// Represents replay start frame.
+	start *metadatav1.ReplayStart
+
+	// Represents all the recorded frames ordered by offset.
+	frames []*metadatav1.ReplayFrame
+
+	// Represents index of the next frame to be applied.
+	index int
+
+	// Represents current playback offset.
+	offset time.Duration
+
+	// Represents total replay duration.
+	duration time.Duration
+
+	// Represents index of the selected playback speed.
+	speedIndex int
+
+	// Represents if playback is paused.
+	paused bool
+
+	// Represents state at the current playback offset.
+	state State
+}
+
+// GetStart retrieves replay start frame.
+func (p *Player) GetStart() *metadatav1.ReplayStart {
+	return p.start
+}
+
+// GetState retrieves state at the current playback offset.
+func (p *Player) GetState() *State {
+	return &p.state
+}
+
+// GetOffset retrieves current playback offset.
+func (p *Player) GetOffset() time.Duration {
+	return p.offset
+}
+
+// GetDuration retrieves total replay duration.
+func (p *Player) GetDuration() time.Duration {
+	return p.duration
+}
+
+// GetSpeed retrieves selected playback speed.
+func (p *Player) GetSpeed() float64 {
+	return speeds[p.speedIndex]
+}
+
+// IsPaused checks if playback is paused.
+func (p *Player) IsPaused() bool {
+	return p.paused
+}
+
+// IsDone checks if playback has reached the end of the replay.
+func (p *Player) IsDone() bool {
+	return p.offset >= p.duration
+}
+
+// TogglePause pauses playback if it is running and resumes it otherwise.
+func (p *Player) TogglePause() {
+	p.paused = !p.paused
+}
+
+// IncreaseSpeed selects the next faster playback speed, if there is one.
+func (p *Player) IncreaseSpeed() {
+	if p.speedIndex < len(speeds)-1 {
+		p.speedIndex++
+	}
+}
+
+// DecreaseSpeed selects the next slower playback speed, if there is one.
+func (p *Player) DecreaseSpeed() {
+	if p.speedIndex > 0 {
+		p.speedIndex--
+	}
+}
+
+// Update advances playback by the given delta multiplied by the selected speed.
+func (p *Player) Update(delta time.Duration) {
+	if p.paused {
+		return
+	}
+
+	p.advance(p.offset + time.Duration(float64(delta)*p.GetSpeed()))
+}
+
+// Seek moves playback to the given offset, rebuilding the state from the beginning when
+// moving backwards.
+func (p *Player) Seek(offset time.Duration) {
+	if offset < p.offset {
+		p.reset()
+	}
+
+	p.advance(offset)
+}
+
+// advance applies all the frames up to the given offset, which is limited by replay bounds.
+func (p *Player) advance(offset time.Duration) {
+	p.offset = min(max(offset, 0), p.duration)
+
+	for p.index < len(p.frames) &&
+		time.Duration(p.frames[p.index].GetOffset())*time.Millisecond <= p.offset {
+		p.apply(p.frames[p.index])
+
+		p.index++
+	}
+}
+
+// apply applies the given frame to the state.
+func (p *Player) apply(frame *metadatav1.ReplayFrame) {
+	switch value := frame.GetValue().(type) {
+	case *metadatav1.ReplayFrame_Position:
+		p.state.Positions[value.Position.GetIssuer()] = dto.Position{
+			X: value.Position.GetPosition().GetX(),
+			Y: value.Position.GetPosition().GetY(),
+		}
+
+	case *metadatav1.ReplayFrame_Static:
+		p.state.Statics[value.Static.GetIssuer()] = value.Static.GetStatic()
+
+	case *metadatav1.ReplayFrame_Health:
+		p.state.Health[value.Health.GetIssuer()] = value.Health.GetHealth()
+
+	case *metadatav1.ReplayFrame_Event:
+		p.state.Event = value.Event.GetName()
+		p.state.EventOffset = time.Duration(frame.GetOffset()) * time.Millisecond
+
+	case *metadatav1.ReplayFrame_Chest:
+		if value.Chest.GetItemId() == 0 {
+			p.state.OpenedChests[value.Chest.GetChestId()] = true
+		} else {
+			p.state.TakenChestItems[value.Chest.GetItemId()] = true
+		}
+
+		p.state.Chest = value.Chest
+		p.state.ChestOffset = time.Duration(frame.GetOffset()) * time.Millisecond
+
+	case *metadatav1.ReplayFrame_HealthPack:
+		p.state.TakenHealthPacks[value.HealthPack.GetHealthPackId()] = true
+
+	case *metadatav1.ReplayFrame_Elimination:
+		p.state.Eliminated[value.Elimination.GetIssuer()] = true
+	}
+}
+
+// reset resets playback to the beginning of the replay.
+func (p *Player) reset() {
+	p.index = 0
+	p.offset = 0
+
+	p.state = State{
+		Positions:        make(map[string]dto.Position),
+		Statics:          make(map[string]bool),
+		Health:           make(map[string]uint64),
+		Eliminated:       make(map[string]bool),
+		TakenHealthPacks: make(map[int64]bool),
+		OpenedChests:     make(map[int64]bool),
+		TakenChestItems:  make(map[int64]bool),
+	}
+}
+
+// NewPlayer initializes Player with the given frames, which are expected to be ordered by offset.
+func NewPlayer(frames []*metadatav1.ReplayFrame) *Player {
+	result := &Player{
+		frames:     frames,
+		speedIndex: defaultSpeedIndex,
+	}
+
+	for _, frame := range frames {
+		if result.start == nil && frame.GetStart() != nil {
+			result.start = frame.GetStart()
+		}
+
+		result.duration = max(result.duration, time.Duration(frame.GetOffset())*time.Millisecond)
+	}
+
+	if result.start == nil {
+		result.start = new(metadatav1.ReplayStart)
+	}
+
+	result.reset()
+
+	return result
+}
@@ -0,0 +1,67 @@
+package replay
+
+import (
+	"testing"
+	"time"
+
+	metadatav1 "github.com/YarikRevich/fate-seekers/services/fate-seekers-client/pkg/core/networking/metadata/api"
+	"github.com/stretchr/testify/require"
+)
+
+// TestPlayerSeek tests replay player forward and backward seeking.
+func TestPlayerSeek(t *testing.T) {
+	player := NewPlayer([]*metadatav1.ReplayFrame{
+		{Offset: 0, Value: &metadatav1.ReplayFrame_Start{
+			Start: &metadatav1.ReplayStart{Issuers: []string{"first"}}}},
+		{Offset: 1000, Value: &metadatav1.ReplayFrame_Position{
+			Position: &metadatav1.ReplayPosition{Issuer: "first", Position: &metadatav1.Position{X: 1, Y: 1}}}},
+		{Offset: 2000, Value: &metadatav1.ReplayFrame_Elimination{
+			Elimination: &metadatav1.ReplayElimination{Issuer: "first"}}},
+	})
+
+	require.Equal(t, []string{"first"}, player.GetStart().GetIssuers())
+	require.Equal(t, time.Second*2, player.GetDuration())
+
+	player.Update(time.Second)
+
+	require.Equal(t, 1.0, player.GetState().Positions["first"].X)
+	require.False(t, player.GetState().Eliminated["first"])
+
+	player.Seek(time.Second * 5)
+
+	require.True(t, player.IsDone())
+	require.True(t, player.GetState().Eliminated["first"])
+
+	player.Seek(time.Millisecond * 500)
+
+	require.Empty(t, player.GetState().Positions)
+	require.False(t, player.GetState().Eliminated["first"])
+}
+
+// TestPlayerChest tests replay player chest opening and chest item taking.
+func TestPlayerChest(t *testing.T) {
+	player := NewPlayer([]*metadatav1.ReplayFrame{
+		{Offset: 0, Value: &metadatav1.ReplayFrame_Start{
+			Start: &metadatav1.ReplayStart{Issuers: []string{"first"}}}},
+		{Offset: 1000, Value: &metadatav1.ReplayFrame_Chest{
+			Chest: &metadatav1.ReplayChest{Issuer: "first", ChestId: 1}}},
+		{Offset: 2000, Value: &metadatav1.ReplayFrame_Chest{
+			Chest: &metadatav1.ReplayChest{Issuer: "first", ChestId: 1, ItemId: 2}}},
+	})
+
+	player.Update(time.Second)
+
+	require.True(t, player.GetState().OpenedChests[1])
+	require.Empty(t, player.GetState().TakenChestItems)
+	require.Equal(t, time.Second, player.GetState().ChestOffset)
+
+	player.Seek(time.Second * 2)
+
+	require.True(t, player.GetState().TakenChestItems[2])
+	require.Equal(t, int64(2), player.GetState().Chest.GetItemId())
+
+	player.Seek(0)
+
+	require.Empty(t, player.GetState().OpenedChests)
+	require.Nil(t, player.GetState().Chest)
+}
@@ -20,6 +20,9 @@ var (
 // Represents static sound options.
 const (
 	CAMERA_SOUND_OFFSET = 60
+
+	// Represents min gain of the positional sounds, which are played at the viewport edge.
+	MIN_POSITIONAL_SOUND_GAIN = 0.2
 )
 
 // Sounder represents active map sound manager.
@@ -147,6 +150,39 @@ func (s *Sounder) InterruptExternalTrackableObject(issuer string) {
 	}
 }
 
+// PushPositionalSound plays sound with the provided name on behalf of the object with the provided key,
+// when the provided position is visible within the camera viewport. Sound is attenuated linearly with
+// the distance from the main trackable object up to the viewport edge.
+func (s *Sounder) PushPositionalSound(key string, value dto.Position, name string, camera *kamera.Camera) {
+	minCameraViewportWidth := store.GetPositionSession().X - (math.Abs(camera.CenterOffsetX) + CAMERA_SOUND_OFFSET)
+	maxCameraViewportWidth := store.GetPositionSession().X + (math.Abs(camera.CenterOffsetX) + CAMERA_SOUND_OFFSET)
+
+	minCameraViewportHeight := store.GetPositionSession().Y - (math.Abs(camera.CenterOffsetY) + CAMERA_SOUND_OFFSET)
+	maxCameraViewportHeight := store.GetPositionSession().Y + (math.Abs(camera.CenterOffsetY) + CAMERA_SOUND_OFFSET)
+
+	if (value.X < minCameraViewportWidth || value.X > maxCameraViewportWidth) ||
+		(value.Y < minCameraViewportHeight || value.Y > maxCameraViewportHeight) {
+		return
+	}
+
+	gain := getPositionalSoundGain(
+		math.Hypot(value.X-store.GetPositionSession().X, value.Y-store.GetPositionSession().Y),
+		math.Hypot(
+			math.Abs(camera.CenterOffsetX)+CAMERA_SOUND_OFFSET, math.Abs(camera.CenterOffsetY)+CAMERA_SOUND_OFFSET))
+
+	s.externalTrackableObjectMutex.Lock()
+
+	if !sound.GetInstance().SoundSounderExternalFxManagerExists(key) {
+		sound.GetInstance().AddSoundSounderExternalFxManager(key)
+	}
+
+	sound.GetInstance().
+		GetSoundSounderExternalFxManager(key).
+		PushWithHandbrakeAndGain(name, gain)
+
+	s.externalTrackableObjectMutex.Unlock()
+}
+
 // Update performs update operation for all the soundable objects.
 func (s *Sounder) Update(camera *kamera.Camera) {
 	s.collisionPolygonsMutex.Lock()
@@ -260,6 +296,16 @@ func (s *Sounder) Clean() {
 	s.externalTrackableObjectMutex.Unlock()
 }
 
+// getPositionalSoundGain retrieves gain of the positional sound played at the provided distance,
+// which decreases linearly down to the min gain at the provided max distance.
+func getPositionalSoundGain(distance, maxDistance float64) float64 {
+	if maxDistance <= 0 {
+		return 1
+	}
+
+	return math.Max(1-(1-MIN_POSITIONAL_SOUND_GAIN)*distance/maxDistance, MIN_POSITIONAL_SOUND_GAIN)
+}
+
 // newSounder initializes Sounder.
 func newSounder() *Sounder {
 	return &Sounder{
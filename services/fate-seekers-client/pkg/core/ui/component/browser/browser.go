@@ -0,0 +1,643 @@
+package browser
+
+import (
+	"fmt"
+	"image/color"
+	"sync"
+	"time"
+
+	"github.com/YarikRevich/fate-seekers/services/fate-seekers-client/pkg/config"
+	"github.com/YarikRevich/fate-seekers/services/fate-seekers-client/pkg/core/sound"
+	"github.com/YarikRevich/fate-seekers/services/fate-seekers-client/pkg/core/tools/scaler"
+	"github.com/YarikRevich/fate-seekers/services/fate-seekers-client/pkg/core/ui/common"
+	componentscommon "github.com/YarikRevich/fate-seekers/services/fate-seekers-client/pkg/core/ui/component/common"
+	"github.com/YarikRevich/fate-seekers/services/fate-seekers-client/pkg/core/ui/manager/translation"
+	"github.com/YarikRevich/fate-seekers/services/fate-seekers-client/pkg/dto"
+	"github.com/YarikRevich/fate-seekers/services/fate-seekers-client/pkg/loader"
+	"github.com/ebitenui/ebitenui/image"
+	"github.com/ebitenui/ebitenui/widget"
+	"github.com/hajimehoshi/ebiten/v2/text/v2"
+)
+
+var (
+	// GetInstance retrieves instance of the browser component, performing initial creation if needed.
+	GetInstance = sync.OnceValue[*BrowserComponent](newBrowserComponent)
+)
+
+// Describes all the sorting orders, which can be selected for public sessions.
+const (
+	sortNewest    = "newest"
+	sortOldest    = "oldest"
+	sortOccupancy = "occupancy"
+	sortName      = "name"
+)
+
+// Describes all the rules presets, which can be used to filter public sessions. Empty
+// preset means that sessions are not filtered by rules.
+const (
+	rulesPresetAny      = ""
+	rulesPresetClassic  = "classic"
+	rulesPresetHardcore = "hardcore"
+	rulesPresetCasual   = "casual"
+)
+
+// Describes all the colors used for list combo definition.
+var (
+	selectedListColor = color.NRGBA{183, 228, 202, 255}
+	focusedListColor  = color.NRGBA{R: 170, G: 170, B: 180, A: 255}
+	disabledListColor = color.NRGBA{100, 100, 100, 255}
+)
+
+// BrowserComponent represents component, which contains public sessions browser.
+type BrowserComponent struct {
+	// Represents sorting order combo button widget.
+	sortComboButton *widget.ListComboButton
+
+	// Represents rules preset filter combo button widget.
+	rulesComboButton *widget.ListComboButton
+
+	// Represents free slots filter combo button widget.
+	slotsComboButton *widget.ListComboButton
+
+	// Represents public sessions list widget.
+	list *widget.List
+
+	// Represents current page text widget.
+	pageText *widget.Text
+
+	// Represents previous page button widget.
+	previousButton *widget.Button
+
+	// Represents next page button widget.
+	nextButton *widget.Button
+
+	// Represents select action button widget.
+	selectActionButton *widget.Button
+
+	// Represents currently selected session name entry.
+	sessionNameEntry string
+
+	// Represents filters change callback.
+	filtersCallback func()
+
+	// Represents previous page callback.
+	previousCallback func()
+
+	// Represents next page callback.
+	nextCallback func()
+
+	// Represents select callback.
+	selectCallback func(sessionName string)
+
+	// Represents back callback.
+	backCallback func()
+
+	// Represents container widget.
+	container *widget.Container
+}
+
+// SetListsEntries sets lists entries to the list widget, keeping previously selected session
+// selected, if it is still present.
+func (bc *BrowserComponent) SetListsEntries(value []interface{}) {
+	bc.list.SetEntries(value)
+
+	for _, entry := range value {
+		if entry.(dto.RetrievedPublicSession).Name == bc.sessionNameEntry {
+			bc.list.SetSelectedEntry(entry)
+
+			return
+		}
+	}
+
+	bc.ResetActionButtons()
+}
+
+// SetPagination sets current page and total amount of pages, updating pagination widgets.
+func (bc *BrowserComponent) SetPagination(page, pages uint32) {
+	if pages == 0 {
+		pages = 1
+	}
+
+	bc.pageText.Label = fmt.Sprintf("%d / %d", page+1, pages)
+
+	bc.previousButton.GetWidget().Disabled = page == 0
+	bc.nextButton.GetWidget().Disabled = page+1 >= pages
+}
+
+// GetSort retrieves selected sorting order.
+func (bc *BrowserComponent) GetSort() string {
+	return bc.sortComboButton.SelectedEntry().(string)
+}
+
+// GetRules retrieves selected rules preset filter, which is nil when sessions are not filtered by rules.
+func (bc *BrowserComponent) GetRules() *string {
+	rules := bc.rulesComboButton.SelectedEntry().(string)
+	if rules == rulesPresetAny {
+		return nil
+	}
+
+	return &rules
+}
+
+// GetFreeSlots retrieves if only sessions with free player slots are selected.
+func (bc *BrowserComponent) GetFreeSlots() bool {
+	return bc.slotsComboButton.SelectedEntry().(bool)
+}
+
+// SetFiltersCallback modifies filters change callback in the container.
+func (bc *BrowserComponent) SetFiltersCallback(callback func()) {
+	bc.filtersCallback = callback
+}
+
+// SetPreviousCallback modifies previous page callback in the container.
+func (bc *BrowserComponent) SetPreviousCallback(callback func()) {
+	bc.previousCallback = callback
+}
+
+// SetNextCallback modifies next page callback in the container.
+func (bc *BrowserComponent) SetNextCallback(callback func()) {
+	bc.nextCallback = callback
+}
+
+// SetSelectCallback modifies select callback in the container.
+func (bc *BrowserComponent) SetSelectCallback(callback func(sessionName string)) {
+	bc.selectCallback = callback
+}
+
+// SetBackCallback modifies back callback in the container.
+func (bc *BrowserComponent) SetBackCallback(callback func()) {
+	bc.backCallback = callback
+}
+
+// ResetActionButtons resets select button widget state.
+func (bc *BrowserComponent) ResetActionButtons() {
+	bc.sessionNameEntry = ""
+
+	bc.selectActionButton.GetWidget().Disabled = true
+}
+
+// GetContainer retrieves container widget.
+func (bc *BrowserComponent) GetContainer() *widget.Container {
+	return bc.container
+}
+
+// newBrowserComponent creates new public sessions browser component.
+func newBrowserComponent() *BrowserComponent {
+	var result *BrowserComponent
+
+	container := widget.NewContainer(
+		widget.ContainerOpts.WidgetOpts(
+			widget.WidgetOpts.MinSize(
+				scaler.GetPercentageOf(config.GetWorldWidth(), 20),
+				scaler.GetPercentageOf(config.GetWorldHeight(), 30)),
+			widget.WidgetOpts.TrackHover(false),
+			widget.WidgetOpts.LayoutData(widget.AnchorLayoutData{
+				Padding: widget.Insets{
+					Left: scaler.GetPercentageOf(config.GetWorldWidth(), 6),
+				},
+				VerticalPosition:  widget.AnchorLayoutPositionCenter,
+				StretchHorizontal: false,
+				StretchVertical:   false,
+			})),
+		widget.ContainerOpts.BackgroundImage(common.GetImageAsNineSlice(loader.PanelIdlePanel, 10, 10)),
+		widget.ContainerOpts.Layout(widget.NewRowLayout(
+			widget.RowLayoutOpts.Direction(widget.DirectionVertical),
+			widget.RowLayoutOpts.Padding(widget.Insets{
+				Left:   30,
+				Right:  30,
+				Top:    30,
+				Bottom: 30,
+			}),
+		)))
+
+	generalFont := &text.GoTextFace{
+		Source: loader.GetInstance().GetFont(loader.KyivRegularFont),
+		Size:   20,
+	}
+
+	container.AddChild(widget.NewText(
+		widget.TextOpts.WidgetOpts(widget.WidgetOpts.LayoutData(widget.RowLayoutData{
+			Stretch: true,
+		})),
+		widget.TextOpts.Text(
+			translation.GetInstance().GetTranslation("client.browser.title"),
+			generalFont,
+			color.White)))
+
+	components := widget.NewContainer(
+		widget.ContainerOpts.WidgetOpts(widget.WidgetOpts.LayoutData(widget.RowLayoutData{
+			Stretch: true,
+		})),
+		widget.ContainerOpts.Layout(widget.NewGridLayout(
+			widget.GridLayoutOpts.Columns(2),
+			widget.GridLayoutOpts.Stretch([]bool{true, true}, nil),
+			widget.GridLayoutOpts.Spacing(
+				10, scaler.GetPercentageOf(config.GetWorldHeight(), 3)),
+			widget.GridLayoutOpts.Padding(widget.Insets{
+				Top: scaler.GetPercentageOf(config.GetWorldHeight(), 4),
+			}))))
+
+	newComboButton := func(entries []interface{}, label func(e any) string) *widget.ListComboButton {
+		return widget.NewListComboButton(
+			widget.ListComboButtonOpts.SelectComboButtonOpts(
+				widget.SelectComboButtonOpts.ComboButtonOpts(
+					widget.ComboButtonOpts.ButtonOpts(
+						widget.ButtonOpts.Image(&widget.ButtonImage{
+							Idle:         common.GetImageAsNineSlice(loader.ComboIdleButton, 12, -10),
+							Hover:        common.GetImageAsNineSlice(loader.ComboIdleButton, 12, -10),
+							Pressed:      common.GetImageAsNineSlice(loader.ComboIdleButton, 12, -10),
+							PressedHover: common.GetImageAsNineSlice(loader.ComboIdleButton, 12, -10),
+						}),
+					),
+				),
+			),
+			widget.ListComboButtonOpts.Text(generalFont, &widget.ButtonImageImage{
+				Idle:     loader.GetInstance().GetStatic(loader.ComboArrayIdleButton),
+				Disabled: loader.GetInstance().GetStatic(loader.ComboArrayIdleButton),
+			}, &widget.ButtonTextColor{
+				Idle:     componentscommon.ButtonTextColor,
+				Disabled: componentscommon.ButtonTextColor,
+				Hover:    componentscommon.ButtonTextColor,
+				Pressed:  componentscommon.ButtonTextColor,
+			}),
+			widget.ListComboButtonOpts.ListOpts(
+				widget.ListOpts.Entries(entries),
+				widget.ListOpts.ScrollContainerOpts(
+					widget.ScrollContainerOpts.Image(&widget.ScrollContainerImage{
+						Idle:     image.NewNineSlice(loader.GetInstance().GetStatic(loader.ListIdle), [3]int{25, 12, 22}, [3]int{25, 12, 25}),
+						Disabled: image.NewNineSlice(loader.GetInstance().GetStatic(loader.ListDisabled), [3]int{25, 12, 22}, [3]int{25, 12, 25}),
+						Mask:     image.NewNineSlice(loader.GetInstance().GetStatic(loader.ListMask), [3]int{26, 10, 23}, [3]int{26, 10, 26}),
+					}),
+				),
+				widget.ListOpts.SliderOpts(
+					widget.SliderOpts.Images(
+						&widget.SliderTrackImage{
+							Idle:     image.NewNineSlice(loader.GetInstance().GetStatic(loader.ListTrackIdle), [3]int{5, 0, 0}, [3]int{25, 12, 25}),
+							Hover:    image.NewNineSlice(loader.GetInstance().GetStatic(loader.ListTrackIdle), [3]int{5, 0, 0}, [3]int{25, 12, 25}),
+							Disabled: image.NewNineSlice(loader.GetInstance().GetStatic(loader.ListTrackDisabled), [3]int{0, 5, 0}, [3]int{25, 12, 25}),
+						},
+						&widget.ButtonImage{
+							Idle:     image.NewNineSliceSimple(loader.GetInstance().GetStatic(loader.SliderHandleIdle), 0, 5),
+							Hover:    image.NewNineSliceSimple(loader.GetInstance().GetStatic(loader.SliderHandleHover), 0, 5),
+							Pressed:  image.NewNineSliceSimple(loader.GetInstance().GetStatic(loader.SliderHandleHover), 0, 5),
+							Disabled: image.NewNineSliceSimple(loader.GetInstance().GetStatic(loader.SliderHandleIdle), 0, 5),
+						}),
+					widget.SliderOpts.MinHandleSize(4),
+					widget.SliderOpts.TrackPadding(widget.Insets{Bottom: 20})),
+				widget.ListOpts.EntryFontFace(generalFont),
+				widget.ListOpts.EntryColor(&widget.ListEntryColor{
+					Selected:                   componentscommon.ButtonTextColor,
+					Unselected:                 selectedListColor,
+					SelectedBackground:         selectedListColor,
+					SelectedFocusedBackground:  selectedListColor,
+					FocusedBackground:          focusedListColor,
+					DisabledUnselected:         disabledListColor,
+					DisabledSelected:           disabledListColor,
+					DisabledSelectedBackground: disabledListColor,
+				}),
+				widget.ListOpts.EntryTextPadding(widget.Insets{
+					Top:    15,
+					Left:   40,
+					Right:  40,
+					Bottom: 15,
+				}),
+			),
+			widget.ListComboButtonOpts.EntryLabelFunc(label, label),
+			widget.ListComboButtonOpts.EntrySelectedHandler(func(args *widget.ListComboButtonEntrySelectedEventArgs) {
+				if args.PreviousEntry == nil || result == nil {
+					return
+				}
+
+				result.filtersCallback()
+			}))
+	}
+
+	components.AddChild(widget.NewText(
+		widget.TextOpts.Text(
+			translation.GetInstance().GetTranslation("client.browser.sort"),
+			generalFont,
+			color.White)))
+
+	sortComboButton := newComboButton([]interface{}{
+		sortNewest,
+		sortOldest,
+		sortOccupancy,
+		sortName,
+	}, func(e any) string {
+		return getSortLabel(e.(string))
+	})
+
+	sortComboButton.SetSelectedEntry(sortNewest)
+
+	components.AddChild(sortComboButton)
+
+	components.AddChild(widget.NewText(
+		widget.TextOpts.Text(
+			translation.GetInstance().GetTranslation("client.browser.rules"),
+			generalFont,
+			color.White)))
+
+	rulesComboButton := newComboButton([]interface{}{
+		rulesPresetAny,
+		rulesPresetClassic,
+		rulesPresetHardcore,
+		rulesPresetCasual,
+	}, func(e any) string {
+		return getRulesPresetLabel(e.(string))
+	})
+
+	rulesComboButton.SetSelectedEntry(rulesPresetAny)
+
+	components.AddChild(rulesComboButton)
+
+	components.AddChild(widget.NewText(
+		widget.TextOpts.Text(
+			translation.GetInstance().GetTranslation("client.browser.slots"),
+			generalFont,
+			color.White)))
+
+	slotsComboButton := newComboButton([]interface{}{
+		false,
+		true,
+	}, func(e any) string {
+		if e.(bool) {
+			return translation.GetInstance().GetTranslation("client.browser.slots.free")
+		}
+
+		return translation.GetInstance().GetTranslation("client.browser.slots.all")
+	})
+
+	slotsComboButton.SetSelectedEntry(false)
+
+	components.AddChild(slotsComboButton)
+
+	container.AddChild(components)
+
+	listsContainer := widget.NewContainer(
+		widget.ContainerOpts.WidgetOpts(
+			widget.WidgetOpts.LayoutData(widget.RowLayoutData{
+				Stretch:  true,
+				Position: widget.RowLayoutPositionCenter,
+			}),
+			widget.WidgetOpts.MinSize(
+				container.GetWidget().MinWidth,
+				container.GetWidget().MinHeight,
+			),
+		),
+		widget.ContainerOpts.Layout(widget.NewRowLayout(
+			widget.RowLayoutOpts.Direction(widget.DirectionVertical),
+			widget.RowLayoutOpts.Padding(widget.Insets{
+				Top: 40,
+			}),
+		)))
+
+	listsContainer.AddChild(widget.NewText(
+		widget.TextOpts.WidgetOpts(widget.WidgetOpts.LayoutData(widget.RowLayoutData{
+			Stretch: true,
+		})),
+		widget.TextOpts.Insets(widget.Insets{
+			Bottom: 20,
+		}),
+		widget.TextOpts.Text(
+			translation.GetInstance().GetTranslation("client.browser.sessions"),
+			generalFont,
+			color.White)))
+
+	list := widget.NewList(
+		widget.ListOpts.ContainerOpts(
+			widget.ContainerOpts.WidgetOpts(
+				widget.WidgetOpts.MinSize(
+					scaler.GetPercentageOf(config.GetWorldWidth(), 40),
+					scaler.GetPercentageOf(config.GetWorldHeight(), 30),
+				),
+				widget.WidgetOpts.LayoutData(widget.RowLayoutData{
+					MaxWidth:  scaler.GetPercentageOf(config.GetWorldWidth(), 40),
+					MaxHeight: scaler.GetPercentageOf(config.GetWorldHeight(), 30),
+					Position:  widget.RowLayoutPositionCenter,
+				}))),
+		widget.ListOpts.ScrollContainerOpts(widget.ScrollContainerOpts.Image(&widget.ScrollContainerImage{
+			Idle:     image.NewNineSlice(loader.GetInstance().GetStatic(loader.ListIdle), [3]int{25, 12, 22}, [3]int{25, 12, 25}),
+			Disabled: image.NewNineSlice(loader.GetInstance().GetStatic(loader.ListDisabled), [3]int{25, 12, 22}, [3]int{25, 12, 25}),
+			Mask:     image.NewNineSlice(loader.GetInstance().GetStatic(loader.ListMask), [3]int{26, 10, 23}, [3]int{26, 10, 26}),
+		})),
+		widget.ListOpts.SliderOpts(
+			widget.SliderOpts.Images(
+				&widget.SliderTrackImage{
+					Idle:     image.NewNineSlice(loader.GetInstance().GetStatic(loader.ListTrackIdle), [3]int{5, 0, 0}, [3]int{25, 12, 25}),
+					Hover:    image.NewNineSlice(loader.GetInstance().GetStatic(loader.ListTrackIdle), [3]int{5, 0, 0}, [3]int{25, 12, 25}),
+					Disabled: image.NewNineSlice(loader.GetInstance().GetStatic(loader.ListTrackDisabled), [3]int{0, 5, 0}, [3]int{25, 12, 25}),
+				},
+				&widget.ButtonImage{
+					Idle:     image.NewNineSliceSimple(loader.GetInstance().GetStatic(loader.SliderHandleIdle), 0, 5),
+					Hover:    image.NewNineSliceSimple(loader.GetInstance().GetStatic(loader.SliderHandleHover), 0, 5),
+					Pressed:  image.NewNineSliceSimple(loader.GetInstance().GetStatic(loader.SliderHandleHover), 0, 5),
+					Disabled: image.NewNineSliceSimple(loader.GetInstance().GetStatic(loader.SliderHandleIdle), 0, 5),
+				}),
+			widget.SliderOpts.MinHandleSize(8),
+			widget.SliderOpts.TrackPadding(widget.Insets{Bottom: 20}),
+		),
+		widget.ListOpts.AllowReselect(),
+		widget.ListOpts.HideHorizontalSlider(),
+		widget.ListOpts.Entries([]interface{}{}),
+		widget.ListOpts.EntryLabelFunc(func(e interface{}) string {
+			return getPublicSessionLabel(e.(dto.RetrievedPublicSession))
+		}),
+		widget.ListOpts.EntrySelectedHandler(func(args *widget.ListEntrySelectedEventArgs) {
+			if result.selectActionButton.GetWidget().Disabled {
+				result.selectActionButton.GetWidget().Disabled = false
+			}
+
+			result.sessionNameEntry = args.Entry.(dto.RetrievedPublicSession).Name
+		}),
+		widget.ListOpts.EntryFontFace(generalFont),
+		widget.ListOpts.EntryColor(&widget.ListEntryColor{
+			Selected:                   componentscommon.ButtonTextColor,
+			Unselected:                 selectedListColor,
+			SelectedBackground:         selectedListColor,
+			SelectedFocusedBackground:  selectedListColor,
+			FocusedBackground:          focusedListColor,
+			DisabledUnselected:         disabledListColor,
+			DisabledSelected:           disabledListColor,
+			DisabledSelectedBackground: disabledListColor,
+		}),
+		widget.ListOpts.EntryTextPadding(widget.Insets{
+			Top:    15,
+			Left:   40,
+			Right:  40,
+			Bottom: 15,
+		}),
+	)
+
+	listsContainer.AddChild(list)
+
+	pageText := widget.NewText(
+		widget.TextOpts.WidgetOpts(widget.WidgetOpts.LayoutData(widget.RowLayoutData{
+			Position: widget.RowLayoutPositionCenter,
+		})),
+		widget.TextOpts.Insets(widget.Insets{
+			Top: 20,
+		}),
+		widget.TextOpts.Text("1 / 1", generalFont, color.White))
+
+	listsContainer.AddChild(pageText)
+
+	container.AddChild(listsContainer)
+
+	buttonIdleIcon := common.GetImageAsNineSlice(loader.ButtonIdleButton, 16, 15)
+	buttonHoverIcon := common.GetImageAsNineSlice(loader.ButtonHoverButton, 16, 15)
+
+	newButton := func(label string, handler func()) *widget.Button {
+		return widget.NewButton(
+			widget.ButtonOpts.Image(&widget.ButtonImage{
+				Idle:         buttonIdleIcon,
+				Hover:        buttonHoverIcon,
+				Pressed:      buttonIdleIcon,
+				PressedHover: buttonIdleIcon,
+				Disabled:     buttonIdleIcon,
+			}),
+			widget.ButtonOpts.Text(
+				translation.GetInstance().GetTranslation(label),
+				generalFont,
+				&widget.ButtonTextColor{Idle: componentscommon.ButtonTextColor}),
+			widget.ButtonOpts.WidgetOpts(
+				widget.WidgetOpts.LayoutData(widget.RowLayoutData{
+					Position: widget.RowLayoutPositionEnd,
+				})),
+			widget.ButtonOpts.TextPadding(widget.Insets{
+				Left:   30,
+				Right:  30,
+				Top:    20,
+				Bottom: 20,
+			}),
+			widget.ButtonOpts.PressedHandler(func(args *widget.ButtonPressedEventArgs) {
+				sound.GetInstance().GetSoundUIFxManager().PushWithHandbrake(loader.ButtonFXSound)
+
+				handler()
+			}))
+	}
+
+	buttonsContainer := widget.NewContainer(
+		widget.ContainerOpts.WidgetOpts(
+			widget.WidgetOpts.MinSize(
+				container.GetWidget().MinWidth,
+				0),
+			widget.WidgetOpts.LayoutData(widget.RowLayoutData{
+				Stretch: true,
+			}),
+		),
+		widget.ContainerOpts.Layout(widget.NewRowLayout(
+			widget.RowLayoutOpts.Direction(widget.DirectionHorizontal),
+			widget.RowLayoutOpts.Spacing(13),
+			widget.RowLayoutOpts.Padding(widget.Insets{
+				Top: 20,
+			}),
+		)),
+	)
+
+	buttonsContainer.AddChild(newButton("client.browser.back", func() {
+		result.backCallback()
+	}))
+
+	previousButton := newButton("client.browser.previous", func() {
+		result.previousCallback()
+	})
+
+	previousButton.GetWidget().Disabled = true
+
+	buttonsContainer.AddChild(previousButton)
+
+	nextButton := newButton("client.browser.next", func() {
+		result.nextCallback()
+	})
+
+	nextButton.GetWidget().Disabled = true
+
+	buttonsContainer.AddChild(nextButton)
+
+	selectActionButton := newButton("client.browser.select", func() {
+		result.selectCallback(result.sessionNameEntry)
+	})
+
+	selectActionButton.GetWidget().Disabled = true
+
+	buttonsContainer.AddChild(selectActionButton)
+
+	container.AddChild(buttonsContainer)
+
+	result = &BrowserComponent{
+		sortComboButton:    sortComboButton,
+		rulesComboButton:   rulesComboButton,
+		slotsComboButton:   slotsComboButton,
+		list:               list,
+		pageText:           pageText,
+		previousButton:     previousButton,
+		nextButton:         nextButton,
+		selectActionButton: selectActionButton,
+		container:          container,
+	}
+
+	return result
+}
+
+// getSortLabel retrieves translated label of the given sorting order.
+func getSortLabel(sort string) string {
+	switch sort {
+	case sortNewest:
+		return translation.GetInstance().GetTranslation("client.browser.sort.newest")
+	case sortOldest:
+		return translation.GetInstance().GetTranslation("client.browser.sort.oldest")
+	case sortOccupancy:
+		return translation.GetInstance().GetTranslation("client.browser.sort.occupancy")
+	case sortName:
+		return translation.GetInstance().GetTranslation("client.browser.sort.name")
+	default:
+		return sort
+	}
+}
+
+// getRulesPresetLabel retrieves translated label of the given rules preset.
+func getRulesPresetLabel(preset string) string {
+	switch preset {
+	case rulesPresetAny:
+		return translation.GetInstance().GetTranslation("client.browser.rules.any")
+	case rulesPresetClassic:
+		return translation.GetInstance().GetTranslation("client.creator.session-rules.classic")
+	case rulesPresetHardcore:
+		return translation.GetInstance().GetTranslation("client.creator.session-rules.hardcore")
+	case rulesPresetCasual:
+		return translation.GetInstance().GetTranslation("client.creator.session-rules.casual")
+	default:
+		return preset
+	}
+}
+
+// getPublicSessionLabel retrieves list entry label of the given public session, which contains
+// its name, owner, occupancy, rules preset and age.
+func getPublicSessionLabel(session dto.RetrievedPublicSession) string {
+	result := fmt.Sprintf(
+		"%s (%s) %d/%d %s %s",
+		session.Name,
+		session.Owner,
+		session.Players,
+		session.MaxPlayers,
+		getRulesPresetLabel(session.Rules),
+		getAgeLabel(time.Since(session.CreatedAt)))
+
+	if session.Started {
+		result += " " + translation.GetInstance().GetTranslation("client.browser.started")
+	}
+
+	return result
+}
+
+// getAgeLabel retrieves short label of the given age.
+func getAgeLabel(age time.Duration) string {
+	switch {
+	case age < time.Hour:
+		return fmt.Sprintf("%dm", int(age.Minutes()))
+	case age < time.Hour*24:
+		return fmt.Sprintf("%dh", int(age.Hours()))
+	default:
+		return fmt.Sprintf("%dd", int(age.Hours()/24))
+	}
+}
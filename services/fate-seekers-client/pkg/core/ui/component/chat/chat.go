@@ -0,0 +1,436 @@
+package chat
+
+import (
+	"fmt"
+	"image/color"
+	"strings"
+	"sync"
+	"unicode/utf8"
+
+	"github.com/YarikRevich/fate-seekers/services/fate-seekers-client/pkg/config"
+	"github.com/YarikRevich/fate-seekers/services/fate-seekers-client/pkg/core/sound"
+	"github.com/YarikRevich/fate-seekers/services/fate-seekers-client/pkg/core/tools/scaler"
+	"github.com/YarikRevich/fate-seekers/services/fate-seekers-client/pkg/core/ui/common"
+	componentscommon "github.com/YarikRevich/fate-seekers/services/fate-seekers-client/pkg/core/ui/component/common"
+	"github.com/YarikRevich/fate-seekers/services/fate-seekers-client/pkg/core/ui/manager/translation"
+	"github.com/YarikRevich/fate-seekers/services/fate-seekers-client/pkg/loader"
+	"github.com/ebitenui/ebitenui/image"
+	"github.com/ebitenui/ebitenui/widget"
+	"github.com/hajimehoshi/ebiten/v2/text/v2"
+)
+
+const (
+	// Describes max amount of symbols, which can be entered to chat input, matching the server default one.
+	maxInputSymbols = 200
+
+	// Describes max amount of messages shown in the chat.
+	maxMessages = 50
+)
+
+// Describes all the on-screen keyboard rows per language, which are used when gamepad is enabled.
+var (
+	englishKeyboardRows = []string{
+		"1234567890",
+		"qwertyuiop",
+		"asdfghjkl",
+		"zxcvbnm.,!?",
+	}
+
+	ukrainianKeyboardRows = []string{
+		"1234567890",
+		"йцукенгшщзхї",
+		"фівапролджє",
+		"ячсмитьбю.,!?",
+	}
+)
+
+var (
+	// GetInstance retrieves instance of the chat component, performing initial creation if needed.
+	GetInstance = sync.OnceValue[*ChatComponent](newChatComponent)
+)
+
+// ChatComponent represents component, which contains session chat overlay.
+type ChatComponent struct {
+	// Represents all the shown messages.
+	messages []string
+
+	// Represents messages text area widget.
+	textArea *widget.TextArea
+
+	// Represents message text input widget.
+	textInput *widget.TextInput
+
+	// Represents on-screen keyboard container widget.
+	keyboardContainer *widget.Container
+
+	// Represents submit callback.
+	submitCallback func(value string)
+
+	// Represents close callback.
+	closeCallback func()
+
+	// Represents container widget.
+	container *widget.Container
+}
+
+// AddMessage adds message of the given author to the messages text area widget.
+func (cc *ChatComponent) AddMessage(author, value string) {
+	cc.messages = append(cc.messages, fmt.Sprintf("%s: %s", author, value))
+
+	if len(cc.messages) > maxMessages {
+		cc.messages = cc.messages[len(cc.messages)-maxMessages:]
+	}
+
+	cc.textArea.SetText(strings.Join(cc.messages, "\n"))
+}
+
+// CleanMessages cleans messages text area widget.
+func (cc *ChatComponent) CleanMessages() {
+	cc.messages = nil
+
+	cc.textArea.SetText("")
+}
+
+// CleanInput cleans message text input widget.
+func (cc *ChatComponent) CleanInput() {
+	cc.textInput.SetText("")
+}
+
+// SetSubmitCallback modifies submit callback in the container.
+func (cc *ChatComponent) SetSubmitCallback(callback func(value string)) {
+	cc.submitCallback = callback
+}
+
+// SetCloseCallback modifies close callback in the container.
+func (cc *ChatComponent) SetCloseCallback(callback func()) {
+	cc.closeCallback = callback
+}
+
+// Show shows chat container widget, focusing message text input widget. On-screen keyboard
+// is shown, when keyboard flag is provided.
+func (cc *ChatComponent) Show(keyboard bool) {
+	cc.container.GetWidget().Visibility = widget.Visibility_Show
+
+	if keyboard {
+		cc.keyboardContainer.GetWidget().Visibility = widget.Visibility_Show
+	} else {
+		cc.keyboardContainer.GetWidget().Visibility = widget.Visibility_Hide
+	}
+
+	cc.textInput.Focus(true)
+}
+
+// Hide hides chat container widget.
+func (cc *ChatComponent) Hide() {
+	cc.textInput.Focus(false)
+
+	cc.container.GetWidget().Visibility = widget.Visibility_Hide
+}
+
+// IsShown checks if chat container widget is shown.
+func (cc *ChatComponent) IsShown() bool {
+	return cc.container.GetWidget().Visibility == widget.Visibility_Show
+}
+
+// GetContainer retrieves container widget.
+func (cc *ChatComponent) GetContainer() *widget.Container {
+	return cc.container
+}
+
+// newChatComponent creates new chat component.
+func newChatComponent() *ChatComponent {
+	var result *ChatComponent
+
+	container := widget.NewContainer(
+		widget.ContainerOpts.WidgetOpts(
+			widget.WidgetOpts.MinSize(
+				scaler.GetPercentageOf(config.GetWorldWidth(), 40),
+				0),
+			widget.WidgetOpts.TrackHover(false),
+			widget.WidgetOpts.LayoutData(widget.AnchorLayoutData{
+				Padding: widget.Insets{
+					Top: scaler.GetPercentageOf(config.GetWorldHeight(), 4),
+				},
+				HorizontalPosition: widget.AnchorLayoutPositionCenter,
+				VerticalPosition:   widget.AnchorLayoutPositionStart,
+				StretchHorizontal:  false,
+				StretchVertical:    false,
+			})),
+		widget.ContainerOpts.BackgroundImage(common.GetImageAsNineSlice(loader.PanelIdlePanel, 10, 10)),
+		widget.ContainerOpts.Layout(widget.NewRowLayout(
+			widget.RowLayoutOpts.Direction(widget.DirectionVertical),
+			widget.RowLayoutOpts.Spacing(15),
+			widget.RowLayoutOpts.Padding(widget.Insets{
+				Left:   30,
+				Right:  30,
+				Top:    30,
+				Bottom: 30,
+			}),
+		)))
+
+	generalFont := &text.GoTextFace{
+		Source: loader.GetInstance().GetFont(loader.KyivRegularFont),
+		Size:   20,
+	}
+
+	container.AddChild(widget.NewText(
+		widget.TextOpts.WidgetOpts(widget.WidgetOpts.LayoutData(widget.RowLayoutData{
+			Stretch: true,
+		})),
+		widget.TextOpts.Text(
+			translation.GetInstance().GetTranslation("client.chat.title"),
+			generalFont,
+			color.White)))
+
+	textArea := widget.NewTextArea(
+		widget.TextAreaOpts.ContainerOpts(
+			widget.ContainerOpts.WidgetOpts(
+				widget.WidgetOpts.MinSize(
+					container.GetWidget().MinWidth,
+					scaler.GetPercentageOf(config.GetWorldHeight(), 25)),
+				widget.WidgetOpts.LayoutData(widget.RowLayoutData{
+					Stretch: true,
+				}))),
+		widget.TextAreaOpts.ScrollContainerOpts(widget.ScrollContainerOpts.Image(&widget.ScrollContainerImage{
+			Idle:     image.NewNineSlice(loader.GetInstance().GetStatic(loader.ListIdle), [3]int{25, 12, 22}, [3]int{25, 12, 25}),
+			Disabled: image.NewNineSlice(loader.GetInstance().GetStatic(loader.ListDisabled), [3]int{25, 12, 22}, [3]int{25, 12, 25}),
+			Mask:     image.NewNineSlice(loader.GetInstance().GetStatic(loader.ListMask), [3]int{26, 10, 23}, [3]int{26, 10, 26}),
+		})),
+		widget.TextAreaOpts.SliderOpts(
+			widget.SliderOpts.Images(
+				&widget.SliderTrackImage{
+					Idle:     image.NewNineSlice(loader.GetInstance().GetStatic(loader.ListTrackIdle), [3]int{5, 0, 0}, [3]int{25, 12, 25}),
+					Hover:    image.NewNineSlice(loader.GetInstance().GetStatic(loader.ListTrackIdle), [3]int{5, 0, 0}, [3]int{25, 12, 25}),
+					Disabled: image.NewNineSlice(loader.GetInstance().GetStatic(loader.ListTrackDisabled), [3]int{0, 5, 0}, [3]int{25, 12, 25}),
+				},
+				&widget.ButtonImage{
+					Idle:     image.NewNineSliceSimple(loader.GetInstance().GetStatic(loader.SliderHandleIdle), 0, 5),
+					Hover:    image.NewNineSliceSimple(loader.GetInstance().GetStatic(loader.SliderHandleHover), 0, 5),
+					Pressed:  image.NewNineSliceSimple(loader.GetInstance().GetStatic(loader.SliderHandleHover), 0, 5),
+					Disabled: image.NewNineSliceSimple(loader.GetInstance().GetStatic(loader.SliderHandleIdle), 0, 5),
+				}),
+			widget.SliderOpts.MinHandleSize(5),
+			widget.SliderOpts.TrackPadding(widget.Insets{
+				Top:    5,
+				Bottom: 24,
+			}),
+		),
+		widget.TextAreaOpts.ShowVerticalScrollbar(),
+		widget.TextAreaOpts.VerticalScrollMode(widget.ScrollEnd),
+		widget.TextAreaOpts.ProcessBBCode(false),
+		widget.TextAreaOpts.FontFace(generalFont),
+		widget.TextAreaOpts.FontColor(color.White),
+		widget.TextAreaOpts.TextPadding(widget.Insets{
+			Top:    15,
+			Bottom: 15,
+			Left:   20,
+			Right:  20,
+		}),
+		widget.TextAreaOpts.Text(""),
+	)
+
+	container.AddChild(textArea)
+
+	var textInput *widget.TextInput
+
+	textInput = widget.NewTextInput(
+		widget.TextInputOpts.WidgetOpts(
+			widget.WidgetOpts.LayoutData(widget.RowLayoutData{
+				Stretch: true,
+			})),
+		widget.TextInputOpts.Image(&widget.TextInputImage{
+			Idle:     image.NewNineSlice(loader.GetInstance().GetStatic(loader.TextInputIdle), [3]int{9, 14, 6}, [3]int{9, 14, 6}),
+			Disabled: image.NewNineSlice(loader.GetInstance().GetStatic(loader.TextInputIdle), [3]int{9, 14, 6}, [3]int{9, 14, 6}),
+		}),
+		widget.TextInputOpts.Color(&widget.TextInputColor{
+			Idle:          color.Black,
+			Disabled:      color.Black,
+			Caret:         color.Black,
+			DisabledCaret: color.Black,
+		}),
+		widget.TextInputOpts.Padding(widget.Insets{
+			Left:   13,
+			Right:  13,
+			Top:    13,
+			Bottom: 13,
+		}),
+		widget.TextInputOpts.Face(generalFont),
+		widget.TextInputOpts.CaretOpts(
+			widget.CaretOpts.Size(generalFont, 2),
+		),
+		widget.TextInputOpts.IgnoreEmptySubmit(true),
+		widget.TextInputOpts.AllowDuplicateSubmit(true),
+		widget.TextInputOpts.Validation(func(newInputText string) (bool, *string) {
+			if utf8.RuneCountInString(newInputText) > maxInputSymbols {
+				return false, nil
+			}
+
+			return true, nil
+		}),
+		widget.TextInputOpts.SubmitHandler(func(args *widget.TextInputChangedEventArgs) {
+			result.submitCallback(args.InputText)
+		}),
+		widget.TextInputOpts.Placeholder(translation.GetInstance().GetTranslation("client.chat.placeholder")))
+
+	container.AddChild(textInput)
+
+	buttonIdleIcon := common.GetImageAsNineSlice(loader.ButtonIdleButton, 16, 15)
+	buttonHoverIcon := common.GetImageAsNineSlice(loader.ButtonHoverButton, 16, 15)
+
+	keyboardFont := &text.GoTextFace{
+		Source: loader.GetInstance().GetFont(loader.KyivRegularFont),
+		Size:   16,
+	}
+
+	newKeyboardButton := func(label string, handler func()) *widget.Button {
+		return widget.NewButton(
+			widget.ButtonOpts.Image(&widget.ButtonImage{
+				Idle:         buttonIdleIcon,
+				Hover:        buttonHoverIcon,
+				Pressed:      buttonIdleIcon,
+				PressedHover: buttonIdleIcon,
+				Disabled:     buttonIdleIcon,
+			}),
+			widget.ButtonOpts.Text(
+				label,
+				keyboardFont,
+				&widget.ButtonTextColor{Idle: componentscommon.ButtonTextColor}),
+			widget.ButtonOpts.TextPadding(widget.Insets{
+				Left:   12,
+				Right:  12,
+				Top:    8,
+				Bottom: 8,
+			}),
+			widget.ButtonOpts.PressedHandler(func(args *widget.ButtonPressedEventArgs) {
+				sound.GetInstance().GetSoundUIFxManager().PushWithHandbrake(loader.ButtonFXSound)
+
+				handler()
+			}),
+		)
+	}
+
+	newKeyboardRow := func() *widget.Container {
+		return widget.NewContainer(
+			widget.ContainerOpts.WidgetOpts(widget.WidgetOpts.LayoutData(widget.RowLayoutData{
+				Position: widget.RowLayoutPositionCenter,
+			})),
+			widget.ContainerOpts.Layout(widget.NewRowLayout(
+				widget.RowLayoutOpts.Direction(widget.DirectionHorizontal),
+				widget.RowLayoutOpts.Spacing(5))))
+	}
+
+	keyboardContainer := widget.NewContainer(
+		widget.ContainerOpts.WidgetOpts(widget.WidgetOpts.LayoutData(widget.RowLayoutData{
+			Stretch: true,
+		})),
+		widget.ContainerOpts.Layout(widget.NewRowLayout(
+			widget.RowLayoutOpts.Direction(widget.DirectionVertical),
+			widget.RowLayoutOpts.Spacing(5))))
+
+	keyboardRows := englishKeyboardRows
+
+	if config.GetSettingsInitialLanguage() == config.SETTINGS_LANGUAGE_UKRAINIAN {
+		keyboardRows = ukrainianKeyboardRows
+	}
+
+	for _, keys := range keyboardRows {
+		keyboardRow := newKeyboardRow()
+
+		for _, key := range keys {
+			keyboardRow.AddChild(newKeyboardButton(string(key), func() {
+				textInput.Insert([]rune{key})
+			}))
+		}
+
+		keyboardContainer.AddChild(keyboardRow)
+	}
+
+	keyboardRow := newKeyboardRow()
+
+	keyboardRow.AddChild(newKeyboardButton(
+		translation.GetInstance().GetTranslation("client.chat.space"), func() {
+			textInput.Insert([]rune{' '})
+		}))
+
+	keyboardRow.AddChild(newKeyboardButton(
+		translation.GetInstance().GetTranslation("client.chat.backspace"), func() {
+			textInput.Backspace()
+		}))
+
+	keyboardContainer.AddChild(keyboardRow)
+
+	keyboardContainer.GetWidget().Visibility = widget.Visibility_Hide
+
+	container.AddChild(keyboardContainer)
+
+	buttonsContainer := widget.NewContainer(
+		widget.ContainerOpts.WidgetOpts(widget.WidgetOpts.LayoutData(widget.RowLayoutData{
+			Position: widget.RowLayoutPositionEnd,
+		})),
+		widget.ContainerOpts.Layout(widget.NewRowLayout(
+			widget.RowLayoutOpts.Direction(widget.DirectionHorizontal),
+			widget.RowLayoutOpts.Spacing(13))))
+
+	buttonsContainer.AddChild(widget.NewButton(
+		widget.ButtonOpts.Image(&widget.ButtonImage{
+			Idle:         buttonIdleIcon,
+			Hover:        buttonHoverIcon,
+			Pressed:      buttonIdleIcon,
+			PressedHover: buttonIdleIcon,
+			Disabled:     buttonIdleIcon,
+		}),
+		widget.ButtonOpts.Text(
+			translation.GetInstance().GetTranslation("client.chat.send"),
+			generalFont,
+			&widget.ButtonTextColor{Idle: componentscommon.ButtonTextColor}),
+		widget.ButtonOpts.TextPadding(widget.Insets{
+			Left:   30,
+			Right:  30,
+			Top:    20,
+			Bottom: 20,
+		}),
+		widget.ButtonOpts.PressedHandler(func(args *widget.ButtonPressedEventArgs) {
+			sound.GetInstance().GetSoundUIFxManager().PushWithHandbrake(loader.ButtonFXSound)
+
+			textInput.Submit()
+		}),
+	))
+
+	buttonsContainer.AddChild(widget.NewButton(
+		widget.ButtonOpts.Image(&widget.ButtonImage{
+			Idle:         buttonIdleIcon,
+			Hover:        buttonHoverIcon,
+			Pressed:      buttonIdleIcon,
+			PressedHover: buttonIdleIcon,
+			Disabled:     buttonIdleIcon,
+		}),
+		widget.ButtonOpts.Text(
+			translation.GetInstance().GetTranslation("client.chat.close"),
+			generalFont,
+			&widget.ButtonTextColor{Idle: componentscommon.ButtonTextColor}),
+		widget.ButtonOpts.TextPadding(widget.Insets{
+			Left:   30,
+			Right:  30,
+			Top:    20,
+			Bottom: 20,
+		}),
+		widget.ButtonOpts.PressedHandler(func(args *widget.ButtonPressedEventArgs) {
+			sound.GetInstance().GetSoundUIFxManager().PushWithHandbrake(loader.ButtonFXSound)
+
+			result.closeCallback()
+		}),
+	))
+
+	container.AddChild(buttonsContainer)
+
+	container.GetWidget().Visibility = widget.Visibility_Hide
+
+	result = &ChatComponent{
+		textArea:          textArea,
+		textInput:         textInput,
+		keyboardContainer: keyboardContainer,
+		container:         container,
+	}
+
+	return result
+}
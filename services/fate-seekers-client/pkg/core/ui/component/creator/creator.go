@@ -32,6 +32,16 @@ const (
 
 	// Describes seed input format, which is allowed to be entered to seed input component.
 	seedInputFormat = `^[0-9-]{0,8}$`
+
+	// Describes password input format, which is allowed to be entered to password input component.
+	passwordInputFormat = `^[[:graph:]]{0,30}$`
+)
+
+// Describes all the rules presets, which can be selected for a new session.
+const (
+	rulesPresetClassic  = "classic"
+	rulesPresetHardcore = "hardcore"
+	rulesPresetCasual   = "casual"
 )
 
 // Describes all the colors used for list combo definition.
@@ -49,8 +59,14 @@ type CreatorComponent struct {
 	// Represents seed input widget.
 	seedInput *widget.TextInput
 
+	// Represents rules preset combo button widget.
+	rulesComboButton *widget.ListComboButton
+
+	// Represents password input widget.
+	passwordInput *widget.TextInput
+
 	// Represents submit callback.
-	submitCallback func(name, seed string)
+	submitCallback func(name, seed, rules, password string)
 
 	// Represents back callback.
 	backCallback func()
@@ -63,10 +79,12 @@ type CreatorComponent struct {
 func (cc *CreatorComponent) CleanInputs() {
 	cc.nameInput.SetText("")
 	cc.seedInput.SetText("")
+	cc.rulesComboButton.SetSelectedEntry(rulesPresetClassic)
+	cc.passwordInput.SetText("")
 }
 
 // SetSubmitCallback modified submit callback in the container.
-func (cc *CreatorComponent) SetSubmitCallback(callback func(name, seed string)) {
+func (cc *CreatorComponent) SetSubmitCallback(callback func(name, seed, rules, password string)) {
 	cc.submitCallback = callback
 }
 
@@ -311,6 +329,171 @@ func newCreatorComponent() *CreatorComponent {
 
 	components.AddChild(seedInput)
 
+	components.AddChild(widget.NewText(
+		widget.TextOpts.Text(
+			translation.GetInstance().GetTranslation("client.creator.session-rules"),
+			generalFont,
+			color.White)))
+
+	rulesComboButton := widget.NewListComboButton(
+		widget.ListComboButtonOpts.SelectComboButtonOpts(
+			widget.SelectComboButtonOpts.ComboButtonOpts(
+				widget.ComboButtonOpts.ButtonOpts(
+					widget.ButtonOpts.Image(&widget.ButtonImage{
+						Idle:         common.GetImageAsNineSlice(loader.ComboIdleButton, 12, -10),
+						Hover:        common.GetImageAsNineSlice(loader.ComboIdleButton, 12, -10),
+						Pressed:      common.GetImageAsNineSlice(loader.ComboIdleButton, 12, -10),
+						PressedHover: common.GetImageAsNineSlice(loader.ComboIdleButton, 12, -10),
+					}),
+				),
+			),
+		),
+		widget.ListComboButtonOpts.Text(generalFont, &widget.ButtonImageImage{
+			Idle:     loader.GetInstance().GetStatic(loader.ComboArrayIdleButton),
+			Disabled: loader.GetInstance().GetStatic(loader.ComboArrayIdleButton),
+		}, &widget.ButtonTextColor{
+			Idle:     componentscommon.ButtonTextColor,
+			Disabled: componentscommon.ButtonTextColor,
+			Hover:    componentscommon.ButtonTextColor,
+			Pressed:  componentscommon.ButtonTextColor,
+		}),
+		widget.ListComboButtonOpts.ListOpts(
+			widget.ListOpts.Entries([]interface{}{
+				rulesPresetClassic,
+				rulesPresetHardcore,
+				rulesPresetCasual,
+			}),
+			widget.ListOpts.ScrollContainerOpts(
+				widget.ScrollContainerOpts.Image(&widget.ScrollContainerImage{
+					Idle:     image.NewNineSlice(loader.GetInstance().GetStatic(loader.ListIdle), [3]int{25, 12, 22}, [3]int{25, 12, 25}),
+					Disabled: image.NewNineSlice(loader.GetInstance().GetStatic(loader.ListDisabled), [3]int{25, 12, 22}, [3]int{25, 12, 25}),
+					Mask:     image.NewNineSlice(loader.GetInstance().GetStatic(loader.ListMask), [3]int{26, 10, 23}, [3]int{26, 10, 26}),
+				}),
+			),
+			widget.ListOpts.SliderOpts(
+				widget.SliderOpts.Images(
+					&widget.SliderTrackImage{
+						Idle:     image.NewNineSlice(loader.GetInstance().GetStatic(loader.ListTrackIdle), [3]int{5, 0, 0}, [3]int{25, 12, 25}),
+						Hover:    image.NewNineSlice(loader.GetInstance().GetStatic(loader.ListTrackIdle), [3]int{5, 0, 0}, [3]int{25, 12, 25}),
+						Disabled: image.NewNineSlice(loader.GetInstance().GetStatic(loader.ListTrackDisabled), [3]int{0, 5, 0}, [3]int{25, 12, 25}),
+					},
+					&widget.ButtonImage{
+						Idle:     image.NewNineSliceSimple(loader.GetInstance().GetStatic(loader.SliderHandleIdle), 0, 5),
+						Hover:    image.NewNineSliceSimple(loader.GetInstance().GetStatic(loader.SliderHandleHover), 0, 5),
+						Pressed:  image.NewNineSliceSimple(loader.GetInstance().GetStatic(loader.SliderHandleHover), 0, 5),
+						Disabled: image.NewNineSliceSimple(loader.GetInstance().GetStatic(loader.SliderHandleIdle), 0, 5),
+					}),
+				widget.SliderOpts.MinHandleSize(4),
+				widget.SliderOpts.TrackPadding(widget.Insets{Bottom: 20})),
+			widget.ListOpts.EntryFontFace(generalFont),
+			widget.ListOpts.EntryColor(&widget.ListEntryColor{
+				Selected:                   componentscommon.ButtonTextColor,
+				Unselected:                 selectedListColor,
+				SelectedBackground:         selectedListColor,
+				SelectedFocusedBackground:  selectedListColor,
+				FocusedBackground:          focusedListColor,
+				DisabledUnselected:         disabledListColor,
+				DisabledSelected:           disabledListColor,
+				DisabledSelectedBackground: disabledListColor,
+			}),
+			widget.ListOpts.EntryTextPadding(widget.Insets{
+				Top:    15,
+				Left:   40,
+				Right:  40,
+				Bottom: 15,
+			}),
+		),
+		widget.ListComboButtonOpts.EntryLabelFunc(
+			func(e any) string {
+				return getRulesPresetLabel(e.(string))
+			},
+			func(e any) string {
+				return getRulesPresetLabel(e.(string))
+			}))
+
+	rulesComboButton.SetSelectedEntry(rulesPresetClassic)
+
+	components.AddChild(rulesComboButton)
+
+	components.AddChild(widget.NewText(
+		widget.TextOpts.Text(
+			translation.GetInstance().GetTranslation("client.creator.session-password"),
+			generalFont,
+			color.White)))
+
+	var passwordInput *widget.TextInput
+
+	passwordInput = widget.NewTextInput(
+		widget.TextInputOpts.WidgetOpts(
+			widget.WidgetOpts.MinSize(
+				scaler.GetPercentageOf(config.GetWorldWidth(), 20), 0),
+			widget.WidgetOpts.LayoutData(widget.AnchorLayoutData{
+				VerticalPosition:   widget.AnchorLayoutPositionCenter,
+				HorizontalPosition: widget.AnchorLayoutPositionCenter,
+				StretchHorizontal:  false,
+				StretchVertical:    false,
+				Padding: widget.Insets{
+					Bottom: scaler.GetPercentageOf(config.GetWorldHeight(), 20),
+				},
+			})),
+		widget.TextInputOpts.Image(&widget.TextInputImage{
+			Idle:     image.NewNineSlice(loader.GetInstance().GetStatic(loader.TextInputIdle), [3]int{9, 14, 6}, [3]int{9, 14, 6}),
+			Disabled: image.NewNineSlice(loader.GetInstance().GetStatic(loader.TextInputIdle), [3]int{9, 14, 6}, [3]int{9, 14, 6}),
+		}),
+		widget.TextInputOpts.Color(&widget.TextInputColor{
+			Idle:          color.White,
+			Disabled:      color.White,
+			Caret:         color.White,
+			DisabledCaret: color.White,
+		}),
+		widget.TextInputOpts.Padding(widget.Insets{
+			Left:   13,
+			Right:  13,
+			Top:    13,
+			Bottom: 13,
+		}),
+		widget.TextInputOpts.Face(&text.GoTextFace{
+			Source: loader.GetInstance().GetFont(loader.KyivRegularFont),
+			Size:   20,
+		}),
+		widget.TextInputOpts.CaretOpts(
+			widget.CaretOpts.Size(generalFont, 4),
+		),
+		widget.TextInputOpts.Secure(true),
+		widget.TextInputOpts.AllowDuplicateSubmit(false),
+		widget.TextInputOpts.Validation(func(newInputTextRaw string) (bool, *string) {
+			newInputText := newInputTextRaw
+
+			parsedNewInputText := newInputText[len(passwordInput.GetText()):]
+
+			if len(parsedNewInputText) > 1 {
+				newInputText = passwordInput.GetText() + parsedNewInputText[:1]
+			} else if len(parsedNewInputText) == 0 {
+				return false, &newInputText
+			}
+
+			if len(newInputText) > maxInputSymbols {
+				replacement := passwordInput.GetText()
+
+				return false, &replacement
+			}
+
+			matched, err := regexp.MatchString(passwordInputFormat, newInputText)
+			if err != nil {
+				logging.GetInstance().Fatal(err.Error())
+			}
+
+			if !matched {
+				replacement := passwordInput.GetText()
+
+				return false, &replacement
+			}
+
+			return false, &newInputText
+		}))
+
+	components.AddChild(passwordInput)
+
 	container.AddChild(components)
 
 	buttonsContainer := widget.NewContainer(
@@ -392,17 +575,37 @@ func newCreatorComponent() *CreatorComponent {
 		widget.ButtonOpts.PressedHandler(func(args *widget.ButtonPressedEventArgs) {
 			sound.GetInstance().GetSoundUIFxManager().PushWithHandbrake(loader.ButtonFXSound)
 
-			result.submitCallback(nameInput.GetText(), seedInput.GetText())
+			result.submitCallback(
+				nameInput.GetText(),
+				seedInput.GetText(),
+				rulesComboButton.SelectedEntry().(string),
+				passwordInput.GetText())
 		}),
 	))
 
 	container.AddChild(buttonsContainer)
 
 	result = &CreatorComponent{
-		nameInput: nameInput,
-		seedInput: seedInput,
-		container: container,
+		nameInput:        nameInput,
+		seedInput:        seedInput,
+		rulesComboButton: rulesComboButton,
+		passwordInput:    passwordInput,
+		container:        container,
 	}
 
 	return result
 }
+
+// getRulesPresetLabel retrieves translated label of the given rules preset.
+func getRulesPresetLabel(preset string) string {
+	switch preset {
+	case rulesPresetClassic:
+		return translation.GetInstance().GetTranslation("client.creator.session-rules.classic")
+	case rulesPresetHardcore:
+		return translation.GetInstance().GetTranslation("client.creator.session-rules.hardcore")
+	case rulesPresetCasual:
+		return translation.GetInstance().GetTranslation("client.creator.session-rules.casual")
+	default:
+		return preset
+	}
+}
@@ -0,0 +1,226 @@
+package emote
+
+import (
+	"sync"
+
+	"github.com/YarikRevich/fate-seekers/services/fate-seekers-client/pkg/config"
+	"github.com/YarikRevich/fate-seekers/services/fate-seekers-client/pkg/core/sound"
+	"github.com/YarikRevich/fate-seekers/services/fate-seekers-client/pkg/core/tools/scaler"
+	"github.com/YarikRevich/fate-seekers/services/fate-seekers-client/pkg/core/ui/common"
+	componentscommon "github.com/YarikRevich/fate-seekers/services/fate-seekers-client/pkg/core/ui/component/common"
+	"github.com/YarikRevich/fate-seekers/services/fate-seekers-client/pkg/core/ui/manager/translation"
+	"github.com/YarikRevich/fate-seekers/services/fate-seekers-client/pkg/dto"
+	"github.com/YarikRevich/fate-seekers/services/fate-seekers-client/pkg/loader"
+	"github.com/ebitenui/ebitenui/widget"
+	"github.com/hajimehoshi/ebiten/v2/text/v2"
+)
+
+const (
+	// Represents wheel cell index of the ping button, which is placed in the center of the wheel.
+	pingCell = 4
+)
+
+var (
+	// Represents translation keys of all the available emotes, emotes are identified by their index,
+	// which matches the server one.
+	EMOTES = []string{
+		"client.emote.hello",
+		"client.emote.follow",
+		"client.emote.thanks",
+		"client.emote.danger",
+		"client.emote.help",
+		"client.emote.wait",
+		"client.emote.sorry",
+		"client.emote.laugh",
+	}
+
+	// Represents emotes placed in each of the wheel cells clockwise starting from the top one,
+	// wheel is laid out as a three by three grid with ping button in the center.
+	wheelCells = []int{7, 0, 1, 6, -1, 2, 5, 4, 3}
+
+	// Represents emotes selected by each of the stick directions.
+	directionEmotes = map[dto.Direction]uint64{
+		dto.DirUp:        0,
+		dto.DirUpRight:   1,
+		dto.DirRight:     2,
+		dto.DirDownRight: 3,
+		dto.DirDown:      4,
+		dto.DirDownLeft:  5,
+		dto.DirLeft:      6,
+		dto.DirUpLeft:    7,
+	}
+)
+
+var (
+	// GetInstance retrieves instance of the emote component, performing initial creation if needed.
+	GetInstance = sync.OnceValue[*EmoteComponent](newEmoteComponent)
+)
+
+// EmoteComponent represents component, which contains quick emotes and pings wheel.
+type EmoteComponent struct {
+	// Represents wheel buttons in the order of wheel cells.
+	buttons []*widget.Button
+
+	// Represents emote callback.
+	emoteCallback func(emote uint64)
+
+	// Represents ping callback.
+	pingCallback func()
+
+	// Represents container widget.
+	container *widget.Container
+}
+
+// SetEmoteCallback modifies emote callback in the container.
+func (ec *EmoteComponent) SetEmoteCallback(callback func(emote uint64)) {
+	ec.emoteCallback = callback
+}
+
+// SetPingCallback modifies ping callback in the container.
+func (ec *EmoteComponent) SetPingCallback(callback func()) {
+	ec.pingCallback = callback
+}
+
+// Highlight highlights wheel button placed in the given direction, ping button is highlighted
+// when there is no direction.
+func (ec *EmoteComponent) Highlight(direction dto.Direction) {
+	highlighted := pingCell
+
+	if emote, ok := directionEmotes[direction]; ok {
+		for i, cell := range wheelCells {
+			if cell == int(emote) {
+				highlighted = i
+			}
+		}
+	}
+
+	for i, button := range ec.buttons {
+		button.Focus(i == highlighted)
+	}
+}
+
+// Submit performs action of the wheel button placed in the given direction, ping is performed
+// when there is no direction.
+func (ec *EmoteComponent) Submit(direction dto.Direction) {
+	if emote, ok := directionEmotes[direction]; ok {
+		ec.emoteCallback(emote)
+	} else {
+		ec.pingCallback()
+	}
+}
+
+// Show shows emote container widget.
+func (ec *EmoteComponent) Show() {
+	ec.container.GetWidget().Visibility = widget.Visibility_Show
+}
+
+// Hide hides emote container widget, removing buttons highlight.
+func (ec *EmoteComponent) Hide() {
+	for _, button := range ec.buttons {
+		button.Focus(false)
+	}
+
+	ec.container.GetWidget().Visibility = widget.Visibility_Hide
+}
+
+// IsShown checks if emote container widget is shown.
+func (ec *EmoteComponent) IsShown() bool {
+	return ec.container.GetWidget().Visibility == widget.Visibility_Show
+}
+
+// GetContainer retrieves container widget.
+func (ec *EmoteComponent) GetContainer() *widget.Container {
+	return ec.container
+}
+
+// newEmoteComponent creates new emote component.
+func newEmoteComponent() *EmoteComponent {
+	var result *EmoteComponent
+
+	container := widget.NewContainer(
+		widget.ContainerOpts.WidgetOpts(
+			widget.WidgetOpts.TrackHover(false),
+			widget.WidgetOpts.LayoutData(widget.AnchorLayoutData{
+				HorizontalPosition: widget.AnchorLayoutPositionCenter,
+				VerticalPosition:   widget.AnchorLayoutPositionCenter,
+				StretchHorizontal:  false,
+				StretchVertical:    false,
+			})),
+		widget.ContainerOpts.BackgroundImage(common.GetImageAsNineSlice(loader.PanelIdlePanel, 10, 10)),
+		widget.ContainerOpts.Layout(widget.NewGridLayout(
+			widget.GridLayoutOpts.Columns(3),
+			widget.GridLayoutOpts.Stretch([]bool{true, true, true}, nil),
+			widget.GridLayoutOpts.Spacing(
+				scaler.GetPercentageOf(config.GetWorldWidth(), 1),
+				scaler.GetPercentageOf(config.GetWorldHeight(), 2)),
+			widget.GridLayoutOpts.Padding(widget.Insets{
+				Left:   30,
+				Right:  30,
+				Top:    30,
+				Bottom: 30,
+			}),
+		)))
+
+	generalFont := &text.GoTextFace{
+		Source: loader.GetInstance().GetFont(loader.KyivRegularFont),
+		Size:   20,
+	}
+
+	buttonIdleIcon := common.GetImageAsNineSlice(loader.ButtonIdleButton, 16, 15)
+	buttonHoverIcon := common.GetImageAsNineSlice(loader.ButtonHoverButton, 16, 15)
+
+	var buttons []*widget.Button
+
+	for _, cell := range wheelCells {
+		var label string
+
+		if cell == -1 {
+			label = translation.GetInstance().GetTranslation("client.emote.ping")
+		} else {
+			label = translation.GetInstance().GetTranslation(EMOTES[cell])
+		}
+
+		button := widget.NewButton(
+			widget.ButtonOpts.Image(&widget.ButtonImage{
+				Idle:         buttonIdleIcon,
+				Hover:        buttonHoverIcon,
+				Pressed:      buttonIdleIcon,
+				PressedHover: buttonIdleIcon,
+				Disabled:     buttonIdleIcon,
+			}),
+			widget.ButtonOpts.Text(
+				label,
+				generalFont,
+				&widget.ButtonTextColor{Idle: componentscommon.ButtonTextColor}),
+			widget.ButtonOpts.TextPadding(widget.Insets{
+				Left:   20,
+				Right:  20,
+				Top:    20,
+				Bottom: 20,
+			}),
+			widget.ButtonOpts.DisableDefaultKeys(),
+			widget.ButtonOpts.PressedHandler(func(args *widget.ButtonPressedEventArgs) {
+				sound.GetInstance().GetSoundUIFxManager().PushWithHandbrake(loader.ButtonFXSound)
+
+				if cell == -1 {
+					result.pingCallback()
+				} else {
+					result.emoteCallback(uint64(cell))
+				}
+			}),
+		)
+
+		buttons = append(buttons, button)
+
+		container.AddChild(button)
+	}
+
+	container.GetWidget().Visibility = widget.Visibility_Hide
+
+	result = &EmoteComponent{
+		buttons:   buttons,
+		container: container,
+	}
+
+	return result
+}
@@ -0,0 +1,461 @@
+package friends
+
+import (
+	"fmt"
+	"image/color"
+	"regexp"
+	"sync"
+
+	"github.com/YarikRevich/fate-seekers/services/fate-seekers-client/pkg/config"
+	"github.com/YarikRevich/fate-seekers/services/fate-seekers-client/pkg/core/sound"
+	"github.com/YarikRevich/fate-seekers/services/fate-seekers-client/pkg/core/tools/scaler"
+	"github.com/YarikRevich/fate-seekers/services/fate-seekers-client/pkg/core/ui/common"
+	componentscommon "github.com/YarikRevich/fate-seekers/services/fate-seekers-client/pkg/core/ui/component/common"
+	"github.com/YarikRevich/fate-seekers/services/fate-seekers-client/pkg/core/ui/manager/translation"
+	"github.com/YarikRevich/fate-seekers/services/fate-seekers-client/pkg/dto"
+	"github.com/YarikRevich/fate-seekers/services/fate-seekers-client/pkg/loader"
+	"github.com/YarikRevich/fate-seekers/services/fate-seekers-client/pkg/logging"
+	"github.com/ebitenui/ebitenui/image"
+	"github.com/ebitenui/ebitenui/widget"
+	"github.com/hajimehoshi/ebiten/v2/text/v2"
+)
+
+var (
+	// GetInstance retrieves instance of the friends component, performing initial creation if needed.
+	GetInstance = sync.OnceValue[*FriendsComponent](newFriendsComponent)
+)
+
+const (
+	// Describes max amount of symbols, which can be entered to input component.
+	maxInputSymbols = 16
+
+	// Describes display name input format, which is allowed to be entered to display name input component.
+	displayNameInputFormat = `^[a-zA-Z0-9_-]{0,16}$`
+)
+
+// Describes all the colors used for list definition.
+var (
+	selectedListColor = color.NRGBA{183, 228, 202, 255}
+	focusedListColor  = color.NRGBA{R: 170, G: 170, B: 180, A: 255}
+	disabledListColor = color.NRGBA{100, 100, 100, 255}
+)
+
+// FriendsComponent represents component, which contains friends list and friend requests form.
+type FriendsComponent struct {
+	// Represents display name input widget.
+	displayNameInput *widget.TextInput
+
+	// Represents friends list widget.
+	list *widget.List
+
+	// Represents accept action button widget.
+	acceptActionButton *widget.Button
+
+	// Represents remove action button widget.
+	removeActionButton *widget.Button
+
+	// Represents currently selected friends list entry.
+	friendEntry dto.FriendsListEntry
+
+	// Represents add callback.
+	addCallback func(displayName string)
+
+	// Represents accept callback.
+	acceptCallback func(friendshipID int64)
+
+	// Represents remove callback.
+	removeCallback func(friendshipID int64)
+
+	// Represents back callback.
+	backCallback func()
+
+	// Represents container widget.
+	container *widget.Container
+}
+
+// CleanInputs cleans all the inputs in the container.
+func (fc *FriendsComponent) CleanInputs() {
+	fc.displayNameInput.SetText("")
+}
+
+// SetListsEntries sets lists entries to the list widget.
+func (fc *FriendsComponent) SetListsEntries(value []interface{}) {
+	fc.list.SetEntries(value)
+}
+
+// SetAddCallback modifies add callback in the container.
+func (fc *FriendsComponent) SetAddCallback(callback func(displayName string)) {
+	fc.addCallback = callback
+}
+
+// SetAcceptCallback modifies accept callback in the container.
+func (fc *FriendsComponent) SetAcceptCallback(callback func(friendshipID int64)) {
+	fc.acceptCallback = callback
+}
+
+// SetRemoveCallback modifies remove callback in the container.
+func (fc *FriendsComponent) SetRemoveCallback(callback func(friendshipID int64)) {
+	fc.removeCallback = callback
+}
+
+// SetBackCallback modifies back callback in the container.
+func (fc *FriendsComponent) SetBackCallback(callback func()) {
+	fc.backCallback = callback
+}
+
+// ResetActionButtons resets action buttons, which depend on the selected list entry.
+func (fc *FriendsComponent) ResetActionButtons() {
+	fc.acceptActionButton.GetWidget().Disabled = true
+	fc.removeActionButton.GetWidget().Disabled = true
+}
+
+// GetContainer retrieves container widget.
+func (fc *FriendsComponent) GetContainer() *widget.Container {
+	return fc.container
+}
+
+// newFriendsComponent creates new friends component.
+func newFriendsComponent() *FriendsComponent {
+	var result *FriendsComponent
+
+	container := widget.NewContainer(
+		widget.ContainerOpts.WidgetOpts(
+			widget.WidgetOpts.MinSize(
+				scaler.GetPercentageOf(config.GetWorldWidth(), 40),
+				scaler.GetPercentageOf(config.GetWorldHeight(), 30)),
+			widget.WidgetOpts.TrackHover(false),
+			widget.WidgetOpts.LayoutData(widget.AnchorLayoutData{
+				Padding: widget.Insets{
+					Left: scaler.GetPercentageOf(config.GetWorldWidth(), 9),
+				},
+				VerticalPosition:  widget.AnchorLayoutPositionCenter,
+				StretchHorizontal: false,
+				StretchVertical:   false,
+			})),
+		widget.ContainerOpts.BackgroundImage(common.GetImageAsNineSlice(loader.PanelIdlePanel, 10, 10)),
+		widget.ContainerOpts.Layout(widget.NewRowLayout(
+			widget.RowLayoutOpts.Direction(widget.DirectionVertical),
+			widget.RowLayoutOpts.Padding(widget.Insets{
+				Left:   30,
+				Right:  30,
+				Top:    30,
+				Bottom: 30,
+			}),
+		)))
+
+	generalFont := &text.GoTextFace{
+		Source: loader.GetInstance().GetFont(loader.KyivRegularFont),
+		Size:   20,
+	}
+
+	container.AddChild(widget.NewText(
+		widget.TextOpts.WidgetOpts(widget.WidgetOpts.LayoutData(widget.RowLayoutData{
+			Stretch: true,
+		})),
+		widget.TextOpts.Text(
+			translation.GetInstance().GetTranslation("client.friends.title"),
+			generalFont,
+			color.White)))
+
+	components := widget.NewContainer(
+		widget.ContainerOpts.WidgetOpts(widget.WidgetOpts.LayoutData(widget.RowLayoutData{
+			Stretch: true,
+		})),
+		widget.ContainerOpts.Layout(widget.NewGridLayout(
+			widget.GridLayoutOpts.Columns(2),
+			widget.GridLayoutOpts.Stretch([]bool{true, true}, nil),
+			widget.GridLayoutOpts.Spacing(
+				10, scaler.GetPercentageOf(config.GetWorldHeight(), 5)),
+			widget.GridLayoutOpts.Padding(widget.Insets{
+				Top: scaler.GetPercentageOf(config.GetWorldHeight(), 6),
+			}))))
+
+	components.AddChild(widget.NewText(
+		widget.TextOpts.WidgetOpts(widget.WidgetOpts.LayoutData(widget.RowLayoutData{
+			Stretch: true,
+		})),
+		widget.TextOpts.Text(
+			translation.GetInstance().GetTranslation("client.friends.display-name"),
+			generalFont,
+			color.White)))
+
+	var displayNameInput *widget.TextInput
+
+	displayNameInput = widget.NewTextInput(
+		widget.TextInputOpts.WidgetOpts(
+			widget.WidgetOpts.MinSize(
+				scaler.GetPercentageOf(config.GetWorldWidth(), 20), 0),
+			widget.WidgetOpts.LayoutData(widget.AnchorLayoutData{
+				VerticalPosition:   widget.AnchorLayoutPositionCenter,
+				HorizontalPosition: widget.AnchorLayoutPositionCenter,
+				StretchHorizontal:  false,
+				StretchVertical:    false,
+			})),
+		widget.TextInputOpts.Image(&widget.TextInputImage{
+			Idle:     image.NewNineSlice(loader.GetInstance().GetStatic(loader.TextInputIdle), [3]int{9, 14, 6}, [3]int{9, 14, 6}),
+			Disabled: image.NewNineSlice(loader.GetInstance().GetStatic(loader.TextInputIdle), [3]int{9, 14, 6}, [3]int{9, 14, 6}),
+		}),
+		widget.TextInputOpts.Color(&widget.TextInputColor{
+			Idle:          color.White,
+			Disabled:      color.White,
+			Caret:         color.White,
+			DisabledCaret: color.White,
+		}),
+		widget.TextInputOpts.Padding(widget.Insets{
+			Left:   13,
+			Right:  13,
+			Top:    13,
+			Bottom: 13,
+		}),
+		widget.TextInputOpts.Face(&text.GoTextFace{
+			Source: loader.GetInstance().GetFont(loader.KyivRegularFont),
+			Size:   20,
+		}),
+		widget.TextInputOpts.CaretOpts(
+			widget.CaretOpts.Size(generalFont, 4),
+		),
+		widget.TextInputOpts.AllowDuplicateSubmit(false),
+		widget.TextInputOpts.Validation(func(newInputTextRaw string) (bool, *string) {
+			newInputText := newInputTextRaw
+
+			parsedNewInputText := newInputText[len(displayNameInput.GetText()):]
+
+			if len(parsedNewInputText) > 1 {
+				newInputText = displayNameInput.GetText() + parsedNewInputText[:1]
+			} else if len(parsedNewInputText) == 0 {
+				return false, &newInputText
+			}
+
+			if len(newInputText) > maxInputSymbols {
+				replacement := displayNameInput.GetText()
+
+				return false, &replacement
+			}
+
+			matched, err := regexp.MatchString(displayNameInputFormat, newInputText)
+			if err != nil {
+				logging.GetInstance().Fatal(err.Error())
+			}
+
+			if !matched {
+				replacement := displayNameInput.GetText()
+
+				return false, &replacement
+			}
+
+			return false, &newInputText
+		}))
+
+	components.AddChild(displayNameInput)
+
+	container.AddChild(components)
+
+	listsContainer := widget.NewContainer(
+		widget.ContainerOpts.WidgetOpts(
+			widget.WidgetOpts.LayoutData(widget.RowLayoutData{
+				Stretch:  true,
+				Position: widget.RowLayoutPositionCenter,
+			})),
+		widget.ContainerOpts.Layout(widget.NewRowLayout(
+			widget.RowLayoutOpts.Direction(widget.DirectionVertical),
+			widget.RowLayoutOpts.Padding(widget.Insets{
+				Top: 40,
+			}),
+		)))
+
+	listsContainer.AddChild(widget.NewText(
+		widget.TextOpts.WidgetOpts(widget.WidgetOpts.LayoutData(widget.RowLayoutData{
+			Stretch: true,
+		})),
+		widget.TextOpts.Insets(widget.Insets{
+			Bottom: 20,
+		}),
+		widget.TextOpts.Text(
+			translation.GetInstance().GetTranslation("client.friends.list"),
+			generalFont,
+			color.White)))
+
+	list := widget.NewList(
+		widget.ListOpts.ContainerOpts(
+			widget.ContainerOpts.WidgetOpts(
+				widget.WidgetOpts.MinSize(
+					scaler.GetPercentageOf(config.GetWorldWidth(), 40),
+					scaler.GetPercentageOf(config.GetWorldHeight(), 30),
+				),
+				widget.WidgetOpts.LayoutData(widget.RowLayoutData{
+					MaxWidth:  scaler.GetPercentageOf(config.GetWorldWidth(), 40),
+					MaxHeight: scaler.GetPercentageOf(config.GetWorldHeight(), 30),
+					Position:  widget.RowLayoutPositionCenter,
+				}))),
+		widget.ListOpts.ScrollContainerOpts(widget.ScrollContainerOpts.Image(&widget.ScrollContainerImage{
+			Idle:     image.NewNineSlice(loader.GetInstance().GetStatic(loader.ListIdle), [3]int{25, 12, 22}, [3]int{25, 12, 25}),
+			Disabled: image.NewNineSlice(loader.GetInstance().GetStatic(loader.ListDisabled), [3]int{25, 12, 22}, [3]int{25, 12, 25}),
+			Mask:     image.NewNineSlice(loader.GetInstance().GetStatic(loader.ListMask), [3]int{26, 10, 23}, [3]int{26, 10, 26}),
+		})),
+		widget.ListOpts.SliderOpts(
+			widget.SliderOpts.Images(
+				&widget.SliderTrackImage{
+					Idle:     image.NewNineSlice(loader.GetInstance().GetStatic(loader.ListTrackIdle), [3]int{5, 0, 0}, [3]int{25, 12, 25}),
+					Hover:    image.NewNineSlice(loader.GetInstance().GetStatic(loader.ListTrackIdle), [3]int{5, 0, 0}, [3]int{25, 12, 25}),
+					Disabled: image.NewNineSlice(loader.GetInstance().GetStatic(loader.ListTrackDisabled), [3]int{0, 5, 0}, [3]int{25, 12, 25}),
+				},
+				&widget.ButtonImage{
+					Idle:     image.NewNineSliceSimple(loader.GetInstance().GetStatic(loader.SliderHandleIdle), 0, 5),
+					Hover:    image.NewNineSliceSimple(loader.GetInstance().GetStatic(loader.SliderHandleHover), 0, 5),
+					Pressed:  image.NewNineSliceSimple(loader.GetInstance().GetStatic(loader.SliderHandleHover), 0, 5),
+					Disabled: image.NewNineSliceSimple(loader.GetInstance().GetStatic(loader.SliderHandleIdle), 0, 5),
+				}),
+			widget.SliderOpts.MinHandleSize(8),
+			widget.SliderOpts.TrackPadding(widget.Insets{Bottom: 20}),
+		),
+		widget.ListOpts.AllowReselect(),
+		widget.ListOpts.HideHorizontalSlider(),
+		widget.ListOpts.Entries([]interface{}{}),
+		widget.ListOpts.EntryLabelFunc(func(e interface{}) string {
+			return getFriendLabel(e.(dto.FriendsListEntry))
+		}),
+		widget.ListOpts.EntrySelectedHandler(func(args *widget.ListEntrySelectedEventArgs) {
+			friendEntry := args.Entry.(dto.FriendsListEntry)
+
+			result.removeActionButton.GetWidget().Disabled = false
+			result.acceptActionButton.GetWidget().Disabled = friendEntry.Accepted || !friendEntry.Incoming
+
+			result.friendEntry = friendEntry
+		}),
+		widget.ListOpts.EntryFontFace(generalFont),
+		widget.ListOpts.EntryColor(&widget.ListEntryColor{
+			Selected:                   componentscommon.ButtonTextColor,
+			Unselected:                 selectedListColor,
+			SelectedBackground:         selectedListColor,
+			SelectedFocusedBackground:  selectedListColor,
+			FocusedBackground:          focusedListColor,
+			DisabledUnselected:         disabledListColor,
+			DisabledSelected:           disabledListColor,
+			DisabledSelectedBackground: disabledListColor,
+		}),
+		widget.ListOpts.EntryTextPadding(widget.Insets{
+			Top:    15,
+			Left:   40,
+			Right:  40,
+			Bottom: 15,
+		}),
+	)
+
+	listsContainer.AddChild(list)
+
+	container.AddChild(listsContainer)
+
+	buttonsContainer := widget.NewContainer(
+		widget.ContainerOpts.WidgetOpts(
+			widget.WidgetOpts.MinSize(
+				container.GetWidget().MinWidth,
+				container.GetWidget().MinHeight),
+			widget.WidgetOpts.LayoutData(widget.AnchorLayoutData{
+				VerticalPosition:   widget.AnchorLayoutPositionEnd,
+				HorizontalPosition: widget.AnchorLayoutPositionEnd,
+				StretchHorizontal:  false,
+				StretchVertical:    false,
+			}),
+		),
+		widget.ContainerOpts.Layout(widget.NewRowLayout(
+			widget.RowLayoutOpts.Direction(widget.DirectionHorizontal),
+			widget.RowLayoutOpts.Spacing(13),
+			widget.RowLayoutOpts.Padding(widget.Insets{
+				Left: scaler.GetPercentageOf(config.GetWorldWidth(), 5),
+			}),
+		)),
+	)
+
+	buttonIdleIcon := common.GetImageAsNineSlice(loader.ButtonIdleButton, 16, 15)
+	buttonHoverIcon := common.GetImageAsNineSlice(loader.ButtonHoverButton, 16, 15)
+
+	newButton := func(label string, handler func()) *widget.Button {
+		return widget.NewButton(
+			widget.ButtonOpts.Image(&widget.ButtonImage{
+				Idle:         buttonIdleIcon,
+				Hover:        buttonHoverIcon,
+				Pressed:      buttonIdleIcon,
+				PressedHover: buttonIdleIcon,
+				Disabled:     buttonIdleIcon,
+			}),
+			widget.ButtonOpts.Text(
+				label,
+				generalFont,
+				&widget.ButtonTextColor{Idle: componentscommon.ButtonTextColor}),
+			widget.ButtonOpts.WidgetOpts(
+				widget.WidgetOpts.LayoutData(widget.RowLayoutData{
+					Position: widget.RowLayoutPositionEnd,
+				})),
+			widget.ButtonOpts.TextPadding(widget.Insets{
+				Left:   30,
+				Right:  30,
+				Top:    20,
+				Bottom: 20,
+			}),
+			widget.ButtonOpts.PressedHandler(func(args *widget.ButtonPressedEventArgs) {
+				sound.GetInstance().GetSoundUIFxManager().PushWithHandbrake(loader.ButtonFXSound)
+
+				handler()
+			}),
+		)
+	}
+
+	buttonsContainer.AddChild(newButton(
+		translation.GetInstance().GetTranslation("shared.settings.close"),
+		func() {
+			result.backCallback()
+		}))
+
+	removeActionButton := newButton(
+		translation.GetInstance().GetTranslation("client.friends.remove"),
+		func() {
+			result.removeCallback(result.friendEntry.FriendshipID)
+		})
+
+	removeActionButton.GetWidget().Disabled = true
+
+	buttonsContainer.AddChild(removeActionButton)
+
+	acceptActionButton := newButton(
+		translation.GetInstance().GetTranslation("client.friends.accept"),
+		func() {
+			result.acceptCallback(result.friendEntry.FriendshipID)
+		})
+
+	acceptActionButton.GetWidget().Disabled = true
+
+	buttonsContainer.AddChild(acceptActionButton)
+
+	buttonsContainer.AddChild(newButton(
+		translation.GetInstance().GetTranslation("client.friends.add"),
+		func() {
+			result.addCallback(displayNameInput.GetText())
+		}))
+
+	container.AddChild(buttonsContainer)
+
+	result = &FriendsComponent{
+		displayNameInput:   displayNameInput,
+		list:               list,
+		acceptActionButton: acceptActionButton,
+		removeActionButton: removeActionButton,
+		container:          container,
+	}
+
+	return result
+}
+
+// getFriendLabel retrieves label of the given friends list entry, marking pending friend requests.
+func getFriendLabel(entry dto.FriendsListEntry) string {
+	if entry.Accepted {
+		return entry.DisplayName
+	}
+
+	if entry.Incoming {
+		return fmt.Sprintf(
+			"%s (%s)", entry.DisplayName, translation.GetInstance().GetTranslation("client.friends.incoming"))
+	}
+
+	return fmt.Sprintf(
+		"%s (%s)", entry.DisplayName, translation.GetInstance().GetTranslation("client.friends.pending"))
+}
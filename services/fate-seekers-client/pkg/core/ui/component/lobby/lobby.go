@@ -11,6 +11,7 @@ import (
 	"github.com/YarikRevich/fate-seekers/services/fate-seekers-client/pkg/core/ui/common"
 	componentscommon "github.com/YarikRevich/fate-seekers/services/fate-seekers-client/pkg/core/ui/component/common"
 	"github.com/YarikRevich/fate-seekers/services/fate-seekers-client/pkg/core/ui/manager/translation"
+	"github.com/YarikRevich/fate-seekers/services/fate-seekers-client/pkg/dto"
 	"github.com/YarikRevich/fate-seekers/services/fate-seekers-client/pkg/loader"
 	"github.com/ebitenui/ebitenui/image"
 	"github.com/ebitenui/ebitenui/widget"
@@ -40,9 +41,18 @@ type LobbyComponent struct {
 	// Represents start action button widget.
 	startActionButton *widget.Button
 
+	// Represents ready action button widget.
+	readyActionButton *widget.Button
+
+	// Represents ready state of the lobby.
+	ready bool
+
 	// Represents start callback.
 	startCallback func()
 
+	// Represents ready callback.
+	readyCallback func()
+
 	// Represents back callback.
 	backCallback func()
 
@@ -50,12 +60,9 @@ type LobbyComponent struct {
 	container *widget.Container
 }
 
-// SetSelectionBySkin sets label by the provided skin for selection text widget.
-func (lc *LobbyComponent) SetSelectionBySkin(value uint64) {
-	lc.selection.Label = translation.
-		GetInstance().
-		GetTranslation(
-			fmt.Sprintf("client.skin.%d.name", value))
+// SetSelection sets label by the provided display name and skin for selection text widget.
+func (lc *LobbyComponent) SetSelection(displayName string, skin uint64) {
+	lc.selection.Label = getLobbySetLabel(displayName, skin)
 }
 
 // CleanSelection cleans selection text widget.
@@ -78,6 +85,11 @@ func (lc *LobbyComponent) SetStartCallback(callback func()) {
 	lc.startCallback = callback
 }
 
+// SetReadyCallback modifies ready callback in the container.
+func (lc *LobbyComponent) SetReadyCallback(callback func()) {
+	lc.readyCallback = callback
+}
+
 // SetBackCallback modifies back callback in the container.
 func (lc *LobbyComponent) SetBackCallback(callback func()) {
 	lc.backCallback = callback
@@ -93,6 +105,22 @@ func (lc *LobbyComponent) HideStartButton() {
 	lc.startActionButton.GetWidget().Visibility = widget.Visibility_Hide
 }
 
+// SetReady sets ready state of the lobby, modifying label of the ready button widget.
+func (lc *LobbyComponent) SetReady(value bool) {
+	lc.ready = value
+
+	if value {
+		lc.readyActionButton.Text().Label = translation.GetInstance().GetTranslation("client.lobby.unready")
+	} else {
+		lc.readyActionButton.Text().Label = translation.GetInstance().GetTranslation("client.lobby.ready")
+	}
+}
+
+// IsReady checks if the lobby is marked as ready.
+func (lc *LobbyComponent) IsReady() bool {
+	return lc.ready
+}
+
 // GetContainer retrieves container widget.
 func (lc *LobbyComponent) GetContainer() *widget.Container {
 	return lc.container
@@ -257,10 +285,9 @@ func newLobbyComponent() *LobbyComponent {
 		widget.ListOpts.HideHorizontalSlider(),
 		widget.ListOpts.Entries([]interface{}{}),
 		widget.ListOpts.EntryLabelFunc(func(e interface{}) string {
-			return translation.
-				GetInstance().
-				GetTranslation(
-					fmt.Sprintf("client.skin.%d.name", e.(uint64)))
+			entry := e.(dto.LobbySetListEntry)
+
+			return getLobbySetLabel(entry.DisplayName, entry.Skin)
 		}),
 		widget.ListOpts.EntryFontFace(generalFont),
 		widget.ListOpts.EntryColor(&widget.ListEntryColor{
@@ -407,6 +434,38 @@ func newLobbyComponent() *LobbyComponent {
 		}),
 	)
 
+	readyActionButton := widget.NewButton(
+		widget.ButtonOpts.Image(&widget.ButtonImage{
+			Idle:         buttonIdleIcon,
+			Hover:        buttonHoverIcon,
+			Pressed:      buttonIdleIcon,
+			PressedHover: buttonIdleIcon,
+			Disabled:     buttonIdleIcon,
+		}),
+		widget.ButtonOpts.Text(
+			translation.GetInstance().GetTranslation("client.lobby.ready"),
+			generalFont,
+			&widget.ButtonTextColor{Idle: componentscommon.ButtonTextColor}),
+		widget.ButtonOpts.WidgetOpts(
+			widget.WidgetOpts.LayoutData(widget.RowLayoutData{
+				Position: widget.RowLayoutPositionEnd,
+			}),
+		),
+		widget.ButtonOpts.TextPadding(widget.Insets{
+			Left:   30,
+			Right:  30,
+			Top:    20,
+			Bottom: 20,
+		}),
+		widget.ButtonOpts.PressedHandler(func(args *widget.ButtonPressedEventArgs) {
+			sound.GetInstance().GetSoundUIFxManager().PushWithHandbrake(loader.ButtonFXSound)
+
+			result.readyCallback()
+		}),
+	)
+
+	actionButtonContainer.AddChild(readyActionButton)
+
 	actionButtonContainer.AddChild(startActionButton)
 
 	buttonsContainer.AddChild(actionButtonContainer)
@@ -417,8 +476,24 @@ func newLobbyComponent() *LobbyComponent {
 		selection:         selection,
 		list:              list,
 		startActionButton: startActionButton,
+		readyActionButton: readyActionButton,
 		container:         container,
 	}
 
 	return result
 }
+
+// getLobbySetLabel retrieves label of the lobby set unit with the provided display name and skin,
+// skin label is used alone, when display name has not been selected yet.
+func getLobbySetLabel(displayName string, skin uint64) string {
+	skinLabel := translation.
+		GetInstance().
+		GetTranslation(
+			fmt.Sprintf("client.skin.%d.name", skin))
+
+	if displayName == "" {
+		return skinLabel
+	}
+
+	return fmt.Sprintf("%s (%s)", displayName, skinLabel)
+}
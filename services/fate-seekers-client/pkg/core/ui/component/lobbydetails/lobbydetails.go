@@ -1 +1,603 @@
 package lobbydetails
+
+import (
+	"fmt"
+	"image/color"
+	"sync"
+
+	"github.com/YarikRevich/fate-seekers/services/fate-seekers-client/pkg/config"
+	"github.com/YarikRevich/fate-seekers/services/fate-seekers-client/pkg/core/sound"
+	"github.com/YarikRevich/fate-seekers/services/fate-seekers-client/pkg/core/tools/scaler"
+	"github.com/YarikRevich/fate-seekers/services/fate-seekers-client/pkg/core/ui/common"
+	componentscommon "github.com/YarikRevich/fate-seekers/services/fate-seekers-client/pkg/core/ui/component/common"
+	"github.com/YarikRevich/fate-seekers/services/fate-seekers-client/pkg/core/ui/manager/translation"
+	"github.com/YarikRevich/fate-seekers/services/fate-seekers-client/pkg/dto"
+	"github.com/YarikRevich/fate-seekers/services/fate-seekers-client/pkg/loader"
+	"github.com/ebitenui/ebitenui/image"
+	"github.com/ebitenui/ebitenui/widget"
+	"github.com/hajimehoshi/ebiten/v2/text/v2"
+)
+
+var (
+	// GetInstance retrieves instance of the lobby details component, performing initial creation if needed.
+	GetInstance = sync.OnceValue[*LobbyDetailsComponent](newLobbyDetailsComponent)
+)
+
+// Describes all the session start countdowns in seconds, which can be selected by the host. Zero
+// countdown means that session is started only when all the players are ready.
+const (
+	countdownOff    = uint64(0)
+	countdownShort  = uint64(10)
+	countdownMedium = uint64(30)
+	countdownLong   = uint64(60)
+)
+
+// Describes all the teams, which can be selected by the player. Zero team means that player is not
+// assigned to any team, session is played in free-for-all mode, when none of the players are assigned.
+const (
+	teamNone   = uint64(0)
+	teamFirst  = uint64(1)
+	teamSecond = uint64(2)
+)
+
+// Describes all the colors used for list combo definition.
+var (
+	selectedListColor = color.NRGBA{183, 228, 202, 255}
+	focusedListColor  = color.NRGBA{R: 170, G: 170, B: 180, A: 255}
+	disabledListColor = color.NRGBA{100, 100, 100, 255}
+)
+
+// LobbyDetailsComponent represents component, which contains lobby ready check details.
+type LobbyDetailsComponent struct {
+	// Represents host name text widget.
+	hostNameText *widget.Text
+
+	// Represents ready players text widget.
+	readyPlayersText *widget.Text
+
+	// Represents session start countdown text widget.
+	countdownText *widget.Text
+
+	// Represents session start countdown selection container widget, which is shown only to the host.
+	countdownSelectionContainer *widget.Container
+
+	// Represents session start countdown combo button widget.
+	countdownComboButton *widget.ListComboButton
+
+	// Represents team players text widget.
+	teamPlayersText *widget.Text
+
+	// Represents team selection container widget, which is hidden for spectators.
+	teamSelectionContainer *widget.Container
+
+	// Represents team combo button widget.
+	teamComboButton *widget.ListComboButton
+
+	// Represents friends list widget, which is used to select friend to invite.
+	friendsList *widget.List
+
+	// Represents friendship id of the selected friend to invite.
+	friendshipID int64
+
+	// Represents team of the player retrieved from the server.
+	team uint64
+
+	// Represents team selection callback.
+	teamCallback func(team uint64)
+
+	// Represents teams balance callback.
+	balanceCallback func()
+
+	// Represents friend invitation callback.
+	inviteCallback func(friendshipID int64)
+
+	// Represents container widget.
+	container *widget.Container
+}
+
+// SetHostName sets display name of the lobby host, which is replaced with placeholder, when it has not been selected yet.
+func (ldc *LobbyDetailsComponent) SetHostName(value string) {
+	if value == "" {
+		ldc.hostNameText.Label = "-"
+	} else {
+		ldc.hostNameText.Label = value
+	}
+}
+
+// SetReadyPlayers sets amount of ready players out of all the players in the lobby.
+func (ldc *LobbyDetailsComponent) SetReadyPlayers(ready, total int) {
+	ldc.readyPlayersText.Label = fmt.Sprintf("%d / %d", ready, total)
+}
+
+// SetCountdown sets remaining seconds of session start countdown, which is nil when countdown is not started.
+func (ldc *LobbyDetailsComponent) SetCountdown(value *uint64) {
+	if value == nil {
+		ldc.countdownText.Label = "-"
+
+		return
+	}
+
+	ldc.countdownText.Label = fmt.Sprintf("%ds", *value)
+}
+
+// GetCountdown retrieves selected session start countdown in seconds.
+func (ldc *LobbyDetailsComponent) GetCountdown() uint64 {
+	return ldc.countdownComboButton.SelectedEntry().(uint64)
+}
+
+// ShowCountdownSelection shows session start countdown selection and teams balance widgets.
+func (ldc *LobbyDetailsComponent) ShowCountdownSelection() {
+	ldc.countdownSelectionContainer.GetWidget().Visibility = widget.Visibility_Show
+}
+
+// HideCountdownSelection hides session start countdown selection and teams balance widgets.
+func (ldc *LobbyDetailsComponent) HideCountdownSelection() {
+	ldc.countdownSelectionContainer.GetWidget().Visibility = widget.Visibility_Hide
+}
+
+// SetTeamPlayers sets amount of players in each of the teams.
+func (ldc *LobbyDetailsComponent) SetTeamPlayers(first, second int) {
+	ldc.teamPlayersText.Label = fmt.Sprintf("%d / %d", first, second)
+}
+
+// SetTeam sets team of the player retrieved from the server, without triggering team selection callback.
+func (ldc *LobbyDetailsComponent) SetTeam(value uint64) {
+	ldc.team = value
+
+	ldc.teamComboButton.SetSelectedEntry(value)
+}
+
+// SetTeamCallback modifies team selection callback in the container.
+func (ldc *LobbyDetailsComponent) SetTeamCallback(callback func(team uint64)) {
+	ldc.teamCallback = callback
+}
+
+// SetBalanceCallback modifies teams balance callback in the container.
+func (ldc *LobbyDetailsComponent) SetBalanceCallback(callback func()) {
+	ldc.balanceCallback = callback
+}
+
+// SetInviteCallback modifies friend invitation callback in the container.
+func (ldc *LobbyDetailsComponent) SetInviteCallback(callback func(friendshipID int64)) {
+	ldc.inviteCallback = callback
+}
+
+// SetFriends sets friends, who can be invited to the session, to the friends list widget.
+func (ldc *LobbyDetailsComponent) SetFriends(value []interface{}) {
+	ldc.friendsList.SetEntries(value)
+
+	ldc.friendshipID = 0
+}
+
+// ShowTeamSelection shows team selection widgets.
+func (ldc *LobbyDetailsComponent) ShowTeamSelection() {
+	ldc.teamSelectionContainer.GetWidget().Visibility = widget.Visibility_Show
+}
+
+// HideTeamSelection hides team selection widgets.
+func (ldc *LobbyDetailsComponent) HideTeamSelection() {
+	ldc.teamSelectionContainer.GetWidget().Visibility = widget.Visibility_Hide
+}
+
+// Clean cleans all the lobby details widgets.
+func (ldc *LobbyDetailsComponent) Clean() {
+	ldc.hostNameText.Label = "-"
+	ldc.readyPlayersText.Label = "-"
+	ldc.countdownText.Label = "-"
+	ldc.teamPlayersText.Label = "-"
+
+	ldc.countdownComboButton.SetSelectedEntry(countdownOff)
+
+	ldc.SetTeam(teamNone)
+
+	ldc.SetFriends(nil)
+
+	ldc.HideCountdownSelection()
+}
+
+// GetContainer retrieves container widget.
+func (ldc *LobbyDetailsComponent) GetContainer() *widget.Container {
+	return ldc.container
+}
+
+// newLobbyDetailsComponent creates new lobby details component.
+func newLobbyDetailsComponent() *LobbyDetailsComponent {
+	var result *LobbyDetailsComponent
+
+	container := widget.NewContainer(
+		widget.ContainerOpts.WidgetOpts(
+			widget.WidgetOpts.MinSize(
+				scaler.GetPercentageOf(config.GetWorldWidth(), 20),
+				scaler.GetPercentageOf(config.GetWorldHeight(), 20)),
+			widget.WidgetOpts.TrackHover(false),
+			widget.WidgetOpts.LayoutData(widget.AnchorLayoutData{
+				Padding: widget.Insets{
+					Right: scaler.GetPercentageOf(config.GetWorldWidth(), 6),
+				},
+				HorizontalPosition: widget.AnchorLayoutPositionEnd,
+				VerticalPosition:   widget.AnchorLayoutPositionCenter,
+				StretchHorizontal:  false,
+				StretchVertical:    false,
+			})),
+		widget.ContainerOpts.BackgroundImage(common.GetImageAsNineSlice(loader.PanelIdlePanel, 10, 10)),
+		widget.ContainerOpts.Layout(widget.NewRowLayout(
+			widget.RowLayoutOpts.Direction(widget.DirectionVertical),
+			widget.RowLayoutOpts.Padding(widget.Insets{
+				Left:   30,
+				Right:  30,
+				Top:    30,
+				Bottom: 30,
+			}),
+		)))
+
+	generalFont := &text.GoTextFace{
+		Source: loader.GetInstance().GetFont(loader.KyivRegularFont),
+		Size:   20,
+	}
+
+	container.AddChild(widget.NewText(
+		widget.TextOpts.WidgetOpts(widget.WidgetOpts.LayoutData(widget.RowLayoutData{
+			Stretch: true,
+		})),
+		widget.TextOpts.Text(
+			translation.GetInstance().GetTranslation("client.lobbydetails.title"),
+			generalFont,
+			color.White)))
+
+	newComponents := func() *widget.Container {
+		return widget.NewContainer(
+			widget.ContainerOpts.WidgetOpts(widget.WidgetOpts.LayoutData(widget.RowLayoutData{
+				Stretch: true,
+			})),
+			widget.ContainerOpts.Layout(widget.NewGridLayout(
+				widget.GridLayoutOpts.Columns(2),
+				widget.GridLayoutOpts.Stretch([]bool{true, true}, nil),
+				widget.GridLayoutOpts.Spacing(
+					scaler.GetPercentageOf(config.GetWorldWidth(), 3),
+					scaler.GetPercentageOf(config.GetWorldHeight(), 3)),
+				widget.GridLayoutOpts.Padding(widget.Insets{
+					Top: scaler.GetPercentageOf(config.GetWorldHeight(), 4),
+				}))))
+	}
+
+	components := newComponents()
+
+	components.AddChild(widget.NewText(
+		widget.TextOpts.Text(
+			translation.GetInstance().GetTranslation("client.lobbydetails.host-name"),
+			generalFont,
+			color.White)))
+
+	hostNameText := widget.NewText(
+		widget.TextOpts.Text("-", generalFont, color.White))
+
+	components.AddChild(hostNameText)
+
+	components.AddChild(widget.NewText(
+		widget.TextOpts.Text(
+			translation.GetInstance().GetTranslation("client.lobbydetails.ready-players"),
+			generalFont,
+			color.White)))
+
+	readyPlayersText := widget.NewText(
+		widget.TextOpts.Text("-", generalFont, color.White))
+
+	components.AddChild(readyPlayersText)
+
+	components.AddChild(widget.NewText(
+		widget.TextOpts.Text(
+			translation.GetInstance().GetTranslation("client.lobbydetails.countdown"),
+			generalFont,
+			color.White)))
+
+	countdownText := widget.NewText(
+		widget.TextOpts.Text("-", generalFont, color.White))
+
+	components.AddChild(countdownText)
+
+	components.AddChild(widget.NewText(
+		widget.TextOpts.Text(
+			translation.GetInstance().GetTranslation("client.lobbydetails.team-players"),
+			generalFont,
+			color.White)))
+
+	teamPlayersText := widget.NewText(
+		widget.TextOpts.Text("-", generalFont, color.White))
+
+	components.AddChild(teamPlayersText)
+
+	container.AddChild(components)
+
+	countdownSelectionContainer := newComponents()
+
+	countdownSelectionContainer.AddChild(widget.NewText(
+		widget.TextOpts.Text(
+			translation.GetInstance().GetTranslation("client.lobbydetails.countdown-selection"),
+			generalFont,
+			color.White)))
+
+	countdownLabel := func(e any) string {
+		if e.(uint64) == countdownOff {
+			return translation.GetInstance().GetTranslation("client.lobbydetails.countdown-off")
+		}
+
+		return fmt.Sprintf("%ds", e.(uint64))
+	}
+
+	newComboButton := func(entries []interface{}, label func(e any) string, opts ...widget.ListComboButtonOpt) *widget.ListComboButton {
+		return widget.NewListComboButton(append([]widget.ListComboButtonOpt{
+			widget.ListComboButtonOpts.SelectComboButtonOpts(
+				widget.SelectComboButtonOpts.ComboButtonOpts(
+					widget.ComboButtonOpts.ButtonOpts(
+						widget.ButtonOpts.Image(&widget.ButtonImage{
+							Idle:         common.GetImageAsNineSlice(loader.ComboIdleButton, 12, -10),
+							Hover:        common.GetImageAsNineSlice(loader.ComboIdleButton, 12, -10),
+							Pressed:      common.GetImageAsNineSlice(loader.ComboIdleButton, 12, -10),
+							PressedHover: common.GetImageAsNineSlice(loader.ComboIdleButton, 12, -10),
+						}),
+					),
+				),
+			),
+			widget.ListComboButtonOpts.Text(generalFont, &widget.ButtonImageImage{
+				Idle:     loader.GetInstance().GetStatic(loader.ComboArrayIdleButton),
+				Disabled: loader.GetInstance().GetStatic(loader.ComboArrayIdleButton),
+			}, &widget.ButtonTextColor{
+				Idle:     componentscommon.ButtonTextColor,
+				Disabled: componentscommon.ButtonTextColor,
+				Hover:    componentscommon.ButtonTextColor,
+				Pressed:  componentscommon.ButtonTextColor,
+			}),
+			widget.ListComboButtonOpts.ListOpts(
+				widget.ListOpts.Entries(entries),
+				widget.ListOpts.ScrollContainerOpts(
+					widget.ScrollContainerOpts.Image(&widget.ScrollContainerImage{
+						Idle:     image.NewNineSlice(loader.GetInstance().GetStatic(loader.ListIdle), [3]int{25, 12, 22}, [3]int{25, 12, 25}),
+						Disabled: image.NewNineSlice(loader.GetInstance().GetStatic(loader.ListDisabled), [3]int{25, 12, 22}, [3]int{25, 12, 25}),
+						Mask:     image.NewNineSlice(loader.GetInstance().GetStatic(loader.ListMask), [3]int{26, 10, 23}, [3]int{26, 10, 26}),
+					}),
+				),
+				widget.ListOpts.SliderOpts(
+					widget.SliderOpts.Images(
+						&widget.SliderTrackImage{
+							Idle:     image.NewNineSlice(loader.GetInstance().GetStatic(loader.ListTrackIdle), [3]int{5, 0, 0}, [3]int{25, 12, 25}),
+							Hover:    image.NewNineSlice(loader.GetInstance().GetStatic(loader.ListTrackIdle), [3]int{5, 0, 0}, [3]int{25, 12, 25}),
+							Disabled: image.NewNineSlice(loader.GetInstance().GetStatic(loader.ListTrackDisabled), [3]int{0, 5, 0}, [3]int{25, 12, 25}),
+						},
+						&widget.ButtonImage{
+							Idle:     image.NewNineSliceSimple(loader.GetInstance().GetStatic(loader.SliderHandleIdle), 0, 5),
+							Hover:    image.NewNineSliceSimple(loader.GetInstance().GetStatic(loader.SliderHandleHover), 0, 5),
+							Pressed:  image.NewNineSliceSimple(loader.GetInstance().GetStatic(loader.SliderHandleHover), 0, 5),
+							Disabled: image.NewNineSliceSimple(loader.GetInstance().GetStatic(loader.SliderHandleIdle), 0, 5),
+						}),
+					widget.SliderOpts.MinHandleSize(4),
+					widget.SliderOpts.TrackPadding(widget.Insets{Bottom: 20})),
+				widget.ListOpts.EntryFontFace(generalFont),
+				widget.ListOpts.EntryColor(&widget.ListEntryColor{
+					Selected:                   componentscommon.ButtonTextColor,
+					Unselected:                 selectedListColor,
+					SelectedBackground:         selectedListColor,
+					SelectedFocusedBackground:  selectedListColor,
+					FocusedBackground:          focusedListColor,
+					DisabledUnselected:         disabledListColor,
+					DisabledSelected:           disabledListColor,
+					DisabledSelectedBackground: disabledListColor,
+				}),
+				widget.ListOpts.EntryTextPadding(widget.Insets{
+					Top:    15,
+					Left:   40,
+					Right:  40,
+					Bottom: 15,
+				}),
+			),
+			widget.ListComboButtonOpts.EntryLabelFunc(label, label),
+		}, opts...)...)
+	}
+
+	countdownComboButton := newComboButton(
+		[]interface{}{
+			countdownOff,
+			countdownShort,
+			countdownMedium,
+			countdownLong,
+		},
+		countdownLabel)
+
+	countdownComboButton.SetSelectedEntry(countdownOff)
+
+	countdownSelectionContainer.AddChild(countdownComboButton)
+
+	countdownSelectionContainer.AddChild(widget.NewText(
+		widget.TextOpts.Text(
+			translation.GetInstance().GetTranslation("client.lobbydetails.balance-selection"),
+			generalFont,
+			color.White)))
+
+	buttonIdleIcon := common.GetImageAsNineSlice(loader.ButtonIdleButton, 16, 15)
+	buttonHoverIcon := common.GetImageAsNineSlice(loader.ButtonHoverButton, 16, 15)
+
+	countdownSelectionContainer.AddChild(widget.NewButton(
+		widget.ButtonOpts.Image(&widget.ButtonImage{
+			Idle:         buttonIdleIcon,
+			Hover:        buttonHoverIcon,
+			Pressed:      buttonIdleIcon,
+			PressedHover: buttonIdleIcon,
+			Disabled:     buttonIdleIcon,
+		}),
+		widget.ButtonOpts.Text(
+			translation.GetInstance().GetTranslation("client.lobbydetails.balance"),
+			generalFont,
+			&widget.ButtonTextColor{Idle: componentscommon.ButtonTextColor}),
+		widget.ButtonOpts.TextPadding(widget.Insets{
+			Left:   30,
+			Right:  30,
+			Top:    15,
+			Bottom: 15,
+		}),
+		widget.ButtonOpts.PressedHandler(func(args *widget.ButtonPressedEventArgs) {
+			sound.GetInstance().GetSoundUIFxManager().PushWithHandbrake(loader.ButtonFXSound)
+
+			result.balanceCallback()
+		}),
+	))
+
+	countdownSelectionContainer.GetWidget().Visibility = widget.Visibility_Hide
+
+	container.AddChild(countdownSelectionContainer)
+
+	teamSelectionContainer := newComponents()
+
+	teamSelectionContainer.AddChild(widget.NewText(
+		widget.TextOpts.Text(
+			translation.GetInstance().GetTranslation("client.lobbydetails.team-selection"),
+			generalFont,
+			color.White)))
+
+	teamLabel := func(e any) string {
+		if e.(uint64) == teamNone {
+			return translation.GetInstance().GetTranslation("client.lobbydetails.team-none")
+		}
+
+		return translation.
+			GetInstance().
+			GetTranslation(
+				fmt.Sprintf("client.lobbydetails.team.%d", e.(uint64)))
+	}
+
+	teamComboButton := newComboButton(
+		[]interface{}{
+			teamNone,
+			teamFirst,
+			teamSecond,
+		},
+		teamLabel,
+		widget.ListComboButtonOpts.EntrySelectedHandler(func(args *widget.ListComboButtonEntrySelectedEventArgs) {
+			if result == nil || args.Entry.(uint64) == result.team {
+				return
+			}
+
+			result.team = args.Entry.(uint64)
+
+			result.teamCallback(result.team)
+		}))
+
+	teamComboButton.SetSelectedEntry(teamNone)
+
+	teamSelectionContainer.AddChild(teamComboButton)
+
+	container.AddChild(teamSelectionContainer)
+
+	inviteSelectionContainer := newComponents()
+
+	inviteSelectionContainer.AddChild(widget.NewText(
+		widget.TextOpts.Text(
+			translation.GetInstance().GetTranslation("client.lobbydetails.invite-selection"),
+			generalFont,
+			color.White)))
+
+	inviteSelectionContainer.AddChild(widget.NewButton(
+		widget.ButtonOpts.Image(&widget.ButtonImage{
+			Idle:         buttonIdleIcon,
+			Hover:        buttonHoverIcon,
+			Pressed:      buttonIdleIcon,
+			PressedHover: buttonIdleIcon,
+			Disabled:     buttonIdleIcon,
+		}),
+		widget.ButtonOpts.Text(
+			translation.GetInstance().GetTranslation("client.lobbydetails.invite"),
+			generalFont,
+			&widget.ButtonTextColor{Idle: componentscommon.ButtonTextColor}),
+		widget.ButtonOpts.TextPadding(widget.Insets{
+			Left:   30,
+			Right:  30,
+			Top:    15,
+			Bottom: 15,
+		}),
+		widget.ButtonOpts.PressedHandler(func(args *widget.ButtonPressedEventArgs) {
+			sound.GetInstance().GetSoundUIFxManager().PushWithHandbrake(loader.ButtonFXSound)
+
+			if result.friendshipID != 0 {
+				result.inviteCallback(result.friendshipID)
+			}
+		}),
+	))
+
+	container.AddChild(inviteSelectionContainer)
+
+	friendsList := widget.NewList(
+		widget.ListOpts.ContainerOpts(
+			widget.ContainerOpts.WidgetOpts(
+				widget.WidgetOpts.MinSize(
+					scaler.GetPercentageOf(config.GetWorldWidth(), 20),
+					scaler.GetPercentageOf(config.GetWorldHeight(), 15),
+				),
+				widget.WidgetOpts.LayoutData(widget.RowLayoutData{
+					MaxWidth:  scaler.GetPercentageOf(config.GetWorldWidth(), 20),
+					MaxHeight: scaler.GetPercentageOf(config.GetWorldHeight(), 15),
+					Stretch:   true,
+				}))),
+		widget.ListOpts.ScrollContainerOpts(widget.ScrollContainerOpts.Image(&widget.ScrollContainerImage{
+			Idle:     image.NewNineSlice(loader.GetInstance().GetStatic(loader.ListIdle), [3]int{25, 12, 22}, [3]int{25, 12, 25}),
+			Disabled: image.NewNineSlice(loader.GetInstance().GetStatic(loader.ListDisabled), [3]int{25, 12, 22}, [3]int{25, 12, 25}),
+			Mask:     image.NewNineSlice(loader.GetInstance().GetStatic(loader.ListMask), [3]int{26, 10, 23}, [3]int{26, 10, 26}),
+		})),
+		widget.ListOpts.SliderOpts(
+			widget.SliderOpts.Images(
+				&widget.SliderTrackImage{
+					Idle:     image.NewNineSlice(loader.GetInstance().GetStatic(loader.ListTrackIdle), [3]int{5, 0, 0}, [3]int{25, 12, 25}),
+					Hover:    image.NewNineSlice(loader.GetInstance().GetStatic(loader.ListTrackIdle), [3]int{5, 0, 0}, [3]int{25, 12, 25}),
+					Disabled: image.NewNineSlice(loader.GetInstance().GetStatic(loader.ListTrackDisabled), [3]int{0, 5, 0}, [3]int{25, 12, 25}),
+				},
+				&widget.ButtonImage{
+					Idle:     image.NewNineSliceSimple(loader.GetInstance().GetStatic(loader.SliderHandleIdle), 0, 5),
+					Hover:    image.NewNineSliceSimple(loader.GetInstance().GetStatic(loader.SliderHandleHover), 0, 5),
+					Pressed:  image.NewNineSliceSimple(loader.GetInstance().GetStatic(loader.SliderHandleHover), 0, 5),
+					Disabled: image.NewNineSliceSimple(loader.GetInstance().GetStatic(loader.SliderHandleIdle), 0, 5),
+				}),
+			widget.SliderOpts.MinHandleSize(4),
+			widget.SliderOpts.TrackPadding(widget.Insets{Bottom: 20}),
+		),
+		widget.ListOpts.AllowReselect(),
+		widget.ListOpts.HideHorizontalSlider(),
+		widget.ListOpts.Entries([]interface{}{}),
+		widget.ListOpts.EntryLabelFunc(func(e interface{}) string {
+			return e.(dto.FriendsListEntry).DisplayName
+		}),
+		widget.ListOpts.EntrySelectedHandler(func(args *widget.ListEntrySelectedEventArgs) {
+			result.friendshipID = args.Entry.(dto.FriendsListEntry).FriendshipID
+		}),
+		widget.ListOpts.EntryFontFace(generalFont),
+		widget.ListOpts.EntryColor(&widget.ListEntryColor{
+			Selected:                   componentscommon.ButtonTextColor,
+			Unselected:                 selectedListColor,
+			SelectedBackground:         selectedListColor,
+			SelectedFocusedBackground:  selectedListColor,
+			FocusedBackground:          focusedListColor,
+			DisabledUnselected:         disabledListColor,
+			DisabledSelected:           disabledListColor,
+			DisabledSelectedBackground: disabledListColor,
+		}),
+		widget.ListOpts.EntryTextPadding(widget.Insets{
+			Top:    10,
+			Left:   20,
+			Right:  20,
+			Bottom: 10,
+		}),
+	)
+
+	container.AddChild(friendsList)
+
+	result = &LobbyDetailsComponent{
+		hostNameText:                hostNameText,
+		readyPlayersText:            readyPlayersText,
+		countdownText:               countdownText,
+		countdownSelectionContainer: countdownSelectionContainer,
+		countdownComboButton:        countdownComboButton,
+		teamPlayersText:             teamPlayersText,
+		teamSelectionContainer:      teamSelectionContainer,
+		teamComboButton:             teamComboButton,
+		friendsList:                 friendsList,
+		container:                   container,
+	}
+
+	return result
+}
@@ -0,0 +1,440 @@
+package profile
+
+import (
+	"fmt"
+	"image/color"
+	"regexp"
+	"sync"
+
+	"github.com/YarikRevich/fate-seekers/services/fate-seekers-client/pkg/config"
+	"github.com/YarikRevich/fate-seekers/services/fate-seekers-client/pkg/core/sound"
+	"github.com/YarikRevich/fate-seekers/services/fate-seekers-client/pkg/core/tools/scaler"
+	"github.com/YarikRevich/fate-seekers/services/fate-seekers-client/pkg/core/ui/common"
+	componentscommon "github.com/YarikRevich/fate-seekers/services/fate-seekers-client/pkg/core/ui/component/common"
+	"github.com/YarikRevich/fate-seekers/services/fate-seekers-client/pkg/core/ui/manager/translation"
+	"github.com/YarikRevich/fate-seekers/services/fate-seekers-client/pkg/loader"
+	"github.com/YarikRevich/fate-seekers/services/fate-seekers-client/pkg/logging"
+	"github.com/ebitenui/ebitenui/image"
+	"github.com/ebitenui/ebitenui/widget"
+	"github.com/hajimehoshi/ebiten/v2/text/v2"
+)
+
+var (
+	// GetInstance retrieves instance of the profile component, performing initial creation if needed.
+	GetInstance = sync.OnceValue[*ProfileComponent](newProfileComponent)
+)
+
+const (
+	// Describes max amount of symbols, which can be entered to input component.
+	maxInputSymbols = 16
+
+	// Describes display name input format, which is allowed to be entered to display name input component.
+	displayNameInputFormat = `^[a-zA-Z0-9_-]{0,16}$`
+
+	// Describes amount of the skins, which can be selected as default skin or avatar.
+	skinsAmount = 8
+)
+
+// Describes all the colors used for list combo definition.
+var (
+	selectedListColor = color.NRGBA{183, 228, 202, 255}
+	focusedListColor  = color.NRGBA{R: 170, G: 170, B: 180, A: 255}
+	disabledListColor = color.NRGBA{100, 100, 100, 255}
+)
+
+// ProfileComponent represents component, which contains user profile form.
+type ProfileComponent struct {
+	// Represents display name input widget.
+	displayNameInput *widget.TextInput
+
+	// Represents default skin combo button widget.
+	skinComboButton *widget.ListComboButton
+
+	// Represents avatar combo button widget.
+	avatarComboButton *widget.ListComboButton
+
+	// Represents submit callback.
+	submitCallback func(displayName string, skin, avatar uint64)
+
+	// Represents back callback.
+	backCallback func()
+
+	// Represents container widget.
+	container *widget.Container
+}
+
+// SetProfile sets the provided profile values to all the inputs in the container.
+func (pc *ProfileComponent) SetProfile(displayName string, skin, avatar uint64) {
+	pc.displayNameInput.SetText(displayName)
+	pc.skinComboButton.SetSelectedEntry(skin)
+	pc.avatarComboButton.SetSelectedEntry(avatar)
+}
+
+// CleanInputs cleans all the inputs in the container.
+func (pc *ProfileComponent) CleanInputs() {
+	pc.SetProfile("", 0, 0)
+}
+
+// SetSubmitCallback modifies submit callback in the container.
+func (pc *ProfileComponent) SetSubmitCallback(callback func(displayName string, skin, avatar uint64)) {
+	pc.submitCallback = callback
+}
+
+// SetBackCallback modifies back callback in the container.
+func (pc *ProfileComponent) SetBackCallback(callback func()) {
+	pc.backCallback = callback
+}
+
+// GetContainer retrieves container widget.
+func (pc *ProfileComponent) GetContainer() *widget.Container {
+	return pc.container
+}
+
+// newProfileComponent creates new profile component.
+func newProfileComponent() *ProfileComponent {
+	var result *ProfileComponent
+
+	container := widget.NewContainer(
+		widget.ContainerOpts.WidgetOpts(
+			widget.WidgetOpts.MinSize(
+				scaler.GetPercentageOf(config.GetWorldWidth(), 40),
+				scaler.GetPercentageOf(config.GetWorldHeight(), 30)),
+			widget.WidgetOpts.TrackHover(false),
+			widget.WidgetOpts.LayoutData(widget.AnchorLayoutData{
+				Padding: widget.Insets{
+					Left: scaler.GetPercentageOf(config.GetWorldWidth(), 9),
+				},
+				VerticalPosition:  widget.AnchorLayoutPositionCenter,
+				StretchHorizontal: false,
+				StretchVertical:   false,
+			})),
+		widget.ContainerOpts.BackgroundImage(common.GetImageAsNineSlice(loader.PanelIdlePanel, 10, 10)),
+		widget.ContainerOpts.Layout(widget.NewRowLayout(
+			widget.RowLayoutOpts.Direction(widget.DirectionVertical),
+			widget.RowLayoutOpts.Padding(widget.Insets{
+				Left:   30,
+				Right:  30,
+				Top:    30,
+				Bottom: 30,
+			}),
+		)))
+
+	generalFont := &text.GoTextFace{
+		Source: loader.GetInstance().GetFont(loader.KyivRegularFont),
+		Size:   20,
+	}
+
+	container.AddChild(widget.NewText(
+		widget.TextOpts.WidgetOpts(widget.WidgetOpts.LayoutData(widget.RowLayoutData{
+			Stretch: true,
+		})),
+		widget.TextOpts.Text(
+			translation.GetInstance().GetTranslation("client.profile.title"),
+			generalFont,
+			color.White)))
+
+	components := widget.NewContainer(
+		widget.ContainerOpts.WidgetOpts(widget.WidgetOpts.LayoutData(widget.RowLayoutData{
+			Stretch: true,
+		})),
+		widget.ContainerOpts.Layout(widget.NewGridLayout(
+			widget.GridLayoutOpts.Columns(2),
+			widget.GridLayoutOpts.Stretch([]bool{true, true}, nil),
+			widget.GridLayoutOpts.Spacing(
+				10, scaler.GetPercentageOf(config.GetWorldHeight(), 5)),
+			widget.GridLayoutOpts.Padding(widget.Insets{
+				Top: scaler.GetPercentageOf(config.GetWorldHeight(), 6),
+			}))))
+
+	components.AddChild(widget.NewText(
+		widget.TextOpts.WidgetOpts(widget.WidgetOpts.LayoutData(widget.RowLayoutData{
+			Stretch: true,
+		})),
+		widget.TextOpts.Text(
+			translation.GetInstance().GetTranslation("client.profile.display-name"),
+			generalFont,
+			color.White)))
+
+	var displayNameInput *widget.TextInput
+
+	displayNameInput = widget.NewTextInput(
+		widget.TextInputOpts.WidgetOpts(
+			widget.WidgetOpts.MinSize(
+				scaler.GetPercentageOf(config.GetWorldWidth(), 20), 0),
+			widget.WidgetOpts.LayoutData(widget.AnchorLayoutData{
+				VerticalPosition:   widget.AnchorLayoutPositionCenter,
+				HorizontalPosition: widget.AnchorLayoutPositionCenter,
+				StretchHorizontal:  false,
+				StretchVertical:    false,
+				Padding: widget.Insets{
+					Bottom: scaler.GetPercentageOf(config.GetWorldHeight(), 20),
+				},
+			})),
+		widget.TextInputOpts.Image(&widget.TextInputImage{
+			Idle:     image.NewNineSlice(loader.GetInstance().GetStatic(loader.TextInputIdle), [3]int{9, 14, 6}, [3]int{9, 14, 6}),
+			Disabled: image.NewNineSlice(loader.GetInstance().GetStatic(loader.TextInputIdle), [3]int{9, 14, 6}, [3]int{9, 14, 6}),
+		}),
+		widget.TextInputOpts.Color(&widget.TextInputColor{
+			Idle:          color.White,
+			Disabled:      color.White,
+			Caret:         color.White,
+			DisabledCaret: color.White,
+		}),
+		widget.TextInputOpts.Padding(widget.Insets{
+			Left:   13,
+			Right:  13,
+			Top:    13,
+			Bottom: 13,
+		}),
+		widget.TextInputOpts.Face(&text.GoTextFace{
+			Source: loader.GetInstance().GetFont(loader.KyivRegularFont),
+			Size:   20,
+		}),
+		widget.TextInputOpts.CaretOpts(
+			widget.CaretOpts.Size(generalFont, 4),
+		),
+		widget.TextInputOpts.AllowDuplicateSubmit(false),
+		widget.TextInputOpts.Validation(func(newInputTextRaw string) (bool, *string) {
+			newInputText := newInputTextRaw
+
+			parsedNewInputText := newInputText[len(displayNameInput.GetText()):]
+
+			if len(parsedNewInputText) > 1 {
+				newInputText = displayNameInput.GetText() + parsedNewInputText[:1]
+			} else if len(parsedNewInputText) == 0 {
+				return false, &newInputText
+			}
+
+			if len(newInputText) > maxInputSymbols {
+				replacement := displayNameInput.GetText()
+
+				return false, &replacement
+			}
+
+			matched, err := regexp.MatchString(displayNameInputFormat, newInputText)
+			if err != nil {
+				logging.GetInstance().Fatal(err.Error())
+			}
+
+			if !matched {
+				replacement := displayNameInput.GetText()
+
+				return false, &replacement
+			}
+
+			return false, &newInputText
+		}))
+
+	components.AddChild(displayNameInput)
+
+	var skinEntries []interface{}
+
+	for i := uint64(0); i < skinsAmount; i++ {
+		skinEntries = append(skinEntries, i)
+	}
+
+	newSkinComboButton := func() *widget.ListComboButton {
+		return widget.NewListComboButton(
+			widget.ListComboButtonOpts.SelectComboButtonOpts(
+				widget.SelectComboButtonOpts.ComboButtonOpts(
+					widget.ComboButtonOpts.ButtonOpts(
+						widget.ButtonOpts.Image(&widget.ButtonImage{
+							Idle:         common.GetImageAsNineSlice(loader.ComboIdleButton, 12, -10),
+							Hover:        common.GetImageAsNineSlice(loader.ComboIdleButton, 12, -10),
+							Pressed:      common.GetImageAsNineSlice(loader.ComboIdleButton, 12, -10),
+							PressedHover: common.GetImageAsNineSlice(loader.ComboIdleButton, 12, -10),
+						}),
+					),
+				),
+			),
+			widget.ListComboButtonOpts.Text(generalFont, &widget.ButtonImageImage{
+				Idle:     loader.GetInstance().GetStatic(loader.ComboArrayIdleButton),
+				Disabled: loader.GetInstance().GetStatic(loader.ComboArrayIdleButton),
+			}, &widget.ButtonTextColor{
+				Idle:     componentscommon.ButtonTextColor,
+				Disabled: componentscommon.ButtonTextColor,
+				Hover:    componentscommon.ButtonTextColor,
+				Pressed:  componentscommon.ButtonTextColor,
+			}),
+			widget.ListComboButtonOpts.ListOpts(
+				widget.ListOpts.Entries(skinEntries),
+				widget.ListOpts.ScrollContainerOpts(
+					widget.ScrollContainerOpts.Image(&widget.ScrollContainerImage{
+						Idle:     image.NewNineSlice(loader.GetInstance().GetStatic(loader.ListIdle), [3]int{25, 12, 22}, [3]int{25, 12, 25}),
+						Disabled: image.NewNineSlice(loader.GetInstance().GetStatic(loader.ListDisabled), [3]int{25, 12, 22}, [3]int{25, 12, 25}),
+						Mask:     image.NewNineSlice(loader.GetInstance().GetStatic(loader.ListMask), [3]int{26, 10, 23}, [3]int{26, 10, 26}),
+					}),
+				),
+				widget.ListOpts.SliderOpts(
+					widget.SliderOpts.Images(
+						&widget.SliderTrackImage{
+							Idle:     image.NewNineSlice(loader.GetInstance().GetStatic(loader.ListTrackIdle), [3]int{5, 0, 0}, [3]int{25, 12, 25}),
+							Hover:    image.NewNineSlice(loader.GetInstance().GetStatic(loader.ListTrackIdle), [3]int{5, 0, 0}, [3]int{25, 12, 25}),
+							Disabled: image.NewNineSlice(loader.GetInstance().GetStatic(loader.ListTrackDisabled), [3]int{0, 5, 0}, [3]int{25, 12, 25}),
+						},
+						&widget.ButtonImage{
+							Idle:     image.NewNineSliceSimple(loader.GetInstance().GetStatic(loader.SliderHandleIdle), 0, 5),
+							Hover:    image.NewNineSliceSimple(loader.GetInstance().GetStatic(loader.SliderHandleHover), 0, 5),
+							Pressed:  image.NewNineSliceSimple(loader.GetInstance().GetStatic(loader.SliderHandleHover), 0, 5),
+							Disabled: image.NewNineSliceSimple(loader.GetInstance().GetStatic(loader.SliderHandleIdle), 0, 5),
+						}),
+					widget.SliderOpts.MinHandleSize(4),
+					widget.SliderOpts.TrackPadding(widget.Insets{Bottom: 20})),
+				widget.ListOpts.EntryFontFace(generalFont),
+				widget.ListOpts.EntryColor(&widget.ListEntryColor{
+					Selected:                   componentscommon.ButtonTextColor,
+					Unselected:                 selectedListColor,
+					SelectedBackground:         selectedListColor,
+					SelectedFocusedBackground:  selectedListColor,
+					FocusedBackground:          focusedListColor,
+					DisabledUnselected:         disabledListColor,
+					DisabledSelected:           disabledListColor,
+					DisabledSelectedBackground: disabledListColor,
+				}),
+				widget.ListOpts.EntryTextPadding(widget.Insets{
+					Top:    15,
+					Left:   40,
+					Right:  40,
+					Bottom: 15,
+				}),
+			),
+			widget.ListComboButtonOpts.EntryLabelFunc(
+				func(e any) string {
+					return getSkinLabel(e.(uint64))
+				},
+				func(e any) string {
+					return getSkinLabel(e.(uint64))
+				}))
+	}
+
+	components.AddChild(widget.NewText(
+		widget.TextOpts.Text(
+			translation.GetInstance().GetTranslation("client.profile.skin"),
+			generalFont,
+			color.White)))
+
+	skinComboButton := newSkinComboButton()
+
+	skinComboButton.SetSelectedEntry(uint64(0))
+
+	components.AddChild(skinComboButton)
+
+	components.AddChild(widget.NewText(
+		widget.TextOpts.Text(
+			translation.GetInstance().GetTranslation("client.profile.avatar"),
+			generalFont,
+			color.White)))
+
+	avatarComboButton := newSkinComboButton()
+
+	avatarComboButton.SetSelectedEntry(uint64(0))
+
+	components.AddChild(avatarComboButton)
+
+	container.AddChild(components)
+
+	buttonsContainer := widget.NewContainer(
+		widget.ContainerOpts.WidgetOpts(
+			widget.WidgetOpts.MinSize(
+				container.GetWidget().MinWidth,
+				container.GetWidget().MinHeight),
+			widget.WidgetOpts.LayoutData(widget.AnchorLayoutData{
+				VerticalPosition:   widget.AnchorLayoutPositionEnd,
+				HorizontalPosition: widget.AnchorLayoutPositionEnd,
+				StretchHorizontal:  false,
+				StretchVertical:    false,
+			}),
+		),
+		widget.ContainerOpts.Layout(widget.NewRowLayout(
+			widget.RowLayoutOpts.Direction(widget.DirectionHorizontal),
+			widget.RowLayoutOpts.Spacing(13),
+			widget.RowLayoutOpts.Padding(widget.Insets{
+				Left: scaler.GetPercentageOf(config.GetWorldWidth(), 20),
+			}),
+		)),
+	)
+
+	buttonIdleIcon := common.GetImageAsNineSlice(loader.ButtonIdleButton, 16, 15)
+	buttonHoverIcon := common.GetImageAsNineSlice(loader.ButtonHoverButton, 16, 15)
+
+	buttonsContainer.AddChild(widget.NewButton(
+		widget.ButtonOpts.Image(&widget.ButtonImage{
+			Idle:         buttonIdleIcon,
+			Hover:        buttonHoverIcon,
+			Pressed:      buttonIdleIcon,
+			PressedHover: buttonIdleIcon,
+			Disabled:     buttonIdleIcon,
+		}),
+		widget.ButtonOpts.Text(
+			translation.GetInstance().GetTranslation("shared.settings.close"),
+			generalFont,
+			&widget.ButtonTextColor{Idle: componentscommon.ButtonTextColor}),
+		widget.ButtonOpts.WidgetOpts(
+			widget.WidgetOpts.LayoutData(widget.RowLayoutData{
+				Position: widget.RowLayoutPositionEnd,
+			})),
+		widget.ButtonOpts.TextPadding(widget.Insets{
+			Left:   30,
+			Right:  30,
+			Top:    20,
+			Bottom: 20,
+		}),
+		widget.ButtonOpts.PressedHandler(func(args *widget.ButtonPressedEventArgs) {
+			sound.GetInstance().GetSoundUIFxManager().PushWithHandbrake(loader.ButtonFXSound)
+
+			result.backCallback()
+		}),
+	))
+
+	buttonsContainer.AddChild(widget.NewButton(
+		widget.ButtonOpts.Image(&widget.ButtonImage{
+			Idle:         buttonIdleIcon,
+			Hover:        buttonHoverIcon,
+			Pressed:      buttonIdleIcon,
+			PressedHover: buttonIdleIcon,
+			Disabled:     buttonIdleIcon,
+		}),
+		widget.ButtonOpts.Text(
+			translation.GetInstance().GetTranslation("shared.settings.submit"),
+			generalFont,
+			&widget.ButtonTextColor{Idle: componentscommon.ButtonTextColor}),
+		widget.ButtonOpts.WidgetOpts(
+			widget.WidgetOpts.LayoutData(widget.RowLayoutData{
+				Position: widget.RowLayoutPositionEnd,
+			}),
+		),
+		widget.ButtonOpts.TextPadding(widget.Insets{
+			Left:   30,
+			Right:  30,
+			Top:    20,
+			Bottom: 20,
+		}),
+		widget.ButtonOpts.PressedHandler(func(args *widget.ButtonPressedEventArgs) {
+			sound.GetInstance().GetSoundUIFxManager().PushWithHandbrake(loader.ButtonFXSound)
+
+			result.submitCallback(
+				displayNameInput.GetText(),
+				skinComboButton.SelectedEntry().(uint64),
+				avatarComboButton.SelectedEntry().(uint64))
+		}),
+	))
+
+	container.AddChild(buttonsContainer)
+
+	result = &ProfileComponent{
+		displayNameInput:  displayNameInput,
+		skinComboButton:   skinComboButton,
+		avatarComboButton: avatarComboButton,
+		container:         container,
+	}
+
+	return result
+}
+
+// getSkinLabel retrieves translated label of the given skin.
+func getSkinLabel(skin uint64) string {
+	return translation.
+		GetInstance().
+		GetTranslation(
+			fmt.Sprintf("client.skin.%d.name", skin))
+}
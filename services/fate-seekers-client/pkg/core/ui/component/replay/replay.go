@@ -0,0 +1,81 @@
+package replay
+
+import (
+	"image/color"
+	"sync"
+
+	"github.com/YarikRevich/fate-seekers/services/fate-seekers-client/pkg/core/ui/common"
+	"github.com/YarikRevich/fate-seekers/services/fate-seekers-client/pkg/loader"
+	"github.com/ebitenui/ebitenui/widget"
+	"github.com/hajimehoshi/ebiten/v2/text/v2"
+)
+
+var (
+	// GetInstance retrieves instance of the replay component, performing initial creation if needed.
+	GetInstance = sync.OnceValue[*ReplayComponent](newReplayComponent)
+)
+
+// ReplayComponent represents component, which contains replay playback status.
+type ReplayComponent struct {
+	// Represents text widget.
+	text *widget.Text
+
+	// Represents container widget.
+	container *widget.Container
+}
+
+// SetText modifies text component in the container.
+func (rc *ReplayComponent) SetText(value string) {
+	rc.text.Label = value
+}
+
+// GetContainer retrieves container widget.
+func (rc *ReplayComponent) GetContainer() *widget.Container {
+	return rc.container
+}
+
+// newReplayComponent initializes ReplayComponent.
+func newReplayComponent() *ReplayComponent {
+	container := widget.NewContainer(
+		widget.ContainerOpts.BackgroundImage(common.GetImageAsNineSlice(loader.PanelIdlePanel, 10, 10)),
+		widget.ContainerOpts.WidgetOpts(
+			widget.WidgetOpts.TrackHover(false),
+			widget.WidgetOpts.LayoutData(widget.AnchorLayoutData{
+				Padding:            widget.Insets{Bottom: 10},
+				HorizontalPosition: widget.AnchorLayoutPositionCenter,
+				VerticalPosition:   widget.AnchorLayoutPositionEnd,
+				StretchHorizontal:  false,
+				StretchVertical:    false,
+			}),
+		),
+		widget.ContainerOpts.Layout(widget.NewAnchorLayout()))
+
+	textWidget := widget.NewText(
+		widget.TextOpts.WidgetOpts(widget.WidgetOpts.LayoutData(widget.AnchorLayoutData{
+			HorizontalPosition: widget.AnchorLayoutPositionCenter,
+			VerticalPosition:   widget.AnchorLayoutPositionCenter,
+			StretchHorizontal:  false,
+			StretchVertical:    false,
+		})),
+		widget.TextOpts.Position(widget.TextPositionCenter, widget.TextPositionCenter),
+		widget.TextOpts.Insets(widget.Insets{
+			Top:    15,
+			Bottom: 15,
+			Left:   20,
+			Right:  20,
+		}),
+		widget.TextOpts.Text(
+			"",
+			&text.GoTextFace{
+				Source: loader.GetInstance().GetFont(loader.KyivRegularFont),
+				Size:   20,
+			},
+			color.White))
+
+	container.AddChild(textWidget)
+
+	return &ReplayComponent{
+		text:      textWidget,
+		container: container,
+	}
+}
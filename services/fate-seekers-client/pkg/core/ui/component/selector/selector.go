@@ -1,6 +1,7 @@
 package selector
 
 import (
+	"fmt"
 	"image/color"
 	"sync"
 
@@ -19,6 +20,9 @@ import (
 const (
 	// Describes max amount of symbols, which can be entered to input component.
 	maxInputSymbols = 8
+
+	// Describes max amount of symbols, which can be entered to access input components.
+	maxAccessInputSymbols = 30
 )
 
 // Describes all the colors used for list combo definition.
@@ -38,17 +42,35 @@ type SelectorComponent struct {
 	// Represents session name input widget.
 	sessionNameInput *widget.TextInput
 
+	// Represents private session password input widget.
+	passwordInput *widget.TextInput
+
+	// Represents private session invite code input widget.
+	inviteCodeInput *widget.TextInput
+
 	// Represents sessions list widget.
 	list *widget.List
 
 	// Represents delete action button widget.
 	deleteActionButton *widget.Button
 
+	// Represents invite action button widget.
+	inviteActionButton *widget.Button
+
+	// Represents matchmaking action button widget.
+	matchmakingActionButton *widget.Button
+
+	// Represents matchmaking queue status text widget.
+	matchmakingStatusText *widget.Text
+
 	// Represents currently selected session name entry.
 	sessionNameEntry string
 
 	// Represents submit callback.
-	submitCallback func(sessionName string)
+	submitCallback func(sessionName, password, inviteCode string)
+
+	// Represents spectate callback.
+	spectateCallback func(sessionName, password, inviteCode string)
 
 	// Represents create callback.
 	createCallback func()
@@ -56,6 +78,21 @@ type SelectorComponent struct {
 	// Represents delete callback.
 	deleteCallback func(sessionName string)
 
+	// Represents invite callback.
+	inviteCallback func(sessionName string)
+
+	// Represents browse callback.
+	browseCallback func()
+
+	// Represents matchmaking callback.
+	matchmakingCallback func()
+
+	// Represents profile callback.
+	profileCallback func()
+
+	// Represents friends callback.
+	friendsCallback func()
+
 	// Represents back callback.
 	backCallback func()
 
@@ -66,6 +103,13 @@ type SelectorComponent struct {
 // CleanInputs cleans all the inputs in the container.
 func (sc *SelectorComponent) CleanInputs() {
 	sc.sessionNameInput.SetText("")
+	sc.passwordInput.SetText("")
+	sc.inviteCodeInput.SetText("")
+}
+
+// SetSessionName sets session name input value.
+func (sc *SelectorComponent) SetSessionName(value string) {
+	sc.sessionNameInput.SetText(value)
 }
 
 // SetListsEntries sets lists entries to the list widget.
@@ -74,10 +118,15 @@ func (sc *SelectorComponent) SetListsEntries(value []interface{}) {
 }
 
 // SetSubmitCallback modifies submit callback in the container.
-func (sc *SelectorComponent) SetSubmitCallback(callback func(sessionName string)) {
+func (sc *SelectorComponent) SetSubmitCallback(callback func(sessionName, password, inviteCode string)) {
 	sc.submitCallback = callback
 }
 
+// SetSpectateCallback modifies spectate callback in the container.
+func (sc *SelectorComponent) SetSpectateCallback(callback func(sessionName, password, inviteCode string)) {
+	sc.spectateCallback = callback
+}
+
 // SetCreateCallback modifies create callback in the container.
 func (sc *SelectorComponent) SetCreateCallback(callback func()) {
 	sc.createCallback = callback
@@ -88,14 +137,61 @@ func (sc *SelectorComponent) SetDeleteCallback(callback func(sessionName string)
 	sc.deleteCallback = callback
 }
 
+// SetInviteCallback modifies invite callback in the container.
+func (sc *SelectorComponent) SetInviteCallback(callback func(sessionName string)) {
+	sc.inviteCallback = callback
+}
+
+// SetBrowseCallback modifies browse callback in the container.
+func (sc *SelectorComponent) SetBrowseCallback(callback func()) {
+	sc.browseCallback = callback
+}
+
+// SetMatchmakingCallback modifies matchmaking callback in the container.
+func (sc *SelectorComponent) SetMatchmakingCallback(callback func()) {
+	sc.matchmakingCallback = callback
+}
+
+// SetProfileCallback modifies profile callback in the container.
+func (sc *SelectorComponent) SetProfileCallback(callback func()) {
+	sc.profileCallback = callback
+}
+
+// SetFriendsCallback modifies friends callback in the container.
+func (sc *SelectorComponent) SetFriendsCallback(callback func()) {
+	sc.friendsCallback = callback
+}
+
 // SetBackCallback modifies back callback in the container.
 func (sc *SelectorComponent) SetBackCallback(callback func()) {
 	sc.backCallback = callback
 }
 
-// ResetDeleteButton resets delete button widget state.
-func (sc *SelectorComponent) ResetDeleteButton() {
+// ResetActionButtons resets delete and invite buttons widgets state.
+func (sc *SelectorComponent) ResetActionButtons() {
 	sc.deleteActionButton.GetWidget().Disabled = true
+	sc.inviteActionButton.GetWidget().Disabled = true
+}
+
+// SetMatchmakingStatus sets matchmaking queue position and estimated wait in seconds.
+func (sc *SelectorComponent) SetMatchmakingStatus(position, estimatedWait uint64) {
+	sc.matchmakingStatusText.Label = fmt.Sprintf(
+		"%s:   %d   %s:   %ds",
+		translation.GetInstance().GetTranslation("client.selector.matchmaking-position"),
+		position,
+		translation.GetInstance().GetTranslation("client.selector.matchmaking-wait"),
+		estimatedWait)
+
+	sc.matchmakingActionButton.Text().Label =
+		translation.GetInstance().GetTranslation("client.selector.matchmaking-cancel")
+}
+
+// ResetMatchmakingStatus resets matchmaking queue status and matchmaking button widgets state.
+func (sc *SelectorComponent) ResetMatchmakingStatus() {
+	sc.matchmakingStatusText.Label = ""
+
+	sc.matchmakingActionButton.Text().Label =
+		translation.GetInstance().GetTranslation("client.selector.matchmaking")
 }
 
 // GetContainer retrieves container widget.
@@ -235,6 +331,93 @@ func newSelectorComponent() *SelectorComponent {
 
 	components.AddChild(sessionNameInput)
 
+	newAccessInput := func(secure bool) *widget.TextInput {
+		var input *widget.TextInput
+
+		input = widget.NewTextInput(
+			widget.TextInputOpts.WidgetOpts(
+				widget.WidgetOpts.LayoutData(widget.AnchorLayoutData{
+					VerticalPosition:   widget.AnchorLayoutPositionCenter,
+					HorizontalPosition: widget.AnchorLayoutPositionCenter,
+					StretchHorizontal:  false,
+					StretchVertical:    false,
+				})),
+			widget.TextInputOpts.Image(&widget.TextInputImage{
+				Idle:     image.NewNineSlice(loader.GetInstance().GetStatic(loader.TextInputIdle), [3]int{9, 14, 6}, [3]int{9, 14, 6}),
+				Disabled: image.NewNineSlice(loader.GetInstance().GetStatic(loader.TextInputIdle), [3]int{9, 14, 6}, [3]int{9, 14, 6}),
+			}),
+			widget.TextInputOpts.Color(&widget.TextInputColor{
+				Idle:          color.White,
+				Disabled:      color.White,
+				Caret:         color.White,
+				DisabledCaret: color.White,
+			}),
+			widget.TextInputOpts.Padding(widget.Insets{
+				Left:   13,
+				Right:  13,
+				Top:    13,
+				Bottom: 13,
+			}),
+			widget.TextInputOpts.Face(&text.GoTextFace{
+				Source: loader.GetInstance().GetFont(loader.KyivRegularFont),
+				Size:   20,
+			}),
+			widget.TextInputOpts.CaretOpts(
+				widget.CaretOpts.Size(generalFont, 4),
+			),
+			widget.TextInputOpts.Secure(secure),
+			widget.TextInputOpts.AllowDuplicateSubmit(false),
+			widget.TextInputOpts.Validation(func(newInputTextRaw string) (bool, *string) {
+				newInputText := newInputTextRaw
+
+				parsedNewInputText := newInputText[len(input.GetText()):]
+
+				if len(parsedNewInputText) > 1 {
+					newInputText = input.GetText() + parsedNewInputText[:1]
+				} else if len(parsedNewInputText) == 0 {
+					return false, &newInputText
+				}
+
+				parsedNewInputTextSymbol := rune(parsedNewInputText[0])
+
+				if parsedNewInputTextSymbol <= 32 || parsedNewInputTextSymbol >= 127 {
+					replacement := input.GetText()
+
+					return false, &replacement
+				}
+
+				if len(newInputText) > maxAccessInputSymbols {
+					replacement := input.GetText()
+
+					return false, &replacement
+				}
+
+				return false, &newInputText
+			}))
+
+		return input
+	}
+
+	components.AddChild(widget.NewText(
+		widget.TextOpts.Text(
+			translation.GetInstance().GetTranslation("client.selector.session-password"),
+			generalFont,
+			color.White)))
+
+	passwordInput := newAccessInput(true)
+
+	components.AddChild(passwordInput)
+
+	components.AddChild(widget.NewText(
+		widget.TextOpts.Text(
+			translation.GetInstance().GetTranslation("client.selector.session-invite-code"),
+			generalFont,
+			color.White)))
+
+	inviteCodeInput := newAccessInput(false)
+
+	components.AddChild(inviteCodeInput)
+
 	container.AddChild(components)
 
 	listsContainer := widget.NewContainer(
@@ -311,6 +494,10 @@ func newSelectorComponent() *SelectorComponent {
 				result.deleteActionButton.GetWidget().Disabled = false
 			}
 
+			if result.inviteActionButton.GetWidget().Disabled {
+				result.inviteActionButton.GetWidget().Disabled = false
+			}
+
 			sessionNameEntry := args.Entry.(string)
 
 			sessionNameInput.SetText(sessionNameEntry)
@@ -338,6 +525,17 @@ func newSelectorComponent() *SelectorComponent {
 
 	listsContainer.AddChild(list)
 
+	matchmakingStatusText := widget.NewText(
+		widget.TextOpts.WidgetOpts(widget.WidgetOpts.LayoutData(widget.RowLayoutData{
+			Stretch: true,
+		})),
+		widget.TextOpts.Insets(widget.Insets{
+			Top: 20,
+		}),
+		widget.TextOpts.Text("", generalFont, color.White))
+
+	listsContainer.AddChild(matchmakingStatusText)
+
 	container.AddChild(listsContainer)
 
 	buttonsContainer := widget.NewContainer(
@@ -466,6 +664,162 @@ func newSelectorComponent() *SelectorComponent {
 
 	actionButtonContainer.AddChild(deleteActionButton)
 
+	inviteActionButton := widget.NewButton(
+		widget.ButtonOpts.Image(&widget.ButtonImage{
+			Idle:         buttonIdleIcon,
+			Hover:        buttonHoverIcon,
+			Pressed:      buttonIdleIcon,
+			PressedHover: buttonIdleIcon,
+			Disabled:     buttonIdleIcon,
+		}),
+		widget.ButtonOpts.Text(
+			translation.GetInstance().GetTranslation("client.selector.invite"),
+			generalFont,
+			&widget.ButtonTextColor{Idle: componentscommon.ButtonTextColor}),
+		widget.ButtonOpts.WidgetOpts(
+			widget.WidgetOpts.LayoutData(widget.RowLayoutData{
+				Position: widget.RowLayoutPositionEnd,
+			}),
+		),
+		widget.ButtonOpts.TextPadding(widget.Insets{
+			Left:   30,
+			Right:  30,
+			Top:    20,
+			Bottom: 20,
+		}),
+		widget.ButtonOpts.PressedHandler(func(args *widget.ButtonPressedEventArgs) {
+			sound.GetInstance().GetSoundUIFxManager().PushWithHandbrake(loader.ButtonFXSound)
+
+			result.inviteCallback(result.sessionNameEntry)
+		}),
+	)
+
+	inviteActionButton.GetWidget().Disabled = true
+
+	actionButtonContainer.AddChild(inviteActionButton)
+
+	matchmakingActionButton := widget.NewButton(
+		widget.ButtonOpts.Image(&widget.ButtonImage{
+			Idle:         buttonIdleIcon,
+			Hover:        buttonHoverIcon,
+			Pressed:      buttonIdleIcon,
+			PressedHover: buttonIdleIcon,
+			Disabled:     buttonIdleIcon,
+		}),
+		widget.ButtonOpts.Text(
+			translation.GetInstance().GetTranslation("client.selector.matchmaking"),
+			generalFont,
+			&widget.ButtonTextColor{Idle: componentscommon.ButtonTextColor}),
+		widget.ButtonOpts.WidgetOpts(
+			widget.WidgetOpts.LayoutData(widget.RowLayoutData{
+				Position: widget.RowLayoutPositionEnd,
+			}),
+		),
+		widget.ButtonOpts.TextPadding(widget.Insets{
+			Left:   30,
+			Right:  30,
+			Top:    20,
+			Bottom: 20,
+		}),
+		widget.ButtonOpts.PressedHandler(func(args *widget.ButtonPressedEventArgs) {
+			sound.GetInstance().GetSoundUIFxManager().PushWithHandbrake(loader.ButtonFXSound)
+
+			result.matchmakingCallback()
+		}),
+	)
+
+	actionButtonContainer.AddChild(matchmakingActionButton)
+
+	actionButtonContainer.AddChild(widget.NewButton(
+		widget.ButtonOpts.Image(&widget.ButtonImage{
+			Idle:         buttonIdleIcon,
+			Hover:        buttonHoverIcon,
+			Pressed:      buttonIdleIcon,
+			PressedHover: buttonIdleIcon,
+			Disabled:     buttonIdleIcon,
+		}),
+		widget.ButtonOpts.Text(
+			translation.GetInstance().GetTranslation("client.selector.browse"),
+			generalFont,
+			&widget.ButtonTextColor{Idle: componentscommon.ButtonTextColor}),
+		widget.ButtonOpts.WidgetOpts(
+			widget.WidgetOpts.LayoutData(widget.RowLayoutData{
+				Position: widget.RowLayoutPositionEnd,
+			}),
+		),
+		widget.ButtonOpts.TextPadding(widget.Insets{
+			Left:   30,
+			Right:  30,
+			Top:    20,
+			Bottom: 20,
+		}),
+		widget.ButtonOpts.PressedHandler(func(args *widget.ButtonPressedEventArgs) {
+			sound.GetInstance().GetSoundUIFxManager().PushWithHandbrake(loader.ButtonFXSound)
+
+			result.browseCallback()
+		}),
+	))
+
+	actionButtonContainer.AddChild(widget.NewButton(
+		widget.ButtonOpts.Image(&widget.ButtonImage{
+			Idle:         buttonIdleIcon,
+			Hover:        buttonHoverIcon,
+			Pressed:      buttonIdleIcon,
+			PressedHover: buttonIdleIcon,
+			Disabled:     buttonIdleIcon,
+		}),
+		widget.ButtonOpts.Text(
+			translation.GetInstance().GetTranslation("client.selector.profile"),
+			generalFont,
+			&widget.ButtonTextColor{Idle: componentscommon.ButtonTextColor}),
+		widget.ButtonOpts.WidgetOpts(
+			widget.WidgetOpts.LayoutData(widget.RowLayoutData{
+				Position: widget.RowLayoutPositionEnd,
+			}),
+		),
+		widget.ButtonOpts.TextPadding(widget.Insets{
+			Left:   30,
+			Right:  30,
+			Top:    20,
+			Bottom: 20,
+		}),
+		widget.ButtonOpts.PressedHandler(func(args *widget.ButtonPressedEventArgs) {
+			sound.GetInstance().GetSoundUIFxManager().PushWithHandbrake(loader.ButtonFXSound)
+
+			result.profileCallback()
+		}),
+	))
+
+	actionButtonContainer.AddChild(widget.NewButton(
+		widget.ButtonOpts.Image(&widget.ButtonImage{
+			Idle:         buttonIdleIcon,
+			Hover:        buttonHoverIcon,
+			Pressed:      buttonIdleIcon,
+			PressedHover: buttonIdleIcon,
+			Disabled:     buttonIdleIcon,
+		}),
+		widget.ButtonOpts.Text(
+			translation.GetInstance().GetTranslation("client.selector.friends"),
+			generalFont,
+			&widget.ButtonTextColor{Idle: componentscommon.ButtonTextColor}),
+		widget.ButtonOpts.WidgetOpts(
+			widget.WidgetOpts.LayoutData(widget.RowLayoutData{
+				Position: widget.RowLayoutPositionEnd,
+			}),
+		),
+		widget.ButtonOpts.TextPadding(widget.Insets{
+			Left:   30,
+			Right:  30,
+			Top:    20,
+			Bottom: 20,
+		}),
+		widget.ButtonOpts.PressedHandler(func(args *widget.ButtonPressedEventArgs) {
+			sound.GetInstance().GetSoundUIFxManager().PushWithHandbrake(loader.ButtonFXSound)
+
+			result.friendsCallback()
+		}),
+	))
+
 	actionButtonContainer.AddChild(widget.NewButton(
 		widget.ButtonOpts.Image(&widget.ButtonImage{
 			Idle:         buttonIdleIcon,
@@ -496,6 +850,37 @@ func newSelectorComponent() *SelectorComponent {
 		}),
 	))
 
+	actionButtonContainer.AddChild(widget.NewButton(
+		widget.ButtonOpts.Image(&widget.ButtonImage{
+			Idle:         buttonIdleIcon,
+			Hover:        buttonHoverIcon,
+			Pressed:      buttonIdleIcon,
+			PressedHover: buttonIdleIcon,
+			Disabled:     buttonIdleIcon,
+		}),
+		widget.ButtonOpts.Text(
+			translation.GetInstance().GetTranslation("client.selector.spectate"),
+			generalFont,
+			&widget.ButtonTextColor{Idle: componentscommon.ButtonTextColor}),
+		widget.ButtonOpts.WidgetOpts(
+			widget.WidgetOpts.LayoutData(widget.RowLayoutData{
+				Position: widget.RowLayoutPositionEnd,
+			}),
+		),
+		widget.ButtonOpts.TextPadding(widget.Insets{
+			Left:   30,
+			Right:  30,
+			Top:    20,
+			Bottom: 20,
+		}),
+		widget.ButtonOpts.PressedHandler(func(args *widget.ButtonPressedEventArgs) {
+			sound.GetInstance().GetSoundUIFxManager().PushWithHandbrake(loader.ButtonFXSound)
+
+			result.spectateCallback(
+				sessionNameInput.GetText(), passwordInput.GetText(), inviteCodeInput.GetText())
+		}),
+	))
+
 	actionButtonContainer.AddChild(widget.NewButton(
 		widget.ButtonOpts.Image(&widget.ButtonImage{
 			Idle:         buttonIdleIcon,
@@ -522,7 +907,8 @@ func newSelectorComponent() *SelectorComponent {
 		widget.ButtonOpts.PressedHandler(func(args *widget.ButtonPressedEventArgs) {
 			sound.GetInstance().GetSoundUIFxManager().PushWithHandbrake(loader.ButtonFXSound)
 
-			result.submitCallback(sessionNameInput.GetText())
+			result.submitCallback(
+				sessionNameInput.GetText(), passwordInput.GetText(), inviteCodeInput.GetText())
 		}),
 	))
 
@@ -531,10 +917,15 @@ func newSelectorComponent() *SelectorComponent {
 	container.AddChild(buttonsContainer)
 
 	result = &SelectorComponent{
-		sessionNameInput:   sessionNameInput,
-		list:               list,
-		deleteActionButton: deleteActionButton,
-		container:          container,
+		sessionNameInput:        sessionNameInput,
+		passwordInput:           passwordInput,
+		inviteCodeInput:         inviteCodeInput,
+		list:                    list,
+		deleteActionButton:      deleteActionButton,
+		inviteActionButton:      inviteActionButton,
+		matchmakingActionButton: matchmakingActionButton,
+		matchmakingStatusText:   matchmakingStatusText,
+		container:               container,
 	}
 
 	return result
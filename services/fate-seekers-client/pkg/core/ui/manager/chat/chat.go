@@ -0,0 +1,230 @@
+package chat
+
+import (
+	"fmt"
+	"slices"
+	"sync"
+	"time"
+
+	metadatav1 "github.com/YarikRevich/fate-seekers/services/fate-seekers-client/pkg/core/networking/metadata/api"
+	"github.com/YarikRevich/fate-seekers/services/fate-seekers-client/pkg/core/networking/metadata/handler"
+	"github.com/YarikRevich/fate-seekers/services/fate-seekers-client/pkg/core/networking/metadata/stream"
+	"github.com/YarikRevich/fate-seekers/services/fate-seekers-client/pkg/core/ui/component/chat"
+	"github.com/YarikRevich/fate-seekers/services/fate-seekers-client/pkg/core/ui/component/common"
+	"github.com/YarikRevich/fate-seekers/services/fate-seekers-client/pkg/core/ui/manager/notification"
+	"github.com/YarikRevich/fate-seekers/services/fate-seekers-client/pkg/core/ui/manager/translation"
+	"github.com/YarikRevich/fate-seekers/services/fate-seekers-client/pkg/state/action"
+	"github.com/YarikRevich/fate-seekers/services/fate-seekers-client/pkg/state/dispatcher"
+	"github.com/YarikRevich/fate-seekers/services/fate-seekers-client/pkg/state/store"
+	"github.com/YarikRevich/fate-seekers/services/fate-seekers-client/pkg/state/value"
+	"github.com/hajimehoshi/ebiten/v2"
+	"github.com/hajimehoshi/ebiten/v2/inpututil"
+)
+
+var (
+	// GetInstance retrieves instance of the chat manager, performing initial creation if needed.
+	GetInstance = sync.OnceValue[*ChatManager](newChatManager)
+)
+
+const (
+	// Represents length of the issuer tag shown as an author of other users messages.
+	authorTagLength = 8
+)
+
+var (
+	// Represents all the screens, during which chat messages of the selected session are retrieved.
+	chatScreens = []string{
+		value.ACTIVE_SCREEN_LOBBY_VALUE,
+		value.ACTIVE_SCREEN_TRAVEL_VALUE,
+		value.ACTIVE_SCREEN_SESSION_VALUE,
+		value.ACTIVE_SCREEN_ANSWER_INPUT_VALUE,
+		value.ACTIVE_SCREEN_RESUME_VALUE,
+		value.ACTIVE_SCREEN_DEATH_VALUE,
+	}
+)
+
+// ChatManager represents chat manager, which retrieves messages of the selected session and
+// handles chat overlay input.
+type ChatManager struct {
+	// Represents id of the session, which chat messages are retrieved.
+	sessionID int64
+}
+
+// Update performs chat messages retrieval of the selected session, handling chat overlay toggle
+// input. Chat is opened with enter key or gamepad back button and closed with escape key or
+// the same gamepad button.
+func (cm *ChatManager) Update() {
+	sessionID := store.GetSelectedSessionMetadata().ID
+
+	if store.GetChatMessagesRetrievalStartedNetworking() == value.CHAT_MESSAGES_RETRIEVAL_STARTED_NETWORKING_FALSE_VALUE ||
+		cm.sessionID != sessionID {
+		dispatcher.GetInstance().Dispatch(
+			action.NewSetChatMessagesRetrievalStartedNetworkingAction(
+				value.CHAT_MESSAGES_RETRIEVAL_STARTED_NETWORKING_TRUE_VALUE))
+
+		cm.sessionID = sessionID
+
+		chat.GetInstance().CleanMessages()
+
+		stream.GetGetChatMessagesSubmitter().Clean(func() {
+			stream.GetGetChatMessagesSubmitter().Submit(
+				sessionID, func(response *metadatav1.GetChatMessagesResponse, err error) bool {
+					if cm.sessionID != sessionID {
+						return true
+					}
+
+					if !slices.Contains(chatScreens, store.GetActiveScreen()) {
+						dispatcher.GetInstance().Dispatch(
+							action.NewSetChatMessagesRetrievalStartedNetworkingAction(
+								value.CHAT_MESSAGES_RETRIEVAL_STARTED_NETWORKING_FALSE_VALUE))
+
+						return true
+					}
+
+					if err != nil {
+						notification.GetInstance().Push(
+							common.ComposeMessage(
+								translation.GetInstance().GetTranslation("client.networking.get-chat-messages-failure"),
+								err.Error()),
+							time.Second*3,
+							common.NotificationErrorTextColor)
+
+						return true
+					}
+
+					for _, message := range response.GetMessages() {
+						author := GetAuthor(message.GetIssuer())
+
+						chat.GetInstance().AddMessage(author, message.GetText())
+
+						if !chat.GetInstance().IsShown() && message.GetIssuer() != store.GetRepositoryUUID() {
+							notification.GetInstance().Push(
+								fmt.Sprintf("%s: %s", author, message.GetText()),
+								time.Second*2,
+								common.NotificationInfoTextColor)
+						}
+					}
+
+					return false
+				})
+		})
+	}
+
+	var (
+		openPressed  bool
+		closePressed bool
+	)
+
+	if store.GetApplicationStateGamepadEnabled() == value.GAMEPAD_ENABLED_APPLICATION_TRUE_VALUE && ebiten.IsFocused() {
+		if inpututil.IsStandardGamepadButtonJustPressed(
+			ebiten.GamepadIDs()[0], ebiten.StandardGamepadButtonCenterLeft) {
+			openPressed = true
+			closePressed = true
+		}
+	} else {
+		if inpututil.IsKeyJustPressed(ebiten.KeyEnter) {
+			openPressed = true
+		}
+
+		if inpututil.IsKeyJustPressed(ebiten.KeyEscape) {
+			closePressed = true
+		}
+	}
+
+	if chat.GetInstance().IsShown() {
+		if closePressed {
+			cm.close()
+		}
+	} else if openPressed {
+		chat.GetInstance().Show(
+			store.GetApplicationStateGamepadEnabled() == value.GAMEPAD_ENABLED_APPLICATION_TRUE_VALUE)
+	}
+}
+
+// Reset stops chat messages retrieval, cleaning chat overlay.
+func (cm *ChatManager) Reset() {
+	cm.sessionID = 0
+
+	cm.close()
+
+	chat.GetInstance().CleanMessages()
+
+	stream.GetGetChatMessagesSubmitter().Clean(func() {
+		dispatcher.GetInstance().Dispatch(
+			action.NewSetChatMessagesRetrievalStartedNetworkingAction(
+				value.CHAT_MESSAGES_RETRIEVAL_STARTED_NETWORKING_FALSE_VALUE))
+	})
+}
+
+// close hides chat overlay, cleaning entered message.
+func (cm *ChatManager) close() {
+	chat.GetInstance().CleanInput()
+
+	chat.GetInstance().Hide()
+}
+
+// GetAuthor retrieves author label of the message or marker sent by the given issuer, which is
+// display name of the issuer, when it has been selected, or issuer tag otherwise.
+func GetAuthor(issuer string) string {
+	if issuer == store.GetRepositoryUUID() {
+		return translation.GetInstance().GetTranslation("client.chat.you")
+	}
+
+	if displayName := getDisplayName(issuer); displayName != "" {
+		return displayName
+	}
+
+	if len(issuer) > authorTagLength {
+		return issuer[:authorTagLength]
+	}
+
+	return issuer
+}
+
+// getDisplayName retrieves display name of the given issuer from the retrieved users metadata of the
+// session, falling back to the retrieved lobby set, when issuer is not present in the session yet.
+func getDisplayName(issuer string) string {
+	store.RetrievedUsersMetadataSessionSyncHelper.Lock()
+
+	usersMetadata, ok := store.GetRetrievedUsersMetadataSession()[issuer]
+
+	store.RetrievedUsersMetadataSessionSyncHelper.Unlock()
+
+	if ok && usersMetadata.DisplayName != "" {
+		return usersMetadata.DisplayName
+	}
+
+	for _, lobbySetUnit := range store.GetRetrievedLobbySetMetadata() {
+		if lobbySetUnit.Issuer == issuer {
+			return lobbySetUnit.DisplayName
+		}
+	}
+
+	return ""
+}
+
+// newChatManager initializes ChatManager.
+func newChatManager() *ChatManager {
+	result := new(ChatManager)
+
+	chat.GetInstance().SetSubmitCallback(func(value string) {
+		chat.GetInstance().CleanInput()
+
+		handler.PerformSendChatMessage(result.sessionID, value, func(err error) {
+			if err != nil {
+				notification.GetInstance().Push(
+					common.ComposeMessage(
+						translation.GetInstance().GetTranslation("client.networking.send-chat-message-failure"),
+						err.Error()),
+					time.Second*3,
+					common.NotificationErrorTextColor)
+			}
+		})
+	})
+
+	chat.GetInstance().SetCloseCallback(func() {
+		result.close()
+	})
+
+	return result
+}
@@ -0,0 +1,190 @@
+package emote
+
+import (
+	"sync"
+	"time"
+
+	"github.com/YarikRevich/fate-seekers/services/fate-seekers-client/pkg/core/networking/content/call"
+	metadatav1 "github.com/YarikRevich/fate-seekers/services/fate-seekers-client/pkg/core/networking/metadata/api"
+	"github.com/YarikRevich/fate-seekers/services/fate-seekers-client/pkg/core/networking/metadata/stream"
+	"github.com/YarikRevich/fate-seekers/services/fate-seekers-client/pkg/core/tools/gamepad"
+	"github.com/YarikRevich/fate-seekers/services/fate-seekers-client/pkg/core/tools/marker"
+	"github.com/YarikRevich/fate-seekers/services/fate-seekers-client/pkg/core/ui/component/chat"
+	"github.com/YarikRevich/fate-seekers/services/fate-seekers-client/pkg/core/ui/component/common"
+	"github.com/YarikRevich/fate-seekers/services/fate-seekers-client/pkg/core/ui/component/emote"
+	chatmanager "github.com/YarikRevich/fate-seekers/services/fate-seekers-client/pkg/core/ui/manager/chat"
+	"github.com/YarikRevich/fate-seekers/services/fate-seekers-client/pkg/core/ui/manager/notification"
+	"github.com/YarikRevich/fate-seekers/services/fate-seekers-client/pkg/core/ui/manager/translation"
+	"github.com/YarikRevich/fate-seekers/services/fate-seekers-client/pkg/dto"
+	"github.com/YarikRevich/fate-seekers/services/fate-seekers-client/pkg/state/action"
+	"github.com/YarikRevich/fate-seekers/services/fate-seekers-client/pkg/state/dispatcher"
+	"github.com/YarikRevich/fate-seekers/services/fate-seekers-client/pkg/state/store"
+	"github.com/YarikRevich/fate-seekers/services/fate-seekers-client/pkg/state/value"
+	"github.com/hajimehoshi/ebiten/v2"
+	"github.com/hajimehoshi/ebiten/v2/inpututil"
+	"github.com/setanarut/kamera/v2"
+)
+
+var (
+	// GetInstance retrieves instance of the emote manager, performing initial creation if needed.
+	GetInstance = sync.OnceValue[*EmoteManager](newEmoteManager)
+)
+
+// EmoteManager represents emote manager, which retrieves emotes and pings relayed to the user and
+// handles emotes wheel input.
+type EmoteManager struct {
+	// Represents world position under the cursor, when emotes wheel has been opened, which is used for pings.
+	pingPosition dto.Position
+
+	// Represents stick direction selected, while emotes wheel is held with gamepad button.
+	direction dto.Direction
+}
+
+// Update performs retrieval of the emotes and pings relayed to the user, handling emotes wheel input,
+// when interaction is allowed. Emotes wheel is toggled with Q key or held with gamepad right bumper,
+// while gamepad left stick selects emote, which is sent on bumper release.
+func (em *EmoteManager) Update(camera *kamera.Camera, interactive bool) {
+	if store.GetEmotesRetrievalStartedNetworking() == value.EMOTES_RETRIEVAL_STARTED_NETWORKING_FALSE_VALUE {
+		dispatcher.GetInstance().Dispatch(
+			action.NewSetEmotesRetrievalStartedNetworkingAction(
+				value.EMOTES_RETRIEVAL_STARTED_NETWORKING_TRUE_VALUE))
+
+		stream.GetGetEmotesSubmitter().Clean(func() {
+			stream.GetGetEmotesSubmitter().Submit(
+				store.GetSelectedSessionMetadata().ID, func(response *metadatav1.GetEmotesResponse, err error) bool {
+					if store.GetActiveScreen() != value.ACTIVE_SCREEN_SESSION_VALUE {
+						dispatcher.GetInstance().Dispatch(
+							action.NewSetEmotesRetrievalStartedNetworkingAction(
+								value.EMOTES_RETRIEVAL_STARTED_NETWORKING_FALSE_VALUE))
+
+						return true
+					}
+
+					if err != nil {
+						notification.GetInstance().Push(
+							common.ComposeMessage(
+								translation.GetInstance().GetTranslation("client.networking.get-emotes-failure"),
+								err.Error()),
+							time.Second*3,
+							common.NotificationErrorTextColor)
+
+						return true
+					}
+
+					for _, unit := range response.GetEmotes() {
+						position := dto.Position{
+							X: unit.GetPosition().GetX(),
+							Y: unit.GetPosition().GetY(),
+						}
+
+						if unit.GetPing() {
+							marker.GetInstance().AddPingMarker(
+								unit.GetIssuer(), chatmanager.GetAuthor(unit.GetIssuer()), position)
+
+							continue
+						}
+
+						if unit.GetEmote() >= uint64(len(emote.EMOTES)) {
+							continue
+						}
+
+						marker.GetInstance().AddEmoteMarker(
+							unit.GetIssuer(),
+							translation.GetInstance().GetTranslation(emote.EMOTES[unit.GetEmote()]),
+							position)
+					}
+
+					return false
+				})
+		})
+	}
+
+	if !interactive || chat.GetInstance().IsShown() {
+		if emote.GetInstance().IsShown() {
+			emote.GetInstance().Hide()
+		}
+
+		return
+	}
+
+	if store.GetApplicationStateGamepadEnabled() == value.GAMEPAD_ENABLED_APPLICATION_TRUE_VALUE && ebiten.IsFocused() {
+		gamepadID := ebiten.GamepadIDs()[0]
+
+		if inpututil.IsStandardGamepadButtonJustPressed(gamepadID, ebiten.StandardGamepadButtonFrontTopRight) {
+			em.open(camera)
+		}
+
+		if emote.GetInstance().IsShown() {
+			if ebiten.IsStandardGamepadButtonPressed(gamepadID, ebiten.StandardGamepadButtonFrontTopRight) {
+				em.direction = gamepad.GetGamepadLeftStickDirection(gamepadID)
+
+				emote.GetInstance().Highlight(em.direction)
+			} else if inpututil.IsStandardGamepadButtonJustReleased(gamepadID, ebiten.StandardGamepadButtonFrontTopRight) {
+				emote.GetInstance().Submit(em.direction)
+			}
+		}
+	} else {
+		if emote.GetInstance().IsShown() {
+			if inpututil.IsKeyJustPressed(ebiten.KeyQ) || inpututil.IsKeyJustPressed(ebiten.KeyEscape) {
+				emote.GetInstance().Hide()
+			}
+		} else if inpututil.IsKeyJustPressed(ebiten.KeyQ) {
+			em.open(camera)
+		}
+	}
+}
+
+// open shows emotes wheel, remembering world position under the cursor for pings.
+func (em *EmoteManager) open(camera *kamera.Camera) {
+	var cursorPositionX, cursorPositionY int
+
+	if store.GetApplicationStateGamepadEnabled() == value.GAMEPAD_ENABLED_APPLICATION_TRUE_VALUE {
+		rawGamepadPosition := store.GetApplicationStateGamepadPointerPosition()
+
+		cursorPositionX = int(rawGamepadPosition.X)
+		cursorPositionY = int(rawGamepadPosition.Y)
+	} else {
+		cursorPositionX, cursorPositionY = ebiten.CursorPosition()
+	}
+
+	em.pingPosition = dto.Position{
+		X: camera.X + float64(cursorPositionX),
+		Y: -(camera.Y + float64(cursorPositionY)),
+	}
+
+	em.direction = dto.DirNone
+
+	emote.GetInstance().Show()
+}
+
+// send performs emote or ping send, hiding emotes wheel.
+func (em *EmoteManager) send(emoteID uint64, ping bool) {
+	emote.GetInstance().Hide()
+
+	call.PerformSendEmote(
+		store.GetSelectedSessionMetadata().ID, emoteID, ping, em.pingPosition, func(err error) {
+			if err != nil {
+				notification.GetInstance().Push(
+					common.ComposeMessage(
+						translation.GetInstance().GetTranslation("client.networking.send-emote-failure"),
+						err.Error()),
+					time.Second*3,
+					common.NotificationErrorTextColor)
+			}
+		})
+}
+
+// newEmoteManager initializes EmoteManager.
+func newEmoteManager() *EmoteManager {
+	result := new(EmoteManager)
+
+	emote.GetInstance().SetEmoteCallback(func(emoteID uint64) {
+		result.send(emoteID, false)
+	})
+
+	emote.GetInstance().SetPingCallback(func() {
+		result.send(0, true)
+	})
+
+	return result
+}
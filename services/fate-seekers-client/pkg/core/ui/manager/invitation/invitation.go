@@ -0,0 +1,141 @@
+package invitation
+
+import (
+	"fmt"
+	"slices"
+	"sync"
+	"time"
+
+	metadatav1 "github.com/YarikRevich/fate-seekers/services/fate-seekers-client/pkg/core/networking/metadata/api"
+	"github.com/YarikRevich/fate-seekers/services/fate-seekers-client/pkg/core/networking/metadata/converter"
+	"github.com/YarikRevich/fate-seekers/services/fate-seekers-client/pkg/core/networking/metadata/stream"
+	"github.com/YarikRevich/fate-seekers/services/fate-seekers-client/pkg/core/ui/component/common"
+	"github.com/YarikRevich/fate-seekers/services/fate-seekers-client/pkg/core/ui/manager/notification"
+	"github.com/YarikRevich/fate-seekers/services/fate-seekers-client/pkg/core/ui/manager/translation"
+	"github.com/YarikRevich/fate-seekers/services/fate-seekers-client/pkg/dto"
+	"github.com/YarikRevich/fate-seekers/services/fate-seekers-client/pkg/state/action"
+	"github.com/YarikRevich/fate-seekers/services/fate-seekers-client/pkg/state/dispatcher"
+	"github.com/YarikRevich/fate-seekers/services/fate-seekers-client/pkg/state/store"
+	"github.com/YarikRevich/fate-seekers/services/fate-seekers-client/pkg/state/value"
+)
+
+var (
+	// GetInstance retrieves instance of the invitation manager, performing initial creation if needed.
+	GetInstance = sync.OnceValue[*InvitationManager](newInvitationManager)
+)
+
+// InvitationManager represents invitation manager, which retrieves session invitations sent by
+// the friends and keeps them until they are answered.
+type InvitationManager struct {
+	// Represents mutex used for pending invitations access.
+	mu sync.Mutex
+
+	// Represents received invitations, which have not been answered yet.
+	pending []dto.RetrievedInvitation
+}
+
+// Update performs retrieval of the session invitations, notifying about each of the received ones.
+// Retrieval is stopped, when connection is closed or menu screen is active, keeping the invitations
+// received with the last response.
+func (im *InvitationManager) Update() {
+	if store.GetInvitationsRetrievalStartedNetworking() == value.INVITATIONS_RETRIEVAL_STARTED_NETWORKING_FALSE_VALUE {
+		dispatcher.GetInstance().Dispatch(
+			action.NewSetInvitationsRetrievalStartedNetworkingAction(
+				value.INVITATIONS_RETRIEVAL_STARTED_NETWORKING_TRUE_VALUE))
+
+		stream.GetGetInvitationsSubmitter().Clean(func() {
+			stream.GetGetInvitationsSubmitter().Submit(
+				func(response *metadatav1.GetInvitationsResponse, err error) bool {
+					if err != nil {
+						if store.GetActiveScreen() == value.ACTIVE_SCREEN_MENU_VALUE {
+							dispatcher.GetInstance().Dispatch(
+								action.NewSetInvitationsRetrievalStartedNetworkingAction(
+									value.INVITATIONS_RETRIEVAL_STARTED_NETWORKING_FALSE_VALUE))
+
+							return true
+						}
+
+						notification.GetInstance().Push(
+							common.ComposeMessage(
+								translation.GetInstance().GetTranslation("client.networking.get-invitations-failure"),
+								err.Error()),
+							time.Second*3,
+							common.NotificationErrorTextColor)
+
+						return true
+					}
+
+					for _, invitation := range converter.ConvertGetInvitationsResponseToRetrievedInvitations(response) {
+						notification.GetInstance().Push(
+							GetInvitationText(invitation),
+							time.Second*5,
+							common.NotificationInfoTextColor)
+
+						im.mu.Lock()
+
+						im.pending = append(
+							slices.DeleteFunc(im.pending, func(value dto.RetrievedInvitation) bool {
+								return value.SessionID == invitation.SessionID
+							}), invitation)
+
+						im.mu.Unlock()
+					}
+
+					if store.GetActiveScreen() == value.ACTIVE_SCREEN_MENU_VALUE {
+						dispatcher.GetInstance().Dispatch(
+							action.NewSetInvitationsRetrievalStartedNetworkingAction(
+								value.INVITATIONS_RETRIEVAL_STARTED_NETWORKING_FALSE_VALUE))
+
+						return true
+					}
+
+					return false
+				})
+		})
+	}
+}
+
+// Take retrieves and removes the oldest pending invitation, if there is one.
+func (im *InvitationManager) Take() (dto.RetrievedInvitation, bool) {
+	im.mu.Lock()
+	defer im.mu.Unlock()
+
+	if len(im.pending) == 0 {
+		return dto.RetrievedInvitation{}, false
+	}
+
+	result := im.pending[0]
+
+	im.pending = im.pending[1:]
+
+	return result, true
+}
+
+// Clean performs invitations retrieval close, dropping all the pending invitations.
+func (im *InvitationManager) Clean() {
+	im.mu.Lock()
+
+	im.pending = nil
+
+	im.mu.Unlock()
+
+	stream.GetGetInvitationsSubmitter().Clean(func() {
+		dispatcher.GetInstance().Dispatch(
+			action.NewSetInvitationsRetrievalStartedNetworkingAction(
+				value.INVITATIONS_RETRIEVAL_STARTED_NETWORKING_FALSE_VALUE))
+	})
+}
+
+// GetInvitationText retrieves text of the given invitation, which is shown to the user.
+func GetInvitationText(invitation dto.RetrievedInvitation) string {
+	return fmt.Sprintf(
+		"%s %s %s",
+		invitation.DisplayName,
+		translation.GetInstance().GetTranslation("client.invitationmanager.invited"),
+		invitation.SessionName)
+}
+
+// newInvitationManager initializes InvitationManager.
+func newInvitationManager() *InvitationManager {
+	return new(InvitationManager)
+}
@@ -0,0 +1,24 @@
+package profile
+
+import (
+	"time"
+
+	"github.com/YarikRevich/fate-seekers/services/fate-seekers-client/pkg/core/ui/component/common"
+	"github.com/YarikRevich/fate-seekers/services/fate-seekers-client/pkg/core/ui/manager/notification"
+	"github.com/YarikRevich/fate-seekers/services/fate-seekers-client/pkg/core/ui/manager/translation"
+	"github.com/YarikRevich/fate-seekers/services/fate-seekers-client/pkg/core/ui/validator/displayname"
+)
+
+// ProcessChanges performs provided changes validation.
+func ProcessChanges(displayName string) bool {
+	if !displayname.Validate(displayName) {
+		notification.GetInstance().Push(
+			translation.GetInstance().GetTranslation("client.profilemanager.invalid-display-name"),
+			time.Second*3,
+			common.NotificationErrorTextColor)
+
+		return false
+	}
+
+	return true
+}
@@ -0,0 +1,18 @@
+package displayname
+
+import "regexp"
+
+// Describes allowed display name validation value.
+const (
+	allowedPattern = `^[a-zA-Z0-9_-]{3,16}$`
+)
+
+// Validate performs provided display name value validation.
+func Validate(value string) bool {
+	match, err := regexp.MatchString(allowedPattern, value)
+	if err != nil {
+		return false
+	}
+
+	return match
+}
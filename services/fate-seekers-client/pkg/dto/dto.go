@@ -114,6 +114,9 @@ type FXSoundUnit struct {
 
 	// Rperensets fx sound interruptable mode.
 	Interruptable bool
+
+	// Represents fx sound gain in range from 0 to 1, which is applied to the configured fx volume.
+	Gain float64
 }
 
 // ReducerResult represents result of reducer execution operation.
@@ -176,9 +179,34 @@ type RetrievedChestItems struct {
 
 // RetrievedLobbySetMetadata represents retrieved lobby set holder for reducer components.
 type RetrievedLobbySetMetadata struct {
-	Issuer string
-	Skin   uint64
-	Host   bool
+	Issuer      string
+	DisplayName string
+	Skin        uint64
+	Host        bool
+	Team        uint64
+}
+
+// LobbySetListEntry represents lobby set unit shown in lobby list.
+type LobbySetListEntry struct {
+	DisplayName string
+	Skin        uint64
+}
+
+// FriendsListEntry represents friend or pending friend request shown in friends list.
+type FriendsListEntry struct {
+	FriendshipID int64
+	DisplayName  string
+	Accepted     bool
+	Incoming     bool
+}
+
+// RetrievedInvitation represents retrieved session invitation sent by the friend.
+type RetrievedInvitation struct {
+	DisplayName string
+	SessionID   int64
+	SessionName string
+	Seed        uint64
+	InviteCode  string
 }
 
 // GetFilteredSessionsRequest represents filtered sessions retrieval request.
@@ -186,6 +214,25 @@ type GetFilteredSessionsRequest struct {
 	Name string
 }
 
+// ListPublicSessionsRequest represents public sessions retrieval request.
+type ListPublicSessionsRequest struct {
+	Page      uint32
+	Sort      string
+	Rules     *string
+	FreeSlots bool
+}
+
+// RetrievedPublicSession represents retrieved public session used as a browser list entry.
+type RetrievedPublicSession struct {
+	Name       string
+	Owner      string
+	Players    uint64
+	MaxPlayers uint64
+	Started    bool
+	Rules      string
+	CreatedAt  time.Time
+}
+
 // SelectedSessionMetadata represents selected session metadata.
 type SelectedSessionMetadata struct {
 	ID   int64
@@ -229,10 +276,12 @@ type RetrievedInventoryUnit struct {
 
 // RetrievedUsersMetadataSessionUnit represents retrieved users metadata session content unit.
 type RetrievedUsersMetadataSessionUnit struct {
+	DisplayName        string
 	Health             uint64
 	Skin               uint64
 	Active             bool
 	Eliminated         bool
+	Team               uint64
 	AnimationDirection string
 	AnimationStatic    bool
 	Position           Position
@@ -303,6 +352,12 @@ type SelectableStatic struct {
 	TileWidth, TileHeight int
 }
 
+// SpawnableTile represents spawnable tile, which can be tagged with team.
+type SpawnableTile struct {
+	Position Position
+	Team     uint64
+}
+
 // SoundableTile represents soundable tile.
 type SoundableTile struct {
 	Position              Position
@@ -340,6 +395,21 @@ type ExternalSounderObject struct {
 	Polygon *resolv.ConvexPolygon
 }
 
+// Marker represents emote or ping marker shown in the world.
+type Marker struct {
+	// Represents marker label.
+	Label string
+
+	// Represents marker world position.
+	Position Position
+
+	// Represents time when marker has been added.
+	CreatedAt time.Time
+
+	// Represents if marker sound has already been played.
+	Sounded bool
+}
+
 // RendererPositionItem represents renderer position item.
 type RendererPositionItem struct {
 	Name string
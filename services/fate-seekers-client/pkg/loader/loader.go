@@ -81,6 +81,7 @@ const (
 	TilemapCollidableProperty         = "collidable"
 	TilemapSoundProperty              = "sound"
 	TilemapSpawnableProperty          = "spawnable"
+	TilemapSpawnableTeamProperty      = "spawnable_team"
 	TilemapSelectableProperty         = "selectable"
 	TilemapChestLocationProperty      = "chest_location"
 	TilemapHealthPackLocationProperty = "health_pack_location"
@@ -357,7 +358,7 @@ func (l *Loader) GetMapTilesetStandardChest(selectedMap string) *ebiten.Image {
 // GetMapLayerTiles retrieves map layer tiles for the provided layer.
 func GetMapLayerTiles(layer *tiled.Layer, height, width, tileHeight, tileWidth int) (
 	*btree.Map[float64, []*dto.ProcessedTile],
-	[]dto.Position,
+	[]dto.SpawnableTile,
 	[]dto.Position,
 	[]dto.Position,
 	[]*dto.CollidableTile,
@@ -365,7 +366,7 @@ func GetMapLayerTiles(layer *tiled.Layer, height, width, tileHeight, tileWidth i
 	[]*dto.SelectableTile) {
 	var (
 		result      = btree.NewMap[float64, []*dto.ProcessedTile](32)
-		spawnables  []dto.Position
+		spawnables  []dto.SpawnableTile
 		chests      []dto.Position
 		healthPacks []dto.Position
 		collidables []*dto.CollidableTile
@@ -432,7 +433,10 @@ func GetMapLayerTiles(layer *tiled.Layer, height, width, tileHeight, tileWidth i
 
 					spawnableProperty := tile.Properties.GetBool(TilemapSpawnableProperty)
 					if spawnableProperty {
-						spawnables = append(spawnables, position)
+						spawnables = append(spawnables, dto.SpawnableTile{
+							Position: position,
+							Team:     uint64(max(tile.Properties.GetInt(TilemapSpawnableTeamProperty), 0)),
+						})
 					}
 
 					selectableProperty := tile.Properties.GetBool(TilemapSelectableProperty)
@@ -13,10 +13,11 @@ import (
 )
 
 // PerformLoadMap loads map to different renderer levels calling callback when it ends.
-func PerformLoadMap(tilemap *tiled.Map, callback func(spawnables, chestLocations, healthPackLocations []dto.Position)) {
+func PerformLoadMap(
+	tilemap *tiled.Map, callback func(spawnables []dto.SpawnableTile, chestLocations, healthPackLocations []dto.Position)) {
 	go func() {
 		var (
-			spawnables          []dto.Position
+			spawnables          []dto.SpawnableTile
 			chestLocations      []dto.Position
 			healthPackLocations []dto.Position
 		)
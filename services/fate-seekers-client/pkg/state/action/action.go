@@ -48,6 +48,13 @@ const (
 	SET_CHESTS_RETRIEVAL_STARTED_NETWORKING_ACTION               = "SET_CHESTS_RETRIEVAL_STARTED_NETWORKING_ACTION"
 	SET_HEALTH_PACKS_RETRIEVAL_STARTED_NETWORKING_ACTION         = "SET_HEALTH_PACKS_RETRIEVAL_STARTED_NETWORKING_ACTION"
 	SET_HIT_PLAYER_WITH_FIST_STARTED_NETWORKING_ACTION           = "SET_HIT_PLAYER_WITH_FIST_STARTED_NETWORKING_ACTION"
+	SET_PUBLIC_SESSIONS_RETRIEVAL_STARTED_NETWORKING_ACTION      = "SET_PUBLIC_SESSIONS_RETRIEVAL_STARTED_NETWORKING_ACTION"
+	SET_MATCHMAKING_STARTED_NETWORKING_ACTION                    = "SET_MATCHMAKING_STARTED_NETWORKING_ACTION"
+	SET_CHAT_MESSAGES_RETRIEVAL_STARTED_NETWORKING_ACTION        = "SET_CHAT_MESSAGES_RETRIEVAL_STARTED_NETWORKING_ACTION"
+	SET_EMOTES_RETRIEVAL_STARTED_NETWORKING_ACTION               = "SET_EMOTES_RETRIEVAL_STARTED_NETWORKING_ACTION"
+	SET_PROFILE_UPDATE_STARTED_NETWORKING_ACTION                 = "SET_PROFILE_UPDATE_STARTED_NETWORKING_ACTION"
+	SET_FRIENDS_RETRIEVAL_STARTED_NETWORKING_ACTION              = "SET_FRIENDS_RETRIEVAL_STARTED_NETWORKING_ACTION"
+	SET_INVITATIONS_RETRIEVAL_STARTED_NETWORKING_ACTION          = "SET_INVITATIONS_RETRIEVAL_STARTED_NETWORKING_ACTION"
 )
 
 // Describes all the available state actions for letter reducer.
@@ -121,6 +128,8 @@ const (
 	SET_INVENTORY_OPENED_ACTION                 = "SET_INVENTORY_OPENED_ACTION"
 	SET_CHEST_OPENED_ACTION                     = "SET_CHEST_OPENED_ACTION"
 	SET_SELECTED_POSITION_ACTION                = "SET_SELECTED_POSITION_ACTION"
+	SET_SPECTATING_SESSION_ACTION               = "SET_SPECTATING_SESSION_ACTION"
+	SET_FINISHED_SESSION_ACTION                 = "SET_FINISHED_SESSION_ACTION"
 )
 
 // Describes all the available state actions for collections reducer.
@@ -139,6 +148,11 @@ const (
 	SET_RESET_DEATH_ACTION = "SET_RESET_DEATH_ACTION"
 )
 
+// Describes all the available state actions for replay reducer.
+const (
+	SET_RESET_REPLAY_ACTION = "SET_RESET_REPLAY_ACTION"
+)
+
 // NewSetActiveScreenAction creates new set active screen action.
 func NewSetActiveScreenAction(value string) godux.Action {
 	return godux.Action{
@@ -365,6 +379,62 @@ func NewSetEventRetrievalStartedNetworking(value string) godux.Action {
 	}
 }
 
+// NewSetPublicSessionsRetrievalStartedNetworkingAction creates new set public sessions retrieval started networking action.
+func NewSetPublicSessionsRetrievalStartedNetworkingAction(value string) godux.Action {
+	return godux.Action{
+		Type:  SET_PUBLIC_SESSIONS_RETRIEVAL_STARTED_NETWORKING_ACTION,
+		Value: value,
+	}
+}
+
+// NewSetMatchmakingStartedNetworkingAction creates new set matchmaking started networking action.
+func NewSetMatchmakingStartedNetworkingAction(value string) godux.Action {
+	return godux.Action{
+		Type:  SET_MATCHMAKING_STARTED_NETWORKING_ACTION,
+		Value: value,
+	}
+}
+
+// NewSetChatMessagesRetrievalStartedNetworkingAction creates new set chat messages retrieval started networking action.
+func NewSetChatMessagesRetrievalStartedNetworkingAction(value string) godux.Action {
+	return godux.Action{
+		Type:  SET_CHAT_MESSAGES_RETRIEVAL_STARTED_NETWORKING_ACTION,
+		Value: value,
+	}
+}
+
+// NewSetEmotesRetrievalStartedNetworkingAction creates new set emotes retrieval started networking action.
+func NewSetEmotesRetrievalStartedNetworkingAction(value string) godux.Action {
+	return godux.Action{
+		Type:  SET_EMOTES_RETRIEVAL_STARTED_NETWORKING_ACTION,
+		Value: value,
+	}
+}
+
+// NewSetProfileUpdateStartedNetworkingAction creates new set profile update started networking action.
+func NewSetProfileUpdateStartedNetworkingAction(value string) godux.Action {
+	return godux.Action{
+		Type:  SET_PROFILE_UPDATE_STARTED_NETWORKING_ACTION,
+		Value: value,
+	}
+}
+
+// NewSetFriendsRetrievalStartedNetworkingAction creates new set friends retrieval started networking action.
+func NewSetFriendsRetrievalStartedNetworkingAction(value string) godux.Action {
+	return godux.Action{
+		Type:  SET_FRIENDS_RETRIEVAL_STARTED_NETWORKING_ACTION,
+		Value: value,
+	}
+}
+
+// NewSetInvitationsRetrievalStartedNetworkingAction creates new set invitations retrieval started networking action.
+func NewSetInvitationsRetrievalStartedNetworkingAction(value string) godux.Action {
+	return godux.Action{
+		Type:  SET_INVITATIONS_RETRIEVAL_STARTED_NETWORKING_ACTION,
+		Value: value,
+	}
+}
+
 // NewSetLetterUpdatedAction creates new set letter updated action.
 func NewSetLetterUpdatedAction(value string) godux.Action {
 	return godux.Action{
@@ -680,6 +750,22 @@ func NewSetSelectedPositionSession(value *dto.SelectedObjectDetails) godux.Actio
 	}
 }
 
+// NewSetSpectatingSession creates new set spectating session action.
+func NewSetSpectatingSession(value string) godux.Action {
+	return godux.Action{
+		Type:  SET_SPECTATING_SESSION_ACTION,
+		Value: value,
+	}
+}
+
+// NewSetFinishedSession creates new set finished session action.
+func NewSetFinishedSession(value string) godux.Action {
+	return godux.Action{
+		Type:  SET_FINISHED_SESSION_ACTION,
+		Value: value,
+	}
+}
+
 // NewSetResetCollections creates new set reset collections action.
 func NewSetResetCollections(value string) godux.Action {
 	return godux.Action{
@@ -711,3 +797,11 @@ func NewSetResetDeath(value string) godux.Action {
 		Value: value,
 	}
 }
+
+// NewSetResetReplay creates new set reset replay action.
+func NewSetResetReplay(value string) godux.Action {
+	return godux.Action{
+		Type:  SET_RESET_REPLAY_ACTION,
+		Value: value,
+	}
+}
@@ -26,6 +26,13 @@ const (
 	CHESTS_RETRIEVAL_STARTED_NETWORKING_STATE               = "chests_retrieval_started"
 	HEALTH_PACKS_RETRIEVAL_STARTED_NETWORKING_STATE         = "health_packs_retrieval_started"
 	HIT_PLAYER_WITH_FIST_STARTED_NETWORKING_STATE           = "hit_player_with_fist_started"
+	PUBLIC_SESSIONS_RETRIEVAL_STARTED_NETWORKING_STATE      = "public_sessions_retrieval_started"
+	MATCHMAKING_STARTED_NETWORKING_STATE                    = "matchmaking_started"
+	CHAT_MESSAGES_RETRIEVAL_STARTED_NETWORKING_STATE        = "chat_messages_retrieval_started"
+	EMOTES_RETRIEVAL_STARTED_NETWORKING_STATE               = "emotes_retrieval_started"
+	PROFILE_UPDATE_STARTED_NETWORKING_STATE                 = "profile_update_started"
+	FRIENDS_RETRIEVAL_STARTED_NETWORKING_STATE              = "friends_retrieval_started"
+	INVITATIONS_RETRIEVAL_STARTED_NETWORKING_STATE          = "invitations_retrieval_started"
 )
 
 // NetworkingStateReducer represents reducer used for networking state management.
@@ -74,6 +81,27 @@ func (nsr *NetworkingStateReducer) Init() {
 	nsr.store.SetState(
 		HIT_PLAYER_WITH_FIST_STARTED_NETWORKING_STATE,
 		value.HIT_PLAYER_WITH_FIST_STARTED_NETWORKING_FALSE_STATE)
+	nsr.store.SetState(
+		PUBLIC_SESSIONS_RETRIEVAL_STARTED_NETWORKING_STATE,
+		value.PUBLIC_SESSIONS_RETRIEVAL_STARTED_NETWORKING_FALSE_VALUE)
+	nsr.store.SetState(
+		MATCHMAKING_STARTED_NETWORKING_STATE,
+		value.MATCHMAKING_STARTED_NETWORKING_FALSE_VALUE)
+	nsr.store.SetState(
+		CHAT_MESSAGES_RETRIEVAL_STARTED_NETWORKING_STATE,
+		value.CHAT_MESSAGES_RETRIEVAL_STARTED_NETWORKING_FALSE_VALUE)
+	nsr.store.SetState(
+		EMOTES_RETRIEVAL_STARTED_NETWORKING_STATE,
+		value.EMOTES_RETRIEVAL_STARTED_NETWORKING_FALSE_VALUE)
+	nsr.store.SetState(
+		PROFILE_UPDATE_STARTED_NETWORKING_STATE,
+		value.PROFILE_UPDATE_STARTED_NETWORKING_FALSE_VALUE)
+	nsr.store.SetState(
+		FRIENDS_RETRIEVAL_STARTED_NETWORKING_STATE,
+		value.FRIENDS_RETRIEVAL_STARTED_NETWORKING_FALSE_VALUE)
+	nsr.store.SetState(
+		INVITATIONS_RETRIEVAL_STARTED_NETWORKING_STATE,
+		value.INVITATIONS_RETRIEVAL_STARTED_NETWORKING_FALSE_VALUE)
 }
 
 func (nsr *NetworkingStateReducer) GetProcessor() func(value godux.Action) interface{} {
@@ -159,6 +187,41 @@ func (nsr *NetworkingStateReducer) GetProcessor() func(value godux.Action) inter
 				dto.ReducerResultUnit{
 					Key: HIT_PLAYER_WITH_FIST_STARTED_NETWORKING_STATE, Value: value.Value})
 
+		case action.SET_PUBLIC_SESSIONS_RETRIEVAL_STARTED_NETWORKING_ACTION:
+			return dto.ComposeReducerResult(
+				dto.ReducerResultUnit{
+					Key: PUBLIC_SESSIONS_RETRIEVAL_STARTED_NETWORKING_STATE, Value: value.Value})
+
+		case action.SET_MATCHMAKING_STARTED_NETWORKING_ACTION:
+			return dto.ComposeReducerResult(
+				dto.ReducerResultUnit{
+					Key: MATCHMAKING_STARTED_NETWORKING_STATE, Value: value.Value})
+
+		case action.SET_CHAT_MESSAGES_RETRIEVAL_STARTED_NETWORKING_ACTION:
+			return dto.ComposeReducerResult(
+				dto.ReducerResultUnit{
+					Key: CHAT_MESSAGES_RETRIEVAL_STARTED_NETWORKING_STATE, Value: value.Value})
+
+		case action.SET_EMOTES_RETRIEVAL_STARTED_NETWORKING_ACTION:
+			return dto.ComposeReducerResult(
+				dto.ReducerResultUnit{
+					Key: EMOTES_RETRIEVAL_STARTED_NETWORKING_STATE, Value: value.Value})
+
+		case action.SET_PROFILE_UPDATE_STARTED_NETWORKING_ACTION:
+			return dto.ComposeReducerResult(
+				dto.ReducerResultUnit{
+					Key: PROFILE_UPDATE_STARTED_NETWORKING_STATE, Value: value.Value})
+
+		case action.SET_FRIENDS_RETRIEVAL_STARTED_NETWORKING_ACTION:
+			return dto.ComposeReducerResult(
+				dto.ReducerResultUnit{
+					Key: FRIENDS_RETRIEVAL_STARTED_NETWORKING_STATE, Value: value.Value})
+
+		case action.SET_INVITATIONS_RETRIEVAL_STARTED_NETWORKING_ACTION:
+			return dto.ComposeReducerResult(
+				dto.ReducerResultUnit{
+					Key: INVITATIONS_RETRIEVAL_STARTED_NETWORKING_STATE, Value: value.Value})
+
 		default:
 			return nil
 		}
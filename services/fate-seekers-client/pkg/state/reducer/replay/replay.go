@@ -0,0 +1,44 @@
+package replay
+
+import (
+	"github.com/YarikRevich/fate-seekers/services/fate-seekers-client/pkg/dto"
+	"github.com/YarikRevich/fate-seekers/services/fate-seekers-client/pkg/state/action"
+	"github.com/YarikRevich/fate-seekers/services/fate-seekers-client/pkg/state/reducer"
+	"github.com/YarikRevich/fate-seekers/services/fate-seekers-client/pkg/state/value"
+	"github.com/luisvinicius167/godux"
+)
+
+// Describes all the available replay reducer store states.
+const (
+	RESET_REPLAY_STATE = "reset_replay"
+)
+
+// ReplayStateReducer represents reducer used for replay state management.
+type ReplayStateReducer struct {
+	// Represents of instance of state store.
+	store *godux.Store
+}
+
+func (rsr *ReplayStateReducer) Init() {
+	rsr.store.SetState(RESET_REPLAY_STATE, value.RESET_REPLAY_TRUE_VALUE)
+}
+
+func (rsr *ReplayStateReducer) GetProcessor() func(value godux.Action) interface{} {
+	return func(value godux.Action) interface{} {
+		switch value.Type {
+		case action.SET_RESET_REPLAY_ACTION:
+			return dto.ComposeReducerResult(
+				dto.ReducerResultUnit{Key: RESET_REPLAY_STATE, Value: value.Value})
+
+		default:
+			return nil
+		}
+	}
+}
+
+// NewReplayStateReducer initializes new instance of ReplayStateReducer.
+func NewReplayStateReducer(store *godux.Store) reducer.Reducer {
+	return &ReplayStateReducer{
+		store: store,
+	}
+}
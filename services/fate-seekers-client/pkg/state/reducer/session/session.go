@@ -21,6 +21,8 @@ const (
 	INVENTORY_OPENED_STATE                 = "inventory_opened"
 	CHEST_OPENED_STATE                     = "chest_opened"
 	SELECTED_POSITION_STATE                = "selected_position"
+	SPECTATING_SESSION_STATE               = "spectating"
+	FINISHED_SESSION_STATE                 = "finished"
 )
 
 // SessionStateReducer represents reducer used for session state management.
@@ -41,6 +43,8 @@ func (ssr *SessionStateReducer) Init() {
 	ssr.store.SetState(INVENTORY_OPENED_STATE, value.INVENTORY_OPENED_FALSE_VALUE)
 	ssr.store.SetState(CHEST_OPENED_STATE, value.CHEST_OPENED_FALSE_VALUE)
 	ssr.store.SetState(SELECTED_POSITION_STATE, value.SELECTED_POSITION_EMPTY_VALUE)
+	ssr.store.SetState(SPECTATING_SESSION_STATE, value.SPECTATING_SESSION_FALSE_VALUE)
+	ssr.store.SetState(FINISHED_SESSION_STATE, value.FINISHED_SESSION_FALSE_VALUE)
 }
 
 func (ssr *SessionStateReducer) GetProcessor() func(value godux.Action) interface{} {
@@ -196,6 +200,14 @@ func (ssr *SessionStateReducer) GetProcessor() func(value godux.Action) interfac
 			return dto.ComposeReducerResult(
 				dto.ReducerResultUnit{Key: SELECTED_POSITION_STATE, Value: value.Value})
 
+		case action.SET_SPECTATING_SESSION_ACTION:
+			return dto.ComposeReducerResult(
+				dto.ReducerResultUnit{Key: SPECTATING_SESSION_STATE, Value: value.Value})
+
+		case action.SET_FINISHED_SESSION_ACTION:
+			return dto.ComposeReducerResult(
+				dto.ReducerResultUnit{Key: FINISHED_SESSION_STATE, Value: value.Value})
+
 		default:
 			return nil
 		}
@@ -15,6 +15,7 @@ import (
 	"github.com/YarikRevich/fate-seekers/services/fate-seekers-client/pkg/state/reducer/metadata"
 	"github.com/YarikRevich/fate-seekers/services/fate-seekers-client/pkg/state/reducer/networking"
 	"github.com/YarikRevich/fate-seekers/services/fate-seekers-client/pkg/state/reducer/prompt"
+	"github.com/YarikRevich/fate-seekers/services/fate-seekers-client/pkg/state/reducer/replay"
 	"github.com/YarikRevich/fate-seekers/services/fate-seekers-client/pkg/state/reducer/repository"
 	"github.com/YarikRevich/fate-seekers/services/fate-seekers-client/pkg/state/reducer/screen"
 	"github.com/YarikRevich/fate-seekers/services/fate-seekers-client/pkg/state/reducer/session"
@@ -211,6 +212,55 @@ func GetHistPlayerWithFistStartedNetworking() string {
 	return instance.GetState(networking.HIT_PLAYER_WITH_FIST_STARTED_NETWORKING_STATE).(string)
 }
 
+// GetPublicSessionsRetrievalStartedNetworking retrieves public sessions retrieval started networking state value.
+func GetPublicSessionsRetrievalStartedNetworking() string {
+	instance := GetInstance()
+
+	return instance.GetState(networking.PUBLIC_SESSIONS_RETRIEVAL_STARTED_NETWORKING_STATE).(string)
+}
+
+// GetMatchmakingStartedNetworking retrieves matchmaking started networking state value.
+func GetMatchmakingStartedNetworking() string {
+	instance := GetInstance()
+
+	return instance.GetState(networking.MATCHMAKING_STARTED_NETWORKING_STATE).(string)
+}
+
+// GetChatMessagesRetrievalStartedNetworking retrieves chat messages retrieval started networking state value.
+func GetChatMessagesRetrievalStartedNetworking() string {
+	instance := GetInstance()
+
+	return instance.GetState(networking.CHAT_MESSAGES_RETRIEVAL_STARTED_NETWORKING_STATE).(string)
+}
+
+// GetEmotesRetrievalStartedNetworking retrieves emotes retrieval started networking state value.
+func GetEmotesRetrievalStartedNetworking() string {
+	instance := GetInstance()
+
+	return instance.GetState(networking.EMOTES_RETRIEVAL_STARTED_NETWORKING_STATE).(string)
+}
+
+// GetProfileUpdateStartedNetworking retrieves profile update started networking state value.
+func GetProfileUpdateStartedNetworking() string {
+	instance := GetInstance()
+
+	return instance.GetState(networking.PROFILE_UPDATE_STARTED_NETWORKING_STATE).(string)
+}
+
+// GetFriendsRetrievalStartedNetworking retrieves friends retrieval started networking state value.
+func GetFriendsRetrievalStartedNetworking() string {
+	instance := GetInstance()
+
+	return instance.GetState(networking.FRIENDS_RETRIEVAL_STARTED_NETWORKING_STATE).(string)
+}
+
+// GetInvitationsRetrievalStartedNetworking retrieves invitations retrieval started networking state value.
+func GetInvitationsRetrievalStartedNetworking() string {
+	instance := GetInstance()
+
+	return instance.GetState(networking.INVITATIONS_RETRIEVAL_STARTED_NETWORKING_STATE).(string)
+}
+
 // GetLetterUpdated retrieves letter updated state value.
 func GetLetterUpdated() string {
 	instance := GetInstance()
@@ -407,6 +457,20 @@ func GetSelectedPositionSession() *dto.SelectedObjectDetails {
 	return instance.GetState(session.SELECTED_POSITION_STATE).(*dto.SelectedObjectDetails)
 }
 
+// GetSpectatingSession retrieves spectating session state value.
+func GetSpectatingSession() string {
+	instance := GetInstance()
+
+	return instance.GetState(session.SPECTATING_SESSION_STATE).(string)
+}
+
+// GetFinishedSession retrieves finished session state value.
+func GetFinishedSession() string {
+	instance := GetInstance()
+
+	return instance.GetState(session.FINISHED_SESSION_STATE).(string)
+}
+
 // RetrievedUsersMetadataSessionSyncHelper represents retrieved users metadata session sync helper.
 var RetrievedUsersMetadataSessionSyncHelper sync.Mutex
 
@@ -445,6 +509,13 @@ func GetResetDeath() string {
 	return instance.GetState(death.RESET_DEATH_STATE).(string)
 }
 
+// GetResetReplay retrieves reset replay state value.
+func GetResetReplay() string {
+	instance := GetInstance()
+
+	return instance.GetState(replay.RESET_REPLAY_STATE).(string)
+}
+
 // newStore creates new instance of application store.
 func newStore() *godux.Store {
 	store := godux.NewStore()
@@ -497,6 +568,9 @@ func newStore() *godux.Store {
 	deathReducer := death.NewDeathStateReducer(store)
 	deathReducer.Init()
 
+	replayReducer := replay.NewReplayStateReducer(store)
+	replayReducer.Init()
+
 	store.Reducer(func(action godux.Action) interface{} {
 		result := screenStateReducer.GetProcessor()(action)
 		if result != nil {
@@ -578,6 +652,11 @@ func newStore() *godux.Store {
 			return result
 		}
 
+		result = replayReducer.GetProcessor()(action)
+		if result != nil {
+			return result
+		}
+
 		return nil
 	})
 
@@ -23,6 +23,10 @@ const (
 	ACTIVE_SCREEN_ANSWER_INPUT_VALUE = "answer_input"
 	ACTIVE_SCREEN_RESUME_VALUE       = "resume"
 	ACTIVE_SCREEN_DEATH_VALUE        = "death"
+	ACTIVE_SCREEN_REPLAY_VALUE       = "replay"
+	ACTIVE_SCREEN_BROWSER_VALUE      = "browser"
+	ACTIVE_SCREEN_PROFILE_VALUE      = "profile"
+	ACTIVE_SCREEN_FRIENDS_VALUE      = "friends"
 
 	PREVIOUS_SCREEN_MENU_VALUE   = "menu"
 	PREVIOUS_SCREEN_RESUME_VALUE = "resume"
@@ -104,6 +108,27 @@ const (
 
 	HIT_PLAYER_WITH_FIST_STARTED_NETWORKING_TRUE_STATE  = "true"
 	HIT_PLAYER_WITH_FIST_STARTED_NETWORKING_FALSE_STATE = "false"
+
+	PUBLIC_SESSIONS_RETRIEVAL_STARTED_NETWORKING_TRUE_VALUE  = "true"
+	PUBLIC_SESSIONS_RETRIEVAL_STARTED_NETWORKING_FALSE_VALUE = "false"
+
+	MATCHMAKING_STARTED_NETWORKING_TRUE_VALUE  = "true"
+	MATCHMAKING_STARTED_NETWORKING_FALSE_VALUE = "false"
+
+	CHAT_MESSAGES_RETRIEVAL_STARTED_NETWORKING_TRUE_VALUE  = "true"
+	CHAT_MESSAGES_RETRIEVAL_STARTED_NETWORKING_FALSE_VALUE = "false"
+
+	EMOTES_RETRIEVAL_STARTED_NETWORKING_TRUE_VALUE  = "true"
+	EMOTES_RETRIEVAL_STARTED_NETWORKING_FALSE_VALUE = "false"
+
+	PROFILE_UPDATE_STARTED_NETWORKING_TRUE_VALUE  = "true"
+	PROFILE_UPDATE_STARTED_NETWORKING_FALSE_VALUE = "false"
+
+	FRIENDS_RETRIEVAL_STARTED_NETWORKING_TRUE_VALUE  = "true"
+	FRIENDS_RETRIEVAL_STARTED_NETWORKING_FALSE_VALUE = "false"
+
+	INVITATIONS_RETRIEVAL_STARTED_NETWORKING_TRUE_VALUE  = "true"
+	INVITATIONS_RETRIEVAL_STARTED_NETWORKING_FALSE_VALUE = "false"
 )
 
 // Describes all the available letter reducer store values.
@@ -187,6 +212,10 @@ var (
 	CHEST_OPENED_FALSE_VALUE                                         = "false"
 	CHEST_OPENED_TRUE_VALUE                                          = "true"
 	SELECTED_POSITION_EMPTY_VALUE         *dto.SelectedObjectDetails = nil
+	SPECTATING_SESSION_FALSE_VALUE                                   = "false"
+	SPECTATING_SESSION_TRUE_VALUE                                    = "true"
+	FINISHED_SESSION_FALSE_VALUE                                     = "false"
+	FINISHED_SESSION_TRUE_VALUE                                      = "true"
 )
 
 // Describes available collections reducer store values.
@@ -209,3 +238,9 @@ const (
 	RESET_DEATH_FALSE_VALUE = "false"
 	RESET_DEATH_TRUE_VALUE  = "true"
 )
+
+// Describes available replay reducer store values.
+const (
+	RESET_REPLAY_FALSE_VALUE = "false"
+	RESET_REPLAY_TRUE_VALUE  = "true"
+)
@@ -0,0 +1,375 @@
+package admin
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/YarikRevich/fate-seekers/services/fate-seekers-server/pkg/shared/config"
+	"github.com/YarikRevich/fate-seekers/services/fate-seekers-server/pkg/shared/logging"
+	adminv1 "github.com/YarikRevich/fate-seekers/services/fate-seekers-server/pkg/shared/networking/admin/api"
+	"github.com/YarikRevich/fate-seekers/services/fate-seekers-server/pkg/shared/networking/admin/middleware"
+	"github.com/YarikRevich/fate-seekers/services/fate-seekers-server/pkg/ui/ui/component/common"
+	"github.com/YarikRevich/fate-seekers/services/fate-seekers-server/pkg/ui/ui/manager/translation"
+	"github.com/spf13/cobra"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/protobuf/types/known/durationpb"
+)
+
+const (
+	// Describes timeout applied to each of the admin requests.
+	adminRequestTimeout = time.Second * 10
+
+	// Describes default creator name used for bans.
+	adminDefaultCreator = "admin"
+)
+
+// Init performs initialization of admin command.
+func Init(root *cobra.Command) {
+	var host, credential string
+
+	command := &cobra.Command{
+		Use:   "admin",
+		Short: "Manages running FateSeekers server",
+		Long:  `Manages running FateSeekers server via admin API, which should be enabled in the server configuration.`,
+	}
+
+	command.PersistentFlags().StringVar(&host, "host", "127.0.0.1", "a host of the running server admin API")
+	command.PersistentFlags().StringVar(&credential, "credential", "", "an admin credential, configured value is used by default")
+
+	perform := func(callback func(ctx context.Context, client adminv1.AdminServiceClient) error) {
+		if credential == "" {
+			credential = config.GetSettingsAdminCredential()
+		}
+
+		conn, err := grpc.NewClient(
+			fmt.Sprintf("%s:%s", host, config.GetSettingsAdminPort()),
+			grpc.WithTransportCredentials(insecure.NewCredentials()),
+			grpc.WithPerRPCCredentials(middleware.NewAuthenticationCredentials(credential)))
+		if err != nil {
+			logging.GetInstance().Fatal(
+				common.ComposeMessage(
+					translation.GetInstance().GetTranslation("server.cli.admin.failure"),
+					err.Error()))
+
+			return
+		}
+
+		defer conn.Close()
+
+		ctx, cancel := context.WithTimeout(context.Background(), adminRequestTimeout)
+		defer cancel()
+
+		if err := callback(ctx, adminv1.NewAdminServiceClient(conn)); err != nil {
+			logging.GetInstance().Fatal(
+				common.ComposeMessage(
+					translation.GetInstance().GetTranslation("server.cli.admin.failure"),
+					err.Error()))
+		}
+	}
+
+	command.AddCommand(&cobra.Command{
+		Use:   "sessions",
+		Short: "Lists all the available sessions",
+		Run: func(cmd *cobra.Command, args []string) {
+			perform(func(ctx context.Context, client adminv1.AdminServiceClient) error {
+				response, err := client.ListSessions(ctx, new(adminv1.ListSessionsRequest))
+				if err != nil {
+					return err
+				}
+
+				writer := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+
+				fmt.Fprintln(writer, "ID\tNAME\tSEED\tISSUER\tSTARTED\tLOBBIES\tCACHED\tCREATED")
+
+				for _, session := range response.GetSessions() {
+					fmt.Fprintf(
+						writer,
+						"%d\t%s\t%d\t%s\t%t\t%d\t%t\t%s\n",
+						session.GetSessionId(),
+						session.GetName(),
+						session.GetSeed(),
+						session.GetIssuer(),
+						session.GetStarted(),
+						session.GetLobbies(),
+						session.GetCached(),
+						session.GetCreatedAt().AsTime().Format(time.DateTime))
+				}
+
+				return writer.Flush()
+			})
+		},
+	})
+
+	var sessionID int64
+
+	lobbiesCommand := &cobra.Command{
+		Use:   "lobbies",
+		Short: "Lists all the lobbies of the given session",
+		Run: func(cmd *cobra.Command, args []string) {
+			perform(func(ctx context.Context, client adminv1.AdminServiceClient) error {
+				response, err := client.ListLobbies(ctx, &adminv1.ListLobbiesRequest{
+					SessionId: sessionID,
+				})
+				if err != nil {
+					return err
+				}
+
+				writer := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+
+				fmt.Fprintln(writer, "ID\tISSUER\tSKIN\tHOST\tHEALTH\tACTIVE\tELIMINATED\tPOSITION\tCACHED")
+
+				for _, lobby := range response.GetLobbies() {
+					fmt.Fprintf(
+						writer,
+						"%d\t%s\t%d\t%t\t%d\t%t\t%t\t%.1f,%.1f\t%t\n",
+						lobby.GetLobbyId(),
+						lobby.GetIssuer(),
+						lobby.GetSkin(),
+						lobby.GetHost(),
+						lobby.GetHealth(),
+						lobby.GetActive(),
+						lobby.GetEliminated(),
+						lobby.GetPosition().GetX(),
+						lobby.GetPosition().GetY(),
+						lobby.GetCached())
+				}
+
+				return writer.Flush()
+			})
+		},
+	}
+
+	lobbiesCommand.Flags().Int64Var(&sessionID, "session", 0, "an identifier of the session")
+	lobbiesCommand.MarkFlagRequired("session")
+
+	command.AddCommand(lobbiesCommand)
+
+	endCommand := &cobra.Command{
+		Use:   "end",
+		Short: "Forcefully ends the given session",
+		Run: func(cmd *cobra.Command, args []string) {
+			perform(func(ctx context.Context, client adminv1.AdminServiceClient) error {
+				_, err := client.EndSession(ctx, &adminv1.EndSessionRequest{
+					SessionId: sessionID,
+				})
+				if err != nil {
+					return err
+				}
+
+				logging.GetInstance().Info(
+					translation.GetInstance().GetTranslation("server.cli.admin.end.success"))
+
+				return nil
+			})
+		},
+	}
+
+	endCommand.Flags().Int64Var(&sessionID, "session", 0, "an identifier of the session")
+	endCommand.MarkFlagRequired("session")
+
+	command.AddCommand(endCommand)
+
+	var issuer string
+
+	kickCommand := &cobra.Command{
+		Use:   "kick",
+		Short: "Kicks the given issuer from all or the given session",
+		Run: func(cmd *cobra.Command, args []string) {
+			perform(func(ctx context.Context, client adminv1.AdminServiceClient) error {
+				request := &adminv1.KickIssuerRequest{
+					Issuer: issuer,
+				}
+
+				if cmd.Flags().Changed("session") {
+					request.SessionId = &sessionID
+				}
+
+				_, err := client.KickIssuer(ctx, request)
+				if err != nil {
+					return err
+				}
+
+				logging.GetInstance().Info(
+					translation.GetInstance().GetTranslation("server.cli.admin.kick.success"))
+
+				return nil
+			})
+		},
+	}
+
+	kickCommand.Flags().StringVar(&issuer, "issuer", "", "an issuer to be kicked")
+	kickCommand.Flags().Int64Var(&sessionID, "session", 0, "an identifier of the session to kick from")
+	kickCommand.MarkFlagRequired("issuer")
+
+	command.AddCommand(kickCommand)
+
+	var reason, address, creator string
+
+	var duration time.Duration
+
+	banCommand := &cobra.Command{
+		Use:   "ban",
+		Short: "Bans the given issuer or address",
+		Run: func(cmd *cobra.Command, args []string) {
+			perform(func(ctx context.Context, client adminv1.AdminServiceClient) error {
+				request := &adminv1.BanIssuerRequest{
+					Issuer:  issuer,
+					Address: address,
+					Reason:  reason,
+					Creator: creator,
+				}
+
+				if duration > 0 {
+					request.Duration = durationpb.New(duration)
+				}
+
+				_, err := client.BanIssuer(ctx, request)
+				if err != nil {
+					return err
+				}
+
+				logging.GetInstance().Info(
+					translation.GetInstance().GetTranslation("server.cli.admin.ban.success"))
+
+				return nil
+			})
+		},
+	}
+
+	banCommand.Flags().StringVar(&issuer, "issuer", "", "an issuer to be banned")
+	banCommand.Flags().StringVar(&address, "address", "", "an address to be banned")
+	banCommand.Flags().StringVar(&reason, "reason", "", "a reason of the ban")
+	banCommand.Flags().StringVar(&creator, "creator", adminDefaultCreator, "a creator of the ban")
+	banCommand.Flags().DurationVar(&duration, "duration", 0, "a duration of the ban, permanent by default")
+	banCommand.MarkFlagsOneRequired("issuer", "address")
+	banCommand.MarkFlagsMutuallyExclusive("issuer", "address")
+
+	command.AddCommand(banCommand)
+
+	command.AddCommand(&cobra.Command{
+		Use:   "bans",
+		Short: "Lists all the active bans",
+		Run: func(cmd *cobra.Command, args []string) {
+			perform(func(ctx context.Context, client adminv1.AdminServiceClient) error {
+				response, err := client.ListBans(ctx, new(adminv1.ListBansRequest))
+				if err != nil {
+					return err
+				}
+
+				writer := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+
+				fmt.Fprintln(writer, "ID\tISSUER\tADDRESS\tREASON\tCREATOR\tEXPIRES\tCREATED")
+
+				for _, ban := range response.GetBans() {
+					expiresAt := "-"
+
+					if ban.ExpiresAt != nil {
+						expiresAt = ban.GetExpiresAt().AsTime().Format(time.DateTime)
+					}
+
+					fmt.Fprintf(
+						writer,
+						"%d\t%s\t%s\t%s\t%s\t%s\t%s\n",
+						ban.GetBanId(),
+						ban.GetIssuer(),
+						ban.GetAddress(),
+						ban.GetReason(),
+						ban.GetCreator(),
+						expiresAt,
+						ban.GetCreatedAt().AsTime().Format(time.DateTime))
+				}
+
+				return writer.Flush()
+			})
+		},
+	})
+
+	var banID int64
+
+	unbanCommand := &cobra.Command{
+		Use:   "unban",
+		Short: "Lifts the given ban",
+		Run: func(cmd *cobra.Command, args []string) {
+			perform(func(ctx context.Context, client adminv1.AdminServiceClient) error {
+				_, err := client.LiftBan(ctx, &adminv1.LiftBanRequest{
+					BanId: banID,
+				})
+				if err != nil {
+					return err
+				}
+
+				logging.GetInstance().Info(
+					translation.GetInstance().GetTranslation("server.cli.admin.unban.success"))
+
+				return nil
+			})
+		},
+	}
+
+	unbanCommand.Flags().Int64Var(&banID, "ban", 0, "an identifier of the ban")
+	unbanCommand.MarkFlagRequired("ban")
+
+	command.AddCommand(unbanCommand)
+
+	var message string
+
+	broadcastCommand := &cobra.Command{
+		Use:   "broadcast",
+		Short: "Broadcasts the given message to all the connected users",
+		Run: func(cmd *cobra.Command, args []string) {
+			perform(func(ctx context.Context, client adminv1.AdminServiceClient) error {
+				_, err := client.Broadcast(ctx, &adminv1.BroadcastRequest{
+					Message: message,
+				})
+				if err != nil {
+					return err
+				}
+
+				logging.GetInstance().Info(
+					translation.GetInstance().GetTranslation("server.cli.admin.broadcast.success"))
+
+				return nil
+			})
+		},
+	}
+
+	broadcastCommand.Flags().StringVar(&message, "message", "", "a message to be broadcasted")
+	broadcastCommand.MarkFlagRequired("message")
+
+	command.AddCommand(broadcastCommand)
+
+	var regions []string
+
+	flushCommand := &cobra.Command{
+		Use:   "flush",
+		Short: "Flushes the given cache regions, all of them by default",
+		Run: func(cmd *cobra.Command, args []string) {
+			perform(func(ctx context.Context, client adminv1.AdminServiceClient) error {
+				response, err := client.FlushCache(ctx, &adminv1.FlushCacheRequest{
+					Regions: regions,
+				})
+				if err != nil {
+					return err
+				}
+
+				logging.GetInstance().Info(
+					common.ComposeMessage(
+						translation.GetInstance().GetTranslation("server.cli.admin.flush.success"),
+						strings.Join(response.GetRegions(), ", ")))
+
+				return nil
+			})
+		},
+	}
+
+	flushCommand.Flags().StringSliceVar(&regions, "region", nil, "a cache region to be flushed")
+
+	command.AddCommand(flushCommand)
+
+	root.AddCommand(command)
+}
@@ -0,0 +1,83 @@
+package audit
+
+import (
+	"fmt"
+	"os"
+	"text/tabwriter"
+	"time"
+
+	"github.com/YarikRevich/fate-seekers/services/fate-seekers-server/pkg/shared/audit"
+	"github.com/YarikRevich/fate-seekers/services/fate-seekers-server/pkg/shared/config"
+	"github.com/YarikRevich/fate-seekers/services/fate-seekers-server/pkg/shared/db"
+	"github.com/YarikRevich/fate-seekers/services/fate-seekers-server/pkg/shared/dto"
+	"github.com/YarikRevich/fate-seekers/services/fate-seekers-server/pkg/shared/logging"
+	"github.com/YarikRevich/fate-seekers/services/fate-seekers-server/pkg/ui/ui/component/common"
+	"github.com/YarikRevich/fate-seekers/services/fate-seekers-server/pkg/ui/ui/manager/translation"
+	"github.com/spf13/cobra"
+)
+
+const (
+	// Describes default amount of the latest audit entries to be shown.
+	auditDefaultLimit = 100
+)
+
+// Init performs initialization of audit command.
+func Init(root *cobra.Command) {
+	var (
+		issuer    string
+		sessionID int64
+		limit     int
+	)
+
+	command := &cobra.Command{
+		Use:   "audit",
+		Short: "Queries FateSeekers server audit log",
+		Long: `Queries FateSeekers server audit log by the given session or issuer. Database is used as a source
+if audit persistence is enabled, otherwise audit files in the logging directory are scanned.`,
+		Run: func(cmd *cobra.Command, args []string) {
+			if config.GetSettingsAuditDatabase() {
+				db.Init()
+			}
+
+			entries, err := audit.Query(dto.AuditRepositoryFilterRequest{
+				Issuer:    issuer,
+				SessionID: sessionID,
+				Limit:     limit,
+			})
+			if err != nil {
+				logging.GetInstance().Fatal(
+					common.ComposeMessage(
+						translation.GetInstance().GetTranslation("server.cli.audit.failure"),
+						err.Error()))
+
+				return
+			}
+
+			writer := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+
+			fmt.Fprintln(writer, "TIMESTAMP\tACTION\tISSUER\tTARGET\tSESSION\tDETAILS")
+
+			for _, entry := range entries {
+				fmt.Fprintf(
+					writer,
+					"%s\t%s\t%s\t%s\t%d\t%s\n",
+					entry.Timestamp.Format(time.DateTime),
+					entry.Action,
+					entry.Issuer,
+					entry.Target,
+					entry.SessionID,
+					entry.Details)
+			}
+
+			writer.Flush()
+		},
+	}
+
+	command.Flags().StringVar(&issuer, "issuer", "", "an issuer, which either performed or was affected by the action")
+	command.Flags().Int64Var(&sessionID, "session", 0, "an identifier of the session")
+	command.Flags().IntVar(&limit, "limit", auditDefaultLimit, "a max amount of the latest entries to be shown, all of them if zero")
+
+	command.MarkFlagsOneRequired("issuer", "session")
+
+	root.AddCommand(command)
+}
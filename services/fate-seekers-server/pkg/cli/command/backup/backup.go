@@ -0,0 +1,50 @@
+package backup
+
+import (
+	"fmt"
+	"path/filepath"
+	"time"
+
+	"github.com/YarikRevich/fate-seekers/services/fate-seekers-server/pkg/shared/config"
+	"github.com/YarikRevich/fate-seekers/services/fate-seekers-server/pkg/shared/db"
+	"github.com/YarikRevich/fate-seekers/services/fate-seekers-server/pkg/shared/logging"
+	"github.com/YarikRevich/fate-seekers/services/fate-seekers-server/pkg/ui/ui/component/common"
+	"github.com/YarikRevich/fate-seekers/services/fate-seekers-server/pkg/ui/ui/manager/translation"
+	"github.com/spf13/cobra"
+)
+
+// Init performs initialization of backup command.
+func Init(root *cobra.Command) {
+	var file string
+
+	command := &cobra.Command{
+		Use:   "backup",
+		Short: "Creates FateSeekers server database backup",
+		Long:  `Creates consistent FateSeekers server database snapshot, which can be used later by restore command.`,
+		Run: func(cmd *cobra.Command, args []string) {
+			if file == "" {
+				file = filepath.Join(
+					config.GetDatabaseBackupDirectory(),
+					fmt.Sprintf("%s.%s", filepath.Base(config.GetDatabaseName()), time.Now().Format("20060102150405")))
+			}
+
+			if err := db.Backup(file); err != nil {
+				logging.GetInstance().Fatal(
+					common.ComposeMessage(
+						translation.GetInstance().GetTranslation("server.cli.backup.failure"),
+						err.Error()))
+
+				return
+			}
+
+			logging.GetInstance().Info(
+				common.ComposeMessage(
+					translation.GetInstance().GetTranslation("server.cli.backup.success"),
+					file))
+		},
+	}
+
+	command.Flags().StringVar(&file, "file", "", "a path of the backup file to be created")
+
+	root.AddCommand(command)
+}
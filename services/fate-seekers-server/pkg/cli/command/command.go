@@ -3,6 +3,12 @@ package command
 import (
 	"log"
 
+	"github.com/YarikRevich/fate-seekers/services/fate-seekers-server/pkg/cli/command/admin"
+	"github.com/YarikRevich/fate-seekers/services/fate-seekers-server/pkg/cli/command/audit"
+	"github.com/YarikRevich/fate-seekers/services/fate-seekers-server/pkg/cli/command/backup"
+	"github.com/YarikRevich/fate-seekers/services/fate-seekers-server/pkg/cli/command/exporter"
+	"github.com/YarikRevich/fate-seekers/services/fate-seekers-server/pkg/cli/command/importer"
+	"github.com/YarikRevich/fate-seekers/services/fate-seekers-server/pkg/cli/command/restore"
 	"github.com/YarikRevich/fate-seekers/services/fate-seekers-server/pkg/cli/command/start"
 	"github.com/spf13/cobra"
 )
@@ -19,6 +25,18 @@ func Init() {
 
 	start.Init(root)
 
+	backup.Init(root)
+
+	restore.Init(root)
+
+	exporter.Init(root)
+
+	importer.Init(root)
+
+	admin.Init(root)
+
+	audit.Init(root)
+
 	if err := root.Execute(); err != nil {
 		log.Fatalln(err)
 	}
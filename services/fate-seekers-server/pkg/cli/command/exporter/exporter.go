@@ -0,0 +1,44 @@
+package exporter
+
+import (
+	"github.com/YarikRevich/fate-seekers/services/fate-seekers-server/pkg/shared/db"
+	"github.com/YarikRevich/fate-seekers/services/fate-seekers-server/pkg/shared/logging"
+	"github.com/YarikRevich/fate-seekers/services/fate-seekers-server/pkg/shared/repository/transfer"
+	"github.com/YarikRevich/fate-seekers/services/fate-seekers-server/pkg/ui/ui/component/common"
+	"github.com/YarikRevich/fate-seekers/services/fate-seekers-server/pkg/ui/ui/manager/translation"
+	"github.com/spf13/cobra"
+)
+
+// Init performs initialization of export command.
+func Init(root *cobra.Command) {
+	var file string
+
+	command := &cobra.Command{
+		Use:   "export",
+		Short: "Exports FateSeekers server sessions, users and friendships",
+		Long:  `Exports FateSeekers server sessions, users and friendships to the JSON file.`,
+		Run: func(cmd *cobra.Command, args []string) {
+			db.Init()
+
+			if err := transfer.Export(cmd.Context(), file); err != nil {
+				logging.GetInstance().Fatal(
+					common.ComposeMessage(
+						translation.GetInstance().GetTranslation("server.cli.export.failure"),
+						err.Error()))
+
+				return
+			}
+
+			logging.GetInstance().Info(
+				common.ComposeMessage(
+					translation.GetInstance().GetTranslation("server.cli.export.success"),
+					file))
+		},
+	}
+
+	command.Flags().StringVar(&file, "file", "", "a path of the JSON file to be created")
+
+	command.MarkFlagRequired("file")
+
+	root.AddCommand(command)
+}
@@ -0,0 +1,44 @@
+package importer
+
+import (
+	"github.com/YarikRevich/fate-seekers/services/fate-seekers-server/pkg/shared/db"
+	"github.com/YarikRevich/fate-seekers/services/fate-seekers-server/pkg/shared/logging"
+	"github.com/YarikRevich/fate-seekers/services/fate-seekers-server/pkg/shared/repository/transfer"
+	"github.com/YarikRevich/fate-seekers/services/fate-seekers-server/pkg/ui/ui/component/common"
+	"github.com/YarikRevich/fate-seekers/services/fate-seekers-server/pkg/ui/ui/manager/translation"
+	"github.com/spf13/cobra"
+)
+
+// Init performs initialization of import command.
+func Init(root *cobra.Command) {
+	var file string
+
+	command := &cobra.Command{
+		Use:   "import",
+		Short: "Imports FateSeekers server sessions, users and friendships",
+		Long:  `Imports FateSeekers server sessions, users and friendships from the JSON file, skipping already existing ones.`,
+		Run: func(cmd *cobra.Command, args []string) {
+			db.Init()
+
+			if err := transfer.Import(cmd.Context(), file); err != nil {
+				logging.GetInstance().Fatal(
+					common.ComposeMessage(
+						translation.GetInstance().GetTranslation("server.cli.import.failure"),
+						err.Error()))
+
+				return
+			}
+
+			logging.GetInstance().Info(
+				common.ComposeMessage(
+					translation.GetInstance().GetTranslation("server.cli.import.success"),
+					file))
+		},
+	}
+
+	command.Flags().StringVar(&file, "file", "", "a path of the JSON file to be imported")
+
+	command.MarkFlagRequired("file")
+
+	root.AddCommand(command)
+}
@@ -0,0 +1,43 @@
+package restore
+
+import (
+	"github.com/YarikRevich/fate-seekers/services/fate-seekers-server/pkg/shared/db"
+	"github.com/YarikRevich/fate-seekers/services/fate-seekers-server/pkg/shared/logging"
+	"github.com/YarikRevich/fate-seekers/services/fate-seekers-server/pkg/ui/ui/component/common"
+	"github.com/YarikRevich/fate-seekers/services/fate-seekers-server/pkg/ui/ui/manager/translation"
+	"github.com/spf13/cobra"
+)
+
+// Init performs initialization of restore command.
+func Init(root *cobra.Command) {
+	var file string
+
+	command := &cobra.Command{
+		Use:   "restore",
+		Short: "Restores FateSeekers server database from backup",
+		Long:  `Restores FateSeekers server database from backup. Server must be stopped before the operation.`,
+		Run: func(cmd *cobra.Command, args []string) {
+			if err := db.Restore(file); err != nil {
+				logging.GetInstance().Fatal(
+					common.ComposeMessage(
+						translation.GetInstance().GetTranslation("server.cli.restore.failure"),
+						err.Error()))
+
+				return
+			}
+
+			db.Init()
+
+			logging.GetInstance().Info(
+				common.ComposeMessage(
+					translation.GetInstance().GetTranslation("server.cli.restore.success"),
+					file))
+		},
+	}
+
+	command.Flags().StringVar(&file, "file", "", "a path of the backup file to be restored")
+
+	command.MarkFlagRequired("file")
+
+	root.AddCommand(command)
+}
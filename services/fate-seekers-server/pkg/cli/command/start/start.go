@@ -6,14 +6,19 @@ import (
 	"os/signal"
 	"syscall"
 
+	"github.com/YarikRevich/fate-seekers/services/fate-seekers-server/pkg/shared/audit"
 	"github.com/YarikRevich/fate-seekers/services/fate-seekers-server/pkg/shared/config"
 	"github.com/YarikRevich/fate-seekers/services/fate-seekers-server/pkg/shared/db"
 	"github.com/YarikRevich/fate-seekers/services/fate-seekers-server/pkg/shared/logging"
 	"github.com/YarikRevich/fate-seekers/services/fate-seekers-server/pkg/shared/monitoring/manager"
+	"github.com/YarikRevich/fate-seekers/services/fate-seekers-server/pkg/shared/monitoring/server"
 	"github.com/YarikRevich/fate-seekers/services/fate-seekers-server/pkg/shared/networking/connector"
 	"github.com/YarikRevich/fate-seekers/services/fate-seekers-server/pkg/shared/networking/metadata/events"
+	"github.com/YarikRevich/fate-seekers/services/fate-seekers-server/pkg/shared/networking/metadata/matchmaking"
+	"github.com/YarikRevich/fate-seekers/services/fate-seekers-server/pkg/shared/replay"
 	"github.com/YarikRevich/fate-seekers/services/fate-seekers-server/pkg/shared/repository/dashboards"
 	"github.com/YarikRevich/fate-seekers/services/fate-seekers-server/pkg/shared/repository/sync"
+	"github.com/YarikRevich/fate-seekers/services/fate-seekers-server/pkg/shared/tracing"
 	"github.com/YarikRevich/fate-seekers/services/fate-seekers-server/pkg/shared/validator/encryptionkey"
 	"github.com/YarikRevich/fate-seekers/services/fate-seekers-server/pkg/ui/ui/component/common"
 	"github.com/YarikRevich/fate-seekers/services/fate-seekers-server/pkg/ui/ui/manager/translation"
@@ -31,6 +36,12 @@ func Init(root *cobra.Command) {
 		Short: "Starts FateSeekers server process",
 		Long:  `Starts FateSeekers server process as a blocking operation.`,
 		Run: func(cmd *cobra.Command, args []string) {
+			config.Watch()
+
+			if err := tracing.Init(); err != nil {
+				logging.GetInstance().Fatal(err.Error())
+			}
+
 			db.Init()
 
 			sync.Run()
@@ -39,6 +50,12 @@ func Init(root *cobra.Command) {
 
 			events.Run()
 
+			matchmaking.Run()
+
+			audit.GetInstance().Run()
+
+			replay.GetInstance().Run()
+
 			if !encryptionkey.Validate(config.GetSettingsNetworkingEncryptionKey()) {
 				logging.GetInstance().Fatal(ErrEncryptionKeyValidationFailed.Error())
 
@@ -67,6 +84,18 @@ func Init(root *cobra.Command) {
 								err.Error()))
 					}
 
+					if err := replay.GetInstance().Close(); err != nil {
+						logging.GetInstance().Error(err.Error())
+					}
+
+					if err := audit.GetInstance().Close(); err != nil {
+						logging.GetInstance().Error(err.Error())
+					}
+
+					if err := tracing.Shutdown(); err != nil {
+						logging.GetInstance().Error(err.Error())
+					}
+
 					if config.GetSettingsMonitoringEnabled() {
 						manager.GetInstance().Remove(func(err error) {
 							if err != nil {
@@ -90,6 +119,10 @@ func Init(root *cobra.Command) {
 				})
 			}()
 
+			server.GetInstance().Start(func() {
+				gracefulShutdown <- true
+			})
+
 			connector.GetInstance().Connect(func(err error) {
 				if err != nil {
 					logging.GetInstance().Error(
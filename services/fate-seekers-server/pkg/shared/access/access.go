@@ -0,0 +1,122 @@
+package access
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"math/big"
+	"time"
+
+	"github.com/YarikRevich/fate-seekers/services/fate-seekers-server/pkg/shared/dto"
+	"github.com/YarikRevich/fate-seekers/services/fate-seekers-server/pkg/shared/entity"
+	"github.com/YarikRevich/fate-seekers/services/fate-seekers-server/pkg/shared/repository"
+	"golang.org/x/crypto/bcrypt"
+	"gorm.io/gorm"
+)
+
+var (
+	ErrSessionAccessDenied = errors.New("err happened session access denied, password or invite code is not valid")
+)
+
+const (
+	// Represents length of the generated invite codes.
+	inviteCodeLength = 8
+
+	// Represents symbols used for invite codes generation, which excludes easily confused ones.
+	inviteCodeAlphabet = "ABCDEFGHJKLMNPQRSTUVWXYZ23456789"
+
+	// Represents length of the anonymized owner tags.
+	ownerTagLength = 8
+)
+
+// HashPassword hashes the given session password to be persisted.
+func HashPassword(password string) (string, error) {
+	result, err := bcrypt.GenerateFromPassword([]byte(password), bcrypt.DefaultCost)
+	if err != nil {
+		return "", err
+	}
+
+	return string(result), nil
+}
+
+// GenerateInviteCode generates new random invite code.
+func GenerateInviteCode() (string, error) {
+	result := make([]byte, inviteCodeLength)
+
+	for i := range result {
+		index, err := rand.Int(rand.Reader, big.NewInt(int64(len(inviteCodeAlphabet))))
+		if err != nil {
+			return "", err
+		}
+
+		result[i] = inviteCodeAlphabet[index.Int64()]
+	}
+
+	return string(result), nil
+}
+
+// GetOwnerTag generates anonymized tag of the session owner with the given name, which can
+// be shared publicly, because user names are used as credentials.
+func GetOwnerTag(issuer string) string {
+	result := sha256.Sum256([]byte(issuer))
+
+	return hex.EncodeToString(result[:])[:ownerTagLength]
+}
+
+// Check checks if user with the given id is allowed to join the given session with the provided
+// password or invite code. Public sessions and sessions owned by the user are always allowed.
+// Single use invite is not consumed, but retrieved, so that it can be consumed together with
+// the lobby creation.
+func Check(session dto.CacheSessionEntity, userID int64, password, inviteCode string) (*entity.InviteEntity, error) {
+	if session.Password == "" || session.Issuer == userID {
+		return nil, nil
+	}
+
+	if password != "" &&
+		bcrypt.CompareHashAndPassword([]byte(session.Password), []byte(password)) == nil {
+		return nil, nil
+	}
+
+	if inviteCode == "" {
+		return nil, ErrSessionAccessDenied
+	}
+
+	invite, exists, err := repository.
+		GetInvitesRepository().
+		GetByCode(inviteCode)
+	if err != nil {
+		return nil, err
+	}
+
+	if !exists || invite.SessionID != session.ID || !invite.Valid(time.Now()) {
+		return nil, ErrSessionAccessDenied
+	}
+
+	if invite.SingleUse {
+		return invite, nil
+	}
+
+	return nil, nil
+}
+
+// ConsumeWithTransaction consumes the given single use invite with provided transaction, failing
+// if it has already been consumed concurrently.
+func ConsumeWithTransaction(transaction *gorm.DB, invite *entity.InviteEntity) error {
+	if invite == nil {
+		return nil
+	}
+
+	used, err := repository.
+		GetInvitesRepository().
+		MarkUsedByIDWithTransaction(transaction, invite.ID)
+	if err != nil {
+		return err
+	}
+
+	if !used {
+		return ErrSessionAccessDenied
+	}
+
+	return nil
+}
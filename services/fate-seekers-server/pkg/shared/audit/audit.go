@@ -0,0 +1,294 @@
+package audit
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"os"
+	"path"
+	"path/filepath"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/YarikRevich/fate-seekers/services/fate-seekers-server/pkg/shared/config"
+	"github.com/YarikRevich/fate-seekers/services/fate-seekers-server/pkg/shared/dto"
+	"github.com/YarikRevich/fate-seekers/services/fate-seekers-server/pkg/shared/logging"
+	"github.com/YarikRevich/fate-seekers/services/fate-seekers-server/pkg/shared/repository"
+	"github.com/YarikRevich/fate-seekers/services/fate-seekers-server/pkg/shared/supervisor"
+	"github.com/pkg/errors"
+	"gopkg.in/natefinch/lumberjack.v2"
+)
+
+var (
+	ErrAuditRecordFailed = errors.New("err happened during audit entry record")
+	ErrAuditQueryFailed  = errors.New("err happened during audit entries query")
+)
+
+// Describes all the available audit actions.
+const (
+	ACTION_SESSION_CREATE  = "session_create"
+	ACTION_SESSION_REMOVE  = "session_remove"
+	ACTION_SESSION_START   = "session_start"
+	ACTION_SESSION_INVITE  = "session_invite"
+	ACTION_FRIEND_INVITE   = "friend_invite"
+	ACTION_SESSION_MATCH   = "session_match"
+	ACTION_LOBBY_JOIN      = "lobby_join"
+	ACTION_LOBBY_LEAVE     = "lobby_leave"
+	ACTION_CHEST_OPEN      = "chest_open"
+	ACTION_ITEM_TAKE       = "item_take"
+	ACTION_ITEM_DROP       = "item_drop"
+	ACTION_HEALTH_PACK_USE = "health_pack_use"
+	ACTION_HIT             = "hit"
+	ACTION_ELIMINATION     = "elimination"
+)
+
+const (
+	// Represents name of the worker used for supervision.
+	workerName = "audit"
+
+	// Represents interval between pending entries database flushes.
+	flushInterval = time.Second * 5
+
+	// Represents max size of a single audit file line.
+	maxLineSize = 1024 * 1024
+)
+
+var (
+	// GetInstance retrieves instance of the auditor, performing initial creation if needed.
+	GetInstance = sync.OnceValue[*Auditor](newAuditor)
+)
+
+// Entry represents a single audit log entry.
+type Entry struct {
+	// Represents time the action was performed at.
+	Timestamp time.Time `json:"timestamp"`
+
+	// Represents performed action, which is one of the audit actions.
+	Action string `json:"action"`
+
+	// Represents issuer, who has performed the action.
+	Issuer string `json:"issuer"`
+
+	// Represents issuer affected by the action, if there is one.
+	Target string `json:"target,omitempty"`
+
+	// Represents session the action was performed in.
+	SessionID int64 `json:"session_id"`
+
+	// Represents action specific details.
+	Details string `json:"details,omitempty"`
+}
+
+// Auditor represents audit sink, which writes entries to the rotated JSON lines
+// file and, if enabled, persists them to the database in batches.
+type Auditor struct {
+	// Represents mutex used for writer and pending entries access.
+	mu sync.Mutex
+
+	// Represents rotated audit file writer.
+	writer *lumberjack.Logger
+
+	// Represents entries, which have not been persisted to the database yet.
+	pending []dto.AuditRepositoryInsertRequest
+
+	// Represents if database persistence has already been started.
+	once sync.Once
+}
+
+// Run starts database persistence of the recorded entries, which is performed only once.
+func (a *Auditor) Run() {
+	if !config.GetSettingsAuditEnabled() || !config.GetSettingsAuditDatabase() {
+		return
+	}
+
+	a.once.Do(func() {
+		supervisor.GetInstance().Run(workerName, flushInterval, a.process)
+	})
+}
+
+// Record records the given entry, setting its timestamp if it's not present.
+func (a *Auditor) Record(entry Entry) {
+	if !config.GetSettingsAuditEnabled() {
+		return
+	}
+
+	if entry.Timestamp.IsZero() {
+		entry.Timestamp = time.Now()
+	}
+
+	data, err := json.Marshal(entry)
+	if err != nil {
+		logging.GetInstance().Error(errors.Wrap(err, ErrAuditRecordFailed.Error()).Error())
+
+		return
+	}
+
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	if _, err := a.writer.Write(append(data, '\n')); err != nil {
+		logging.GetInstance().Error(errors.Wrap(err, ErrAuditRecordFailed.Error()).Error())
+	}
+
+	if config.GetSettingsAuditDatabase() {
+		a.pending = append(a.pending, dto.AuditRepositoryInsertRequest{
+			Action:    entry.Action,
+			Issuer:    entry.Issuer,
+			Target:    entry.Target,
+			SessionID: entry.SessionID,
+			Details:   entry.Details,
+			CreatedAt: entry.Timestamp,
+		})
+	}
+}
+
+// Close persists all the pending entries and closes audit file.
+func (a *Auditor) Close() error {
+	if err := a.process(context.Background()); err != nil {
+		return err
+	}
+
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	return a.writer.Close()
+}
+
+// process persists all the pending entries to the database, returning them back on failure.
+func (a *Auditor) process(_ context.Context) error {
+	a.mu.Lock()
+
+	pending := a.pending
+
+	a.pending = nil
+
+	a.mu.Unlock()
+
+	err := repository.
+		GetAuditRepository().
+		InsertBatch(pending)
+	if err != nil {
+		a.mu.Lock()
+
+		a.pending = append(pending, a.pending...)
+
+		a.mu.Unlock()
+
+		return err
+	}
+
+	return nil
+}
+
+// Query retrieves the latest entries, which match the given filter, ordered from the oldest to
+// the latest one. Database is used as a source if persistence is enabled, otherwise all the
+// audit files including rotated ones are scanned.
+func Query(filter dto.AuditRepositoryFilterRequest) ([]Entry, error) {
+	if config.GetSettingsAuditDatabase() {
+		entities, err := repository.
+			GetAuditRepository().
+			GetFiltered(filter)
+		if err != nil {
+			return nil, errors.Wrap(err, ErrAuditQueryFailed.Error())
+		}
+
+		result := make([]Entry, len(entities))
+
+		for i, entity := range entities {
+			result[i] = Entry{
+				Timestamp: entity.CreatedAt,
+				Action:    entity.Action,
+				Issuer:    entity.Issuer,
+				Target:    entity.Target,
+				SessionID: entity.SessionID,
+				Details:   entity.Details,
+			}
+		}
+
+		return result, nil
+	}
+
+	var result []Entry
+
+	for _, file := range getFiles() {
+		entries, err := readFile(file, filter)
+		if err != nil {
+			return nil, errors.Wrap(err, ErrAuditQueryFailed.Error())
+		}
+
+		result = append(result, entries...)
+	}
+
+	if filter.Limit > 0 && len(result) > filter.Limit {
+		result = result[len(result)-filter.Limit:]
+	}
+
+	return result, nil
+}
+
+// getFiles retrieves all the audit files ordered from the oldest to the latest one.
+func getFiles() []string {
+	name := path.Join(config.GetLoggingDirectory(), config.GetSettingsAuditFile())
+
+	extension := filepath.Ext(name)
+
+	// Rotated files are named with the timestamp suffix, which keeps them ordered by name.
+	backups, _ := filepath.Glob(strings.TrimSuffix(name, extension) + "-*" + extension)
+
+	slices.Sort(backups)
+
+	return append(backups, name)
+}
+
+// readFile reads all the entries from the given audit file, which match the given filter.
+func readFile(name string, filter dto.AuditRepositoryFilterRequest) ([]Entry, error) {
+	file, err := os.Open(name)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, nil
+		}
+
+		return nil, err
+	}
+
+	defer file.Close()
+
+	var result []Entry
+
+	scanner := bufio.NewScanner(file)
+	scanner.Buffer(make([]byte, 0, bufio.MaxScanTokenSize), maxLineSize)
+
+	for scanner.Scan() {
+		var entry Entry
+
+		if err := json.Unmarshal(scanner.Bytes(), &entry); err != nil {
+			continue
+		}
+
+		if filter.Issuer != "" && entry.Issuer != filter.Issuer && entry.Target != filter.Issuer {
+			continue
+		}
+
+		if filter.SessionID != 0 && entry.SessionID != filter.SessionID {
+			continue
+		}
+
+		result = append(result, entry)
+	}
+
+	return result, scanner.Err()
+}
+
+// newAuditor initializes Auditor.
+func newAuditor() *Auditor {
+	return &Auditor{
+		writer: &lumberjack.Logger{
+			Filename:   path.Join(config.GetLoggingDirectory(), config.GetSettingsAuditFile()),
+			MaxSize:    100,
+			MaxBackups: 5,
+			MaxAge:     28,
+		},
+	}
+}
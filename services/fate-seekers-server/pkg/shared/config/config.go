@@ -2,17 +2,23 @@ package config
 
 import (
 	"flag"
+	"fmt"
 	"log"
 	"os"
 	"path/filepath"
+	"reflect"
+	"slices"
+	"sync"
 	"time"
 
 	"github.com/YarikRevich/fate-seekers/services/fate-seekers-server/pkg/shared/validator/encryptionkey"
 	"github.com/YarikRevich/fate-seekers/services/fate-seekers-server/pkg/shared/validator/port"
+	"github.com/fsnotify/fsnotify"
 	"github.com/hajimehoshi/ebiten/v2"
 	"github.com/pkg/errors"
 	"github.com/spf13/viper"
 	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
 	"golang.org/x/crypto/blake2b"
 )
 
@@ -21,6 +27,9 @@ var (
 	ErrReadingSettingsLanguageFromConfig                = errors.New("err happened during config file settings language read operation")
 	ErrReadingSettingsNetworkingServerPortFromConfig    = errors.New("err happened during config file networking server port read operation")
 	ErrReadingSettingsNetworkingEncryptionKeyFromConfig = errors.New("err happened during config file networking encryption key read operation")
+	ErrReadingSettingsAdminPortFromConfig               = errors.New("err happened during config file admin port read operation")
+	ErrReadingSettingsAdminCredentialFromConfig         = errors.New("err happened during config file admin credential read operation")
+	ErrValidatingReloadedConfig                         = errors.New("err happened during reloaded config file validation operation")
 )
 
 var (
@@ -37,6 +46,18 @@ var (
 	settingsMonitoringPrometheusName, settingsMonitoringPrometheusPort,
 	settingsMonitoringNetworkName string
 
+	settingsAdminEnabled                                          bool
+	settingsAdminHost, settingsAdminPort, settingsAdminCredential string
+
+	settingsTracingEnabled, settingsTracingInsecure                       bool
+	settingsTracingExporter, settingsTracingEndpoint, settingsTracingFile string
+	settingsTracingSampleRatio                                            float64
+
+	settingsAuditEnabled, settingsAuditDatabase bool
+	settingsAuditFile                           string
+
+	settingsReplayEnabled bool
+
 	settingsSoundFX  int
 	settingsLanguage string
 
@@ -48,16 +69,142 @@ var (
 	operationMaxChestsAmount,
 	operationMinChestsAmount,
 	operationMaxHealthPacksAmount,
-	operationMinHealthPacksAmount int
+	operationMinHealthPacksAmount,
+	operationWorkersMaxFailures,
+	operationTimeseriesCapacity int
+
+	operationTimeseriesInterval time.Duration
+
+	operationEventsPause,
+	operationEventsToxicRainDuration,
+	operationEventsToxicRainFrequency time.Duration
+	operationEventsToxicRainHitRate int
+
+	operationMatchmakingMinPlayers int
+	operationMatchmakingTimeout    time.Duration
 
-	databaseName                 string
-	databaseConnectionRetryDelay time.Duration
+	operationChatMaxLength,
+	operationChatRateLimit int
+	operationChatRateInterval time.Duration
+	operationChatBannedWords  []string
+
+	operationEmoteRadius   float64
+	operationEmoteCooldown time.Duration
+
+	databaseName                  string
+	databaseConnectionRetryDelay  time.Duration
+	databaseConnectionMaxAttempts int
 
 	loggingLevel                  string
 	loggingConsole                bool
 	loggingName, loggingDirectory string
+
+	// Represents mutex used to guard values, which can be changed by the configuration file reload.
+	reloadMutex sync.RWMutex
+
+	// Represents listeners notified after every configuration file reload.
+	reloadListeners []func(result ReloadResult, err error)
 )
 
+// ReloadResult represents outcome of the configuration file reload operation.
+type ReloadResult struct {
+	// Represents configuration keys, which changes have been applied live.
+	Applied []string
+
+	// Represents configuration keys, which changes require server restart and have been rejected.
+	Rejected []string
+}
+
+// Represents configuration values, which can't be changed without server restart, compared against the running ones.
+var reloadUnsafeSettings = map[string]func() bool{
+	"settings.networking.server.port": func() bool {
+		return viper.GetString("settings.networking.server.port") != settingsNetworkingServerPort
+	},
+	"settings.networking.encryption.key": func() bool {
+		return viper.GetString("settings.networking.encryption.key") != settingsNetworkingEncryptionKey
+	},
+	"settings.monitoring.enabled": func() bool {
+		return viper.GetBool("settings.monitoring.enabled") != settingsMonitoringEnabled
+	},
+	"settings.monitoring.prometheus.port": func() bool {
+		return viper.GetString("settings.monitoring.prometheus.port") != settingsMonitoringPrometheusPort
+	},
+	"settings.admin.enabled": func() bool {
+		return viper.GetBool("settings.admin.enabled") != settingsAdminEnabled
+	},
+	"settings.admin.host": func() bool {
+		return viper.GetString("settings.admin.host") != settingsAdminHost
+	},
+	"settings.admin.port": func() bool {
+		return viper.GetString("settings.admin.port") != settingsAdminPort
+	},
+	"settings.admin.credential": func() bool {
+		return viper.GetString("settings.admin.credential") != settingsAdminCredential
+	},
+	"settings.tracing.enabled": func() bool {
+		return viper.GetBool("settings.tracing.enabled") != settingsTracingEnabled
+	},
+	"settings.tracing.exporter": func() bool {
+		return viper.GetString("settings.tracing.exporter") != settingsTracingExporter
+	},
+	"settings.tracing.endpoint": func() bool {
+		return viper.GetString("settings.tracing.endpoint") != settingsTracingEndpoint
+	},
+	"settings.tracing.insecure": func() bool {
+		return viper.GetBool("settings.tracing.insecure") != settingsTracingInsecure
+	},
+	"settings.tracing.sample-ratio": func() bool {
+		return viper.GetFloat64("settings.tracing.sample-ratio") != settingsTracingSampleRatio
+	},
+	"settings.tracing.file": func() bool {
+		return viper.GetString("settings.tracing.file") != settingsTracingFile
+	},
+	"settings.audit.enabled": func() bool {
+		return viper.GetBool("settings.audit.enabled") != settingsAuditEnabled
+	},
+	"settings.audit.database": func() bool {
+		return viper.GetBool("settings.audit.database") != settingsAuditDatabase
+	},
+	"settings.audit.file": func() bool {
+		return viper.GetString("settings.audit.file") != settingsAuditFile
+	},
+	"settings.replay.enabled": func() bool {
+		return viper.GetBool("settings.replay.enabled") != settingsReplayEnabled
+	},
+	"operation.debug": func() bool {
+		return viper.GetBool("operation.debug") != operationDebug
+	},
+	"operation.max-sessions-amount": func() bool {
+		return viper.GetInt("operation.max-sessions-amount") != operationMaxSessionsAmount
+	},
+	"operation.timeseries.interval": func() bool {
+		return viper.GetDuration("operation.timeseries.interval") != operationTimeseriesInterval
+	},
+	"database.name": func() bool {
+		return viper.GetString("database.name") != databaseName
+	},
+	"database.connection-retry-delay": func() bool {
+		return viper.GetDuration("database.connection-retry-delay") != databaseConnectionRetryDelay
+	},
+	"database.connection-max-attempts": func() bool {
+		return viper.GetInt("database.connection-max-attempts") != databaseConnectionMaxAttempts
+	},
+	"logging.console": func() bool {
+		return viper.GetBool("logging.console") != loggingConsole
+	},
+	"logging.name": func() bool {
+		return viper.GetString("logging.name") != loggingName
+	},
+	"logging.directory": func() bool {
+		homeDirectory, err := os.UserHomeDir()
+		if err != nil {
+			return true
+		}
+
+		return filepath.Join(homeDirectory, internalGlobalDirectory, viper.GetString("logging.directory")) != loggingDirectory
+	},
+}
+
 // Represents window configuration.
 const (
 	windowName = "Fate Seekers(Server)"
@@ -81,6 +228,13 @@ const (
 	SETTINGS_LANGUAGE_UKRAINIAN = "uk"
 )
 
+// Represents all the available tracing exporter values.
+const (
+	SETTINGS_TRACING_EXPORTER_OTLP   = "otlp"
+	SETTINGS_TRACING_EXPORTER_STDOUT = "stdout"
+	SETTINGS_TRACING_EXPORTER_FILE   = "file"
+)
+
 // Represents all the available operational settings values.
 const (
 	// One session contains max 8 players.
@@ -100,18 +254,60 @@ const (
 
 	// Item generation max radius value.
 	generationMaxRadius = 100
+
+	// Max amount of consecutive background worker failures before escalation.
+	workersMaxFailures = 5
+
+	// Max amount of database connection attempts before failure.
+	connectionMaxAttempts = 5
+
+	// Interval between in-process metrics samples.
+	timeseriesInterval = time.Second * 5
+
+	// Max amount of in-process metrics samples kept per series.
+	timeseriesCapacity = 120
+
+	// Cache TTL applied to read-through regions, which can always be restored from the storage.
+	readThroughCacheTTL = time.Minute * 10
+
+	// Pause between session events.
+	eventsPause = time.Minute * 1
+
+	// Duration of the toxic rain event.
+	eventsToxicRainDuration = time.Second * 20
+
+	// Interval between toxic rain event hits.
+	eventsToxicRainFrequency = time.Second * 5
+
+	// Health taken by a single toxic rain event hit.
+	eventsToxicRainHitRate = 2
+
+	// Amount of queued players, which are grouped into a new session right away.
+	matchmakingMinPlayers = 4
+
+	// Max wait of the longest queued player, after which available queued players are grouped.
+	matchmakingTimeout = time.Second * 30
+
+	// Max length of a single chat message.
+	chatMaxLength = 200
+
+	// Max amount of chat messages a single user can send per chat rate interval.
+	chatRateLimit = 5
+
+	// Interval, within which chat rate limit is applied.
+	chatRateInterval = time.Second * 10
+
+	// Max distance from the sender, within which emotes and pings are relayed to other users.
+	emoteRadius = 400.0
+
+	// Min interval between two emotes or pings sent by a single user.
+	emoteCooldown = time.Millisecond * 500
 )
 
 // Represents session related static values.
 const (
-	// Max amount of users per session.
+	// Max amount of users per session, which is limited by the amount of available skins.
 	MAX_SESSION_USERS = 8
-
-	// Max chest items to be generated per chest.
-	MAX_CHEST_ITEMS_PER_CHEST = 6
-
-	// Max items in the player inventory.
-	MAX_INVENTORY_ITEMS = 8
 )
 
 // Represents monitoring containers configuration properties.
@@ -161,6 +357,12 @@ const (
 
 	// Represents database directory where all the database files is located.
 	internalDatabaseDirectory = "/internal/database"
+
+	// Represents database backup directory where all the database backup files are located.
+	internalDatabaseBackupDirectory = "/internal/backup"
+
+	// Represents replay directory where all the session replay files are located.
+	internalReplayDirectory = "/internal/replay"
 )
 
 // SetupDefaultConfig initializes default parameters for the configuration file.
@@ -176,6 +378,20 @@ func SetupDefaultConfig() {
 	viper.SetDefault("settings.monitoring.prometheus.name", "fate-seekers-server-prometheus")
 	viper.SetDefault("settings.monitoring.prometheus.port", "8091")
 	viper.SetDefault("settings.monitoring.network.name", "fate-seekers-server-network")
+	viper.SetDefault("settings.admin.enabled", false)
+	viper.SetDefault("settings.admin.host", "127.0.0.1")
+	viper.SetDefault("settings.admin.port", "8093")
+	viper.SetDefault("settings.admin.credential", "")
+	viper.SetDefault("settings.tracing.enabled", false)
+	viper.SetDefault("settings.tracing.exporter", SETTINGS_TRACING_EXPORTER_OTLP)
+	viper.SetDefault("settings.tracing.endpoint", "localhost:4317")
+	viper.SetDefault("settings.tracing.insecure", true)
+	viper.SetDefault("settings.tracing.file", "fate_seekers_traces.json")
+	viper.SetDefault("settings.tracing.sample-ratio", 1.0)
+	viper.SetDefault("settings.audit.enabled", true)
+	viper.SetDefault("settings.audit.file", "fate_seekers_audit.jsonl")
+	viper.SetDefault("settings.audit.database", false)
+	viper.SetDefault("settings.replay.enabled", true)
 	viper.SetDefault("settings.language", SETTINGS_LANGUAGE_ENGLISH)
 	viper.SetDefault("operation.debug", false)
 	viper.SetDefault("operation.max-sessions-amount", maxSessionsAmount)
@@ -184,8 +400,26 @@ func SetupDefaultConfig() {
 	viper.SetDefault("operation.generation.max-radius", generationMaxRadius)
 	viper.SetDefault("operation.max-chests-amount", maxChestsAmount)
 	viper.SetDefault("operation.max-health-packs-amount", maxHealthPacksAmount)
+	viper.SetDefault("operation.workers.max-failures", workersMaxFailures)
+	viper.SetDefault("operation.timeseries.interval", timeseriesInterval)
+	viper.SetDefault("operation.timeseries.capacity", timeseriesCapacity)
+	viper.SetDefault("operation.cache.ttl.users", readThroughCacheTTL)
+	viper.SetDefault("operation.cache.ttl.user-sessions", readThroughCacheTTL)
+	viper.SetDefault("operation.events.pause", eventsPause)
+	viper.SetDefault("operation.events.toxic-rain.duration", eventsToxicRainDuration)
+	viper.SetDefault("operation.events.toxic-rain.frequency", eventsToxicRainFrequency)
+	viper.SetDefault("operation.events.toxic-rain.hit-rate", eventsToxicRainHitRate)
+	viper.SetDefault("operation.matchmaking.min-players", matchmakingMinPlayers)
+	viper.SetDefault("operation.matchmaking.timeout", matchmakingTimeout)
+	viper.SetDefault("operation.chat.max-length", chatMaxLength)
+	viper.SetDefault("operation.chat.rate-limit", chatRateLimit)
+	viper.SetDefault("operation.chat.rate-interval", chatRateInterval)
+	viper.SetDefault("operation.chat.banned-words", []string{})
+	viper.SetDefault("operation.emote.radius", emoteRadius)
+	viper.SetDefault("operation.emote.cooldown", emoteCooldown)
 	viper.SetDefault("database.name", "fate_seekers.db")
 	viper.SetDefault("database.connection-retry-delay", time.Second*3)
+	viper.SetDefault("database.connection-max-attempts", connectionMaxAttempts)
 	viper.SetDefault("logging.level", "info")
 	viper.SetDefault("logging.console", true)
 	viper.SetDefault("logging.name", "fate_seekers.log")
@@ -238,6 +472,36 @@ func Init() {
 	settingsMonitoringPrometheusName = viper.GetString("settings.monitoring.prometheus.name")
 	settingsMonitoringPrometheusPort = viper.GetString("settings.monitoring.prometheus.port")
 	settingsMonitoringNetworkName = viper.GetString("settings.monitoring.network.name")
+	settingsAdminEnabled = viper.GetBool("settings.admin.enabled")
+	settingsAdminHost = viper.GetString("settings.admin.host")
+	settingsAdminPort = viper.GetString("settings.admin.port")
+	settingsAdminCredential = viper.GetString("settings.admin.credential")
+	settingsTracingEnabled = viper.GetBool("settings.tracing.enabled")
+	settingsTracingExporter = viper.GetString("settings.tracing.exporter")
+	settingsTracingEndpoint = viper.GetString("settings.tracing.endpoint")
+	settingsTracingInsecure = viper.GetBool("settings.tracing.insecure")
+	settingsTracingFile = viper.GetString("settings.tracing.file")
+	settingsTracingSampleRatio = viper.GetFloat64("settings.tracing.sample-ratio")
+	settingsAuditEnabled = viper.GetBool("settings.audit.enabled")
+	settingsAuditFile = viper.GetString("settings.audit.file")
+	settingsAuditDatabase = viper.GetBool("settings.audit.database")
+	settingsReplayEnabled = viper.GetBool("settings.replay.enabled")
+
+	if settingsAdminEnabled {
+		if !port.Validate(settingsAdminPort) {
+			log.Fatalln(
+				ErrReadingSettingsAdminPortFromConfig.Error(),
+				zap.String("configFile", *configFile),
+				zap.String("settingsAdminPort", settingsAdminPort))
+		}
+
+		if len(settingsAdminCredential) == 0 {
+			log.Fatalln(
+				ErrReadingSettingsAdminCredentialFromConfig.Error(),
+				zap.String("configFile", *configFile))
+		}
+	}
+
 	settingsSoundFX = viper.GetInt("settings.sound.fx")
 	settingsLanguage = viper.GetString("settings.language")
 
@@ -253,13 +517,17 @@ func Init() {
 
 	operationDebug = viper.GetBool("operation.debug")
 	operationMaxSessionsAmount = viper.GetInt("operation.max-sessions-amount")
-	operationMaxChestsAmount = viper.GetInt("operation.max-chests-amount")
-	operationMinChestsAmount = viper.GetInt("operation.min-chests-amount")
-	operationMaxHealthPacksAmount = viper.GetInt("operation.max-health-packs-amount")
-	operationMinHealthPacksAmount = viper.GetInt("operation.min-health-packs-amount")
+	operationTimeseriesInterval = viper.GetDuration("operation.timeseries.interval")
 	databaseName = viper.GetString("database.name")
 	databaseConnectionRetryDelay = viper.GetDuration("database.connection-retry-delay")
-	loggingLevel = viper.GetString("logging.level")
+	databaseConnectionMaxAttempts = viper.GetInt("database.connection-max-attempts")
+
+	if err := validateReloadable(); err != nil {
+		log.Fatalln(err.Error(), zap.String("configFile", *configFile))
+	}
+
+	applyReloadable()
+
 	loggingConsole = viper.GetBool("logging.console")
 	loggingName = viper.GetString("logging.name")
 
@@ -280,6 +548,173 @@ func Init() {
 	ebiten.SetVsyncEnabled(true)
 }
 
+// Watch starts configuration file watching, which applies safe changes live and rejects the ones requiring restart.
+func Watch() {
+	viper.OnConfigChange(func(event fsnotify.Event) {
+		if !event.Has(fsnotify.Write) && !event.Has(fsnotify.Create) {
+			return
+		}
+
+		result, err := reload()
+
+		reloadMutex.RLock()
+
+		listeners := reloadListeners
+
+		reloadMutex.RUnlock()
+
+		for _, listener := range listeners {
+			listener(result, err)
+		}
+	})
+
+	viper.WatchConfig()
+}
+
+// AddReloadListener registers listener notified after every configuration file reload.
+func AddReloadListener(listener func(result ReloadResult, err error)) {
+	reloadMutex.Lock()
+
+	reloadListeners = append(reloadListeners, listener)
+
+	reloadMutex.Unlock()
+}
+
+// reload performs validation of the re-read configuration file and applies the values, which are safe to change.
+func reload() (ReloadResult, error) {
+	var result ReloadResult
+
+	for key, changed := range reloadUnsafeSettings {
+		if changed() {
+			result.Rejected = append(result.Rejected, key)
+		}
+	}
+
+	slices.Sort(result.Rejected)
+
+	if err := validateReloadable(); err != nil {
+		return result, err
+	}
+
+	previous := getReloadable()
+
+	applyReloadable()
+
+	current := getReloadable()
+
+	for key, value := range current {
+		if !reflect.DeepEqual(previous[key], value) {
+			result.Applied = append(result.Applied, key)
+		}
+	}
+
+	slices.Sort(result.Applied)
+
+	return result, nil
+}
+
+// getReloadable retrieves the running configuration values, which can be changed live.
+func getReloadable() map[string]any {
+	return map[string]any{
+		"operation.max-chests-amount":           GetOperationMaxChestsAmount(),
+		"operation.min-chests-amount":           GetOperationMinChestsAmount(),
+		"operation.max-health-packs-amount":     GetOperationMaxHealthPacksAmount(),
+		"operation.min-health-packs-amount":     GetOperationMinHealthPacksAmount(),
+		"operation.workers.max-failures":        GetOperationWorkersMaxFailures(),
+		"operation.timeseries.capacity":         GetOperationTimeseriesCapacity(),
+		"operation.events.pause":                GetOperationEventsPause(),
+		"operation.events.toxic-rain.duration":  GetOperationEventsToxicRainDuration(),
+		"operation.events.toxic-rain.frequency": GetOperationEventsToxicRainFrequency(),
+		"operation.events.toxic-rain.hit-rate":  GetOperationEventsToxicRainHitRate(),
+		"operation.matchmaking.min-players":     GetOperationMatchmakingMinPlayers(),
+		"operation.matchmaking.timeout":         GetOperationMatchmakingTimeout(),
+		"operation.chat.max-length":             GetOperationChatMaxLength(),
+		"operation.chat.rate-limit":             GetOperationChatRateLimit(),
+		"operation.chat.rate-interval":          GetOperationChatRateInterval(),
+		"operation.chat.banned-words":           GetOperationChatBannedWords(),
+		"operation.emote.radius":                GetOperationEmoteRadius(),
+		"operation.emote.cooldown":              GetOperationEmoteCooldown(),
+		"logging.level":                         GetLoggingLevel(),
+	}
+}
+
+// validateReloadable performs validation of the configuration values, which can be changed live.
+func validateReloadable() error {
+	if _, err := zapcore.ParseLevel(viper.GetString("logging.level")); err != nil {
+		return errors.Wrap(ErrValidatingReloadedConfig, err.Error())
+	}
+
+	if viper.GetInt("operation.min-chests-amount") < 0 ||
+		viper.GetInt("operation.min-chests-amount") > viper.GetInt("operation.max-chests-amount") {
+		return errors.Wrap(ErrValidatingReloadedConfig, "chests amount range is invalid")
+	}
+
+	if viper.GetInt("operation.min-health-packs-amount") < 0 ||
+		viper.GetInt("operation.min-health-packs-amount") > viper.GetInt("operation.max-health-packs-amount") {
+		return errors.Wrap(ErrValidatingReloadedConfig, "health packs amount range is invalid")
+	}
+
+	if viper.GetInt("operation.workers.max-failures") <= 0 {
+		return errors.Wrap(ErrValidatingReloadedConfig, "workers max failures should be positive")
+	}
+
+	if viper.GetInt("operation.timeseries.capacity") <= 0 {
+		return errors.Wrap(ErrValidatingReloadedConfig, "timeseries capacity should be positive")
+	}
+
+	if viper.GetDuration("operation.events.pause") <= 0 ||
+		viper.GetDuration("operation.events.toxic-rain.duration") <= 0 ||
+		viper.GetDuration("operation.events.toxic-rain.frequency") <= 0 ||
+		viper.GetInt("operation.events.toxic-rain.hit-rate") <= 0 {
+		return errors.Wrap(ErrValidatingReloadedConfig, "events tuning values should be positive")
+	}
+
+	if viper.GetInt("operation.matchmaking.min-players") < 2 ||
+		viper.GetInt("operation.matchmaking.min-players") > MAX_SESSION_USERS ||
+		viper.GetDuration("operation.matchmaking.timeout") <= 0 {
+		return errors.Wrap(ErrValidatingReloadedConfig, "matchmaking tuning values are invalid")
+	}
+
+	if viper.GetInt("operation.chat.max-length") <= 0 ||
+		viper.GetInt("operation.chat.rate-limit") <= 0 ||
+		viper.GetDuration("operation.chat.rate-interval") <= 0 {
+		return errors.Wrap(ErrValidatingReloadedConfig, "chat tuning values should be positive")
+	}
+
+	if viper.GetFloat64("operation.emote.radius") <= 0 ||
+		viper.GetDuration("operation.emote.cooldown") <= 0 {
+		return errors.Wrap(ErrValidatingReloadedConfig, "emote tuning values should be positive")
+	}
+
+	return nil
+}
+
+// applyReloadable retrieves the configuration values, which can be changed live.
+func applyReloadable() {
+	reloadMutex.Lock()
+	defer reloadMutex.Unlock()
+
+	operationMaxChestsAmount = viper.GetInt("operation.max-chests-amount")
+	operationMinChestsAmount = viper.GetInt("operation.min-chests-amount")
+	operationMaxHealthPacksAmount = viper.GetInt("operation.max-health-packs-amount")
+	operationMinHealthPacksAmount = viper.GetInt("operation.min-health-packs-amount")
+	operationWorkersMaxFailures = viper.GetInt("operation.workers.max-failures")
+	operationTimeseriesCapacity = viper.GetInt("operation.timeseries.capacity")
+	operationEventsPause = viper.GetDuration("operation.events.pause")
+	operationEventsToxicRainDuration = viper.GetDuration("operation.events.toxic-rain.duration")
+	operationEventsToxicRainFrequency = viper.GetDuration("operation.events.toxic-rain.frequency")
+	operationEventsToxicRainHitRate = viper.GetInt("operation.events.toxic-rain.hit-rate")
+	operationMatchmakingMinPlayers = viper.GetInt("operation.matchmaking.min-players")
+	operationMatchmakingTimeout = viper.GetDuration("operation.matchmaking.timeout")
+	operationChatMaxLength = viper.GetInt("operation.chat.max-length")
+	operationChatRateLimit = viper.GetInt("operation.chat.rate-limit")
+	operationChatRateInterval = viper.GetDuration("operation.chat.rate-interval")
+	operationChatBannedWords = viper.GetStringSlice("operation.chat.banned-words")
+	operationEmoteRadius = viper.GetFloat64("operation.emote.radius")
+	operationEmoteCooldown = viper.GetDuration("operation.emote.cooldown")
+	loggingLevel = viper.GetString("logging.level")
+}
+
 func SetSettingsWindowSize(width, height int) {
 	viper.Set("settings.window.width", width)
 	viper.Set("settings.window.height", height)
@@ -292,9 +727,9 @@ func SetSettingsWindowSize(width, height int) {
 func SetSettingsNetworkingServerPort(value string) {
 	viper.Set("settings.networking.server.port", value)
 
-	viper.WriteConfigAs(viper.ConfigFileUsed())
-
 	settingsNetworkingServerPort = value
+
+	viper.WriteConfigAs(viper.ConfigFileUsed())
 }
 
 func GetSettingsNetworkingServerPort() string {
@@ -304,10 +739,10 @@ func GetSettingsNetworkingServerPort() string {
 func SetSettingsNetworkingEncryptionKey(value string) {
 	viper.Set("settings.networking.encryption.key", value)
 
-	viper.WriteConfigAs(viper.ConfigFileUsed())
-
 	settingsNetworkingEncryptionKey = value
 
+	viper.WriteConfigAs(viper.ConfigFileUsed())
+
 	networkingEncryptionKeyHash, err := blake2b.New256([]byte(value))
 	if err != nil {
 		log.Fatalln(err.Error())
@@ -352,6 +787,62 @@ func GetSettingsMonitoringNetworkName() string {
 	return settingsMonitoringNetworkName
 }
 
+func GetSettingsAdminEnabled() bool {
+	return settingsAdminEnabled
+}
+
+func GetSettingsAdminHost() string {
+	return settingsAdminHost
+}
+
+func GetSettingsAdminPort() string {
+	return settingsAdminPort
+}
+
+func GetSettingsAdminCredential() string {
+	return settingsAdminCredential
+}
+
+func GetSettingsTracingEnabled() bool {
+	return settingsTracingEnabled
+}
+
+func GetSettingsTracingExporter() string {
+	return settingsTracingExporter
+}
+
+func GetSettingsTracingEndpoint() string {
+	return settingsTracingEndpoint
+}
+
+func GetSettingsTracingInsecure() bool {
+	return settingsTracingInsecure
+}
+
+func GetSettingsTracingFile() string {
+	return settingsTracingFile
+}
+
+func GetSettingsTracingSampleRatio() float64 {
+	return settingsTracingSampleRatio
+}
+
+func GetSettingsAuditEnabled() bool {
+	return settingsAuditEnabled
+}
+
+func GetSettingsAuditFile() string {
+	return settingsAuditFile
+}
+
+func GetSettingsAuditDatabase() bool {
+	return settingsAuditDatabase
+}
+
+func GetSettingsReplayEnabled() bool {
+	return settingsReplayEnabled
+}
+
 func SetSettingsLanguage(value string) {
 	viper.Set("settings.language", value)
 
@@ -389,21 +880,141 @@ func GetOperationMaxSessionsAmount() int {
 }
 
 func GetOperationMaxChestsAmount() int {
+	reloadMutex.RLock()
+	defer reloadMutex.RUnlock()
+
 	return operationMaxChestsAmount
 }
 
 func GetOperationMinChestsAmount() int {
+	reloadMutex.RLock()
+	defer reloadMutex.RUnlock()
+
 	return operationMinChestsAmount
 }
 
 func GetOperationMaxHealthPacksAmount() int {
+	reloadMutex.RLock()
+	defer reloadMutex.RUnlock()
+
 	return operationMaxHealthPacksAmount
 }
 
 func GetOperationMinHealthPacksAmount() int {
+	reloadMutex.RLock()
+	defer reloadMutex.RUnlock()
+
 	return operationMinHealthPacksAmount
 }
 
+func GetOperationWorkersMaxFailures() int {
+	reloadMutex.RLock()
+	defer reloadMutex.RUnlock()
+
+	return operationWorkersMaxFailures
+}
+
+func GetOperationTimeseriesInterval() time.Duration {
+	return operationTimeseriesInterval
+}
+
+func GetOperationTimeseriesCapacity() int {
+	reloadMutex.RLock()
+	defer reloadMutex.RUnlock()
+
+	return operationTimeseriesCapacity
+}
+
+func GetOperationEventsPause() time.Duration {
+	reloadMutex.RLock()
+	defer reloadMutex.RUnlock()
+
+	return operationEventsPause
+}
+
+func GetOperationEventsToxicRainDuration() time.Duration {
+	reloadMutex.RLock()
+	defer reloadMutex.RUnlock()
+
+	return operationEventsToxicRainDuration
+}
+
+func GetOperationEventsToxicRainFrequency() time.Duration {
+	reloadMutex.RLock()
+	defer reloadMutex.RUnlock()
+
+	return operationEventsToxicRainFrequency
+}
+
+func GetOperationEventsToxicRainHitRate() int {
+	reloadMutex.RLock()
+	defer reloadMutex.RUnlock()
+
+	return operationEventsToxicRainHitRate
+}
+
+func GetOperationMatchmakingMinPlayers() int {
+	reloadMutex.RLock()
+	defer reloadMutex.RUnlock()
+
+	return operationMatchmakingMinPlayers
+}
+
+func GetOperationMatchmakingTimeout() time.Duration {
+	reloadMutex.RLock()
+	defer reloadMutex.RUnlock()
+
+	return operationMatchmakingTimeout
+}
+
+func GetOperationChatMaxLength() int {
+	reloadMutex.RLock()
+	defer reloadMutex.RUnlock()
+
+	return operationChatMaxLength
+}
+
+func GetOperationChatRateLimit() int {
+	reloadMutex.RLock()
+	defer reloadMutex.RUnlock()
+
+	return operationChatRateLimit
+}
+
+func GetOperationChatRateInterval() time.Duration {
+	reloadMutex.RLock()
+	defer reloadMutex.RUnlock()
+
+	return operationChatRateInterval
+}
+
+func GetOperationEmoteRadius() float64 {
+	reloadMutex.RLock()
+	defer reloadMutex.RUnlock()
+
+	return operationEmoteRadius
+}
+
+func GetOperationEmoteCooldown() time.Duration {
+	reloadMutex.RLock()
+	defer reloadMutex.RUnlock()
+
+	return operationEmoteCooldown
+}
+
+// GetOperationChatBannedWords retrieves words, which are masked in chat messages by the default chat filter.
+func GetOperationChatBannedWords() []string {
+	reloadMutex.RLock()
+	defer reloadMutex.RUnlock()
+
+	return slices.Clone(operationChatBannedWords)
+}
+
+// GetOperationCacheTTL retrieves entries TTL of the given cache region, zero value disables expiration.
+func GetOperationCacheTTL(region string) time.Duration {
+	return viper.GetDuration(fmt.Sprintf("operation.cache.ttl.%s", region))
+}
+
 func GetDatabaseName() string {
 	homeDir, err := os.UserHomeDir()
 	if err != nil {
@@ -413,11 +1024,36 @@ func GetDatabaseName() string {
 	return filepath.Join(homeDir, internalGlobalDirectory, internalDatabaseDirectory, databaseName)
 }
 
+func GetDatabaseBackupDirectory() string {
+	homeDir, err := os.UserHomeDir()
+	if err != nil {
+		log.Fatalln(err)
+	}
+
+	return filepath.Join(homeDir, internalGlobalDirectory, internalDatabaseBackupDirectory)
+}
+
+func GetReplayDirectory() string {
+	homeDir, err := os.UserHomeDir()
+	if err != nil {
+		log.Fatalln(err)
+	}
+
+	return filepath.Join(homeDir, internalGlobalDirectory, internalReplayDirectory)
+}
+
 func GetDatabaseConnectionRetryDelay() time.Duration {
 	return databaseConnectionRetryDelay
 }
 
+func GetDatabaseConnectionMaxAttempts() int {
+	return databaseConnectionMaxAttempts
+}
+
 func GetLoggingLevel() string {
+	reloadMutex.RLock()
+	defer reloadMutex.RUnlock()
+
 	return loggingLevel
 }
 
@@ -1,12 +1,19 @@
 package db
 
 import (
+	"context"
+	"fmt"
+	"io"
 	"log"
+	"os"
+	"path/filepath"
 	"sync"
+	"sync/atomic"
 	"time"
 
 	"github.com/YarikRevich/fate-seekers/services/fate-seekers-server/pkg/shared/config"
 	"github.com/YarikRevich/fate-seekers/services/fate-seekers-server/pkg/shared/db/migrator"
+	"github.com/YarikRevich/fate-seekers/services/fate-seekers-server/pkg/shared/tracing"
 	"github.com/pkg/errors"
 	"github.com/pressly/goose/v3"
 	"gorm.io/driver/sqlite"
@@ -15,17 +22,44 @@ import (
 )
 
 var (
-	ErrDatabaseConnection = errors.New("failed to establish connection to the database")
-	ErrDatabaseMigration  = errors.New("failed to perform database migration")
+	ErrDatabaseConnection  = errors.New("failed to establish connection to the database")
+	ErrDatabaseMigration   = errors.New("failed to perform database migration")
+	ErrDatabaseBackup      = errors.New("failed to perform database backup")
+	ErrDatabaseRestore     = errors.New("failed to perform database restore")
+	ErrBackupFileExists    = errors.New("err happened backup file already exists")
+	ErrBackupFileNotFound  = errors.New("err happened backup file does not exist")
+	ErrDatabaseNotReady    = errors.New("err happened database connection is not established")
+	ErrDatabaseNotMigrated = errors.New("err happened database migrations are not applied")
+)
+
+// Describes database connection properties.
+const (
+	// Represents time during which SQLite waits for a locked database instead of failing with busy error.
+	busyTimeout = time.Second * 5
+)
+
+var (
+	// Represents if database connection has been established.
+	connected atomic.Bool
+
+	// Represents if database migrations have been applied.
+	migrated atomic.Bool
 )
 
 // GetInstance retrieves instance of the database, performing initial connection if needed.
+// Connection is retried with backoff, escalating only after repeated failures.
 var GetInstance = sync.OnceValue[*gorm.DB](func() *gorm.DB {
 	db, err := connect()
 	if err != nil {
 		log.Fatalln(err)
 	}
 
+	if err := registerTracing(db); err != nil {
+		log.Fatalln(err)
+	}
+
+	connected.Store(true)
+
 	return db
 })
 
@@ -36,35 +70,66 @@ func Init() {
 	if err := migrateDatabase(instance); err != nil {
 		log.Fatalln(errors.Wrap(err, ErrDatabaseMigration.Error()))
 	}
+
+	migrated.Store(true)
 }
 
-// connect establishes database connection.
-func connect() (*gorm.DB, error) {
-	retryTicker := time.NewTimer(config.GetDatabaseConnectionRetryDelay())
+// Ping checks database connectivity without establishing a new connection.
+func Ping() error {
+	if !connected.Load() {
+		return ErrDatabaseNotReady
+	}
 
-	var (
-		connection *gorm.DB
-		err        error
-	)
+	instance, err := GetInstance().DB()
+	if err != nil {
+		return err
+	}
 
-	for range retryTicker.C {
-		retryTicker.Stop()
+	return instance.Ping()
+}
+
+// CheckMigrated checks if database migrations have been applied.
+func CheckMigrated() error {
+	if !migrated.Load() {
+		return ErrDatabaseNotMigrated
+	}
+
+	return nil
+}
+
+// connect establishes database connection, retrying it with backoff up to the configured
+// max amount of failures.
+func connect() (*gorm.DB, error) {
+	delay := config.GetDatabaseConnectionRetryDelay()
+
+	var err error
+
+	for attempt := 1; ; attempt++ {
+		var connection *gorm.DB
+
+		connection, err = gorm.Open(
+			sqlite.Open(fmt.Sprintf("%s?_busy_timeout=%d", config.GetDatabaseName(), busyTimeout.Milliseconds())),
+			&gorm.Config{
+				DisableForeignKeyConstraintWhenMigrating: true,
+				TranslateError:                           true,
+				Logger:                                   logger.Default.LogMode(logger.Silent),
+			})
+		if err == nil {
+			return connection, nil
+		}
 
-		connection, err = gorm.Open(sqlite.Open(config.GetDatabaseName()), &gorm.Config{
-			DisableForeignKeyConstraintWhenMigrating: true,
-			Logger:                                   logger.Default.LogMode(logger.Silent),
-		})
-		if err != nil {
-			log.Println(errors.Wrap(err, ErrDatabaseConnection.Error()).Error())
+		log.Println(errors.Wrap(err, ErrDatabaseConnection.Error()).Error())
 
-			retryTicker.Reset(config.GetDatabaseConnectionRetryDelay())
-			continue
+		if attempt >= config.GetDatabaseConnectionMaxAttempts() {
+			break
 		}
 
-		break
+		time.Sleep(delay)
+
+		delay *= 2
 	}
 
-	return connection, nil
+	return nil, errors.Wrap(err, ErrDatabaseConnection.Error())
 }
 
 // migrateDatabase performs database migration.
@@ -88,7 +153,83 @@ func migrateDatabase(src *gorm.DB) error {
 	return src.AutoMigrate()
 }
 
-// BeginTransaction starts a transaction for the provided callback.
-func BeginTransaction(callback func(tx *gorm.DB) error) error {
-	return GetInstance().Transaction(callback)
+// BeginTransaction starts a transaction for the provided callback. Transaction span is started
+// as a child of the span in the given context, statements performed within are its children.
+func BeginTransaction(ctx context.Context, callback func(tx *gorm.DB) error) error {
+	ctx, span := tracing.Start(ctx, "db.transaction")
+
+	err := GetInstance().WithContext(ctx).Transaction(callback)
+
+	tracing.End(span, err)
+
+	return err
+}
+
+// Backup creates a consistent snapshot of the database at the provided path.
+func Backup(path string) error {
+	if _, err := os.Stat(path); err == nil {
+		return ErrBackupFileExists
+	}
+
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		return errors.Wrap(err, ErrDatabaseBackup.Error())
+	}
+
+	if err := GetInstance().Exec("VACUUM INTO ?", path).Error; err != nil {
+		return errors.Wrap(err, ErrDatabaseBackup.Error())
+	}
+
+	return nil
+}
+
+// Restore replaces the database file with the snapshot located at the provided path.
+// It must be called before the database connection is established.
+func Restore(path string) error {
+	src, err := os.Open(path)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return ErrBackupFileNotFound
+		}
+
+		return errors.Wrap(err, ErrDatabaseRestore.Error())
+	}
+
+	defer src.Close()
+
+	databaseName := config.GetDatabaseName()
+
+	if err := os.MkdirAll(filepath.Dir(databaseName), 0755); err != nil {
+		return errors.Wrap(err, ErrDatabaseRestore.Error())
+	}
+
+	temporary := databaseName + ".restore"
+
+	dst, err := os.Create(temporary)
+	if err != nil {
+		return errors.Wrap(err, ErrDatabaseRestore.Error())
+	}
+
+	if _, err := io.Copy(dst, src); err != nil {
+		dst.Close()
+
+		os.Remove(temporary)
+
+		return errors.Wrap(err, ErrDatabaseRestore.Error())
+	}
+
+	if err := dst.Close(); err != nil {
+		os.Remove(temporary)
+
+		return errors.Wrap(err, ErrDatabaseRestore.Error())
+	}
+
+	for _, suffix := range []string{"-wal", "-shm", "-journal"} {
+		os.Remove(databaseName + suffix)
+	}
+
+	if err := os.Rename(temporary, databaseName); err != nil {
+		return errors.Wrap(err, ErrDatabaseRestore.Error())
+	}
+
+	return nil
 }
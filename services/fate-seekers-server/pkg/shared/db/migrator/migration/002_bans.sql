@@ -0,0 +1,32 @@
+-- +goose Up
+-- +goose StatementBegin
+
+--
+-- Name: bans; Type: TABLE; Schema: public;
+--
+
+CREATE TABLE bans (
+    id INTEGER PRIMARY KEY AUTOINCREMENT,
+    issuer TEXT NOT NULL DEFAULT '',
+    address TEXT NOT NULL DEFAULT '',
+    reason TEXT NOT NULL,
+    creator TEXT NOT NULL,
+    expires_at TIMESTAMP,
+    created_at TIMESTAMP NOT NULL
+);
+
+--
+-- Name: idx_bans_issuer; Type: INDEX; Schema: public;
+--
+
+CREATE INDEX idx_bans_issuer
+ON bans (issuer);
+
+--
+-- Name: idx_bans_address; Type: INDEX; Schema: public;
+--
+
+CREATE INDEX idx_bans_address
+ON bans (address);
+
+-- +goose StatementEnd
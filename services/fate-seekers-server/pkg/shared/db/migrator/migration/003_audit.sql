@@ -0,0 +1,39 @@
+-- +goose Up
+-- +goose StatementBegin
+
+--
+-- Name: audit; Type: TABLE; Schema: public;
+--
+
+CREATE TABLE audit (
+    id INTEGER PRIMARY KEY AUTOINCREMENT,
+    action TEXT NOT NULL,
+    issuer TEXT NOT NULL DEFAULT '',
+    target TEXT NOT NULL DEFAULT '',
+    session_id INTEGER NOT NULL DEFAULT 0,
+    details TEXT NOT NULL DEFAULT '',
+    created_at TIMESTAMP NOT NULL
+);
+
+--
+-- Name: idx_audit_issuer; Type: INDEX; Schema: public;
+--
+
+CREATE INDEX idx_audit_issuer
+ON audit (issuer);
+
+--
+-- Name: idx_audit_target; Type: INDEX; Schema: public;
+--
+
+CREATE INDEX idx_audit_target
+ON audit (target);
+
+--
+-- Name: idx_audit_session_id; Type: INDEX; Schema: public;
+--
+
+CREATE INDEX idx_audit_session_id
+ON audit (session_id);
+
+-- +goose StatementEnd
@@ -0,0 +1,10 @@
+-- +goose Up
+-- +goose StatementBegin
+
+--
+-- Name: sessions; Type: TABLE; Schema: public;
+--
+
+ALTER TABLE sessions ADD COLUMN rules TEXT NOT NULL DEFAULT 'classic';
+
+-- +goose StatementEnd
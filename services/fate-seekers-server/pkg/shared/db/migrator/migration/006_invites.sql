@@ -0,0 +1,25 @@
+-- +goose Up
+-- +goose StatementBegin
+
+--
+-- Name: sessions; Type: TABLE; Schema: public;
+--
+
+ALTER TABLE sessions ADD COLUMN password TEXT NOT NULL DEFAULT '';
+
+--
+-- Name: invites; Type: TABLE; Schema: public;
+--
+
+CREATE TABLE invites (
+    id INTEGER PRIMARY KEY AUTOINCREMENT,
+    session_id INTEGER NOT NULL,
+    code TEXT NOT NULL UNIQUE,
+    single_use BOOLEAN NOT NULL DEFAULT FALSE,
+    used BOOLEAN NOT NULL DEFAULT FALSE,
+    expires_at TIMESTAMP,
+    created_at TIMESTAMP NOT NULL,
+    FOREIGN KEY (session_id) REFERENCES sessions(id) ON DELETE CASCADE
+);
+
+-- +goose StatementEnd
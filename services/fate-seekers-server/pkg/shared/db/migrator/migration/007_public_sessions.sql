@@ -0,0 +1,18 @@
+-- +goose Up
+-- +goose StatementBegin
+
+--
+-- Name: idx_sessions_password_created_at; Type: INDEX; Schema: public;
+--
+
+CREATE INDEX idx_sessions_password_created_at
+ON sessions (password, created_at);
+
+--
+-- Name: idx_lobbies_session_id_spectator; Type: INDEX; Schema: public;
+--
+
+CREATE INDEX idx_lobbies_session_id_spectator
+ON lobbies (session_id, spectator);
+
+-- +goose StatementEnd
@@ -0,0 +1,10 @@
+-- +goose Up
+-- +goose StatementBegin
+
+--
+-- Name: lobbies; Type: TABLE; Schema: public;
+--
+
+ALTER TABLE lobbies ADD COLUMN ready BOOLEAN NOT NULL DEFAULT FALSE;
+
+-- +goose StatementEnd
@@ -0,0 +1,10 @@
+-- +goose Up
+-- +goose StatementBegin
+
+--
+-- Name: lobbies; Type: TABLE; Schema: public;
+--
+
+ALTER TABLE lobbies ADD COLUMN team INTEGER NOT NULL DEFAULT 0;
+
+-- +goose StatementEnd
@@ -0,0 +1,22 @@
+-- +goose Up
+-- +goose StatementBegin
+
+--
+-- Name: users; Type: TABLE; Schema: public;
+--
+
+ALTER TABLE users ADD COLUMN display_name TEXT NOT NULL DEFAULT '';
+
+ALTER TABLE users ADD COLUMN skin INTEGER NOT NULL DEFAULT 0;
+
+ALTER TABLE users ADD COLUMN avatar INTEGER NOT NULL DEFAULT 0;
+
+--
+-- Name: idx_users_display_name; Type: INDEX; Schema: public;
+--
+
+CREATE UNIQUE INDEX idx_users_display_name
+ON users (display_name COLLATE NOCASE)
+WHERE display_name != '';
+
+-- +goose StatementEnd
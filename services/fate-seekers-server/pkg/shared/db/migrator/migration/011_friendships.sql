@@ -0,0 +1,26 @@
+-- +goose Up
+-- +goose StatementBegin
+
+--
+-- Name: friendships; Type: TABLE; Schema: public;
+--
+
+CREATE TABLE friendships (
+    id INTEGER PRIMARY KEY AUTOINCREMENT,
+    issuer INTEGER NOT NULL,
+    receiver INTEGER NOT NULL,
+    accepted BOOLEAN NOT NULL DEFAULT FALSE,
+    created_at TIMESTAMP NOT NULL,
+    UNIQUE (issuer, receiver),
+    FOREIGN KEY (issuer) REFERENCES users(id) ON DELETE CASCADE,
+    FOREIGN KEY (receiver) REFERENCES users(id) ON DELETE CASCADE
+);
+
+--
+-- Name: idx_friendships_receiver; Type: INDEX; Schema: public;
+--
+
+CREATE INDEX idx_friendships_receiver
+ON friendships (receiver);
+
+-- +goose StatementEnd
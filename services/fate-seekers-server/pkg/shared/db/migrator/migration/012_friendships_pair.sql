@@ -0,0 +1,22 @@
+-- +goose Up
+-- +goose StatementBegin
+
+--
+-- Name: friendships; Type: TABLE; Schema: public;
+--
+
+DELETE FROM friendships
+WHERE id NOT IN (
+    SELECT MIN(id)
+    FROM friendships
+    GROUP BY MIN(issuer, receiver), MAX(issuer, receiver)
+);
+
+--
+-- Name: idx_friendships_pair; Type: INDEX; Schema: public;
+--
+
+CREATE UNIQUE INDEX idx_friendships_pair
+ON friendships (MIN(issuer, receiver), MAX(issuer, receiver));
+
+-- +goose StatementEnd
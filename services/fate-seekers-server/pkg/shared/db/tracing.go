@@ -0,0 +1,65 @@
+package db
+
+import (
+	"errors"
+
+	"github.com/YarikRevich/fate-seekers/services/fate-seekers-server/pkg/shared/tracing"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+	"gorm.io/gorm"
+)
+
+const (
+	// Represents key of the statement instance value, which holds started span.
+	tracingSpanKey = "tracing:span"
+)
+
+// registerTracing registers callbacks, which wrap each of the statements with span. Span
+// is started as a child of the span in the statement context, so repository calls performed
+// within transaction started with BeginTransaction are attached to the caller trace.
+func registerTracing(connection *gorm.DB) error {
+	callbacks := connection.Callback()
+
+	return errors.Join(
+		callbacks.Create().Before("gorm:create").Register("tracing:before_create", startSpan("create")),
+		callbacks.Create().After("gorm:create").Register("tracing:after_create", endSpan),
+		callbacks.Query().Before("gorm:query").Register("tracing:before_query", startSpan("query")),
+		callbacks.Query().After("gorm:query").Register("tracing:after_query", endSpan),
+		callbacks.Update().Before("gorm:update").Register("tracing:before_update", startSpan("update")),
+		callbacks.Update().After("gorm:update").Register("tracing:after_update", endSpan),
+		callbacks.Delete().Before("gorm:delete").Register("tracing:before_delete", startSpan("delete")),
+		callbacks.Delete().After("gorm:delete").Register("tracing:after_delete", endSpan),
+		callbacks.Row().Before("gorm:row").Register("tracing:before_row", startSpan("row")),
+		callbacks.Row().After("gorm:row").Register("tracing:after_row", endSpan),
+		callbacks.Raw().Before("gorm:raw").Register("tracing:before_raw", startSpan("raw")),
+		callbacks.Raw().After("gorm:raw").Register("tracing:after_raw", endSpan))
+}
+
+// startSpan creates callback, which starts span for the statement with the given operation.
+func startSpan(operation string) func(tx *gorm.DB) {
+	return func(tx *gorm.DB) {
+		ctx, span := tracing.Start(
+			tx.Statement.Context, "db."+operation, attribute.String("db.system", "sqlite"))
+
+		tx.Statement.Context = ctx
+
+		tx.InstanceSet(tracingSpanKey, span)
+	}
+}
+
+// endSpan ends span of the statement, recording its table, query and error.
+func endSpan(tx *gorm.DB) {
+	value, ok := tx.InstanceGet(tracingSpanKey)
+	if !ok {
+		return
+	}
+
+	span := value.(trace.Span)
+
+	span.SetAttributes(
+		attribute.String("db.table", tx.Statement.Table),
+		attribute.String("db.statement", tx.Statement.SQL.String()),
+		attribute.Int64("db.rows_affected", tx.Statement.RowsAffected))
+
+	tracing.End(span, tx.Error)
+}
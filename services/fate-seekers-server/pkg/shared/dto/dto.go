@@ -7,36 +7,6 @@ import (
 	"github.com/hajimehoshi/ebiten/v2/audio"
 )
 
-// Describes all the settings used for inventory management.
-const (
-	MAX_INVENTORY_CAPACITY = 8
-)
-
-// Describes all the settings used for attack distance.
-const (
-	HIT_PLAYER_WITH_FIST_DISTANCE = 80
-)
-
-// Describes all the settings used for attack rates.
-const (
-	HIT_PLAYER_WITH_FIST_RATE = 5
-)
-
-// Describes all the available event duration time.
-const (
-	EVENT_DURATION_TIME_TOXIC_RAIN = time.Second * 20
-)
-
-// Describes all the available event hit rates.
-const (
-	EVENT_HIT_RATE_TOXIC_RAIN = 2
-)
-
-// Describes all the available event frequency rates.
-const (
-	EVENT_FREQUENCY_RATE_TOXIC_RAIN = time.Second * 5
-)
-
 // Describes all the available event names.
 const (
 	EVENT_NAME_TOXIC_RAIN = "toxic_rain"
@@ -168,11 +138,14 @@ func ComposeReducerResult(units ...ReducerResultUnit) ReducerResult {
 
 // SessionsRepositoryInsertOrUpdateRequest represents sessions repository entity update request.
 type SessionsRepositoryInsertOrUpdateRequest struct {
-	ID      int64
-	Name    string
-	Seed    int64
-	Issuer  int64
-	Started bool
+	ID        int64
+	Name      string
+	Seed      int64
+	Issuer    int64
+	Started   bool
+	Rules     string
+	Password  string
+	CreatedAt time.Time
 }
 
 // SessionsRepositoryGetByFiltersRequest represents sessions repository entity get by filters request.
@@ -180,6 +153,51 @@ type SessionsRepositoryGetByFiltersRequest struct {
 	Name string
 }
 
+// Describes all the available public sessions sorting orders.
+const (
+	PUBLIC_SESSIONS_SORT_NEWEST    = "newest"
+	PUBLIC_SESSIONS_SORT_OLDEST    = "oldest"
+	PUBLIC_SESSIONS_SORT_OCCUPANCY = "occupancy"
+	PUBLIC_SESSIONS_SORT_NAME      = "name"
+)
+
+// SessionsRepositoryGetPublicRequest represents sessions repository public sessions retrieval request.
+type SessionsRepositoryGetPublicRequest struct {
+	Offset     int
+	Limit      int
+	Sort       string
+	Rules      *string
+	Started    *bool
+	NamePrefix string
+
+	// Represents if only sessions with free player slots should be retrieved, using max players
+	// per rules preset and the default one for unknown presets.
+	FreeSlots         bool
+	MaxPlayers        map[string]int
+	DefaultMaxPlayers int
+}
+
+// SessionsRepositoryPublicSession represents public session retrieved by sessions repository.
+type SessionsRepositoryPublicSession struct {
+	ID        int64
+	Name      string
+	Issuer    string
+	Started   bool
+	Rules     string
+	Players   int64
+	CreatedAt time.Time
+}
+
+// MatchmakingMatch represents session matched by the matchmaker for a single queued user.
+type MatchmakingMatch struct {
+	SessionID int64
+	Name      string
+	Seed      int64
+	LobbyID   int64
+	Skin      uint64
+	Host      bool
+}
+
 // GenerationsRepositoryInsertOrUpdateRequest represents generations repository entity update request.
 type GenerationsRepositoryInsertOrUpdateRequest struct {
 	ID        int64
@@ -211,6 +229,7 @@ type LobbiesRepositoryInsertOrUpdateRequest struct {
 	Active         bool
 	Host           bool
 	Eliminated     bool
+	Spectator      bool
 	PositionX      float64
 	PositionY      float64
 	PositionStatic bool
@@ -224,12 +243,136 @@ type InventoryRepositoryInsertOrUpdateRequest struct {
 	Name      string
 }
 
+// UsersRepositoryInsertRequest represents users repository entity insert request.
+type UsersRepositoryInsertRequest struct {
+	Name        string
+	DisplayName string
+	Skin        uint64
+	Avatar      uint64
+	CreatedAt   time.Time
+}
+
+// FriendshipsRepositoryInsertRequest represents friendships repository entity insert request.
+type FriendshipsRepositoryInsertRequest struct {
+	Issuer    int64
+	Receiver  int64
+	Accepted  bool
+	CreatedAt time.Time
+}
+
+// BansRepositoryInsertRequest represents bans repository entity insert request.
+type BansRepositoryInsertRequest struct {
+	Issuer    string
+	Address   string
+	Reason    string
+	Creator   string
+	ExpiresAt *time.Time
+}
+
+// InvitesRepositoryInsertRequest represents invites repository entity insert request.
+type InvitesRepositoryInsertRequest struct {
+	SessionID int64
+	Code      string
+	SingleUse bool
+	ExpiresAt *time.Time
+}
+
+// AuditRepositoryInsertRequest represents audit repository entity insert request.
+type AuditRepositoryInsertRequest struct {
+	Action    string
+	Issuer    string
+	Target    string
+	SessionID int64
+	Details   string
+	CreatedAt time.Time
+}
+
+// AuditRepositoryFilterRequest represents audit repository entities filter request.
+type AuditRepositoryFilterRequest struct {
+	Issuer    string
+	SessionID int64
+	Limit     int
+}
+
+// TransferSnapshot represents exported database snapshot used by transfer operations.
+type TransferSnapshot struct {
+	Users       []TransferUserUnit       `json:"users"`
+	Sessions    []TransferSessionUnit    `json:"sessions"`
+	Friendships []TransferFriendshipUnit `json:"friendships"`
+}
+
+// TransferUserUnit represents exported user unit.
+type TransferUserUnit struct {
+	Name        string    `json:"name"`
+	DisplayName string    `json:"display_name"`
+	Skin        uint64    `json:"skin"`
+	Avatar      uint64    `json:"avatar"`
+	CreatedAt   time.Time `json:"created_at"`
+}
+
+// TransferSessionUnit represents exported session unit.
+type TransferSessionUnit struct {
+	Name      string    `json:"name"`
+	Seed      int64     `json:"seed"`
+	Issuer    string    `json:"issuer"`
+	Started   bool      `json:"started"`
+	Rules     string    `json:"rules"`
+	Password  string    `json:"password"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+// TransferFriendshipUnit represents exported friendship unit.
+type TransferFriendshipUnit struct {
+	Issuer    string    `json:"issuer"`
+	Receiver  string    `json:"receiver"`
+	Accepted  bool      `json:"accepted"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+// BroadcastMessageUnit represents broadcast message unit delivered to the connected users.
+type BroadcastMessageUnit struct {
+	ID        int64
+	Text      string
+	CreatedAt time.Time
+}
+
+// ChatMessageUnit represents chat message unit sent to the session.
+type ChatMessageUnit struct {
+	ID        int64
+	Issuer    string
+	Text      string
+	CreatedAt time.Time
+}
+
+// EmoteUnit represents emote or ping unit relayed to the session users.
+type EmoteUnit struct {
+	Issuer    string
+	Emote     uint64
+	Ping      bool
+	PositionX float64
+	PositionY float64
+}
+
+// InvitationUnit represents session invitation unit sent to the friend.
+type InvitationUnit struct {
+	ID          int64
+	DisplayName string
+	SessionID   int64
+	SessionName string
+	Seed        int64
+	InviteCode  string
+	CreatedAt   time.Time
+}
+
 // CacheSessionEntity represent cache session entity used by global networking cache.
 type CacheSessionEntity struct {
-	ID      int64
-	Seed    int64
-	Name    string
-	Started bool
+	ID       int64
+	Seed     int64
+	Name     string
+	Issuer   int64
+	Started  bool
+	Rules    string
+	Password string
 }
 
 // CacheMetadataEntity represent cache metadata entity used by global networking cache.
@@ -243,7 +386,9 @@ type CacheMetadataEntity struct {
 	Health         uint64
 	Active         bool
 	Eliminated     bool
+	Spectator      bool
 	Host           bool
+	Team           uint64
 	Inventory      []CacheInventoryEntity
 }
 
@@ -255,10 +400,14 @@ type CacheInventoryEntity struct {
 
 // CacheLobbySetEntity represent cache lobby set entity used by global networking cache.
 type CacheLobbySetEntity struct {
-	ID     int64
-	Issuer string
-	Skin   uint64
-	Host   bool
+	ID          int64
+	Issuer      string
+	DisplayName string
+	Skin        uint64
+	Host        bool
+	Spectator   bool
+	Ready       bool
+	Team        uint64
 }
 
 // SessionEvent represents session event description.
@@ -3,7 +3,6 @@ package entity
 import (
 	"time"
 
-	"github.com/YarikRevich/fate-seekers/services/fate-seekers-server/pkg/shared/dto"
 	"github.com/YarikRevich/fate-seekers/services/fate-seekers-server/pkg/shared/networking/cache"
 	"gorm.io/gorm"
 )
@@ -15,6 +14,8 @@ type SessionEntity struct {
 	Seed       int64      `gorm:"column:seed;not null"`
 	Issuer     int64      `gorm:"column:issuer;not null"`
 	Started    bool       `gorm:"column:started;not null"`
+	Rules      string     `gorm:"column:rules;not null;default:classic"`
+	Password   string     `gorm:"column:password;not null"`
 	CreatedAt  time.Time  `gorm:"column:created_at;autoCreateTime"`
 	UserEntity UserEntity `gorm:"foreignKey:Issuer;references:ID"`
 }
@@ -29,30 +30,6 @@ func (*SessionEntity) TableView() string {
 	return "SessionEntity"
 }
 
-// BeforeCreate performs sessions cache entity eviction before sessions entity create.
-func (s *SessionEntity) BeforeCreate(tx *gorm.DB) error {
-	if err := tx.
-		Model(&UserEntity{}).
-		Where("id = ?", s.Issuer).
-		First(&s.UserEntity).Error; err != nil {
-		return err
-	}
-
-	cache.
-		GetInstance().
-		EvictSessionsByName(s.Name)
-
-	cache.
-		GetInstance().
-		EvictUserSessions(s.UserEntity.Name)
-
-	cache.
-		GetInstance().
-		EvictLobbySet(s.ID)
-
-	return nil
-}
-
 // GenerationsEntity represents generations entity.
 type GenerationsEntity struct {
 	ID            int64         `gorm:"column:id;primaryKey;auto_increment;not null"`
@@ -77,29 +54,6 @@ func (*GenerationsEntity) TableView() string {
 	return "GenerationsEntity"
 }
 
-// BeforeCreate performs generations cache entity eviction before generations entity create.
-func (g *GenerationsEntity) BeforeCreate(tx *gorm.DB) error {
-	if err := tx.
-		Model(&SessionEntity{}).
-		Where("id = ?", g.SessionID).
-		First(&g.SessionEntity).Error; err != nil {
-		return err
-	}
-
-	switch g.Name {
-	case dto.CHEST_GENERATION_TYPE:
-		cache.
-			GetInstance().
-			EvictGeneratedChests(g.SessionEntity.Name)
-	case dto.HEALTH_PACK_GENERATION_TYPE:
-		cache.
-			GetInstance().
-			EvictGeneratedHealthPacks(g.SessionEntity.Name)
-	}
-
-	return nil
-}
-
 // AssociationsEntity represents associations entity.
 type AssociationsEntity struct {
 	ID                int64             `gorm:"column:id;primaryKey;auto_increment;not null"`
@@ -133,6 +87,9 @@ type LobbyEntity struct {
 	Active         bool          `gorm:"column:active;not null"`
 	Host           bool          `gorm:"column:host;not null"`
 	Eliminated     bool          `gorm:"column:eliminated;not null"`
+	Spectator      bool          `gorm:"column:spectator;not null;default:false"`
+	Ready          bool          `gorm:"column:ready;not null;default:false"`
+	Team           int64         `gorm:"column:team;not null;default:0"`
 	PositionX      float64       `gorm:"column:position_x;not null"`
 	PositionY      float64       `gorm:"column:position_y;not null"`
 	PositionStatic bool          `gorm:"column:position_static;not null"`
@@ -151,15 +108,6 @@ func (*LobbyEntity) TableView() string {
 	return "LobbyEntity"
 }
 
-// BeforeCreate performs lobbies cache entity eviction before lobbies entity create.
-func (l *LobbyEntity) BeforeCreate(tx *gorm.DB) error {
-	cache.
-		GetInstance().
-		EvictLobbySet(l.SessionID)
-
-	return nil
-}
-
 // InventoryEntity represents inventory entity.
 type InventoryEntity struct {
 	ID            int64         `gorm:"column:id;primaryKey;auto_increment;not null"`
@@ -185,9 +133,12 @@ func (*InventoryEntity) TableView() string {
 
 // UserEntity represents users entity.
 type UserEntity struct {
-	ID        int64     `gorm:"column:id;primaryKey;auto_increment;not null"`
-	Name      string    `gorm:"column:name;not null;unique"`
-	CreatedAt time.Time `gorm:"column:created_at;autoCreateTime"`
+	ID          int64     `gorm:"column:id;primaryKey;auto_increment;not null"`
+	Name        string    `gorm:"column:name;not null;unique"`
+	DisplayName string    `gorm:"column:display_name;not null;default:''"`
+	Skin        int64     `gorm:"column:skin;not null;default:0"`
+	Avatar      int64     `gorm:"column:avatar;not null;default:0"`
+	CreatedAt   time.Time `gorm:"column:created_at;autoCreateTime"`
 }
 
 // TableName retrieves name of database table.
@@ -208,3 +159,106 @@ func (u *UserEntity) AfterFind(tx *gorm.DB) error {
 
 	return nil
 }
+
+// BanEntity represents bans entity.
+type BanEntity struct {
+	ID        int64      `gorm:"column:id;primaryKey;auto_increment;not null"`
+	Issuer    string     `gorm:"column:issuer;not null"`
+	Address   string     `gorm:"column:address;not null"`
+	Reason    string     `gorm:"column:reason;not null"`
+	Creator   string     `gorm:"column:creator;not null"`
+	ExpiresAt *time.Time `gorm:"column:expires_at"`
+	CreatedAt time.Time  `gorm:"column:created_at;autoCreateTime"`
+}
+
+// TableName retrieves name of database table.
+func (*BanEntity) TableName() string {
+	return "bans"
+}
+
+// TableView retrieves name of database table view.
+func (*BanEntity) TableView() string {
+	return "BanEntity"
+}
+
+// Active checks if ban is still active at the provided time.
+func (b *BanEntity) Active(now time.Time) bool {
+	return b.ExpiresAt == nil || b.ExpiresAt.After(now)
+}
+
+// InviteEntity represents session invites entity.
+type InviteEntity struct {
+	ID        int64      `gorm:"column:id;primaryKey;auto_increment;not null"`
+	SessionID int64      `gorm:"column:session_id;not null"`
+	Code      string     `gorm:"column:code;not null;unique"`
+	SingleUse bool       `gorm:"column:single_use;not null"`
+	Used      bool       `gorm:"column:used;not null"`
+	ExpiresAt *time.Time `gorm:"column:expires_at"`
+	CreatedAt time.Time  `gorm:"column:created_at;autoCreateTime"`
+}
+
+// TableName retrieves name of database table.
+func (*InviteEntity) TableName() string {
+	return "invites"
+}
+
+// TableView retrieves name of database table view.
+func (*InviteEntity) TableView() string {
+	return "InviteEntity"
+}
+
+// Valid checks if invite can still be used at the provided time.
+func (i *InviteEntity) Valid(now time.Time) bool {
+	return !i.Used && (i.ExpiresAt == nil || i.ExpiresAt.After(now))
+}
+
+// AuditEntity represents audit entity.
+type AuditEntity struct {
+	ID        int64     `gorm:"column:id;primaryKey;auto_increment;not null"`
+	Action    string    `gorm:"column:action;not null"`
+	Issuer    string    `gorm:"column:issuer;not null"`
+	Target    string    `gorm:"column:target;not null"`
+	SessionID int64     `gorm:"column:session_id;not null"`
+	Details   string    `gorm:"column:details;not null"`
+	CreatedAt time.Time `gorm:"column:created_at;not null"`
+}
+
+// TableName retrieves name of database table.
+func (*AuditEntity) TableName() string {
+	return "audit"
+}
+
+// TableView retrieves name of database table view.
+func (*AuditEntity) TableView() string {
+	return "AuditEntity"
+}
+
+// FriendshipEntity represents friendship entity, which is pending until accepted by the receiver.
+type FriendshipEntity struct {
+	ID             int64      `gorm:"column:id;primaryKey;auto_increment;not null"`
+	Issuer         int64      `gorm:"column:issuer;not null"`
+	Receiver       int64      `gorm:"column:receiver;not null"`
+	Accepted       bool       `gorm:"column:accepted;not null"`
+	CreatedAt      time.Time  `gorm:"column:created_at;autoCreateTime"`
+	IssuerEntity   UserEntity `gorm:"foreignKey:Issuer;references:ID"`
+	ReceiverEntity UserEntity `gorm:"foreignKey:Receiver;references:ID"`
+}
+
+// TableName retrieves name of database table.
+func (*FriendshipEntity) TableName() string {
+	return "friendships"
+}
+
+// TableView retrieves name of database table view.
+func (*FriendshipEntity) TableView() string {
+	return "FriendshipEntity"
+}
+
+// GetCounterpart retrieves user entity of the other side of the friendship for the user with the given id.
+func (f *FriendshipEntity) GetCounterpart(userID int64) UserEntity {
+	if f.Issuer == userID {
+		return f.ReceiverEntity
+	}
+
+	return f.IssuerEntity
+}
@@ -1,12 +1,15 @@
 package logging
 
 import (
+	"context"
 	"log"
 	"os"
 	"path"
+	"slices"
 	"sync"
 
 	"github.com/YarikRevich/fate-seekers/services/fate-seekers-server/pkg/shared/config"
+	"go.opentelemetry.io/otel/trace"
 	"go.uber.org/zap"
 	"go.uber.org/zap/zapcore"
 	"gopkg.in/natefinch/lumberjack.v2"
@@ -17,6 +20,23 @@ var (
 	GetInstance = sync.OnceValue[*zap.Logger](configure)
 )
 
+var (
+	// Represents logging level, which can be changed by the configuration file reload.
+	level = zap.NewAtomicLevel()
+)
+
+// WithContext retrieves logger, which includes trace and span identifiers of the span in the given context.
+func WithContext(ctx context.Context) *zap.Logger {
+	spanContext := trace.SpanContextFromContext(ctx)
+	if !spanContext.IsValid() {
+		return GetInstance()
+	}
+
+	return GetInstance().With(
+		zap.String("trace_id", spanContext.TraceID().String()),
+		zap.String("span_id", spanContext.SpanID().String()))
+}
+
 // setup performs logger configuration with the help of pre-defined configuration.
 func configure() *zap.Logger {
 	inputWriter := &lumberjack.Logger{
@@ -54,11 +74,23 @@ func configure() *zap.Logger {
 		}
 	}
 
-	err = loggingConfig.Level.UnmarshalText([]byte(config.GetLoggingLevel()))
+	err = level.UnmarshalText([]byte(config.GetLoggingLevel()))
 	if err != nil {
 		log.Fatalln(err)
 	}
 
+	loggingConfig.Level = level
+
+	config.AddReloadListener(func(result config.ReloadResult, err error) {
+		if err != nil || !slices.Contains(result.Applied, "logging.level") {
+			return
+		}
+
+		if err := level.UnmarshalText([]byte(config.GetLoggingLevel())); err != nil {
+			log.Println(err)
+		}
+	})
+
 	loggingConfig.EncoderConfig.TimeKey = "timestamp"
 	loggingConfig.EncoderConfig.EncodeTime = zapcore.ISO8601TimeEncoder
 
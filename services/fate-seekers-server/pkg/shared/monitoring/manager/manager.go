@@ -197,7 +197,7 @@ func newMonitoringManager() *MonitoringManager {
 
 	return &MonitoringManager{
 		dockerClient:        dockerClient,
-		server:              server.NewMonitoringServer(),
+		server:              server.GetInstance(),
 		networkComponent:    network.NewNetworkComponent(dockerClient),
 		grafanaComponent:    grafana.NewGrafanaComponent(dockerClient),
 		prometheusComponent: prometheus.NewPrometheusComponent(dockerClient),
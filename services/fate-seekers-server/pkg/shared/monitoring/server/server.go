@@ -1,30 +1,111 @@
 package server
 
 import (
+	_ "embed"
+	"encoding/json"
 	"fmt"
+	"sync"
+	"sync/atomic"
 
 	"github.com/YarikRevich/fate-seekers/services/fate-seekers-server/pkg/shared/config"
+	"github.com/YarikRevich/fate-seekers/services/fate-seekers-server/pkg/shared/db"
 	"github.com/YarikRevich/fate-seekers/services/fate-seekers-server/pkg/shared/logging"
 	"github.com/YarikRevich/fate-seekers/services/fate-seekers-server/pkg/shared/monitoring/services"
+	"github.com/YarikRevich/fate-seekers/services/fate-seekers-server/pkg/shared/monitoring/timeseries"
+	"github.com/YarikRevich/fate-seekers/services/fate-seekers-server/pkg/shared/networking/connector"
+	"github.com/YarikRevich/fate-seekers/services/fate-seekers-server/pkg/shared/supervisor"
 	"github.com/fasthttp/router"
+	"github.com/pkg/errors"
 	"github.com/prometheus/client_golang/prometheus/promhttp"
 	"github.com/valyala/fasthttp"
 	"github.com/valyala/fasthttp/fasthttpadaptor"
 )
 
-// MonitoringServer represents monitoring server, which serves metrics endpoint for prometheus.
+var (
+	ErrContentListenerNotReady  = errors.New("err happened content listener is not listening")
+	ErrMetadataListenerNotReady = errors.New("err happened metadata listener is not listening")
+	ErrWorkersNotHealthy        = errors.New("err happened background workers are not healthy")
+)
+
+// Represents self-contained metrics dashboard page, which renders in-process metrics buffer.
+//
+//go:embed dashboard.html
+var dashboardPage []byte
+
+var (
+	// GetInstance retrieves instance of the monitoring server, performing initialization if needed.
+	GetInstance = sync.OnceValue[*MonitoringServer](NewMonitoringServer)
+)
+
+// readinessCheck represents a single readiness check.
+type readinessCheck struct {
+	// Represents check name.
+	name string
+
+	// Represents check callback.
+	callback func() error
+}
+
+// Describes all the readiness checks in the order they are reported.
+var readinessChecks = []readinessCheck{
+	{name: "database", callback: db.Ping},
+	{name: "migrations", callback: db.CheckMigrated},
+	{name: "content-listener", callback: func() error {
+		if !connector.GetInstance().IsContentListening() {
+			return ErrContentListenerNotReady
+		}
+
+		return nil
+	}},
+	{name: "metadata-listener", callback: func() error {
+		if !connector.GetInstance().IsMetadataListening() {
+			return ErrMetadataListenerNotReady
+		}
+
+		return nil
+	}},
+	{name: "workers", callback: func() error {
+		if !supervisor.GetInstance().IsHealthy() {
+			return ErrWorkersNotHealthy
+		}
+
+		return nil
+	}},
+}
+
+// MonitoringServer represents monitoring server, which serves metrics endpoint for prometheus,
+// in-process metrics dashboard and health and readiness endpoints.
 type MonitoringServer struct {
+	// Represents if server has already been started.
+	started atomic.Bool
 }
 
-// Start starts server, which serves metrics for monitoring components.
+// Start starts server, which serves metrics for monitoring components. Server is started
+// only once, all the subsequent calls are ignored.
 func (ms *MonitoringServer) Start(failover func()) {
+	if !ms.started.CompareAndSwap(false, true) {
+		return
+	}
+
 	go func() {
 		services.Init()
 
+		timeseries.GetInstance().Run()
+
 		r := router.New()
 
 		r.GET("/metrics", fasthttpadaptor.NewFastHTTPHandler(promhttp.Handler()))
 
+		r.GET("/status", handleStatus)
+
+		r.GET("/healthz", handleHealth)
+
+		r.GET("/readyz", handleReadiness)
+
+		r.GET("/dashboard", handleDashboard)
+
+		r.GET("/dashboard/series", handleDashboardSeries)
+
 		err := fasthttp.ListenAndServe(
 			fmt.Sprintf("0.0.0.0:%v", config.GetSettingsMonitoringPrometheusPort()), r.Handler)
 		if err != nil {
@@ -37,6 +118,90 @@ func (ms *MonitoringServer) Start(failover func()) {
 	}()
 }
 
+// handleStatus serves health status of all the supervised background workers.
+func handleStatus(ctx *fasthttp.RequestCtx) {
+	healthy := supervisor.GetInstance().IsHealthy()
+
+	statusCode := fasthttp.StatusOK
+
+	if !healthy {
+		statusCode = fasthttp.StatusServiceUnavailable
+	}
+
+	writeJSON(ctx, statusCode, struct {
+		Healthy bool                      `json:"healthy"`
+		Workers []supervisor.WorkerStatus `json:"workers"`
+	}{
+		Healthy: healthy,
+		Workers: supervisor.GetInstance().GetStatuses(),
+	})
+}
+
+// handleHealth serves liveness status, which only confirms server process is responsive.
+func handleHealth(ctx *fasthttp.RequestCtx) {
+	writeJSON(ctx, fasthttp.StatusOK, struct {
+		Status string `json:"status"`
+	}{
+		Status: "ok",
+	})
+}
+
+// handleReadiness serves readiness status, which reports result of each of the readiness checks.
+func handleReadiness(ctx *fasthttp.RequestCtx) {
+	ready := true
+
+	checks := make(map[string]string, len(readinessChecks))
+
+	for _, check := range readinessChecks {
+		if err := check.callback(); err != nil {
+			ready = false
+
+			checks[check.name] = err.Error()
+		} else {
+			checks[check.name] = "ok"
+		}
+	}
+
+	statusCode := fasthttp.StatusOK
+
+	if !ready {
+		statusCode = fasthttp.StatusServiceUnavailable
+	}
+
+	writeJSON(ctx, statusCode, struct {
+		Ready  bool              `json:"ready"`
+		Checks map[string]string `json:"checks"`
+	}{
+		Ready:  ready,
+		Checks: checks,
+	})
+}
+
+// handleDashboard serves self-contained metrics dashboard page.
+func handleDashboard(ctx *fasthttp.RequestCtx) {
+	ctx.SetContentType("text/html; charset=utf-8")
+	ctx.SetBody(dashboardPage)
+}
+
+// handleDashboardSeries serves in-process metrics buffer series.
+func handleDashboardSeries(ctx *fasthttp.RequestCtx) {
+	writeJSON(ctx, fasthttp.StatusOK, timeseries.GetInstance().GetSeries())
+}
+
+// writeJSON writes the given value as JSON response with the given status code.
+func writeJSON(ctx *fasthttp.RequestCtx, statusCode int, value any) {
+	body, err := json.Marshal(value)
+	if err != nil {
+		ctx.Error(err.Error(), fasthttp.StatusInternalServerError)
+
+		return
+	}
+
+	ctx.SetStatusCode(statusCode)
+	ctx.SetContentType("application/json")
+	ctx.SetBody(body)
+}
+
 // NewMonitoringServer initializes MonitoringServer.
 func NewMonitoringServer() *MonitoringServer {
 	return new(MonitoringServer)
@@ -1,6 +1,20 @@
 package services
 
-import "github.com/prometheus/client_golang/prometheus"
+import (
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	dto "github.com/prometheus/client_model/go"
+)
+
+// Describes all the available elimination causes.
+const (
+	EliminationCauseHit   = "hit"
+	EliminationCauseEvent = "event"
+)
+
+// Represents registry, which contains only the collectors registered by the server.
+var registry = prometheus.NewRegistry()
 
 // Represents prometheus available sessions.
 var (
@@ -17,6 +31,116 @@ var (
 			Help: "The current number of available lobbies",
 		},
 	)
+
+	cacheHits = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "cache_hits_total",
+			Help: "The total number of cache hits per region",
+		},
+		[]string{"region"},
+	)
+
+	cacheMisses = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "cache_misses_total",
+			Help: "The total number of cache misses per region",
+		},
+		[]string{"region"},
+	)
+
+	cacheEvictions = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "cache_evictions_total",
+			Help: "The total number of cache evictions per region and reason",
+		},
+		[]string{"region", "reason"},
+	)
+
+	workerHealthy = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name: "worker_healthy",
+			Help: "The current health of the background worker, 1 if the latest run has succeeded",
+		},
+		[]string{"worker"},
+	)
+
+	workerFailures = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "worker_failures_total",
+			Help: "The total number of background worker failures",
+		},
+		[]string{"worker"},
+	)
+
+	rpcDuration = prometheus.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Name:    "rpc_duration_seconds",
+			Help:    "The duration of unary RPC calls per method and status code",
+			Buckets: prometheus.DefBuckets,
+		},
+		[]string{"method", "code"},
+	)
+
+	activeStreams = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name: "active_streams",
+			Help: "The current number of active RPC streams per method",
+		},
+		[]string{"method"},
+	)
+
+	udpPacketsReceived = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "udp_packets_received_total",
+			Help: "The total number of received UDP packets per key",
+		},
+		[]string{"key"},
+	)
+
+	udpPacketsRejected = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "udp_packets_rejected_total",
+			Help: "The total number of rejected UDP packets per key",
+		},
+		[]string{"key"},
+	)
+
+	eventsFired = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "events_fired_total",
+			Help: "The total number of fired session events per event name",
+		},
+		[]string{"event"},
+	)
+
+	hitsLanded = prometheus.NewCounter(
+		prometheus.CounterOpts{
+			Name: "hits_landed_total",
+			Help: "The total number of hits landed on players",
+		},
+	)
+
+	eliminations = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "eliminations_total",
+			Help: "The total number of player eliminations per cause",
+		},
+		[]string{"cause"},
+	)
+
+	chestPickups = prometheus.NewCounter(
+		prometheus.CounterOpts{
+			Name: "chest_pickups_total",
+			Help: "The total number of items taken from chests",
+		},
+	)
+
+	healthPackPickups = prometheus.NewCounter(
+		prometheus.CounterOpts{
+			Name: "health_pack_pickups_total",
+			Help: "The total number of taken health packs",
+		},
+	)
 )
 
 // IncAvailableSession performs available session value incrementation.
@@ -49,8 +173,99 @@ func SetAvailableLobby(value int64) {
 	availableLobbies.Set(float64(value))
 }
 
+// IncCacheHit performs cache hit value incrementation for the given region.
+func IncCacheHit(region string) {
+	cacheHits.WithLabelValues(region).Inc()
+}
+
+// IncCacheMiss performs cache miss value incrementation for the given region.
+func IncCacheMiss(region string) {
+	cacheMisses.WithLabelValues(region).Inc()
+}
+
+// IncCacheEviction performs cache eviction value incrementation for the given region and reason.
+func IncCacheEviction(region, reason string) {
+	cacheEvictions.WithLabelValues(region, reason).Inc()
+}
+
+// SetWorkerHealthy performs worker health value setup for the given worker.
+func SetWorkerHealthy(worker string, value bool) {
+	if value {
+		workerHealthy.WithLabelValues(worker).Set(1)
+	} else {
+		workerHealthy.WithLabelValues(worker).Set(0)
+	}
+}
+
+// IncWorkerFailure performs worker failure value incrementation for the given worker.
+func IncWorkerFailure(worker string) {
+	workerFailures.WithLabelValues(worker).Inc()
+}
+
+// ObserveRPCDuration performs RPC duration observation for the given method and status code.
+func ObserveRPCDuration(method, code string, value time.Duration) {
+	rpcDuration.WithLabelValues(method, code).Observe(value.Seconds())
+}
+
+// IncActiveStream performs active stream value incrementation for the given method.
+func IncActiveStream(method string) {
+	activeStreams.WithLabelValues(method).Inc()
+}
+
+// DecActiveStream performs active stream value decremention for the given method.
+func DecActiveStream(method string) {
+	activeStreams.WithLabelValues(method).Dec()
+}
+
+// IncUDPPacketReceived performs received UDP packet value incrementation for the given key.
+func IncUDPPacketReceived(key string) {
+	udpPacketsReceived.WithLabelValues(key).Inc()
+}
+
+// IncUDPPacketRejected performs rejected UDP packet value incrementation for the given key.
+func IncUDPPacketRejected(key string) {
+	udpPacketsRejected.WithLabelValues(key).Inc()
+}
+
+// IncEventFired performs fired event value incrementation for the given event name.
+func IncEventFired(event string) {
+	eventsFired.WithLabelValues(event).Inc()
+}
+
+// IncHitLanded performs landed hit value incrementation.
+func IncHitLanded() {
+	hitsLanded.Inc()
+}
+
+// IncElimination performs elimination value incrementation for the given cause.
+func IncElimination(cause string) {
+	eliminations.WithLabelValues(cause).Inc()
+}
+
+// IncChestPickup performs chest pickup value incrementation.
+func IncChestPickup() {
+	chestPickups.Inc()
+}
+
+// IncHealthPackPickup performs health pack pickup value incrementation.
+func IncHealthPackPickup() {
+	healthPackPickups.Inc()
+}
+
+// Gather retrieves current values of all the collectors registered by the server.
+func Gather() ([]*dto.MetricFamily, error) {
+	return registry.Gather()
+}
+
 // Init performs registers initialization.
 func Init() {
-	prometheus.MustRegister(
-		availableSessions, availableLobbies)
+	collectors := []prometheus.Collector{
+		availableSessions, availableLobbies, cacheHits, cacheMisses, cacheEvictions,
+		workerHealthy, workerFailures, rpcDuration, activeStreams, udpPacketsReceived,
+		udpPacketsRejected, eventsFired, hitsLanded, eliminations, chestPickups, healthPackPickups,
+	}
+
+	prometheus.MustRegister(collectors...)
+
+	registry.MustRegister(collectors...)
 }
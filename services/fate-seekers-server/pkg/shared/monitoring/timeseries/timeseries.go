@@ -0,0 +1,218 @@
+package timeseries
+
+import (
+	"context"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/YarikRevich/fate-seekers/services/fate-seekers-server/pkg/shared/config"
+	"github.com/YarikRevich/fate-seekers/services/fate-seekers-server/pkg/shared/monitoring/services"
+	"github.com/YarikRevich/fate-seekers/services/fate-seekers-server/pkg/shared/supervisor"
+	dto "github.com/prometheus/client_model/go"
+)
+
+const (
+	// Represents name of the worker used for supervision.
+	workerName = "timeseries"
+)
+
+var (
+	// GetInstance retrieves instance of the metrics buffer, performing initialization if needed.
+	GetInstance = sync.OnceValue[*Buffer](newBuffer)
+)
+
+// Point represents a single series sample.
+type Point struct {
+	// Represents sample time in unix milliseconds.
+	Timestamp int64 `json:"t"`
+
+	// Represents sample value. Counters are sampled as per second rate and
+	// histograms as average observed value since the previous sample.
+	Value float64 `json:"v"`
+}
+
+// Series represents samples of a single metric with a single set of labels.
+type Series struct {
+	// Represents metric name.
+	Name string `json:"name"`
+
+	// Represents metric labels in "name=value" form joined with comma.
+	Labels string `json:"labels"`
+
+	// Represents samples ordered from the oldest to the latest one.
+	Points []Point `json:"points"`
+}
+
+// previousSample represents raw cumulative value, which is used for counters and histograms sampling.
+type previousSample struct {
+	// Represents cumulative value, which is either counter value or histogram sum.
+	value float64
+
+	// Represents histogram samples count.
+	count uint64
+
+	// Represents sample time.
+	timestamp time.Time
+}
+
+// Buffer represents rolling in-process buffer of the registered metrics, which
+// requires neither Prometheus nor any container runtime.
+type Buffer struct {
+	// Represents mutex used for series access.
+	mu sync.RWMutex
+
+	// Represents series indexed by name and labels.
+	series map[string]*Series
+
+	// Represents previous raw values of cumulative series.
+	previous map[string]previousSample
+
+	// Represents if buffer sampling has already been started.
+	once sync.Once
+}
+
+// Run starts metrics sampling, which is performed only once.
+func (b *Buffer) Run() {
+	b.once.Do(func() {
+		supervisor.GetInstance().Run(workerName, config.GetOperationTimeseriesInterval(), b.process)
+	})
+}
+
+// GetSeries retrieves copy of all the buffered series ordered by name and labels.
+func (b *Buffer) GetSeries() []Series {
+	b.mu.RLock()
+
+	result := make([]Series, 0, len(b.series))
+
+	for _, series := range b.series {
+		result = append(result, Series{
+			Name:   series.Name,
+			Labels: series.Labels,
+			Points: slices.Clone(series.Points),
+		})
+	}
+
+	b.mu.RUnlock()
+
+	slices.SortFunc(result, func(a, b Series) int {
+		if result := strings.Compare(a.Name, b.Name); result != 0 {
+			return result
+		}
+
+		return strings.Compare(a.Labels, b.Labels)
+	})
+
+	return result
+}
+
+// process performs a single sampling of all the registered metrics.
+func (b *Buffer) process(_ context.Context) error {
+	families, err := services.Gather()
+	if err != nil {
+		return err
+	}
+
+	now := time.Now()
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	for _, family := range families {
+		for _, metric := range family.GetMetric() {
+			labels := composeLabels(metric.GetLabel())
+
+			key := family.GetName() + "{" + labels + "}"
+
+			var (
+				value float64
+				ok    bool
+			)
+
+			switch family.GetType() {
+			case dto.MetricType_GAUGE:
+				value, ok = metric.GetGauge().GetValue(), true
+			case dto.MetricType_COUNTER:
+				value, ok = b.sampleRate(key, metric.GetCounter().GetValue(), now)
+			case dto.MetricType_HISTOGRAM:
+				value, ok = b.sampleAverage(
+					key, metric.GetHistogram().GetSampleSum(), metric.GetHistogram().GetSampleCount(), now)
+			}
+
+			if !ok {
+				continue
+			}
+
+			series, exists := b.series[key]
+			if !exists {
+				series = &Series{
+					Name:   family.GetName(),
+					Labels: labels,
+				}
+
+				b.series[key] = series
+			}
+
+			series.Points = append(series.Points, Point{
+				Timestamp: now.UnixMilli(),
+				Value:     value,
+			})
+
+			if overflow := len(series.Points) - config.GetOperationTimeseriesCapacity(); overflow > 0 {
+				series.Points = slices.Delete(series.Points, 0, overflow)
+			}
+		}
+	}
+
+	return nil
+}
+
+// sampleRate converts the given cumulative counter value to per second rate since the previous sample.
+func (b *Buffer) sampleRate(key string, value float64, now time.Time) (float64, bool) {
+	previous, ok := b.previous[key]
+
+	b.previous[key] = previousSample{value: value, timestamp: now}
+
+	if !ok || value < previous.value {
+		return 0, false
+	}
+
+	return (value - previous.value) / now.Sub(previous.timestamp).Seconds(), true
+}
+
+// sampleAverage converts the given cumulative histogram values to average observed value since the previous sample.
+func (b *Buffer) sampleAverage(key string, sum float64, count uint64, now time.Time) (float64, bool) {
+	previous, ok := b.previous[key]
+
+	b.previous[key] = previousSample{value: sum, count: count, timestamp: now}
+
+	if !ok || count < previous.count {
+		return 0, false
+	}
+
+	if count == previous.count {
+		return 0, true
+	}
+
+	return (sum - previous.value) / float64(count-previous.count), true
+}
+
+// composeLabels composes the given metric labels into "name=value" form joined with comma.
+func composeLabels(labels []*dto.LabelPair) string {
+	result := make([]string, len(labels))
+
+	for i, label := range labels {
+		result[i] = label.GetName() + "=" + label.GetValue()
+	}
+
+	return strings.Join(result, ",")
+}
+
+// newBuffer initializes Buffer.
+func newBuffer() *Buffer {
+	return &Buffer{
+		series:   make(map[string]*Series),
+		previous: make(map[string]previousSample),
+	}
+}
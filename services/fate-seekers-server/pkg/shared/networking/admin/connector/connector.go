@@ -0,0 +1,56 @@
+package connector
+
+import (
+	"fmt"
+	"net"
+
+	"github.com/YarikRevich/fate-seekers/services/fate-seekers-server/pkg/shared/config"
+	adminv1 "github.com/YarikRevich/fate-seekers/services/fate-seekers-server/pkg/shared/networking/admin/api"
+	"github.com/YarikRevich/fate-seekers/services/fate-seekers-server/pkg/shared/networking/admin/handler"
+	"github.com/YarikRevich/fate-seekers/services/fate-seekers-server/pkg/shared/networking/admin/middleware"
+	metadatamiddleware "github.com/YarikRevich/fate-seekers/services/fate-seekers-server/pkg/shared/networking/metadata/middleware"
+	"go.opentelemetry.io/contrib/instrumentation/google.golang.org/grpc/otelgrpc"
+	"google.golang.org/grpc"
+)
+
+// NetworkingAdminConnector represents networking admin connector.
+type NetworkingAdminConnector struct {
+	// Represents established connection instance.
+	conn net.Listener
+}
+
+func (nac *NetworkingAdminConnector) Connect(callback func(err error)) {
+	go func() {
+		var err error
+
+		nac.conn, err = net.Listen("tcp", fmt.Sprintf("%s:%s", config.GetSettingsAdminHost(), config.GetSettingsAdminPort()))
+		if err != nil {
+			callback(err)
+
+			return
+		}
+
+		grpcServer := grpc.NewServer(
+			grpc.StatsHandler(otelgrpc.NewServerHandler()),
+			grpc.ChainUnaryInterceptor(
+				middleware.CheckAuthenticationMiddleware,
+				metadatamiddleware.CheckValidationMiddleware,
+			),
+		)
+
+		adminv1.RegisterAdminServiceServer(grpcServer, handler.NewHandler())
+
+		callback(nil)
+
+		grpcServer.Serve(nac.conn)
+	}()
+}
+
+func (nac *NetworkingAdminConnector) Close() error {
+	return nac.conn.Close()
+}
+
+// NewNetworkingAdminConnector initializes NetworkingAdminConnector.
+func NewNetworkingAdminConnector() *NetworkingAdminConnector {
+	return new(NetworkingAdminConnector)
+}
@@ -0,0 +1,559 @@
+package handler
+
+import (
+	"context"
+	"errors"
+	"math/rand"
+	"time"
+
+	"github.com/YarikRevich/fate-seekers/services/fate-seekers-server/pkg/shared/db"
+	"github.com/YarikRevich/fate-seekers/services/fate-seekers-server/pkg/shared/dto"
+	"github.com/YarikRevich/fate-seekers/services/fate-seekers-server/pkg/shared/entity"
+	"github.com/YarikRevich/fate-seekers/services/fate-seekers-server/pkg/shared/monitoring/services"
+	adminv1 "github.com/YarikRevich/fate-seekers/services/fate-seekers-server/pkg/shared/networking/admin/api"
+	"github.com/YarikRevich/fate-seekers/services/fate-seekers-server/pkg/shared/networking/cache"
+	"github.com/YarikRevich/fate-seekers/services/fate-seekers-server/pkg/shared/networking/content/emote"
+	"github.com/YarikRevich/fate-seekers/services/fate-seekers-server/pkg/shared/networking/metadata/broadcast"
+	"github.com/YarikRevich/fate-seekers/services/fate-seekers-server/pkg/shared/networking/metadata/chat"
+	"github.com/YarikRevich/fate-seekers/services/fate-seekers-server/pkg/shared/networking/metadata/countdown"
+	"github.com/YarikRevich/fate-seekers/services/fate-seekers-server/pkg/shared/networking/metadata/events"
+	"github.com/YarikRevich/fate-seekers/services/fate-seekers-server/pkg/shared/networking/moderation"
+	"github.com/YarikRevich/fate-seekers/services/fate-seekers-server/pkg/shared/replay"
+	"github.com/YarikRevich/fate-seekers/services/fate-seekers-server/pkg/shared/repository"
+	"golang.org/x/exp/slices"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"gorm.io/gorm"
+)
+
+var (
+	ErrUserDoesNotExist     = errors.New("err happened user does not exist")
+	ErrSessionDoesNotExists = errors.New("err happened session does not exist")
+	ErrCacheRegionUnknown   = errors.New("err happened cache region is unknown")
+)
+
+// Handler represents handler implementation of adminv1.AdminServiceServer.
+type Handler struct {
+	adminv1.UnimplementedAdminServiceServer
+}
+
+func (h *Handler) ListSessions(ctx context.Context, request *adminv1.ListSessionsRequest) (*adminv1.ListSessionsResponse, error) {
+	sessions, err := repository.
+		GetSessionsRepository().
+		GetAll()
+	if err != nil {
+		return nil, err
+	}
+
+	cacheTransaction := cache.
+		GetInstance().
+		BeginTransaction(ctx, cache.SESSIONS_REGION, cache.LOBBY_SETS_REGION)
+	defer cacheTransaction.Commit()
+
+	response := new(adminv1.ListSessionsResponse)
+
+	for _, session := range sessions {
+		unit := &adminv1.SessionUnit{
+			SessionId: session.ID,
+			Name:      session.Name,
+			Seed:      uint64(session.Seed),
+			Issuer:    session.UserEntity.Name,
+			Started:   session.Started,
+			CreatedAt: timestamppb.New(session.CreatedAt),
+		}
+
+		cachedSession, ok := cache.
+			GetInstance().
+			GetSessions(session.ID)
+		if ok {
+			unit.Started = cachedSession.Started
+			unit.Cached = true
+		}
+
+		cachedLobbySet, ok := cache.
+			GetInstance().
+			GetLobbySet(session.ID)
+		if ok {
+			unit.Lobbies = int64(len(cachedLobbySet))
+		} else {
+			lobbies, _, err := repository.
+				GetLobbiesRepository().
+				GetBySessionID(session.ID)
+			if err != nil {
+				return nil, err
+			}
+
+			unit.Lobbies = int64(len(lobbies))
+		}
+
+		response.Sessions = append(response.Sessions, unit)
+	}
+
+	return response, nil
+}
+
+func (h *Handler) ListLobbies(ctx context.Context, request *adminv1.ListLobbiesRequest) (*adminv1.ListLobbiesResponse, error) {
+	lobbies, _, err := repository.
+		GetLobbiesRepository().
+		GetBySessionID(request.GetSessionId())
+	if err != nil {
+		return nil, err
+	}
+
+	cacheTransaction := cache.
+		GetInstance().
+		BeginTransaction(ctx, cache.METADATA_REGION)
+	defer cacheTransaction.Commit()
+
+	response := new(adminv1.ListLobbiesResponse)
+
+	for _, lobby := range lobbies {
+		unit := &adminv1.LobbyUnit{
+			LobbyId:    lobby.ID,
+			SessionId:  lobby.SessionID,
+			Issuer:     lobby.UserEntity.Name,
+			Skin:       uint64(lobby.Skin),
+			Host:       lobby.Host,
+			Health:     uint64(lobby.Health),
+			Active:     lobby.Active,
+			Eliminated: lobby.Eliminated,
+			Position: &adminv1.Position{
+				X: lobby.PositionX,
+				Y: lobby.PositionY,
+			},
+		}
+
+		cachedMetadata, ok := cache.
+			GetInstance().
+			GetMetadata(lobby.UserEntity.Name)
+		if ok {
+			for _, metadata := range cachedMetadata {
+				if metadata.LobbyID == lobby.ID {
+					unit.Skin = metadata.Skin
+					unit.Host = metadata.Host
+					unit.Health = metadata.Health
+					unit.Active = metadata.Active
+					unit.Eliminated = metadata.Eliminated
+					unit.Position = &adminv1.Position{
+						X: metadata.PositionX,
+						Y: metadata.PositionY,
+					}
+					unit.Cached = true
+
+					break
+				}
+			}
+		}
+
+		response.Lobbies = append(response.Lobbies, unit)
+	}
+
+	return response, nil
+}
+
+func (h *Handler) EndSession(ctx context.Context, request *adminv1.EndSessionRequest) (*adminv1.EndSessionResponse, error) {
+	session, exists, err := repository.
+		GetSessionsRepository().
+		GetByID(request.GetSessionId())
+	if err != nil {
+		return nil, err
+	}
+
+	if !exists {
+		return nil, ErrSessionDoesNotExists
+	}
+
+	cacheTransaction := cache.
+		GetInstance().
+		BeginTransaction(
+			ctx,
+			cache.SESSIONS_REGION,
+			cache.LOBBY_SETS_REGION,
+			cache.USER_SESSIONS_REGION,
+			cache.METADATA_REGION,
+			cache.GENERATED_CHESTS_REGION,
+			cache.GENERATED_HEALTH_PACKS_REGION)
+	defer cacheTransaction.Commit()
+
+	repository.
+		GetLobbiesRepository().
+		Lock()
+
+	lobbies, _, err := repository.
+		GetLobbiesRepository().
+		GetBySessionID(request.GetSessionId())
+	if err == nil {
+		err = db.BeginTransaction(ctx, func(transaction *gorm.DB) error {
+			err := repository.
+				GetInventoryRepository().
+				DeleteBySessionIDWithTransaction(transaction, request.GetSessionId())
+			if err != nil {
+				return err
+			}
+
+			err = repository.
+				GetAssociationsRepository().
+				DeleteBySessionIDWithTransaction(transaction, request.GetSessionId())
+			if err != nil {
+				return err
+			}
+
+			err = repository.
+				GetGenerationRepository().
+				DeleteBySessionIDWithTransaction(transaction, request.GetSessionId())
+			if err != nil {
+				return err
+			}
+
+			err = repository.
+				GetLobbiesRepository().
+				DeleteBySessionIDWithTransaction(transaction, request.GetSessionId())
+			if err != nil {
+				return err
+			}
+
+			return repository.
+				GetSessionsRepository().
+				DeleteByIDWithTransaction(transaction, request.GetSessionId())
+		})
+	}
+
+	repository.
+		GetLobbiesRepository().
+		Unlock()
+
+	if err == nil {
+		cache.
+			GetInstance().
+			EvictSessions(request.GetSessionId())
+
+		cache.
+			GetInstance().
+			EvictUserSessions(session.UserEntity.Name)
+
+		cache.
+			GetInstance().
+			EvictLobbySet(request.GetSessionId())
+
+		for _, lobby := range lobbies {
+			cache.
+				GetInstance().
+				EvictMetadata(lobby.UserEntity.Name)
+		}
+
+		cache.
+			GetInstance().
+			EvictGeneratedChests(session.Name)
+
+		cache.
+			GetInstance().
+			EvictGeneratedHealthPacks(session.Name)
+
+		events.
+			GetSessionEvents().
+			Delete(session.Name)
+
+		countdown.Stop(request.GetSessionId())
+
+		replay.
+			GetInstance().
+			Stop(request.GetSessionId())
+
+		chat.
+			GetInstance().
+			Remove(request.GetSessionId())
+
+		emote.
+			GetInstance().
+			Remove(request.GetSessionId())
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	services.DecAvailableSession()
+
+	for range lobbies {
+		services.DecAvailableLobby()
+	}
+
+	return new(adminv1.EndSessionResponse), nil
+}
+
+func (h *Handler) KickIssuer(ctx context.Context, request *adminv1.KickIssuerRequest) (*adminv1.KickIssuerResponse, error) {
+	err := kick(ctx, request.GetIssuer(), request.SessionId)
+	if err != nil {
+		return nil, err
+	}
+
+	return new(adminv1.KickIssuerResponse), nil
+}
+
+func (h *Handler) BanIssuer(ctx context.Context, request *adminv1.BanIssuerRequest) (*adminv1.BanIssuerResponse, error) {
+	var expiresAt *time.Time
+
+	if request.Duration != nil {
+		value := time.Now().Add(request.GetDuration().AsDuration())
+
+		expiresAt = &value
+	}
+
+	err := moderation.
+		GetInstance().
+		Ban(dto.BansRepositoryInsertRequest{
+			Issuer:    request.GetIssuer(),
+			Address:   request.GetAddress(),
+			Reason:    request.GetReason(),
+			Creator:   request.GetCreator(),
+			ExpiresAt: expiresAt,
+		})
+	if err != nil {
+		return nil, err
+	}
+
+	if request.GetIssuer() != "" {
+		err = kick(ctx, request.GetIssuer(), nil)
+		if err != nil && !errors.Is(err, ErrUserDoesNotExist) {
+			return nil, err
+		}
+	}
+
+	return new(adminv1.BanIssuerResponse), nil
+}
+
+func (h *Handler) ListBans(ctx context.Context, request *adminv1.ListBansRequest) (*adminv1.ListBansResponse, error) {
+	response := new(adminv1.ListBansResponse)
+
+	for _, ban := range moderation.GetInstance().GetActive() {
+		unit := &adminv1.BanUnit{
+			BanId:     ban.ID,
+			Issuer:    ban.Issuer,
+			Address:   ban.Address,
+			Reason:    ban.Reason,
+			Creator:   ban.Creator,
+			CreatedAt: timestamppb.New(ban.CreatedAt),
+		}
+
+		if ban.ExpiresAt != nil {
+			unit.ExpiresAt = timestamppb.New(*ban.ExpiresAt)
+		}
+
+		response.Bans = append(response.Bans, unit)
+	}
+
+	return response, nil
+}
+
+func (h *Handler) LiftBan(ctx context.Context, request *adminv1.LiftBanRequest) (*adminv1.LiftBanResponse, error) {
+	err := moderation.
+		GetInstance().
+		Lift(request.GetBanId())
+	if err != nil {
+		return nil, err
+	}
+
+	return new(adminv1.LiftBanResponse), nil
+}
+
+func (h *Handler) Broadcast(ctx context.Context, request *adminv1.BroadcastRequest) (*adminv1.BroadcastResponse, error) {
+	broadcast.
+		GetInstance().
+		Push(request.GetMessage())
+
+	return new(adminv1.BroadcastResponse), nil
+}
+
+func (h *Handler) FlushCache(ctx context.Context, request *adminv1.FlushCacheRequest) (*adminv1.FlushCacheResponse, error) {
+	regions := request.GetRegions()
+	if len(regions) == 0 {
+		regions = cache.Regions
+	}
+
+	for _, region := range regions {
+		if !slices.Contains(cache.Regions, region) {
+			return nil, ErrCacheRegionUnknown
+		}
+	}
+
+	cacheTransaction := cache.
+		GetInstance().
+		BeginTransaction(ctx, regions...)
+	defer cacheTransaction.Commit()
+
+	for _, region := range regions {
+		switch region {
+		case cache.SESSIONS_REGION:
+			cache.
+				GetInstance().
+				PurgeSessions()
+		case cache.USER_SESSIONS_REGION:
+			cache.
+				GetInstance().
+				PurgeUserSessions()
+		case cache.LOBBY_SETS_REGION:
+			cache.
+				GetInstance().
+				PurgeLobbySets()
+		case cache.USER_ACTIVITY_REGION:
+			cache.
+				GetInstance().
+				PurgeUserActivity()
+		case cache.METADATA_REGION:
+			cache.
+				GetInstance().
+				PurgeMetadata()
+		case cache.USERS_REGION:
+			cache.
+				GetInstance().
+				PurgeUsers()
+		case cache.GENERATED_CHESTS_REGION:
+			cache.
+				GetInstance().
+				PurgeGeneratedChests()
+		case cache.GENERATED_HEALTH_PACKS_REGION:
+			cache.
+				GetInstance().
+				PurgeGeneratedHealthPacks()
+		}
+	}
+
+	return &adminv1.FlushCacheResponse{
+		Regions: regions,
+	}, nil
+}
+
+// kick removes lobbies of the given issuer, optionally limiting removal to the given session.
+func kick(ctx context.Context, issuer string, sessionID *int64) error {
+	var userID int64
+
+	cachedUserID, ok := cache.
+		GetInstance().
+		GetUsers(issuer)
+	if ok {
+		userID = cachedUserID
+	} else {
+		user, exists, err := repository.
+			GetUsersRepository().
+			GetByName(issuer)
+		if err != nil {
+			return err
+		}
+
+		if !exists {
+			return ErrUserDoesNotExist
+		}
+
+		userID = user.ID
+	}
+
+	lobbies, _, err := repository.
+		GetLobbiesRepository().
+		GetByUserID(userID)
+	if err != nil {
+		return err
+	}
+
+	var selectedLobbies []*entity.LobbyEntity
+
+	for _, lobby := range lobbies {
+		if sessionID == nil || lobby.SessionID == *sessionID {
+			selectedLobbies = append(selectedLobbies, lobby)
+		}
+	}
+
+	if len(selectedLobbies) == 0 {
+		return nil
+	}
+
+	cacheTransaction := cache.
+		GetInstance().
+		BeginTransaction(ctx, cache.LOBBY_SETS_REGION, cache.METADATA_REGION)
+	defer cacheTransaction.Commit()
+
+	repository.
+		GetLobbiesRepository().
+		Lock()
+
+	err = db.BeginTransaction(ctx, func(transaction *gorm.DB) error {
+		for _, selectedLobby := range selectedLobbies {
+			if selectedLobby.Host {
+				sessionLobbies, _, err := repository.
+					GetLobbiesRepository().
+					GetBySessionID(selectedLobby.SessionID)
+				if err != nil {
+					return err
+				}
+
+				var availableLobbies []*entity.LobbyEntity
+
+				for _, lobby := range sessionLobbies {
+					if lobby.UserID != userID && !lobby.Spectator {
+						availableLobbies = append(availableLobbies, lobby)
+					}
+				}
+
+				if len(availableLobbies) > 0 {
+					lobby := availableLobbies[rand.Intn(len(availableLobbies))]
+
+					err = repository.
+						GetLobbiesRepository().
+						InsertOrUpdateWithTransaction(
+							transaction,
+							dto.LobbiesRepositoryInsertOrUpdateRequest{
+								UserID:    lobby.UserID,
+								SessionID: selectedLobby.SessionID,
+								Host:      true,
+								Skin:      uint64(lobby.Skin),
+							})
+					if err != nil {
+						return err
+					}
+
+					cache.
+						GetInstance().
+						EvictMetadata(lobby.UserEntity.Name)
+				}
+			}
+
+			err := repository.
+				GetLobbiesRepository().
+				DeleteByUserIDAndSessionIDWithTransaction(transaction, userID, selectedLobby.SessionID)
+			if err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+
+	repository.
+		GetLobbiesRepository().
+		Unlock()
+
+	if err == nil {
+		for _, selectedLobby := range selectedLobbies {
+			cache.
+				GetInstance().
+				EvictLobbySet(selectedLobby.SessionID)
+		}
+
+		cache.
+			GetInstance().
+			EvictMetadata(issuer)
+	}
+
+	if err != nil {
+		return err
+	}
+
+	for range selectedLobbies {
+		services.DecAvailableLobby()
+	}
+
+	return nil
+}
+
+// NewHandler initializes implementation of adminv1.AdminServiceServer.
+func NewHandler() adminv1.AdminServiceServer {
+	return new(Handler)
+}
@@ -0,0 +1,73 @@
+package middleware
+
+import (
+	"context"
+	"crypto/subtle"
+
+	"github.com/YarikRevich/fate-seekers/services/fate-seekers-server/pkg/shared/config"
+	"github.com/pkg/errors"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// Represents all the headers used for middlewares management.
+const (
+	AuthenticationHeader = "authentication"
+)
+
+var (
+	ErrMissingMetadata             = errors.New("err happened missing metadata")
+	ErrAuthenticationHeaderInvalid = errors.New("err happened during admin authentication header validation")
+)
+
+// CheckAuthenticationMiddleware performs admin authentication middleware validation.
+func CheckAuthenticationMiddleware(
+	ctx context.Context,
+	req interface{},
+	info *grpc.UnaryServerInfo,
+	handler grpc.UnaryHandler,
+) (interface{}, error) {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return nil, status.Errorf(codes.Unauthenticated, ErrMissingMetadata.Error())
+	}
+
+	if len(md[AuthenticationHeader]) == 0 {
+		return nil, status.Errorf(codes.Unauthenticated, ErrAuthenticationHeaderInvalid.Error())
+	}
+
+	if subtle.ConstantTimeCompare(
+		[]byte(md[AuthenticationHeader][0]),
+		[]byte(config.GetSettingsAdminCredential())) != 1 {
+		return nil, status.Errorf(codes.Unauthenticated, ErrAuthenticationHeaderInvalid.Error())
+	}
+
+	return handler(ctx, req)
+}
+
+// AuthenticationCredentials represents admin credentials attached to each of the outgoing requests.
+type AuthenticationCredentials struct {
+	// Represents admin credential value.
+	credential string
+}
+
+// GetRequestMetadata retrieves request metadata containing admin credential.
+func (ac *AuthenticationCredentials) GetRequestMetadata(ctx context.Context, uri ...string) (map[string]string, error) {
+	return map[string]string{
+		AuthenticationHeader: ac.credential,
+	}, nil
+}
+
+// RequireTransportSecurity checks if transport security is required.
+func (ac *AuthenticationCredentials) RequireTransportSecurity() bool {
+	return false
+}
+
+// NewAuthenticationCredentials initializes AuthenticationCredentials.
+func NewAuthenticationCredentials(credential string) *AuthenticationCredentials {
+	return &AuthenticationCredentials{
+		credential: credential,
+	}
+}
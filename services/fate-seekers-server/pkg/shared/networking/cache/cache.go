@@ -1,16 +1,12 @@
 package cache
 
 import (
+	"slices"
 	"sync"
 	"time"
 
 	"github.com/YarikRevich/fate-seekers/services/fate-seekers-server/pkg/shared/config"
 	"github.com/YarikRevich/fate-seekers/services/fate-seekers-server/pkg/shared/dto"
-	"github.com/YarikRevich/fate-seekers/services/fate-seekers-server/pkg/shared/logging"
-
-	// LRU is utilized as a map with a limited size solution in this case.
-	// Should be replaced with a native solution without external library usage.
-	lru "github.com/hashicorp/golang-lru/v2"
 )
 
 var (
@@ -18,59 +14,55 @@ var (
 	GetInstance = sync.OnceValue[*NetworkingCache](newNetworkingCache)
 )
 
-// NetworkingCache represents networking cache.
-type NetworkingCache struct {
-	// Represents sessions cache instance.
-	sessions *lru.Cache[int64, dto.CacheSessionEntity]
-
-	// Represents mutex sessions related transactions.
-	sessionsMutex sync.Mutex
-
-	// Represents user sessions cache instance.
-	userSessions *lru.Cache[string, []dto.CacheSessionEntity]
-
-	// Represents mutex used for user sessions related transactions.
-	userSessionsMutex sync.Mutex
-
-	// Represents lobby sets cache instance. Value contains issuer names only.
-	lobbySets *lru.Cache[int64, []dto.CacheLobbySetEntity]
-
-	// Represents mutex used for lobby sets related transactions.
-	lobbySetsMutex sync.Mutex
-
-	// Represents user activity cache instance.
-	userActivity *lru.Cache[string, time.Duration]
+// Describes all the available cache regions.
+const (
+	SESSIONS_REGION               = "sessions"
+	USER_SESSIONS_REGION          = "user-sessions"
+	LOBBY_SETS_REGION             = "lobby-sets"
+	USER_ACTIVITY_REGION          = "user-activity"
+	METADATA_REGION               = "metadata"
+	USERS_REGION                  = "users"
+	GENERATED_CHESTS_REGION       = "generated-chests"
+	GENERATED_HEALTH_PACKS_REGION = "generated-health-packs"
+)
 
-	// Represents metadata cache instance.
-	metadata *lru.Cache[string, []*dto.CacheMetadataEntity]
+// Describes all the available cache regions as a list.
+var Regions = []string{
+	SESSIONS_REGION,
+	USER_SESSIONS_REGION,
+	LOBBY_SETS_REGION,
+	USER_ACTIVITY_REGION,
+	METADATA_REGION,
+	USERS_REGION,
+	GENERATED_CHESTS_REGION,
+	GENERATED_HEALTH_PACKS_REGION,
+}
 
-	// Represents mutex used for metadata related transactions.
-	metadataMutex sync.Mutex
+// NetworkingCache represents networking cache.
+type NetworkingCache struct {
+	// Represents sessions cache region.
+	sessions *Region[int64, dto.CacheSessionEntity]
 
-	// Represents users cache instance.
-	users *lru.Cache[string, int64]
+	// Represents user sessions cache region.
+	userSessions *Region[string, []dto.CacheSessionEntity]
 
-	// Represents generated chests cache instance.
-	generatedChests *lru.Cache[string, []*dto.CacheGeneratedChestEntity]
+	// Represents lobby sets cache region. Value contains issuer names only.
+	lobbySets *Region[int64, []dto.CacheLobbySetEntity]
 
-	// Represents mutex used for generated chests related transactions.
-	generatedChestsMutex sync.Mutex
+	// Represents user activity cache region.
+	userActivity *Region[string, time.Duration]
 
-	// Represents generated health packs cache instance.
-	generatedHealthPacks *lru.Cache[string, []*dto.CacheGeneratedHealthPacksEntity]
+	// Represents metadata cache region.
+	metadata *Region[string, []*dto.CacheMetadataEntity]
 
-	// Represents mutex used for generated health packs related transactions.
-	generatedHealthPacksMutex sync.Mutex
-}
+	// Represents users cache region.
+	users *Region[string, int64]
 
-// BeginSessionsTransaction begins sessions cache instance transaction.
-func (nc *NetworkingCache) BeginSessionsTransaction() {
-	nc.sessionsMutex.Lock()
-}
+	// Represents generated chests cache region.
+	generatedChests *Region[string, []*dto.CacheGeneratedChestEntity]
 
-// CommitSessionsTransaction commits sessions cache instance transaction.
-func (nc *NetworkingCache) CommitSessionsTransaction() {
-	nc.sessionsMutex.Unlock()
+	// Represents generated health packs cache region.
+	generatedHealthPacks *Region[string, []*dto.CacheGeneratedHealthPacksEntity]
 }
 
 // AddSessions adds session cache instance with the provided key and value.
@@ -85,15 +77,7 @@ func (nc *NetworkingCache) GetSessions(key int64) (dto.CacheSessionEntity, bool)
 
 // GetSessionsMappings retrieves all sessions mapping cache instances.
 func (nc *NetworkingCache) GetSessionsMappings() map[int64]dto.CacheSessionEntity {
-	result := make(map[int64]dto.CacheSessionEntity)
-
-	for _, key := range nc.sessions.Keys() {
-		value, _ := nc.GetSessions(key)
-
-		result[key] = value
-	}
-
-	return result
+	return nc.sessions.Mappings()
 }
 
 // EvictSessions evicts sessions cache for the provided key.
@@ -103,23 +87,14 @@ func (nc *NetworkingCache) EvictSessions(key int64) {
 
 // EvictSessionsByName evicts sessions cache for the provided name value.
 func (nc *NetworkingCache) EvictSessionsByName(name string) {
-	for _, key := range nc.sessions.Keys() {
-		value, _ := nc.GetSessions(key)
-
-		if value.Name == name {
-			nc.sessions.Remove(key)
-		}
-	}
-}
-
-// BeginUserSessionsTransaction begins user sessions cache instance transaction.
-func (nc *NetworkingCache) BeginUserSessionsTransaction() {
-	nc.userSessionsMutex.Lock()
+	nc.sessions.RemoveIf(func(key int64, value dto.CacheSessionEntity) bool {
+		return value.Name == name
+	})
 }
 
-// CommitUserSessionsTransaction commits user sessions cache instance transaction.
-func (nc *NetworkingCache) CommitUserSessionsTransaction() {
-	nc.userSessionsMutex.Unlock()
+// PurgeSessions evicts all sessions cache instances.
+func (nc *NetworkingCache) PurgeSessions() {
+	nc.sessions.Purge()
 }
 
 // AddUserSessions adds user session cache instance with the provided key and value.
@@ -137,14 +112,9 @@ func (nc *NetworkingCache) EvictUserSessions(key string) {
 	nc.userSessions.Remove(key)
 }
 
-// BeginLobbySetTransaction begins lobby set cache instance transaction.
-func (nc *NetworkingCache) BeginLobbySetTransaction() {
-	nc.lobbySetsMutex.Lock()
-}
-
-// CommitLobbySetTransaction commits lobby set cache instance transaction.
-func (nc *NetworkingCache) CommitLobbySetTransaction() {
-	nc.lobbySetsMutex.Unlock()
+// PurgeUserSessions evicts all user sessions cache instances.
+func (nc *NetworkingCache) PurgeUserSessions() {
+	nc.userSessions.Purge()
 }
 
 // AddLobbySet adds lobby set cache instance with the provided key and value.
@@ -159,15 +129,7 @@ func (nc *NetworkingCache) GetLobbySet(key int64) ([]dto.CacheLobbySetEntity, bo
 
 // GetLobbySetMappings retrieves all lobby set mapping cache instances.
 func (nc *NetworkingCache) GetLobbySetMappings() map[int64][]dto.CacheLobbySetEntity {
-	result := make(map[int64][]dto.CacheLobbySetEntity)
-
-	for _, key := range nc.lobbySets.Keys() {
-		value, _ := nc.GetLobbySet(key)
-
-		result[key] = value
-	}
-
-	return result
+	return nc.lobbySets.Mappings()
 }
 
 // EvictLobbySet evicts lobby set cache for the provided key.
@@ -175,6 +137,11 @@ func (nc *NetworkingCache) EvictLobbySet(key int64) {
 	nc.lobbySets.Remove(key)
 }
 
+// PurgeLobbySets evicts all lobby set cache instances.
+func (nc *NetworkingCache) PurgeLobbySets() {
+	nc.lobbySets.Purge()
+}
+
 // AddUserActivity adds user activity cache instance with the provided key and value.
 func (nc *NetworkingCache) AddUserActivity(key string, value time.Duration) {
 	nc.userActivity.Add(key, value)
@@ -185,14 +152,9 @@ func (nc *NetworkingCache) GetUserActivity(key string) (time.Duration, bool) {
 	return nc.userActivity.Get(key)
 }
 
-// BeginMetadataTransaction begins metadata cache instance transaction.
-func (nc *NetworkingCache) BeginMetadataTransaction() {
-	nc.metadataMutex.Lock()
-}
-
-// CommitMetadataTransaction commits metadata cache instance transaction.
-func (nc *NetworkingCache) CommitMetadataTransaction() {
-	nc.metadataMutex.Unlock()
+// PurgeUserActivity evicts all user activity cache instances.
+func (nc *NetworkingCache) PurgeUserActivity() {
+	nc.userActivity.Purge()
 }
 
 // AddMetadata adds metadata cache instance with the provided key and value.
@@ -207,15 +169,7 @@ func (nc *NetworkingCache) GetMetadata(key string) ([]*dto.CacheMetadataEntity,
 
 // GetMetadataMappings retrieves all metadata mapping cache instances.
 func (nc *NetworkingCache) GetMetadataMappings() map[string][]*dto.CacheMetadataEntity {
-	result := make(map[string][]*dto.CacheMetadataEntity)
-
-	for _, key := range nc.metadata.Keys() {
-		value, _ := nc.GetMetadata(key)
-
-		result[key] = value
-	}
-
-	return result
+	return nc.metadata.Mappings()
 }
 
 // EvictMetadata evicts metadata cache for the provided key.
@@ -223,6 +177,11 @@ func (nc *NetworkingCache) EvictMetadata(key string) {
 	nc.metadata.Remove(key)
 }
 
+// PurgeMetadata evicts all metadata cache instances.
+func (nc *NetworkingCache) PurgeMetadata() {
+	nc.metadata.Purge()
+}
+
 // AddUser adds users cache instance with the provided key and value.
 func (nc *NetworkingCache) AddUser(key string, value int64) {
 	nc.users.Add(key, value)
@@ -233,14 +192,9 @@ func (nc *NetworkingCache) GetUsers(key string) (int64, bool) {
 	return nc.users.Get(key)
 }
 
-// BeginGeneratedChestsTransaction begins generated chests cache instance transaction.
-func (nc *NetworkingCache) BeginGeneratedChestsTransaction() {
-	nc.generatedChestsMutex.Lock()
-}
-
-// CommitGeneratedChestsTransaction commits generated chests cache instance transaction.
-func (nc *NetworkingCache) CommitGeneratedChestsTransaction() {
-	nc.generatedChestsMutex.Unlock()
+// PurgeUsers evicts all users cache instances.
+func (nc *NetworkingCache) PurgeUsers() {
+	nc.users.Purge()
 }
 
 // AddGeneratedChests adds generated chests cache instance.
@@ -258,14 +212,9 @@ func (nc *NetworkingCache) EvictGeneratedChests(key string) {
 	nc.generatedChests.Remove(key)
 }
 
-// BeginGeneratedHealthPacksTransaction begins generated health packs cache instance transaction.
-func (nc *NetworkingCache) BeginGeneratedHealthPacksTransaction() {
-	nc.generatedHealthPacksMutex.Lock()
-}
-
-// CommitGeneratedHealthPacksTransaction commits generated health packs cache instance transaction.
-func (nc *NetworkingCache) CommitGeneratedHealthPacksTransaction() {
-	nc.generatedHealthPacksMutex.Unlock()
+// PurgeGeneratedChests evicts all generated chests cache instances.
+func (nc *NetworkingCache) PurgeGeneratedChests() {
+	nc.generatedChests.Purge()
 }
 
 // AddGeneratedHealthPacks adds generated health packs cache instance.
@@ -283,60 +232,64 @@ func (nc *NetworkingCache) EvictGeneratedHealthPacks(key string) {
 	nc.generatedHealthPacks.Remove(key)
 }
 
-// newNetworkingCache initializes NetworkingCache.
-func newNetworkingCache() *NetworkingCache {
-	sessions, err := lru.New[int64, dto.CacheSessionEntity](config.GetOperationMaxSessionsAmount())
-	if err != nil {
-		logging.GetInstance().Fatal(err.Error())
-	}
-
-	userSessions, err := lru.New[string, []dto.CacheSessionEntity](config.GetOperationMaxSessionsAmount())
-	if err != nil {
-		logging.GetInstance().Fatal(err.Error())
-	}
-
-	lobbySets, err := lru.New[int64, []dto.CacheLobbySetEntity](config.GetOperationMaxSessionsAmount())
-	if err != nil {
-		logging.GetInstance().Fatal(err.Error())
-	}
-
-	userActivity, err := lru.New[string, time.Duration](
-		config.GetOperationMaxSessionsAmount() * config.MAX_SESSION_USERS)
-	if err != nil {
-		logging.GetInstance().Fatal(err.Error())
-	}
+// PurgeGeneratedHealthPacks evicts all generated health packs cache instances.
+func (nc *NetworkingCache) PurgeGeneratedHealthPacks() {
+	nc.generatedHealthPacks.Purge()
+}
 
-	metadata, err := lru.New[string, []*dto.CacheMetadataEntity](
-		config.GetOperationMaxSessionsAmount() * config.MAX_SESSION_USERS)
-	if err != nil {
-		logging.GetInstance().Fatal(err.Error())
+// newNetworkingCache initializes NetworkingCache. Generated chests and health packs regions are
+// grown, when max amount of chests or health packs per session is increased live.
+func newNetworkingCache() *NetworkingCache {
+	result := &NetworkingCache{
+		sessions: NewRegion[int64, dto.CacheSessionEntity](
+			SESSIONS_REGION,
+			config.GetOperationMaxSessionsAmount(),
+			config.GetOperationCacheTTL(SESSIONS_REGION)),
+		userSessions: NewRegion[string, []dto.CacheSessionEntity](
+			USER_SESSIONS_REGION,
+			config.GetOperationMaxSessionsAmount(),
+			config.GetOperationCacheTTL(USER_SESSIONS_REGION)),
+		lobbySets: NewRegion[int64, []dto.CacheLobbySetEntity](
+			LOBBY_SETS_REGION,
+			config.GetOperationMaxSessionsAmount(),
+			config.GetOperationCacheTTL(LOBBY_SETS_REGION)),
+		userActivity: NewRegion[string, time.Duration](
+			USER_ACTIVITY_REGION,
+			config.GetOperationMaxSessionsAmount()*config.MAX_SESSION_USERS,
+			config.GetOperationCacheTTL(USER_ACTIVITY_REGION)),
+		metadata: NewRegion[string, []*dto.CacheMetadataEntity](
+			METADATA_REGION,
+			config.GetOperationMaxSessionsAmount()*config.MAX_SESSION_USERS,
+			config.GetOperationCacheTTL(METADATA_REGION)),
+		users: NewRegion[string, int64](
+			USERS_REGION,
+			config.GetOperationMaxSessionsAmount()*config.MAX_SESSION_USERS,
+			config.GetOperationCacheTTL(USERS_REGION)),
+		generatedChests: NewRegion[string, []*dto.CacheGeneratedChestEntity](
+			GENERATED_CHESTS_REGION,
+			config.GetOperationMaxSessionsAmount()*config.GetOperationMaxChestsAmount(),
+			config.GetOperationCacheTTL(GENERATED_CHESTS_REGION)),
+		generatedHealthPacks: NewRegion[string, []*dto.CacheGeneratedHealthPacksEntity](
+			GENERATED_HEALTH_PACKS_REGION,
+			config.GetOperationMaxSessionsAmount()*config.GetOperationMaxHealthPacksAmount(),
+			config.GetOperationCacheTTL(GENERATED_HEALTH_PACKS_REGION)),
 	}
 
-	users, err := lru.New[string, int64](config.GetOperationMaxSessionsAmount() * config.MAX_SESSION_USERS)
-	if err != nil {
-		logging.GetInstance().Fatal(err.Error())
-	}
+	config.AddReloadListener(func(reload config.ReloadResult, err error) {
+		if err != nil {
+			return
+		}
 
-	generatedChests, err := lru.New[string, []*dto.CacheGeneratedChestEntity](
-		config.GetOperationMaxSessionsAmount() * config.GetOperationMaxChestsAmount())
-	if err != nil {
-		logging.GetInstance().Fatal(err.Error())
-	}
+		if slices.Contains(reload.Applied, "operation.max-chests-amount") {
+			result.generatedChests.Grow(
+				config.GetOperationMaxSessionsAmount() * config.GetOperationMaxChestsAmount())
+		}
 
-	generatedHealthPacks, err := lru.New[string, []*dto.CacheGeneratedHealthPacksEntity](
-		config.GetOperationMaxSessionsAmount() * config.GetOperationMaxHealthPacksAmount())
-	if err != nil {
-		logging.GetInstance().Fatal(err.Error())
-	}
+		if slices.Contains(reload.Applied, "operation.max-health-packs-amount") {
+			result.generatedHealthPacks.Grow(
+				config.GetOperationMaxSessionsAmount() * config.GetOperationMaxHealthPacksAmount())
+		}
+	})
 
-	return &NetworkingCache{
-		sessions:             sessions,
-		userSessions:         userSessions,
-		lobbySets:            lobbySets,
-		userActivity:         userActivity,
-		metadata:             metadata,
-		users:                users,
-		generatedChests:      generatedChests,
-		generatedHealthPacks: generatedHealthPacks,
-	}
+	return result
 }
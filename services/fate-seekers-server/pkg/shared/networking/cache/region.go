@@ -0,0 +1,236 @@
+package cache
+
+import (
+	"container/list"
+	"sync"
+	"time"
+
+	"github.com/YarikRevich/fate-seekers/services/fate-seekers-server/pkg/shared/monitoring/services"
+)
+
+// Describes all the available region eviction reasons.
+const (
+	evictionReasonCapacity = "capacity"
+	evictionReasonExpired  = "expired"
+)
+
+// regionEntry represents a single region entry.
+type regionEntry[K comparable, V any] struct {
+	// Represents entry key.
+	key K
+
+	// Represents entry value.
+	value V
+
+	// Represents entry expiration time, zero value means entry never expires.
+	expiresAt time.Time
+}
+
+// Region represents size limited typed cache region with optional entries TTL.
+// Least recently used entries are evicted, when region capacity is exceeded.
+type Region[K comparable, V any] struct {
+	// Represents region name used for metrics reporting.
+	name string
+
+	// Represents max amount of entries in the region.
+	capacity int
+
+	// Represents entries TTL, zero value disables expiration.
+	ttl time.Duration
+
+	// Represents mutex used for entries access.
+	mu sync.Mutex
+
+	// Represents mutex used for region related transactions.
+	transaction sync.Mutex
+
+	// Represents entries ordered from the most to the least recently used.
+	order *list.List
+
+	// Represents entries index by key.
+	entries map[K]*list.Element
+}
+
+// Begin begins region transaction.
+func (r *Region[K, V]) Begin() {
+	r.transaction.Lock()
+}
+
+// Commit commits region transaction.
+func (r *Region[K, V]) Commit() {
+	r.transaction.Unlock()
+}
+
+// Add adds or replaces entry with the given key, evicting the least recently used one if needed.
+func (r *Region[K, V]) Add(key K, value V) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	var expiresAt time.Time
+
+	if r.ttl > 0 {
+		expiresAt = time.Now().Add(r.ttl)
+	}
+
+	if element, ok := r.entries[key]; ok {
+		entry := element.Value.(*regionEntry[K, V])
+		entry.value = value
+		entry.expiresAt = expiresAt
+
+		r.order.MoveToFront(element)
+
+		return
+	}
+
+	r.entries[key] = r.order.PushFront(&regionEntry[K, V]{
+		key:       key,
+		value:     value,
+		expiresAt: expiresAt,
+	})
+
+	for r.order.Len() > r.capacity {
+		r.remove(r.order.Back())
+
+		services.IncCacheEviction(r.name, evictionReasonCapacity)
+	}
+}
+
+// Grow increases region capacity up to the given one. Capacity is never decreased, so that
+// entries, which are still in use, are not evicted.
+func (r *Region[K, V]) Grow(capacity int) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.capacity = max(r.capacity, capacity)
+}
+
+// Get retrieves entry value with the given key, marking it as recently used.
+func (r *Region[K, V]) Get(key K) (V, bool) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	element, ok := r.entries[key]
+	if !ok {
+		services.IncCacheMiss(r.name)
+
+		var empty V
+
+		return empty, false
+	}
+
+	entry := element.Value.(*regionEntry[K, V])
+
+	if r.expired(entry, time.Now()) {
+		r.remove(element)
+
+		services.IncCacheEviction(r.name, evictionReasonExpired)
+		services.IncCacheMiss(r.name)
+
+		var empty V
+
+		return empty, false
+	}
+
+	r.order.MoveToFront(element)
+
+	services.IncCacheHit(r.name)
+
+	return entry.value, true
+}
+
+// Mappings retrieves all the not expired entries of the region.
+func (r *Region[K, V]) Mappings() map[K]V {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	result := make(map[K]V, len(r.entries))
+
+	now := time.Now()
+
+	for element := r.order.Back(); element != nil; {
+		previous := element.Prev()
+
+		entry := element.Value.(*regionEntry[K, V])
+
+		if r.expired(entry, now) {
+			r.remove(element)
+
+			services.IncCacheEviction(r.name, evictionReasonExpired)
+		} else {
+			result[entry.key] = entry.value
+		}
+
+		element = previous
+	}
+
+	return result
+}
+
+// Len retrieves amount of entries in the region, including not yet collected expired ones.
+func (r *Region[K, V]) Len() int {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	return r.order.Len()
+}
+
+// Remove removes entry with the given key.
+func (r *Region[K, V]) Remove(key K) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if element, ok := r.entries[key]; ok {
+		r.remove(element)
+	}
+}
+
+// RemoveIf removes all the entries, which satisfy the given condition.
+func (r *Region[K, V]) RemoveIf(condition func(key K, value V) bool) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	for element := r.order.Front(); element != nil; {
+		next := element.Next()
+
+		entry := element.Value.(*regionEntry[K, V])
+
+		if condition(entry.key, entry.value) {
+			r.remove(element)
+		}
+
+		element = next
+	}
+}
+
+// Purge removes all the entries of the region.
+func (r *Region[K, V]) Purge() {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.order.Init()
+
+	clear(r.entries)
+}
+
+// expired checks if the given entry is expired at the given time.
+func (r *Region[K, V]) expired(entry *regionEntry[K, V], now time.Time) bool {
+	return !entry.expiresAt.IsZero() && now.After(entry.expiresAt)
+}
+
+// remove removes the given element from the region, expecting entries mutex to be held.
+func (r *Region[K, V]) remove(element *list.Element) {
+	r.order.Remove(element)
+
+	delete(r.entries, element.Value.(*regionEntry[K, V]).key)
+}
+
+// NewRegion initializes Region with the given name, capacity and entries TTL.
+func NewRegion[K comparable, V any](name string, capacity int, ttl time.Duration) *Region[K, V] {
+	return &Region[K, V]{
+		name:     name,
+		capacity: max(capacity, 1),
+		ttl:      ttl,
+		order:    list.New(),
+		entries:  make(map[K]*list.Element),
+	}
+}
@@ -0,0 +1,32 @@
+package cache
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+// TestRegionGrow tests that grown region keeps entries exceeding its initial capacity, while
+// attempt to shrink it does not evict any of the entries.
+func TestRegionGrow(t *testing.T) {
+	region := NewRegion[int, int]("test", 2, 0)
+
+	region.Grow(4)
+
+	for i := range 4 {
+		region.Add(i, i)
+	}
+
+	require.Equal(t, 4, region.Len())
+
+	region.Grow(1)
+
+	region.Add(0, 0)
+
+	require.Equal(t, 4, region.Len())
+
+	for i := range 4 {
+		_, ok := region.Get(i)
+		require.True(t, ok)
+	}
+}
@@ -0,0 +1,122 @@
+package cache
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"sync"
+
+	"github.com/YarikRevich/fate-seekers/services/fate-seekers-server/pkg/shared/tracing"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// Describes canonical order, in which cache regions are acquired by transactions.
+// Any code path holding several regions at once must acquire them in this order,
+// otherwise concurrent transactions may deadlock.
+var canonicalOrder = []string{
+	SESSIONS_REGION,
+	LOBBY_SETS_REGION,
+	USER_SESSIONS_REGION,
+	METADATA_REGION,
+	GENERATED_CHESTS_REGION,
+	GENERATED_HEALTH_PACKS_REGION,
+	USER_ACTIVITY_REGION,
+	USERS_REGION,
+}
+
+// transactional represents cache region, which supports transactions.
+type transactional interface {
+	// Begin begins region transaction.
+	Begin()
+
+	// Commit commits region transaction.
+	Commit()
+}
+
+// Transaction represents cache transaction spanning several regions at once.
+type Transaction struct {
+	// Represents acquired regions in canonical order.
+	regions []transactional
+
+	// Represents span, which lasts from the transaction beginning till its commit.
+	span trace.Span
+
+	// Represents once used to make commit idempotent.
+	once sync.Once
+}
+
+// Commit commits all the acquired regions in reverse canonical order. Commit is
+// idempotent, so it can be deferred and still be called explicitly.
+func (t *Transaction) Commit() {
+	t.once.Do(func() {
+		for i := len(t.regions) - 1; i >= 0; i-- {
+			t.regions[i].Commit()
+		}
+
+		tracing.End(t.span, nil)
+	})
+}
+
+// BeginTransaction begins transaction for the given regions, acquiring them in canonical
+// order regardless of the provided one. Duplicate regions are acquired once. Transaction
+// span is started as a child of the span in the given context, regions acquisition is
+// recorded as span event, so lock contention can be told apart from the work done.
+func (nc *NetworkingCache) BeginTransaction(ctx context.Context, regions ...string) *Transaction {
+	result := new(Transaction)
+
+	for _, region := range canonicalOrder {
+		if slices.Contains(regions, region) {
+			result.regions = append(result.regions, nc.getTransactional(region))
+		}
+	}
+
+	for _, region := range regions {
+		if !slices.Contains(canonicalOrder, region) {
+			panic(fmt.Sprintf("unknown cache region %q", region))
+		}
+	}
+
+	_, result.span = tracing.Start(
+		ctx, "cache.transaction", attribute.StringSlice("cache.regions", regions))
+
+	for _, region := range result.regions {
+		region.Begin()
+	}
+
+	result.span.AddEvent("cache.acquired")
+
+	return result
+}
+
+// WithTransaction performs the given callback within transaction for the given regions.
+func (nc *NetworkingCache) WithTransaction(ctx context.Context, callback func(), regions ...string) {
+	transaction := nc.BeginTransaction(ctx, regions...)
+	defer transaction.Commit()
+
+	callback()
+}
+
+// getTransactional retrieves cache region with the given name.
+func (nc *NetworkingCache) getTransactional(region string) transactional {
+	switch region {
+	case SESSIONS_REGION:
+		return nc.sessions
+	case USER_SESSIONS_REGION:
+		return nc.userSessions
+	case LOBBY_SETS_REGION:
+		return nc.lobbySets
+	case USER_ACTIVITY_REGION:
+		return nc.userActivity
+	case METADATA_REGION:
+		return nc.metadata
+	case USERS_REGION:
+		return nc.users
+	case GENERATED_CHESTS_REGION:
+		return nc.generatedChests
+	case GENERATED_HEALTH_PACKS_REGION:
+		return nc.generatedHealthPacks
+	}
+
+	return nil
+}
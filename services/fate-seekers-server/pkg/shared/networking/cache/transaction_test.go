@@ -0,0 +1,167 @@
+package cache
+
+import (
+	"context"
+	"math/rand"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/YarikRevich/fate-seekers/services/fate-seekers-server/pkg/shared/dto"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	// Describes amount of concurrent workers used for stress testing.
+	stressWorkers = 32
+
+	// Describes amount of transactions performed by each of the workers.
+	stressIterations = 500
+
+	// Describes timeout, after which stress test is considered deadlocked.
+	stressTimeout = time.Second * 30
+)
+
+// Describes region sets acquired by handlers and workers, listed in the order
+// they used to be acquired before canonical ordering was introduced.
+var stressRegionSets = [][]string{
+	// StartSession.
+	{METADATA_REGION, SESSIONS_REGION, LOBBY_SETS_REGION, USER_SESSIONS_REGION},
+
+	// events.Run.
+	{SESSIONS_REGION, METADATA_REGION, LOBBY_SETS_REGION},
+
+	// GetUsersMetadata, activity.Run and sync.Run.
+	{LOBBY_SETS_REGION, METADATA_REGION},
+
+	// CreateSession and RemoveSession.
+	{SESSIONS_REGION, LOBBY_SETS_REGION, USER_SESSIONS_REGION},
+
+	// TakeHealthPack.
+	{GENERATED_HEALTH_PACKS_REGION, SESSIONS_REGION, METADATA_REGION},
+
+	// OpenChest.
+	{SESSIONS_REGION, LOBBY_SETS_REGION, GENERATED_CHESTS_REGION},
+
+	// EndSession.
+	{
+		SESSIONS_REGION,
+		USER_SESSIONS_REGION,
+		LOBBY_SETS_REGION,
+		METADATA_REGION,
+		GENERATED_CHESTS_REGION,
+		GENERATED_HEALTH_PACKS_REGION,
+	},
+}
+
+// newTestNetworkingCache creates NetworkingCache without configuration access.
+func newTestNetworkingCache() *NetworkingCache {
+	return &NetworkingCache{
+		sessions:             NewRegion[int64, dto.CacheSessionEntity](SESSIONS_REGION, 8, 0),
+		userSessions:         NewRegion[string, []dto.CacheSessionEntity](USER_SESSIONS_REGION, 8, 0),
+		lobbySets:            NewRegion[int64, []dto.CacheLobbySetEntity](LOBBY_SETS_REGION, 8, 0),
+		userActivity:         NewRegion[string, time.Duration](USER_ACTIVITY_REGION, 8, 0),
+		metadata:             NewRegion[string, []*dto.CacheMetadataEntity](METADATA_REGION, 8, 0),
+		users:                NewRegion[string, int64](USERS_REGION, 8, time.Millisecond),
+		generatedChests:      NewRegion[string, []*dto.CacheGeneratedChestEntity](GENERATED_CHESTS_REGION, 8, 0),
+		generatedHealthPacks: NewRegion[string, []*dto.CacheGeneratedHealthPacksEntity](GENERATED_HEALTH_PACKS_REGION, 8, 0),
+	}
+}
+
+// TestTransactionStress tests concurrent transactions acquiring regions in conflicting orders.
+// It should be run with -race flag enabled.
+func TestTransactionStress(t *testing.T) {
+	nc := newTestNetworkingCache()
+
+	var wg sync.WaitGroup
+
+	for i := 0; i < stressWorkers; i++ {
+		wg.Add(1)
+
+		go func(seed int64) {
+			defer wg.Done()
+
+			random := rand.New(rand.NewSource(seed))
+
+			for j := 0; j < stressIterations; j++ {
+				regions := stressRegionSets[random.Intn(len(stressRegionSets))]
+
+				key := random.Int63n(16)
+				name := string(rune('a' + key))
+
+				cacheTransaction := nc.BeginTransaction(context.Background(), regions...)
+
+				for _, region := range regions {
+					switch region {
+					case SESSIONS_REGION:
+						value, _ := nc.GetSessions(key)
+						value.ID = key
+						value.Started = !value.Started
+
+						nc.AddSessions(key, value)
+						nc.GetSessionsMappings()
+					case USER_SESSIONS_REGION:
+						nc.AddUserSessions(name, []dto.CacheSessionEntity{{ID: key}})
+						nc.EvictUserSessions(name)
+					case LOBBY_SETS_REGION:
+						value, _ := nc.GetLobbySet(key)
+
+						nc.AddLobbySet(key, append(value[:0:0], dto.CacheLobbySetEntity{ID: key}))
+						nc.GetLobbySetMappings()
+					case METADATA_REGION:
+						value, ok := nc.GetMetadata(name)
+						if ok {
+							for _, metadata := range value {
+								metadata.Health--
+							}
+						} else {
+							nc.AddMetadata(name, []*dto.CacheMetadataEntity{{SessionID: key, Health: 100}})
+						}
+
+						nc.GetMetadataMappings()
+					case GENERATED_CHESTS_REGION:
+						nc.AddGeneratedChests(name, nil)
+						nc.EvictGeneratedChests(name)
+					case GENERATED_HEALTH_PACKS_REGION:
+						nc.AddGeneratedHealthPacks(name, nil)
+						nc.GetGeneratedHealthPacks(name)
+					}
+				}
+
+				nc.AddUser(name, key)
+				nc.GetUsers(name)
+
+				cacheTransaction.Commit()
+				cacheTransaction.Commit()
+			}
+		}(int64(i))
+	}
+
+	done := make(chan struct{})
+
+	go func() {
+		wg.Wait()
+
+		close(done)
+	}()
+
+	select {
+	case <-done:
+	case <-time.After(stressTimeout):
+		t.Fatal("cache transactions deadlocked")
+	}
+
+	require.LessOrEqual(t, nc.sessions.Len(), 8)
+	require.LessOrEqual(t, nc.metadata.Len(), 8)
+}
+
+// TestTransactionUnknownRegion tests transaction creation with unknown region.
+func TestTransactionUnknownRegion(t *testing.T) {
+	nc := newTestNetworkingCache()
+
+	require.Panics(t, func() {
+		nc.BeginTransaction(context.Background(), SESSIONS_REGION, "unknown")
+	})
+
+	nc.WithTransaction(context.Background(), func() {}, SESSIONS_REGION)
+}
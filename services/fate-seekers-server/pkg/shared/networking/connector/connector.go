@@ -3,6 +3,8 @@ package connector
 import (
 	"sync"
 
+	"github.com/YarikRevich/fate-seekers/services/fate-seekers-server/pkg/shared/config"
+	adminconnector "github.com/YarikRevich/fate-seekers/services/fate-seekers-server/pkg/shared/networking/admin/connector"
 	contentconnector "github.com/YarikRevich/fate-seekers/services/fate-seekers-server/pkg/shared/networking/content/connector"
 	metadataconnector "github.com/YarikRevich/fate-seekers/services/fate-seekers-server/pkg/shared/networking/metadata/connector"
 )
@@ -19,6 +21,9 @@ type GlobalNetworkingConnector struct {
 
 	// Represents networking metadata connector.
 	metadataConnector *metadataconnector.NetworkingMetadataConnector
+
+	// Represents networking admin connector.
+	adminConnector *adminconnector.NetworkingAdminConnector
 }
 
 // Connect performs a connection for all the API modules.
@@ -44,7 +49,33 @@ func (gnc *GlobalNetworkingConnector) Connect(callback func(err error)) {
 				return
 			}
 
-			callback(nil)
+			if !config.GetSettingsAdminEnabled() {
+				callback(nil)
+
+				return
+			}
+
+			gnc.adminConnector.Connect(func(err error) {
+				if err != nil {
+					if err := gnc.metadataConnector.Close(); err != nil {
+						callback(err)
+
+						return
+					}
+
+					if err := gnc.contentConnector.Close(); err != nil {
+						callback(err)
+
+						return
+					}
+
+					callback(err)
+
+					return
+				}
+
+				callback(nil)
+			})
 		})
 	}()
 }
@@ -58,14 +89,34 @@ func (gnc *GlobalNetworkingConnector) Close(callback func(err error)) {
 			return
 		}
 
+		if config.GetSettingsAdminEnabled() {
+			err = gnc.adminConnector.Close()
+			if err != nil {
+				callback(err)
+
+				return
+			}
+		}
+
 		callback(gnc.metadataConnector.Close())
 	}()
 }
 
+// IsContentListening checks if networking content connector is currently listening.
+func (gnc *GlobalNetworkingConnector) IsContentListening() bool {
+	return gnc.contentConnector.IsListening()
+}
+
+// IsMetadataListening checks if networking metadata connector is currently listening.
+func (gnc *GlobalNetworkingConnector) IsMetadataListening() bool {
+	return gnc.metadataConnector.IsListening()
+}
+
 // newGlobalNetworkingConnector initializes GlobalNetworkingConnector.
 func newGlobalNetworkingConnector() *GlobalNetworkingConnector {
 	return &GlobalNetworkingConnector{
 		contentConnector:  contentconnector.NewNetworkingContentConnector(),
 		metadataConnector: metadataconnector.NewNetworkingMetadataConnector(),
+		adminConnector:    adminconnector.NewNetworkingAdminConnector(),
 	}
 }
@@ -2,15 +2,20 @@ package connector
 
 import (
 	"context"
+	"fmt"
 	"os"
 	"os/signal"
 	"strconv"
+	"sync/atomic"
 	"syscall"
 
 	"github.com/YarikRevich/fate-seekers/services/fate-seekers-server/pkg/shared/config"
 	"github.com/YarikRevich/fate-seekers/services/fate-seekers-server/pkg/shared/logging"
+	"github.com/YarikRevich/fate-seekers/services/fate-seekers-server/pkg/shared/monitoring/services"
 	"github.com/YarikRevich/fate-seekers/services/fate-seekers-server/pkg/shared/networking/content/handler"
+	"github.com/YarikRevich/fate-seekers/services/fate-seekers-server/pkg/shared/tracing"
 	"github.com/balacode/udpt"
+	"go.opentelemetry.io/otel/attribute"
 )
 
 // NetworkingContentConnector represents networking content connector.
@@ -20,6 +25,9 @@ type NetworkingContentConnector struct {
 
 	// Represents context for initialized receiver.
 	close context.CancelFunc
+
+	// Represents if receiver is currently listening.
+	listening atomic.Bool
 }
 
 func (ncc *NetworkingContentConnector) Connect() error {
@@ -32,13 +40,29 @@ func (ncc *NetworkingContentConnector) Connect() error {
 
 	ctx, ncc.close = context.WithCancel(context.Background())
 
+	ncc.listening.Store(true)
+
 	go func(ctx context.Context, close context.CancelFunc) {
+		defer ncc.listening.Store(false)
+
 		err := udpt.Receive(
 			ctx,
 			networkingServerPortInt,
 			config.GetSettingsParsedNetworkingEncryptionKey(),
 			func(key string, value []byte) error {
-				return ncc.handler.Process(key, value)
+				services.IncUDPPacketReceived(key)
+
+				ctx, span := tracing.Start(
+					context.Background(), fmt.Sprintf("content.%s", key), attribute.String("content.key", key))
+
+				err := ncc.handler.Process(ctx, key, value)
+				if err != nil {
+					services.IncUDPPacketRejected(key)
+				}
+
+				tracing.End(span, err)
+
+				return err
 			})
 		if err != nil {
 			close()
@@ -60,11 +84,18 @@ func (ncc *NetworkingContentConnector) Connect() error {
 }
 
 func (ncc *NetworkingContentConnector) Close() error {
+	ncc.listening.Store(false)
+
 	ncc.close()
 
 	return nil
 }
 
+// IsListening checks if content receiver is currently listening.
+func (ncc *NetworkingContentConnector) IsListening() bool {
+	return ncc.listening.Load()
+}
+
 // NewNetworkingContentConnector initializes NetworkingContentConnector.
 func NewNetworkingContentConnector() *NetworkingContentConnector {
 	return &NetworkingContentConnector{
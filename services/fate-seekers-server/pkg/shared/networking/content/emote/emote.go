@@ -0,0 +1,125 @@
+package emote
+
+import (
+	"errors"
+	"maps"
+	"sync"
+	"time"
+
+	"github.com/YarikRevich/fate-seekers/services/fate-seekers-server/pkg/shared/config"
+	"github.com/YarikRevich/fate-seekers/services/fate-seekers-server/pkg/shared/dto"
+)
+
+var (
+	ErrEmoteIsInvalid      = errors.New("err happened emote is invalid")
+	ErrCooldownIsNotPassed = errors.New("err happened emote cooldown has not passed")
+)
+
+const (
+	// Represents amount of the available emotes, emotes are identified by their index.
+	EMOTES_AMOUNT = 8
+
+	// Represents max amount of pending emotes kept per recipient.
+	maxPendingEmotes = 10
+)
+
+var (
+	// GetInstance retrieves instance of the emote manager, performing initialization if needed.
+	GetInstance = sync.OnceValue[*EmoteManager](newEmoteManager)
+)
+
+// EmoteManager represents emote manager, which holds emotes and pings relayed to each of the
+// session users until they are retrieved.
+type EmoteManager struct {
+	// Represents mutex used for emotes access.
+	mu sync.Mutex
+
+	// Represents pending emotes of each of the recipients of each of the sessions.
+	pending map[int64]map[string][]dto.EmoteUnit
+
+	// Represents send time of the latest emote of each of the issuers, used for cooldown.
+	sent map[string]time.Time
+}
+
+// Check validates emote with the given id sent by the given issuer, recording send time when
+// emote is accepted. Send times, which cooldown has already passed, are pruned.
+func (em *EmoteManager) Check(issuer string, emote uint64, ping bool) error {
+	if !ping && emote >= EMOTES_AMOUNT {
+		return ErrEmoteIsInvalid
+	}
+
+	em.mu.Lock()
+	defer em.mu.Unlock()
+
+	now := time.Now()
+
+	cooldown := config.GetOperationEmoteCooldown()
+
+	maps.DeleteFunc(em.sent, func(_ string, value time.Time) bool {
+		return now.Sub(value) >= cooldown
+	})
+
+	if _, ok := em.sent[issuer]; ok {
+		return ErrCooldownIsNotPassed
+	}
+
+	em.sent[issuer] = now
+
+	return nil
+}
+
+// Push adds the given emote to the pending emotes of the given recipient of the session with
+// the given id, dropping the oldest ones when limit is reached.
+func (em *EmoteManager) Push(sessionID int64, recipient string, value dto.EmoteUnit) {
+	em.mu.Lock()
+	defer em.mu.Unlock()
+
+	recipients, ok := em.pending[sessionID]
+	if !ok {
+		recipients = make(map[string][]dto.EmoteUnit)
+
+		em.pending[sessionID] = recipients
+	}
+
+	emotes := append(recipients[recipient], value)
+
+	if len(emotes) > maxPendingEmotes {
+		emotes = emotes[len(emotes)-maxPendingEmotes:]
+	}
+
+	recipients[recipient] = emotes
+}
+
+// Take retrieves and removes all the pending emotes of the given recipient of the session with
+// the given id.
+func (em *EmoteManager) Take(sessionID int64, recipient string) []dto.EmoteUnit {
+	em.mu.Lock()
+	defer em.mu.Unlock()
+
+	recipients, ok := em.pending[sessionID]
+	if !ok {
+		return nil
+	}
+
+	result := recipients[recipient]
+
+	delete(recipients, recipient)
+
+	return result
+}
+
+// Remove removes all the pending emotes of the session with the given id.
+func (em *EmoteManager) Remove(sessionID int64) {
+	em.mu.Lock()
+	defer em.mu.Unlock()
+
+	delete(em.pending, sessionID)
+}
+
+// newEmoteManager initializes EmoteManager.
+func newEmoteManager() *EmoteManager {
+	return &EmoteManager{
+		pending: make(map[int64]map[string][]dto.EmoteUnit),
+		sent:    make(map[string]time.Time),
+	}
+}
@@ -1,15 +1,23 @@
 package handler
 
 import (
+	"context"
 	"errors"
-	"fmt"
 	"math"
 
+	"github.com/YarikRevich/fate-seekers/services/fate-seekers-server/pkg/shared/audit"
+	"github.com/YarikRevich/fate-seekers/services/fate-seekers-server/pkg/shared/config"
 	"github.com/YarikRevich/fate-seekers/services/fate-seekers-server/pkg/shared/dto"
+	"github.com/YarikRevich/fate-seekers/services/fate-seekers-server/pkg/shared/monitoring/services"
 	"github.com/YarikRevich/fate-seekers/services/fate-seekers-server/pkg/shared/networking/cache"
 	contentv1 "github.com/YarikRevich/fate-seekers/services/fate-seekers-server/pkg/shared/networking/content/api"
+	"github.com/YarikRevich/fate-seekers/services/fate-seekers-server/pkg/shared/networking/content/emote"
+	"github.com/YarikRevich/fate-seekers/services/fate-seekers-server/pkg/shared/networking/content/middleware"
+	"github.com/YarikRevich/fate-seekers/services/fate-seekers-server/pkg/shared/networking/metadata/team"
+	"github.com/YarikRevich/fate-seekers/services/fate-seekers-server/pkg/shared/replay"
 	"github.com/YarikRevich/fate-seekers/services/fate-seekers-server/pkg/shared/repository"
 	"github.com/YarikRevich/fate-seekers/services/fate-seekers-server/pkg/shared/repository/converter"
+	"github.com/YarikRevich/fate-seekers/services/fate-seekers-server/pkg/shared/rules"
 	"google.golang.org/protobuf/proto"
 )
 
@@ -25,7 +33,7 @@ var (
 type Handler struct {
 }
 
-func (h *Handler) Process(key string, value []byte) error {
+func (h *Handler) Process(ctx context.Context, key string, value []byte) error {
 	switch key {
 	case contentv1.UPDATE_USER_METADATA_POSITIONS:
 		var message contentv1.UpdateUserMetadataPositionsRequest
@@ -33,9 +41,16 @@ func (h *Handler) Process(key string, value []byte) error {
 			return err
 		}
 
-		cache.
+		if err := middleware.CheckModerationMiddleware(message.GetIssuer()); err != nil {
+			return err
+		}
+
+		cacheTransaction := cache.
 			GetInstance().
-			BeginMetadataTransaction()
+			BeginTransaction(ctx, cache.METADATA_REGION)
+		defer cacheTransaction.Commit()
+
+		var found bool
 
 		metadata, ok := cache.
 			GetInstance().
@@ -53,18 +68,10 @@ func (h *Handler) Process(key string, value []byte) error {
 					GetUsersRepository().
 					GetByName(message.GetIssuer())
 				if err != nil {
-					cache.
-						GetInstance().
-						CommitMetadataTransaction()
-
 					return err
 				}
 
 				if !exists {
-					cache.
-						GetInstance().
-						CommitMetadataTransaction()
-
 					return ErrUserDoesNotExist
 				}
 
@@ -75,18 +82,10 @@ func (h *Handler) Process(key string, value []byte) error {
 				GetLobbiesRepository().
 				GetByUserID(userID)
 			if err != nil {
-				cache.
-					GetInstance().
-					CommitMetadataTransaction()
-
 				return err
 			}
 
 			if !exists {
-				cache.
-					GetInstance().
-					CommitMetadataTransaction()
-
 				return ErrLobbyDoesNotExist
 			}
 
@@ -94,10 +93,6 @@ func (h *Handler) Process(key string, value []byte) error {
 				GetInventoryRepository().
 				GetBySessionIDAndUserID(message.GetSessionId(), userID)
 			if err != nil {
-				cache.
-					GetInstance().
-					CommitMetadataTransaction()
-
 				return err
 			}
 
@@ -106,15 +101,13 @@ func (h *Handler) Process(key string, value []byte) error {
 			for _, newLobby := range newLobbies {
 				if newLobby.LobbyID == message.GetLobbyId() {
 					if newLobby.Eliminated {
-						cache.
-							GetInstance().
-							CommitMetadataTransaction()
-
 						return ErrUserIsEliminated
 					}
 
 					newLobby.Active = true
 
+					found = newLobby.SessionID == message.GetSessionId()
+
 					newLobby.PositionX = message.GetPosition().X
 					newLobby.PositionY = message.GetPosition().Y
 				}
@@ -127,33 +120,41 @@ func (h *Handler) Process(key string, value []byte) error {
 			for _, lobby := range metadata {
 				if lobby.LobbyID == message.GetLobbyId() {
 					if lobby.Eliminated {
-						cache.
-							GetInstance().
-							CommitMetadataTransaction()
-
 						return ErrUserIsEliminated
 					}
 
 					lobby.Active = true
 
+					found = lobby.SessionID == message.GetSessionId()
+
 					lobby.PositionX = message.GetPosition().X
 					lobby.PositionY = message.GetPosition().Y
 				}
 			}
 		}
 
-		cache.
-			GetInstance().
-			CommitMetadataTransaction()
+		if found {
+			replay.
+				GetInstance().
+				RecordPosition(
+					message.GetSessionId(), message.GetIssuer(), message.GetPosition().GetX(), message.GetPosition().GetY())
+		}
 	case contentv1.UPDATE_USER_METADATA_STATIC:
 		var message contentv1.UpdateUserMetadataStaticRequest
 		if err := proto.Unmarshal(value, &message); err != nil {
 			return err
 		}
 
-		cache.
+		if err := middleware.CheckModerationMiddleware(message.GetIssuer()); err != nil {
+			return err
+		}
+
+		cacheTransaction := cache.
 			GetInstance().
-			BeginMetadataTransaction()
+			BeginTransaction(ctx, cache.METADATA_REGION)
+		defer cacheTransaction.Commit()
+
+		var found bool
 
 		metadata, ok := cache.
 			GetInstance().
@@ -171,18 +172,10 @@ func (h *Handler) Process(key string, value []byte) error {
 					GetUsersRepository().
 					GetByName(message.GetIssuer())
 				if err != nil {
-					cache.
-						GetInstance().
-						CommitMetadataTransaction()
-
 					return err
 				}
 
 				if !exists {
-					cache.
-						GetInstance().
-						CommitMetadataTransaction()
-
 					return ErrUserDoesNotExist
 				}
 
@@ -193,18 +186,10 @@ func (h *Handler) Process(key string, value []byte) error {
 				GetLobbiesRepository().
 				GetByUserID(userID)
 			if err != nil {
-				cache.
-					GetInstance().
-					CommitMetadataTransaction()
-
 				return err
 			}
 
 			if !exists {
-				cache.
-					GetInstance().
-					CommitMetadataTransaction()
-
 				return ErrLobbyDoesNotExist
 			}
 
@@ -212,10 +197,6 @@ func (h *Handler) Process(key string, value []byte) error {
 				GetInventoryRepository().
 				GetBySessionIDAndUserID(message.GetSessionId(), userID)
 			if err != nil {
-				cache.
-					GetInstance().
-					CommitMetadataTransaction()
-
 				return err
 			}
 
@@ -224,13 +205,11 @@ func (h *Handler) Process(key string, value []byte) error {
 			for _, newLobby := range newLobbies {
 				if newLobby.LobbyID == message.GetLobbyId() {
 					if newLobby.Eliminated {
-						cache.
-							GetInstance().
-							CommitMetadataTransaction()
-
 						return ErrUserIsEliminated
 					}
 
+					found = newLobby.SessionID == message.GetSessionId()
+
 					newLobby.PositionStatic = message.GetStatic()
 				}
 			}
@@ -242,30 +221,40 @@ func (h *Handler) Process(key string, value []byte) error {
 			for _, lobby := range metadata {
 				if lobby.LobbyID == message.GetLobbyId() {
 					if lobby.Eliminated {
-						cache.
-							GetInstance().
-							CommitMetadataTransaction()
-
 						return ErrUserIsEliminated
 					}
 
+					found = lobby.SessionID == message.GetSessionId()
+
 					lobby.PositionStatic = message.GetStatic()
 				}
 			}
 		}
 
-		cache.
-			GetInstance().
-			CommitMetadataTransaction()
+		if found {
+			replay.
+				GetInstance().
+				RecordStatic(message.GetSessionId(), message.GetIssuer(), message.GetStatic())
+		}
 	case contentv1.HIT_PLAYER_WITH_FIST_REQUEST:
 		var message contentv1.HitPlayerWithFistRequest
 		if err := proto.Unmarshal(value, &message); err != nil {
 			return err
 		}
 
-		cache.
+		if err := middleware.CheckModerationMiddleware(message.GetIssuer()); err != nil {
+			return err
+		}
+
+		ruleset, err := rules.GetBySessionID(message.GetSessionId())
+		if err != nil {
+			return err
+		}
+
+		cacheTransaction := cache.
 			GetInstance().
-			BeginLobbySetTransaction()
+			BeginTransaction(ctx, cache.LOBBY_SETS_REGION, cache.METADATA_REGION)
+		defer cacheTransaction.Commit()
 
 		cachedLobbySet, ok := cache.
 			GetInstance().
@@ -275,18 +264,10 @@ func (h *Handler) Process(key string, value []byte) error {
 				GetLobbiesRepository().
 				GetBySessionID(message.GetSessionId())
 			if err != nil {
-				cache.
-					GetInstance().
-					CommitLobbySetTransaction()
-
 				return err
 			}
 
 			if !exists {
-				cache.
-					GetInstance().
-					CommitLobbySetTransaction()
-
 				return ErrLobbySetDoesNotExist
 			}
 
@@ -294,10 +275,14 @@ func (h *Handler) Process(key string, value []byte) error {
 
 			for _, lobby := range lobbies {
 				lobbySet = append(lobbySet, dto.CacheLobbySetEntity{
-					ID:     lobby.ID,
-					Issuer: lobby.UserEntity.Name,
-					Skin:   uint64(lobby.Skin),
-					Host:   lobby.Host,
+					ID:          lobby.ID,
+					Issuer:      lobby.UserEntity.Name,
+					DisplayName: lobby.UserEntity.DisplayName,
+					Skin:        uint64(lobby.Skin),
+					Host:        lobby.Host,
+					Spectator:   lobby.Spectator,
+					Ready:       lobby.Ready,
+					Team:        uint64(lobby.Team),
 				})
 			}
 
@@ -308,10 +293,6 @@ func (h *Handler) Process(key string, value []byte) error {
 				AddLobbySet(message.GetSessionId(), lobbySet)
 		}
 
-		cache.
-			GetInstance().
-			BeginMetadataTransaction()
-
 		cachedMetadata, ok := cache.
 			GetInstance().
 			GetMetadata(message.GetIssuer())
@@ -328,26 +309,10 @@ func (h *Handler) Process(key string, value []byte) error {
 					GetUsersRepository().
 					GetByName(message.GetIssuer())
 				if err != nil {
-					cache.
-						GetInstance().
-						CommitMetadataTransaction()
-
-					cache.
-						GetInstance().
-						CommitLobbySetTransaction()
-
 					return err
 				}
 
 				if !exists {
-					cache.
-						GetInstance().
-						CommitMetadataTransaction()
-
-					cache.
-						GetInstance().
-						CommitLobbySetTransaction()
-
 					return ErrUserDoesNotExist
 				}
 
@@ -358,26 +323,10 @@ func (h *Handler) Process(key string, value []byte) error {
 				GetLobbiesRepository().
 				GetByUserID(userID)
 			if err != nil {
-				cache.
-					GetInstance().
-					CommitMetadataTransaction()
-
-				cache.
-					GetInstance().
-					CommitLobbySetTransaction()
-
 				return err
 			}
 
 			if !exists {
-				cache.
-					GetInstance().
-					CommitMetadataTransaction()
-
-				cache.
-					GetInstance().
-					CommitLobbySetTransaction()
-
 				return ErrLobbyDoesNotExist
 			}
 
@@ -385,14 +334,6 @@ func (h *Handler) Process(key string, value []byte) error {
 				GetInventoryRepository().
 				GetBySessionIDAndUserID(message.GetSessionId(), userID)
 			if err != nil {
-				cache.
-					GetInstance().
-					CommitMetadataTransaction()
-
-				cache.
-					GetInstance().
-					CommitLobbySetTransaction()
-
 				return err
 			}
 
@@ -408,14 +349,20 @@ func (h *Handler) Process(key string, value []byte) error {
 		var (
 			mainPositionX float64
 			mainPositionY float64
+			mainTeam      uint64
 
 			mainFound bool
 		)
 
 		for _, metadata := range cachedMetadata {
 			if metadata.SessionID == message.GetSessionId() {
+				if metadata.Eliminated {
+					return ErrUserIsEliminated
+				}
+
 				mainPositionX = metadata.PositionX
 				mainPositionY = metadata.PositionY
+				mainTeam = metadata.Team
 
 				mainFound = true
 			}
@@ -443,26 +390,10 @@ func (h *Handler) Process(key string, value []byte) error {
 							GetUsersRepository().
 							GetByName(message.GetIssuer())
 						if err != nil {
-							cache.
-								GetInstance().
-								CommitMetadataTransaction()
-
-							cache.
-								GetInstance().
-								CommitLobbySetTransaction()
-
 							return err
 						}
 
 						if !exists {
-							cache.
-								GetInstance().
-								CommitMetadataTransaction()
-
-							cache.
-								GetInstance().
-								CommitLobbySetTransaction()
-
 							return ErrUserDoesNotExist
 						}
 
@@ -473,26 +404,10 @@ func (h *Handler) Process(key string, value []byte) error {
 						GetLobbiesRepository().
 						GetByUserID(userID)
 					if err != nil {
-						cache.
-							GetInstance().
-							CommitMetadataTransaction()
-
-						cache.
-							GetInstance().
-							CommitLobbySetTransaction()
-
 						return err
 					}
 
 					if !exists {
-						cache.
-							GetInstance().
-							CommitMetadataTransaction()
-
-						cache.
-							GetInstance().
-							CommitLobbySetTransaction()
-
 						return ErrLobbyDoesNotExist
 					}
 
@@ -500,14 +415,6 @@ func (h *Handler) Process(key string, value []byte) error {
 						GetInventoryRepository().
 						GetBySessionIDAndUserID(message.GetSessionId(), userID)
 					if err != nil {
-						cache.
-							GetInstance().
-							CommitMetadataTransaction()
-
-						cache.
-							GetInstance().
-							CommitLobbySetTransaction()
-
 						return err
 					}
 
@@ -522,34 +429,181 @@ func (h *Handler) Process(key string, value []byte) error {
 
 				for _, metadata := range cachedMetadata {
 					if metadata.SessionID == message.GetSessionId() {
+						if metadata.Spectator || metadata.Eliminated {
+							continue
+						}
+
+						if !ruleset.FriendlyFire && team.IsFriendly(mainTeam, metadata.Team) {
+							continue
+						}
+
 						dist := math.Hypot(metadata.PositionX-mainPositionX, metadata.PositionY-mainPositionY)
 
-						if dist <= dto.HIT_PLAYER_WITH_FIST_DISTANCE {
-							fmt.Println(metadata.Health, dto.HIT_PLAYER_WITH_FIST_RATE, metadata.Health-dto.HIT_PLAYER_WITH_FIST_RATE)
+						if dist <= ruleset.FistDistance {
+							services.IncHitLanded()
+
+							audit.GetInstance().Record(audit.Entry{
+								Action:    audit.ACTION_HIT,
+								Issuer:    message.GetIssuer(),
+								Target:    lobbySet.Issuer,
+								SessionID: message.GetSessionId(),
+							})
+
+							if metadata.Health <= ruleset.FistDamage {
+								services.IncElimination(services.EliminationCauseHit)
+
+								audit.GetInstance().Record(audit.Entry{
+									Action:    audit.ACTION_ELIMINATION,
+									Issuer:    message.GetIssuer(),
+									Target:    lobbySet.Issuer,
+									SessionID: message.GetSessionId(),
+									Details:   services.EliminationCauseHit,
+								})
+
+								replay.
+									GetInstance().
+									RecordElimination(
+										message.GetSessionId(), lobbySet.Issuer, services.EliminationCauseHit, message.GetIssuer())
 
-							if metadata.Health-dto.HIT_PLAYER_WITH_FIST_RATE <= 0 {
 								metadata.Eliminated = true
 							} else {
-								metadata.Health -= dto.HIT_PLAYER_WITH_FIST_RATE
+								metadata.Health -= ruleset.FistDamage
+
+								replay.
+									GetInstance().
+									RecordHealth(message.GetSessionId(), lobbySet.Issuer, metadata.Health)
 							}
 						}
 					}
 				}
 			}
 		}
+	case contentv1.SEND_EMOTE_REQUEST:
+		var message contentv1.SendEmoteRequest
+		if err := proto.Unmarshal(value, &message); err != nil {
+			return err
+		}
+
+		if err := middleware.CheckModerationMiddleware(message.GetIssuer()); err != nil {
+			return err
+		}
 
-		cache.
+		cacheTransaction := cache.
 			GetInstance().
-			CommitMetadataTransaction()
+			BeginTransaction(ctx, cache.LOBBY_SETS_REGION, cache.METADATA_REGION)
+		defer cacheTransaction.Commit()
+
+		cachedLobbySet, ok := cache.
+			GetInstance().
+			GetLobbySet(message.GetSessionId())
+		if !ok {
+			lobbies, exists, err := repository.
+				GetLobbiesRepository().
+				GetBySessionID(message.GetSessionId())
+			if err != nil {
+				return err
+			}
+
+			if !exists {
+				return ErrLobbySetDoesNotExist
+			}
+
+			var lobbySet []dto.CacheLobbySetEntity
 
-		cache.
+			for _, lobby := range lobbies {
+				lobbySet = append(lobbySet, dto.CacheLobbySetEntity{
+					ID:          lobby.ID,
+					Issuer:      lobby.UserEntity.Name,
+					DisplayName: lobby.UserEntity.DisplayName,
+					Skin:        uint64(lobby.Skin),
+					Host:        lobby.Host,
+					Spectator:   lobby.Spectator,
+					Ready:       lobby.Ready,
+					Team:        uint64(lobby.Team),
+				})
+			}
+
+			cachedLobbySet = lobbySet
+
+			cache.
+				GetInstance().
+				AddLobbySet(message.GetSessionId(), lobbySet)
+		}
+
+		mainMetadata, ok := getSessionMetadata(message.GetIssuer(), message.GetSessionId())
+		if !ok {
+			return ErrUserIsNotInLobby
+		}
+
+		if mainMetadata.Eliminated {
+			return ErrUserIsEliminated
+		}
+
+		err := emote.
 			GetInstance().
-			CommitLobbySetTransaction()
+			Check(message.GetIssuer(), message.GetEmote(), message.GetPing())
+		if err != nil {
+			return err
+		}
+
+		unit := dto.EmoteUnit{
+			Issuer:    message.GetIssuer(),
+			Emote:     message.GetEmote(),
+			Ping:      message.GetPing(),
+			PositionX: mainMetadata.PositionX,
+			PositionY: mainMetadata.PositionY,
+		}
+
+		if message.GetPing() {
+			unit.PositionX = message.GetPosition().GetX()
+			unit.PositionY = message.GetPosition().GetY()
+		}
+
+		for _, lobbySet := range cachedLobbySet {
+			if lobbySet.Issuer == message.GetIssuer() {
+				emote.
+					GetInstance().
+					Push(message.GetSessionId(), lobbySet.Issuer, unit)
+
+				continue
+			}
+
+			metadata, ok := getSessionMetadata(lobbySet.Issuer, message.GetSessionId())
+			if !ok || !metadata.Active || metadata.Spectator {
+				continue
+			}
+
+			if math.Hypot(metadata.PositionX-mainMetadata.PositionX, metadata.PositionY-mainMetadata.PositionY) <=
+				config.GetOperationEmoteRadius() {
+				emote.
+					GetInstance().
+					Push(message.GetSessionId(), lobbySet.Issuer, unit)
+			}
+		}
 	}
 
 	return nil
 }
 
+// getSessionMetadata retrieves cached metadata of the given issuer in the session with the given id.
+// Users without cached metadata have not sent their positions yet, so they are ignored.
+func getSessionMetadata(issuer string, sessionID int64) (*dto.CacheMetadataEntity, bool) {
+	cachedMetadata, ok := cache.
+		GetInstance().
+		GetMetadata(issuer)
+	if !ok {
+		return nil, false
+	}
+
+	for _, metadata := range cachedMetadata {
+		if metadata.SessionID == sessionID {
+			return metadata, true
+		}
+	}
+
+	return nil, false
+}
+
 // NewHandler initializes Handler.
 func NewHandler() *Handler {
 	return new(Handler)
@@ -1,7 +1,14 @@
 package middleware
 
 import (
+	"errors"
 	"sync"
+
+	"github.com/YarikRevich/fate-seekers/services/fate-seekers-server/pkg/shared/networking/moderation"
+)
+
+var (
+	ErrIssuerBanned = errors.New("err happened issuer is banned")
 )
 
 var (
@@ -29,6 +36,23 @@ func (ncmp *NetworkingContentMiddlewarePipeline) Run(callback func() error) erro
 	return nil
 }
 
+// CheckModerationMiddleware performs moderation validation, rejecting banned issuers and banned addresses.
+// Content receiver doesn't expose remote address of the packets, so the one issuer has been lately
+// connected to the metadata server from is checked.
+func CheckModerationMiddleware(issuer string) error {
+	if _, ok := moderation.GetInstance().GetIssuerBan(issuer); ok {
+		return ErrIssuerBanned
+	}
+
+	if address, ok := moderation.GetInstance().GetAddress(issuer); ok {
+		if _, ok := moderation.GetInstance().GetAddressBan(address); ok {
+			return ErrIssuerBanned
+		}
+	}
+
+	return nil
+}
+
 // newNetworkingContentMiddlewarePipeline initializes NetworkingContentMiddlewarePipeline.
 func newNetworkingContentMiddlewarePipeline() *NetworkingContentMiddlewarePipeline {
 	middlewares := []func(callback func() error) error{}
@@ -1,13 +1,14 @@
 package activity
 
 import (
+	"context"
 	"errors"
 	"time"
 
 	"github.com/YarikRevich/fate-seekers/services/fate-seekers-server/pkg/shared/dto"
-	"github.com/YarikRevich/fate-seekers/services/fate-seekers-server/pkg/shared/logging"
 	"github.com/YarikRevich/fate-seekers/services/fate-seekers-server/pkg/shared/networking/cache"
 	"github.com/YarikRevich/fate-seekers/services/fate-seekers-server/pkg/shared/repository"
+	"github.com/YarikRevich/fate-seekers/services/fate-seekers-server/pkg/shared/supervisor"
 )
 
 var (
@@ -15,105 +16,71 @@ var (
 )
 
 const (
+	// Represents name of the worker used for supervision.
+	workerName = "activity"
+
 	// Represents ticker duration used for metadata synchronization worker.
 	metadataTickerDuration = time.Second * 10
 )
 
-// Run starts the repository sync worker, which takes latest updates
-// from certain cache instances.
+// Run starts the activity worker under supervision.
 func Run() {
-	go func() {
-		ticker := time.NewTicker(metadataTickerDuration)
-
-		for range ticker.C {
-			ticker.Stop()
-
-			cache.
-				GetInstance().
-				BeginLobbySetTransaction()
-
-			cache.
-				GetInstance().
-				BeginMetadataTransaction()
-
-			for key, value := range cache.
-				GetInstance().
-				GetMetadataMappings() {
-				var userID int64
-
-				cachedUserID, ok := cache.
-					GetInstance().
-					GetUsers(key)
-				if ok {
-					userID = cachedUserID
-				} else {
-					user, exists, err := repository.
-						GetUsersRepository().
-						GetByName(key)
-					if err != nil {
-						cache.
-							GetInstance().
-							CommitMetadataTransaction()
-
-						cache.
-							GetInstance().
-							CommitLobbySetTransaction()
-
-						logging.GetInstance().Fatal(err.Error())
-					}
-
-					if !exists {
-						cache.
-							GetInstance().
-							CommitMetadataTransaction()
-
-						cache.
-							GetInstance().
-							CommitLobbySetTransaction()
-
-						logging.GetInstance().Fatal(ErrUserDoesNotExist.Error())
-					}
-
-					userID = user.ID
-				}
-
-				for _, lobby := range value {
-					err := repository.
-						GetLobbiesRepository().
-						InsertOrUpdate(
-							dto.LobbiesRepositoryInsertOrUpdateRequest{
-								UserID:         userID,
-								SessionID:      lobby.SessionID,
-								Skin:           lobby.Skin,
-								Health:         lobby.Health,
-								Eliminated:     lobby.Eliminated,
-								PositionX:      lobby.PositionX,
-								PositionY:      lobby.PositionY,
-								PositionStatic: lobby.PositionStatic,
-							})
-					if err != nil {
-						cache.
-							GetInstance().
-							CommitLobbySetTransaction()
-
-						cache.
-							GetInstance().
-							CommitMetadataTransaction()
+	supervisor.GetInstance().Run(workerName, metadataTickerDuration, process)
+}
 
-						logging.GetInstance().Fatal(err.Error())
-					}
-				}
+// process performs a single run of the worker, which takes latest updates
+// from certain cache instances.
+func process(ctx context.Context) error {
+	cacheTransaction := cache.
+		GetInstance().
+		BeginTransaction(ctx, cache.LOBBY_SETS_REGION, cache.METADATA_REGION)
+	defer cacheTransaction.Commit()
+
+	for key, value := range cache.
+		GetInstance().
+		GetMetadataMappings() {
+		var userID int64
+
+		cachedUserID, ok := cache.
+			GetInstance().
+			GetUsers(key)
+		if ok {
+			userID = cachedUserID
+		} else {
+			user, exists, err := repository.
+				GetUsersRepository().
+				GetByName(key)
+			if err != nil {
+				return err
 			}
 
-			cache.
-				GetInstance().
-				CommitLobbySetTransaction()
+			if !exists {
+				return ErrUserDoesNotExist
+			}
 
-			cache.
-				GetInstance().
-				CommitMetadataTransaction()
+			userID = user.ID
+		}
 
-			ticker.Reset(metadataTickerDuration)
+		for _, lobby := range value {
+			err := repository.
+				GetLobbiesRepository().
+				InsertOrUpdate(
+					dto.LobbiesRepositoryInsertOrUpdateRequest{
+						UserID:         userID,
+						SessionID:      lobby.SessionID,
+						Skin:           lobby.Skin,
+						Health:         lobby.Health,
+						Eliminated:     lobby.Eliminated,
+						Spectator:      lobby.Spectator,
+						PositionX:      lobby.PositionX,
+						PositionY:      lobby.PositionY,
+						PositionStatic: lobby.PositionStatic,
+					})
+			if err != nil {
+				return err
+			}
 		}
-	}()
+	}
+
+	return nil
 }
@@ -0,0 +1,99 @@
+package broadcast
+
+import (
+	"sync"
+	"time"
+
+	"github.com/YarikRevich/fate-seekers/services/fate-seekers-server/pkg/shared/dto"
+)
+
+var (
+	// GetInstance retrieves instance of the broadcast manager, performing initialization if needed.
+	GetInstance = sync.OnceValue[*BroadcastManager](newBroadcastManager)
+)
+
+const (
+	// Describes duration, during which broadcast message is available for delivery.
+	broadcastMessageDuration = time.Minute
+)
+
+// BroadcastManager represents broadcast messages manager, which holds messages until they expire.
+type BroadcastManager struct {
+	// Represents mutex used for messages access.
+	mu sync.Mutex
+
+	// Represents last assigned message identifier.
+	sequence int64
+
+	// Represents all the available broadcast messages.
+	messages []dto.BroadcastMessageUnit
+
+	// Represents last delivered message identifier for each of the issuers.
+	delivered map[string]int64
+}
+
+// Push adds new broadcast message.
+func (bm *BroadcastManager) Push(text string) {
+	bm.mu.Lock()
+
+	bm.sequence++
+
+	bm.messages = append(bm.messages, dto.BroadcastMessageUnit{
+		ID:        bm.sequence,
+		Text:      text,
+		CreatedAt: time.Now(),
+	})
+
+	bm.mu.Unlock()
+}
+
+// Retrieve retrieves all the broadcast messages, which have not been delivered to the given issuer yet.
+func (bm *BroadcastManager) Retrieve(issuer string) []string {
+	bm.mu.Lock()
+
+	bm.clean()
+
+	var result []string
+
+	last, ok := bm.delivered[issuer]
+	if !ok {
+		// Newly connected users receive only the messages pushed after their first ping.
+		bm.delivered[issuer] = bm.sequence
+
+		bm.mu.Unlock()
+
+		return result
+	}
+
+	for _, message := range bm.messages {
+		if message.ID > last {
+			result = append(result, message.Text)
+		}
+	}
+
+	bm.delivered[issuer] = bm.sequence
+
+	bm.mu.Unlock()
+
+	return result
+}
+
+// clean removes all the expired broadcast messages.
+func (bm *BroadcastManager) clean() {
+	var actual []dto.BroadcastMessageUnit
+
+	for _, message := range bm.messages {
+		if time.Since(message.CreatedAt) < broadcastMessageDuration {
+			actual = append(actual, message)
+		}
+	}
+
+	bm.messages = actual
+}
+
+// newBroadcastManager initializes BroadcastManager.
+func newBroadcastManager() *BroadcastManager {
+	return &BroadcastManager{
+		delivered: make(map[string]int64),
+	}
+}
@@ -0,0 +1,197 @@
+package chat
+
+import (
+	"errors"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/YarikRevich/fate-seekers/services/fate-seekers-server/pkg/shared/config"
+	"github.com/YarikRevich/fate-seekers/services/fate-seekers-server/pkg/shared/dto"
+)
+
+var (
+	ErrMessageIsEmpty     = errors.New("err happened chat message is empty")
+	ErrMessageIsTooLong   = errors.New("err happened chat message exceeds max allowed length")
+	ErrMessageIsRejected  = errors.New("err happened chat message has been rejected by chat filter")
+	ErrRateLimitIsReached = errors.New("err happened chat rate limit has been reached")
+)
+
+const (
+	// Represents max amount of latest messages kept per session.
+	maxSessionMessages = 50
+)
+
+var (
+	// GetInstance retrieves instance of the chat manager, performing initialization if needed.
+	GetInstance = sync.OnceValue[*ChatManager](newChatManager)
+)
+
+// Filter represents chat message filter hook, which is applied to each of the sent messages. Filter
+// returns modified message text or false, when message should be rejected.
+type Filter func(issuer, text string) (string, bool)
+
+// ChatManager represents chat manager, which holds latest messages of each of the sessions.
+type ChatManager struct {
+	// Represents mutex used for messages access.
+	mu sync.Mutex
+
+	// Represents last assigned message identifier.
+	sequence int64
+
+	// Represents latest messages of each of the sessions.
+	messages map[int64][]dto.ChatMessageUnit
+
+	// Represents send times of the latest messages of each of the issuers, used for rate limiting.
+	sent map[string][]time.Time
+
+	// Represents registered chat filters applied in the order of registration.
+	filters []Filter
+}
+
+// AddFilter registers the given chat filter.
+func (cm *ChatManager) AddFilter(filter Filter) {
+	cm.mu.Lock()
+	defer cm.mu.Unlock()
+
+	cm.filters = append(cm.filters, filter)
+}
+
+// Push adds new message of the given issuer to the session with the given id, performing
+// length, rate limit and filters checks. Rejected messages are counted by the rate limit too.
+func (cm *ChatManager) Push(sessionID int64, issuer, text string) error {
+	text = strings.TrimSpace(text)
+
+	if len(text) == 0 {
+		return ErrMessageIsEmpty
+	}
+
+	if utf8.RuneCountInString(text) > config.GetOperationChatMaxLength() {
+		return ErrMessageIsTooLong
+	}
+
+	cm.mu.Lock()
+
+	now := time.Now()
+
+	sent := slices.DeleteFunc(cm.sent[issuer], func(value time.Time) bool {
+		return now.Sub(value) >= config.GetOperationChatRateInterval()
+	})
+
+	if len(sent) >= config.GetOperationChatRateLimit() {
+		cm.sent[issuer] = sent
+
+		cm.mu.Unlock()
+
+		return ErrRateLimitIsReached
+	}
+
+	cm.sent[issuer] = append(sent, now)
+
+	filters := slices.Clone(cm.filters)
+
+	cm.mu.Unlock()
+
+	// Filters are applied without holding the lock, because they may perform slow operations.
+	for _, filter := range filters {
+		var ok bool
+
+		text, ok = filter(issuer, text)
+		if !ok {
+			return ErrMessageIsRejected
+		}
+	}
+
+	cm.mu.Lock()
+	defer cm.mu.Unlock()
+
+	cm.sequence++
+
+	messages := append(cm.messages[sessionID], dto.ChatMessageUnit{
+		ID:        cm.sequence,
+		Issuer:    issuer,
+		Text:      text,
+		CreatedAt: now,
+	})
+
+	if len(messages) > maxSessionMessages {
+		messages = slices.Clone(messages[len(messages)-maxSessionMessages:])
+	}
+
+	cm.messages[sessionID] = messages
+
+	return nil
+}
+
+// Retrieve retrieves all the messages of the session with the given id, which were sent after
+// the message with the given id.
+func (cm *ChatManager) Retrieve(sessionID, after int64) []dto.ChatMessageUnit {
+	cm.mu.Lock()
+	defer cm.mu.Unlock()
+
+	var result []dto.ChatMessageUnit
+
+	for _, message := range cm.messages[sessionID] {
+		if message.ID > after {
+			result = append(result, message)
+		}
+	}
+
+	return result
+}
+
+// Remove removes all the messages of the session with the given id.
+func (cm *ChatManager) Remove(sessionID int64) {
+	cm.mu.Lock()
+	defer cm.mu.Unlock()
+
+	delete(cm.messages, sessionID)
+}
+
+// newChatManager initializes ChatManager with the default chat filter registered.
+func newChatManager() *ChatManager {
+	return &ChatManager{
+		messages: make(map[int64][]dto.ChatMessageUnit),
+		sent:     make(map[string][]time.Time),
+		filters:  []Filter{maskBannedWords},
+	}
+}
+
+// maskBannedWords represents default chat filter, which masks all the configured banned words
+// in the message text regardless of their case.
+func maskBannedWords(issuer, text string) (string, bool) {
+	result := []rune(text)
+
+	lowered := toLower(result)
+
+	for _, word := range config.GetOperationChatBannedWords() {
+		pattern := toLower([]rune(word))
+		if len(pattern) == 0 {
+			continue
+		}
+
+		for i := 0; i+len(pattern) <= len(lowered); i++ {
+			if slices.Equal(lowered[i:i+len(pattern)], pattern) {
+				for j := i; j < i+len(pattern); j++ {
+					result[j] = '*'
+				}
+			}
+		}
+	}
+
+	return string(result), true
+}
+
+// toLower converts each of the given symbols to lower case, keeping symbols positions.
+func toLower(symbols []rune) []rune {
+	result := make([]rune, len(symbols))
+
+	for i, symbol := range symbols {
+		result[i] = unicode.ToLower(symbol)
+	}
+
+	return result
+}
@@ -3,11 +3,13 @@ package connector
 import (
 	"fmt"
 	"net"
+	"sync/atomic"
 
 	"github.com/YarikRevich/fate-seekers/services/fate-seekers-server/pkg/shared/config"
 	metadatav1 "github.com/YarikRevich/fate-seekers/services/fate-seekers-server/pkg/shared/networking/metadata/api"
 	"github.com/YarikRevich/fate-seekers/services/fate-seekers-server/pkg/shared/networking/metadata/handler"
 	"github.com/YarikRevich/fate-seekers/services/fate-seekers-server/pkg/shared/networking/metadata/middleware"
+	"go.opentelemetry.io/contrib/instrumentation/google.golang.org/grpc/otelgrpc"
 	"google.golang.org/grpc"
 )
 
@@ -15,6 +17,9 @@ import (
 type NetworkingMetadataConnector struct {
 	// Represents established connection instance.
 	conn net.Listener
+
+	// Represents if server is currently listening.
+	listening atomic.Bool
 }
 
 func (nmc *NetworkingMetadataConnector) Connect(callback func(err error)) {
@@ -29,24 +34,42 @@ func (nmc *NetworkingMetadataConnector) Connect(callback func(err error)) {
 		}
 
 		grpcServer := grpc.NewServer(
+			grpc.StatsHandler(otelgrpc.NewServerHandler()),
 			grpc.ChainUnaryInterceptor(
+				middleware.MetricsMiddleware,
 				middleware.CheckValidationMiddleware,
 				middleware.CheckAuthenticationMiddleware,
+				middleware.CheckModerationMiddleware,
+			),
+			grpc.ChainStreamInterceptor(
+				middleware.MetricsStreamMiddleware,
+				middleware.CheckModerationStreamMiddleware,
 			),
 		)
 
 		metadatav1.RegisterMetadataServiceServer(grpcServer, handler.NewHandler())
 
+		nmc.listening.Store(true)
+
 		callback(nil)
 
 		grpcServer.Serve(nmc.conn)
+
+		nmc.listening.Store(false)
 	}()
 }
 
 func (nmc *NetworkingMetadataConnector) Close() error {
+	nmc.listening.Store(false)
+
 	return nmc.conn.Close()
 }
 
+// IsListening checks if metadata server is currently listening.
+func (nmc *NetworkingMetadataConnector) IsListening() bool {
+	return nmc.listening.Load()
+}
+
 // NewNetworkingMetadataConnector initializes NetworkingMetadataConnector.
 func NewNetworkingMetadataConnector() *NetworkingMetadataConnector {
 	return new(NetworkingMetadataConnector)
@@ -0,0 +1,36 @@
+package countdown
+
+import (
+	"sync"
+	"time"
+)
+
+// getSessionCountdowns retrieves instance of the session start countdowns map, performing initilization if needed.
+var getSessionCountdowns = sync.OnceValue[*sync.Map](func() *sync.Map {
+	return new(sync.Map)
+})
+
+// Start starts session start countdown of the given duration for the session with the given id,
+// replacing the previously started one. Countdown is only tracked by the server, session is started
+// by the host client, once countdown expires. When host removes its lobby, host rights are handed over
+// to another lobby, which client takes the countdown over. Session is not started, while host client
+// is disconnected without removing its lobby.
+func Start(sessionID int64, duration time.Duration) {
+	getSessionCountdowns().Store(sessionID, time.Now().Add(duration))
+}
+
+// GetRemaining retrieves time remaining until the end of session start countdown of the session
+// with the given id. False is returned, when countdown has not been started.
+func GetRemaining(sessionID int64) (time.Duration, bool) {
+	raw, ok := getSessionCountdowns().Load(sessionID)
+	if !ok {
+		return 0, false
+	}
+
+	return max(time.Until(raw.(time.Time)), 0), true
+}
+
+// Stop stops session start countdown of the session with the given id.
+func Stop(sessionID int64) {
+	getSessionCountdowns().Delete(sessionID)
+}
@@ -1,13 +1,20 @@
 package events
 
 import (
+	"context"
 	"errors"
 	"math/rand"
 	"sync"
 	"time"
 
+	"github.com/YarikRevich/fate-seekers/services/fate-seekers-server/pkg/shared/audit"
+	"github.com/YarikRevich/fate-seekers/services/fate-seekers-server/pkg/shared/config"
 	"github.com/YarikRevich/fate-seekers/services/fate-seekers-server/pkg/shared/dto"
+	"github.com/YarikRevich/fate-seekers/services/fate-seekers-server/pkg/shared/monitoring/services"
 	"github.com/YarikRevich/fate-seekers/services/fate-seekers-server/pkg/shared/networking/cache"
+	"github.com/YarikRevich/fate-seekers/services/fate-seekers-server/pkg/shared/replay"
+	"github.com/YarikRevich/fate-seekers/services/fate-seekers-server/pkg/shared/rules"
+	"github.com/YarikRevich/fate-seekers/services/fate-seekers-server/pkg/shared/supervisor"
 )
 
 var (
@@ -15,11 +22,11 @@ var (
 )
 
 const (
+	// Represents name of the worker used for supervision.
+	workerName = "events"
+
 	// Represents ticker duration used for events processing worker.
 	eventsTickerDuration = time.Second * 1
-
-	// Represents events processing time, which is used as a pause between events.
-	eventsProcessingDuration = time.Minute * 1
 )
 
 // GetSessionEvents retrieves instance of the session events map, performing initilization if needed.
@@ -27,136 +34,149 @@ var GetSessionEvents = sync.OnceValue[*sync.Map](func() *sync.Map {
 	return new(sync.Map)
 })
 
-// Run starts the repository sync worker, which takes latest updates
-// from certain cache instances.
+// Run starts the events worker under supervision.
 func Run() {
-	go func() {
-		ticker := time.NewTicker(eventsTickerDuration)
+	supervisor.GetInstance().Run(workerName, eventsTickerDuration, process)
+}
 
-		for range ticker.C {
-			ticker.Stop()
+// process performs a single run of the worker, which updates events of all the started sessions.
+func process(ctx context.Context) error {
+	cacheTransaction := cache.
+		GetInstance().
+		BeginTransaction(
+			ctx,
+			cache.SESSIONS_REGION,
+			cache.LOBBY_SETS_REGION,
+			cache.METADATA_REGION)
+	defer cacheTransaction.Commit()
+
+	for key, value := range cache.
+		GetInstance().
+		GetLobbySetMappings() {
+		cachedSession, ok := cache.GetInstance().GetSessions(key)
+		if !ok {
+			continue
+		}
 
-			cache.
-				GetInstance().
-				BeginSessionsTransaction()
+		if !cachedSession.Started {
+			continue
+		}
 
-			cache.
-				GetInstance().
-				BeginMetadataTransaction()
+		ruleset := rules.Get(cachedSession.Rules)
+		if !ruleset.EventsEnabled {
+			continue
+		}
 
-			cache.
-				GetInstance().
-				BeginLobbySetTransaction()
+		var sessionEvent *dto.SessionEvent
 
-			for key, value := range cache.
-				GetInstance().
-				GetLobbySetMappings() {
+		raw, ok := GetSessionEvents().Load(cachedSession.Name)
+		if ok {
+			sessionEvent = raw.(*dto.SessionEvent)
+		} else {
+			sessionEvent = new(dto.SessionEvent)
 
-				cachedSession, ok := cache.GetInstance().GetSessions(key)
-				if !ok {
-					ticker.Reset(eventsTickerDuration)
+			GetSessionEvents().Store(cachedSession.Name, sessionEvent)
+		}
 
-					continue
-				}
+		if sessionEvent.EndRate.Before(time.Now()) {
+			if sessionEvent.Name != dto.EVENT_NAME_EMPTY {
+				sessionEvent.Name = dto.EVENT_NAME_EMPTY
+			}
 
-				if !cachedSession.Started {
-					ticker.Reset(eventsTickerDuration)
+			if sessionEvent.PauseRate.IsZero() {
+				sessionEvent.PauseRate = time.Now().Add(config.GetOperationEventsPause())
+			}
 
-					continue
-				}
+			if sessionEvent.PauseRate.After(time.Now()) {
+				continue
+			}
 
-				var sessionEvent *dto.SessionEvent
+			if rand.Intn(2) == 0 {
+				sessionEvent.PauseRate = time.Now().Add(config.GetOperationEventsPause())
 
-				raw, ok := GetSessionEvents().Load(cachedSession.Name)
-				if ok {
-					sessionEvent = raw.(*dto.SessionEvent)
-				} else {
-					sessionEvent = new(dto.SessionEvent)
+				continue
+			}
 
-					GetSessionEvents().Store(cachedSession.Name, sessionEvent)
-				}
+			selectedEvent := dto.EVENTS_NAME_MAP[rand.Intn(len(dto.EVENTS_NAME_MAP))]
 
-				if sessionEvent.EndRate.Before(time.Now()) {
-					if sessionEvent.Name != dto.EVENT_NAME_EMPTY {
-						sessionEvent.Name = dto.EVENT_NAME_EMPTY
-					}
+			sessionEvent.Name = selectedEvent
 
-					if sessionEvent.PauseRate.IsZero() {
-						sessionEvent.PauseRate = time.Now().Add(eventsProcessingDuration)
-					}
+			services.IncEventFired(selectedEvent)
 
-					if sessionEvent.PauseRate.After(time.Now()) {
-						ticker.Reset(eventsTickerDuration)
+			replay.
+				GetInstance().
+				RecordEvent(key, selectedEvent)
 
-						continue
-					}
+			switch selectedEvent {
+			case dto.EVENT_NAME_TOXIC_RAIN:
+				sessionEvent.FrequencyRate = time.Now().Add(config.GetOperationEventsToxicRainFrequency())
+				sessionEvent.EndRate = time.Now().Add(config.GetOperationEventsToxicRainDuration())
+				sessionEvent.PauseRate = sessionEvent.EndRate.Add(config.GetOperationEventsPause())
+			}
+		} else if sessionEvent.FrequencyRate.Before(time.Now()) {
+			for _, lobby := range value {
+				metadataSet, ok := cache.
+					GetInstance().
+					GetMetadata(lobby.Issuer)
+				if ok {
+					for _, metadata := range metadataSet {
+						if metadata.SessionID == key {
+							if !metadata.Eliminated {
+								switch sessionEvent.Name {
+								case dto.EVENT_NAME_TOXIC_RAIN:
+									hitRate := uint64(config.GetOperationEventsToxicRainHitRate()) * ruleset.EventsDamageMultiplier
 
-					if rand.Intn(2) == 0 {
-						sessionEvent.PauseRate = time.Now().Add(eventsProcessingDuration)
+									if metadata.Health >= hitRate {
+										metadata.Health -= hitRate
 
-						ticker.Reset(eventsTickerDuration)
+										replay.
+											GetInstance().
+											RecordHealth(key, lobby.Issuer, metadata.Health)
 
-						continue
-					}
+										if metadata.Health == 0 {
+											metadata.Eliminated = true
 
-					selectedEvent := dto.EVENTS_NAME_MAP[rand.Intn(len(dto.EVENTS_NAME_MAP))]
+											recordElimination(lobby.Issuer, key, sessionEvent.Name)
+										}
+									} else {
+										metadata.Health = 0
+										metadata.Eliminated = true
 
-					sessionEvent.Name = selectedEvent
+										replay.
+											GetInstance().
+											RecordHealth(key, lobby.Issuer, metadata.Health)
 
-					switch selectedEvent {
-					case dto.EVENT_NAME_TOXIC_RAIN:
-						sessionEvent.FrequencyRate = time.Now().Add(dto.EVENT_FREQUENCY_RATE_TOXIC_RAIN)
-						sessionEvent.EndRate = time.Now().Add(dto.EVENT_DURATION_TIME_TOXIC_RAIN)
-						sessionEvent.PauseRate = sessionEvent.EndRate.Add(eventsProcessingDuration)
-					}
-				} else if sessionEvent.FrequencyRate.Before(time.Now()) {
-					for _, lobby := range value {
-						metadataSet, ok := cache.
-							GetInstance().
-							GetMetadata(lobby.Issuer)
-						if ok {
-							for _, metadata := range metadataSet {
-								if metadata.SessionID == key {
-									if !metadata.Eliminated {
-										switch sessionEvent.Name {
-										case dto.EVENT_NAME_TOXIC_RAIN:
-											if metadata.Health-dto.EVENT_HIT_RATE_TOXIC_RAIN >= 0 {
-												metadata.Health -= dto.EVENT_HIT_RATE_TOXIC_RAIN
-
-												if metadata.Health == 0 {
-													metadata.Eliminated = true
-												}
-											} else {
-												metadata.Health = 0
-												metadata.Eliminated = true
-											}
-										}
+										recordElimination(lobby.Issuer, key, sessionEvent.Name)
 									}
 								}
 							}
 						}
 					}
-
-					switch sessionEvent.Name {
-					case dto.EVENT_NAME_TOXIC_RAIN:
-						sessionEvent.FrequencyRate = time.Now().Add(dto.EVENT_FREQUENCY_RATE_TOXIC_RAIN)
-					}
 				}
 			}
 
-			cache.
-				GetInstance().
-				CommitLobbySetTransaction()
+			switch sessionEvent.Name {
+			case dto.EVENT_NAME_TOXIC_RAIN:
+				sessionEvent.FrequencyRate = time.Now().Add(config.GetOperationEventsToxicRainFrequency())
+			}
+		}
+	}
 
-			cache.
-				GetInstance().
-				CommitMetadataTransaction()
+	return nil
+}
 
-			cache.
-				GetInstance().
-				CommitSessionsTransaction()
+// recordElimination records elimination of the given issuer caused by the given event.
+func recordElimination(issuer string, sessionID int64, event string) {
+	services.IncElimination(services.EliminationCauseEvent)
 
-			ticker.Reset(eventsTickerDuration)
-		}
-	}()
+	audit.GetInstance().Record(audit.Entry{
+		Action:    audit.ACTION_ELIMINATION,
+		Issuer:    issuer,
+		SessionID: sessionID,
+		Details:   services.EliminationCauseEvent + ":" + event,
+	})
+
+	replay.
+		GetInstance().
+		RecordElimination(sessionID, issuer, services.EliminationCauseEvent+":"+event, "")
 }
@@ -3,25 +3,40 @@ package handler
 import (
 	"context"
 	"errors"
+	"fmt"
 	"io"
+	"maps"
 	"math/rand"
 	"time"
 
+	"github.com/YarikRevich/fate-seekers/services/fate-seekers-server/pkg/shared/access"
+	"github.com/YarikRevich/fate-seekers/services/fate-seekers-server/pkg/shared/audit"
 	"github.com/YarikRevich/fate-seekers/services/fate-seekers-server/pkg/shared/config"
 	"github.com/YarikRevich/fate-seekers/services/fate-seekers-server/pkg/shared/db"
 	"github.com/YarikRevich/fate-seekers/services/fate-seekers-server/pkg/shared/dto"
 	"github.com/YarikRevich/fate-seekers/services/fate-seekers-server/pkg/shared/entity"
+	"github.com/YarikRevich/fate-seekers/services/fate-seekers-server/pkg/shared/logging"
 	"github.com/YarikRevich/fate-seekers/services/fate-seekers-server/pkg/shared/monitoring/services"
 	"github.com/YarikRevich/fate-seekers/services/fate-seekers-server/pkg/shared/networking/cache"
+	"github.com/YarikRevich/fate-seekers/services/fate-seekers-server/pkg/shared/networking/content/emote"
 	metadatav1 "github.com/YarikRevich/fate-seekers/services/fate-seekers-server/pkg/shared/networking/metadata/api"
+	"github.com/YarikRevich/fate-seekers/services/fate-seekers-server/pkg/shared/networking/metadata/broadcast"
+	"github.com/YarikRevich/fate-seekers/services/fate-seekers-server/pkg/shared/networking/metadata/chat"
+	"github.com/YarikRevich/fate-seekers/services/fate-seekers-server/pkg/shared/networking/metadata/countdown"
 	"github.com/YarikRevich/fate-seekers/services/fate-seekers-server/pkg/shared/networking/metadata/events"
+	"github.com/YarikRevich/fate-seekers/services/fate-seekers-server/pkg/shared/networking/metadata/invitation"
+	"github.com/YarikRevich/fate-seekers/services/fate-seekers-server/pkg/shared/networking/metadata/matchmaking"
+	"github.com/YarikRevich/fate-seekers/services/fate-seekers-server/pkg/shared/networking/metadata/team"
 	"github.com/YarikRevich/fate-seekers/services/fate-seekers-server/pkg/shared/networking/metadata/utils"
+	"github.com/YarikRevich/fate-seekers/services/fate-seekers-server/pkg/shared/replay"
 	"github.com/YarikRevich/fate-seekers/services/fate-seekers-server/pkg/shared/repository"
 	"github.com/YarikRevich/fate-seekers/services/fate-seekers-server/pkg/shared/repository/converter"
+	"github.com/YarikRevich/fate-seekers/services/fate-seekers-server/pkg/shared/rules"
 	"golang.org/x/exp/slices"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
 	"gorm.io/gorm"
 )
 
@@ -48,6 +63,25 @@ var (
 	ErrSessionHasMaxAmountOfLobbies         = errors.New("err happened session has max amount of lobbies")
 	ErrSessionHasLobbies                    = errors.New("err happened session has lobbies")
 	ErrSessionMetadataRetrievalNotAllowed   = errors.New("err happened session metadata retrieval not allowed")
+	ErrSessionHasNoPlayers                  = errors.New("err happened session has no players to spectate")
+	ErrRulesPresetDoesNotExist              = errors.New("err happened rules preset does not exist")
+	ErrSessionIsNotPrivate                  = errors.New("err happened session is not private")
+	ErrLobbyIsNotReady                      = errors.New("err happened not all the players in a lobby are ready")
+	ErrLobbyIsSpectator                     = errors.New("err happened lobby is a spectator one")
+	ErrLobbyCountdownIsNotValid             = errors.New("err happened lobby countdown exceeds max allowed duration")
+	ErrUserIsNotInSession                   = errors.New("err happened user is not in a session")
+	ErrLobbyTeamIsNotValid                  = errors.New("err happened lobby team is not valid")
+	ErrLobbyTeamsNotEnough                  = errors.New("err happened lobbies are not split at least into two teams")
+	ErrProfileSkinIsNotValid                = errors.New("err happened profile skin is not valid")
+	ErrProfileAvatarIsNotValid              = errors.New("err happened profile avatar is not valid")
+	ErrProfileDisplayNameAlreadyExists      = errors.New("err happened profile display name already exists")
+	ErrProfileDisplayNameIsNotSet           = errors.New("err happened profile display name is not set")
+	ErrFriendDoesNotExist                   = errors.New("err happened friend does not exist")
+	ErrFriendRequestIsSentToSelf            = errors.New("err happened friend request is sent to self")
+	ErrFriendRequestIsNotReceived           = errors.New("err happened friend request has not been received by user")
+	ErrFriendshipAlreadyExists              = errors.New("err happened friendship already exists")
+	ErrFriendshipDoesNotExist               = errors.New("err happened friendship does not exist")
+	ErrFriendshipIsNotAccepted              = errors.New("err happened friendship has not been accepted")
 )
 
 // Describes constant values used for handler management.
@@ -58,6 +92,13 @@ const (
 	getChestsFrequency          = time.Second
 	getHealthPacksFrequency     = time.Second
 	getEventsFrequency          = time.Millisecond * 100
+	getReplayChunkSize          = 256
+	listPublicSessionsPageSize  = 20
+	getMatchmakingFrequency     = time.Second
+	maxLobbyCountdown           = time.Minute * 5
+	getChatMessagesFrequency    = time.Millisecond * 500
+	getEmotesFrequency          = time.Millisecond * 100
+	getInvitationsFrequency     = time.Second
 )
 
 // Handler represents handler implementation of metadatav1.MetadataServer.
@@ -66,10 +107,12 @@ type Handler struct {
 }
 
 func (h *Handler) PingConnection(ctx context.Context, request *metadatav1.PingConnectionRequest) (*metadatav1.PingConnectionResponse, error) {
-	// Leave empty. Used to simulation external call to check if client configuration is correct and
-	// perform scheduled ping requests.
+	// Used to simulation external call to check if client configuration is correct and
+	// perform scheduled ping requests, delivering pending broadcast messages.
 
-	return nil, nil
+	return &metadatav1.PingConnectionResponse{
+		Messages: broadcast.GetInstance().Retrieve(request.GetIssuer()),
+	}, nil
 }
 
 func (h *Handler) UpdateSessionActivity(stream grpc.ClientStreamingServer[metadatav1.UpdateSessionActivityRequest, metadatav1.UpdateSessionActivityResponse]) error {
@@ -108,12 +151,97 @@ func (h *Handler) CreateUserIfNotExists(ctx context.Context, request *metadatav1
 	return new(metadatav1.CreateUserIfNotExistsResponse), nil
 }
 
+func (h *Handler) GetProfile(ctx context.Context, request *metadatav1.GetProfileRequest) (*metadatav1.GetProfileResponse, error) {
+	user, exists, err := repository.
+		GetUsersRepository().
+		GetByName(request.GetIssuer())
+	if err != nil {
+		return nil, err
+	}
+
+	if !exists {
+		return nil, ErrUserDoesNotExist
+	}
+
+	return &metadatav1.GetProfileResponse{
+		DisplayName: user.DisplayName,
+		Skin:        uint64(user.Skin),
+		Avatar:      uint64(user.Avatar),
+	}, nil
+}
+
+func (h *Handler) UpdateProfile(ctx context.Context, request *metadatav1.UpdateProfileRequest) (*metadatav1.UpdateProfileResponse, error) {
+	if request.GetSkin() >= config.MAX_SESSION_USERS {
+		return nil, status.Errorf(codes.InvalidArgument, ErrProfileSkinIsNotValid.Error())
+	}
+
+	if request.GetAvatar() >= config.MAX_SESSION_USERS {
+		return nil, status.Errorf(codes.InvalidArgument, ErrProfileAvatarIsNotValid.Error())
+	}
+
+	user, exists, err := repository.
+		GetUsersRepository().
+		GetByName(request.GetIssuer())
+	if err != nil {
+		return nil, err
+	}
+
+	if !exists {
+		return nil, ErrUserDoesNotExist
+	}
+
+	selectedUser, exists, err := repository.
+		GetUsersRepository().
+		GetByDisplayName(request.GetDisplayName())
+	if err != nil {
+		return nil, err
+	}
+
+	if exists && selectedUser.ID != user.ID {
+		return nil, status.Errorf(codes.AlreadyExists, ErrProfileDisplayNameAlreadyExists.Error())
+	}
+
+	cacheTransaction := cache.
+		GetInstance().
+		BeginTransaction(ctx, cache.LOBBY_SETS_REGION)
+	defer cacheTransaction.Commit()
+
+	err = repository.
+		GetUsersRepository().
+		UpdateProfileByName(request.GetIssuer(), request.GetDisplayName(), request.GetSkin(), request.GetAvatar())
+	if err != nil {
+		if errors.Is(err, gorm.ErrDuplicatedKey) {
+			return nil, status.Errorf(codes.AlreadyExists, ErrProfileDisplayNameAlreadyExists.Error())
+		}
+
+		return nil, err
+	}
+
+	lobbies, exists, err := repository.
+		GetLobbiesRepository().
+		GetByUserID(user.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	if exists {
+		for _, lobby := range lobbies {
+			cache.
+				GetInstance().
+				EvictLobbySet(lobby.SessionID)
+		}
+	}
+
+	return new(metadatav1.UpdateProfileResponse), nil
+}
+
 func (h *Handler) GetUserSessions(ctx context.Context, request *metadatav1.GetUserSessionsRequest) (*metadatav1.GetUserSessionsResponse, error) {
 	response := new(metadatav1.GetUserSessionsResponse)
 
-	cache.
+	cacheTransaction := cache.
 		GetInstance().
-		BeginUserSessionsTransaction()
+		BeginTransaction(ctx, cache.USER_SESSIONS_REGION)
+	defer cacheTransaction.Commit()
 
 	cachedSessions, ok := cache.
 		GetInstance().
@@ -124,6 +252,8 @@ func (h *Handler) GetUserSessions(ctx context.Context, request *metadatav1.GetUs
 				SessionId: cachedSession.ID,
 				Seed:      uint64(cachedSession.Seed),
 				Name:      cachedSession.Name,
+				Rules:     cachedSession.Rules,
+				Private:   cachedSession.Password != "",
 			})
 		}
 	} else {
@@ -139,18 +269,10 @@ func (h *Handler) GetUserSessions(ctx context.Context, request *metadatav1.GetUs
 				GetUsersRepository().
 				GetByName(request.GetIssuer())
 			if err != nil {
-				cache.
-					GetInstance().
-					CommitUserSessionsTransaction()
-
 				return nil, err
 			}
 
 			if !exists {
-				cache.
-					GetInstance().
-					CommitUserSessionsTransaction()
-
 				return nil, ErrUserDoesNotExist
 			}
 
@@ -161,10 +283,6 @@ func (h *Handler) GetUserSessions(ctx context.Context, request *metadatav1.GetUs
 			GetSessionsRepository().
 			GetByIssuer(userID)
 		if err != nil {
-			cache.
-				GetInstance().
-				CommitUserSessionsTransaction()
-
 			return nil, err
 		}
 
@@ -175,6 +293,8 @@ func (h *Handler) GetUserSessions(ctx context.Context, request *metadatav1.GetUs
 				SessionId: rawSession.ID,
 				Seed:      uint64(rawSession.Seed),
 				Name:      rawSession.Name,
+				Rules:     rawSession.Rules,
+				Private:   rawSession.Password != "",
 			})
 
 			sessions = append(
@@ -187,19 +307,16 @@ func (h *Handler) GetUserSessions(ctx context.Context, request *metadatav1.GetUs
 			AddUserSessions(request.GetIssuer(), sessions)
 	}
 
-	cache.
-		GetInstance().
-		CommitUserSessionsTransaction()
-
 	return response, nil
 }
 
 func (h *Handler) GetFilteredSession(ctx context.Context, request *metadatav1.GetFilteredSessionRequest) (*metadatav1.GetFilteredSessionResponse, error) {
 	response := new(metadatav1.GetFilteredSessionResponse)
 
-	cache.
+	cacheTransaction := cache.
 		GetInstance().
-		BeginSessionsTransaction()
+		BeginTransaction(ctx, cache.SESSIONS_REGION)
+	defer cacheTransaction.Commit()
 
 	var found bool
 
@@ -211,6 +328,8 @@ func (h *Handler) GetFilteredSession(ctx context.Context, request *metadatav1.Ge
 				SessionId: value.ID,
 				Seed:      uint64(value.Seed),
 				Name:      value.Name,
+				Rules:     value.Rules,
+				Private:   value.Password != "",
 			}
 
 			found = true
@@ -225,18 +344,10 @@ func (h *Handler) GetFilteredSession(ctx context.Context, request *metadatav1.Ge
 			GetByName(request.GetName())
 
 		if err != nil {
-			cache.
-				GetInstance().
-				CommitSessionsTransaction()
-
 			return nil, err
 		}
 
 		if !exists {
-			cache.
-				GetInstance().
-				CommitSessionsTransaction()
-
 			return nil, status.Errorf(codes.NotFound, ErrFilteredSessionDoesNotExists.Error())
 		}
 
@@ -244,6 +355,8 @@ func (h *Handler) GetFilteredSession(ctx context.Context, request *metadatav1.Ge
 			SessionId: session.ID,
 			Seed:      uint64(session.Seed),
 			Name:      session.Name,
+			Rules:     session.Rules,
+			Private:   session.Password != "",
 		}
 
 		cache.GetInstance().EvictSessions(session.ID)
@@ -255,10 +368,6 @@ func (h *Handler) GetFilteredSession(ctx context.Context, request *metadatav1.Ge
 				converter.ConvertSessionEntityToCacheSessionEntity(session))
 	}
 
-	cache.
-		GetInstance().
-		CommitSessionsTransaction()
-
 	return response, nil
 }
 
@@ -285,6 +394,15 @@ func (h *Handler) CreateSession(ctx context.Context, request *metadatav1.CreateS
 		userID = user.ID
 	}
 
+	preset := request.GetRules()
+	if preset == "" {
+		preset = rules.PRESET_CLASSIC
+	}
+
+	if !rules.Exists(preset) {
+		return nil, status.Errorf(codes.InvalidArgument, ErrRulesPresetDoesNotExist.Error())
+	}
+
 	exists, err := repository.
 		GetSessionsRepository().
 		ExistsByName(request.GetName())
@@ -296,64 +414,59 @@ func (h *Handler) CreateSession(ctx context.Context, request *metadatav1.CreateS
 		return nil, ErrSessionAlreadyExists
 	}
 
-	cache.
-		GetInstance().
-		BeginSessionsTransaction()
+	var password string
 
-	cache.
-		GetInstance().
-		BeginLobbySetTransaction()
+	if request.GetPassword() != "" {
+		password, err = access.HashPassword(request.GetPassword())
+		if err != nil {
+			return nil, err
+		}
+	}
 
-	cache.
+	cacheTransaction := cache.
 		GetInstance().
-		BeginUserSessionsTransaction()
+		BeginTransaction(ctx, cache.SESSIONS_REGION, cache.LOBBY_SETS_REGION, cache.USER_SESSIONS_REGION)
+	defer cacheTransaction.Commit()
 
 	err = repository.
 		GetSessionsRepository().
 		InsertOrUpdate(dto.SessionsRepositoryInsertOrUpdateRequest{
-			Name:   request.GetName(),
-			Seed:   int64(request.GetSeed()),
-			Issuer: userID,
+			Name:     request.GetName(),
+			Seed:     int64(request.GetSeed()),
+			Issuer:   userID,
+			Rules:    preset,
+			Password: password,
 		})
 	if err != nil {
-		cache.
-			GetInstance().
-			CommitSessionsTransaction()
-
-		cache.
-			GetInstance().
-			CommitLobbySetTransaction()
-
-		cache.
-			GetInstance().
-			CommitUserSessionsTransaction()
-
 		return nil, err
 	}
 
 	cache.
 		GetInstance().
-		CommitSessionsTransaction()
-
-	cache.
-		GetInstance().
-		CommitLobbySetTransaction()
+		EvictSessionsByName(request.GetName())
 
 	cache.
 		GetInstance().
-		CommitUserSessionsTransaction()
+		EvictUserSessions(request.GetIssuer())
 
 	services.IncAvailableSession()
 
+	audit.GetInstance().Record(audit.Entry{
+		Action:  audit.ACTION_SESSION_CREATE,
+		Issuer:  request.GetIssuer(),
+		Details: request.GetName(),
+	})
+
 	return new(metadatav1.CreateSessionResponse), nil
 }
 
 func (h *Handler) RemoveSession(ctx context.Context, request *metadatav1.RemoveSessionRequest) (*metadatav1.RemoveSessionResponse, error) {
 	var isCacheSessionsPresent bool
 
-	cache.
+	cacheTransaction := cache.
 		GetInstance().
-		BeginSessionsTransaction()
+		BeginTransaction(ctx, cache.SESSIONS_REGION, cache.LOBBY_SETS_REGION, cache.USER_SESSIONS_REGION)
+	defer cacheTransaction.Commit()
 
 	cachedSessions, ok := cache.
 		GetInstance().
@@ -381,18 +494,10 @@ func (h *Handler) RemoveSession(ctx context.Context, request *metadatav1.RemoveS
 				GetUsersRepository().
 				GetByName(request.GetIssuer())
 			if err != nil {
-				cache.
-					GetInstance().
-					CommitSessionsTransaction()
-
 				return nil, err
 			}
 
 			if !exists {
-				cache.
-					GetInstance().
-					CommitSessionsTransaction()
-
 				return nil, ErrUserDoesNotExist
 			}
 
@@ -403,10 +508,6 @@ func (h *Handler) RemoveSession(ctx context.Context, request *metadatav1.RemoveS
 			GetSessionsRepository().
 			GetByIssuer(userID)
 		if err != nil {
-			cache.
-				GetInstance().
-				CommitSessionsTransaction()
-
 			return nil, err
 		}
 
@@ -415,30 +516,14 @@ func (h *Handler) RemoveSession(ctx context.Context, request *metadatav1.RemoveS
 			func(value *entity.SessionEntity) bool {
 				return value.ID == request.GetSessionId()
 			}) {
-			cache.
-				GetInstance().
-				CommitSessionsTransaction()
-
 			return nil, ErrUserDoesNotOwnSession
 		}
 	}
 
-	cache.
-		GetInstance().
-		BeginLobbySetTransaction()
-
 	cachedLobbySet, ok := cache.
 		GetInstance().
 		GetLobbySet(request.GetSessionId())
 	if ok && len(cachedLobbySet) != 0 {
-		cache.
-			GetInstance().
-			CommitSessionsTransaction()
-
-		cache.
-			GetInstance().
-			CommitLobbySetTransaction()
-
 		return nil, ErrSessionHasLobbies
 	}
 
@@ -446,14 +531,6 @@ func (h *Handler) RemoveSession(ctx context.Context, request *metadatav1.RemoveS
 		GetLobbiesRepository().
 		GetBySessionID(request.GetSessionId())
 	if err != nil {
-		cache.
-			GetInstance().
-			CommitSessionsTransaction()
-
-		cache.
-			GetInstance().
-			CommitLobbySetTransaction()
-
 		return nil, err
 	}
 
@@ -462,10 +539,14 @@ func (h *Handler) RemoveSession(ctx context.Context, request *metadatav1.RemoveS
 
 		for _, lobby := range lobbies {
 			lobbySet = append(lobbySet, dto.CacheLobbySetEntity{
-				ID:     lobby.ID,
-				Issuer: lobby.UserEntity.Name,
-				Skin:   uint64(lobby.Skin),
-				Host:   lobby.Host,
+				ID:          lobby.ID,
+				Issuer:      lobby.UserEntity.Name,
+				DisplayName: lobby.UserEntity.DisplayName,
+				Skin:        uint64(lobby.Skin),
+				Host:        lobby.Host,
+				Spectator:   lobby.Spectator,
+				Ready:       lobby.Ready,
+				Team:        uint64(lobby.Team),
 			})
 		}
 
@@ -477,63 +558,59 @@ func (h *Handler) RemoveSession(ctx context.Context, request *metadatav1.RemoveS
 			GetInstance().
 			AddLobbySet(request.GetSessionId(), lobbySet)
 
-		cache.
-			GetInstance().
-			CommitSessionsTransaction()
-
-		cache.
-			GetInstance().
-			CommitLobbySetTransaction()
-
 		return nil, ErrSessionHasLobbies
 	}
 
-	cache.
-		GetInstance().
-		BeginUserSessionsTransaction()
-
 	err = repository.
 		GetSessionsRepository().
 		DeleteByID(request.GetSessionId())
 	if err != nil {
-		cache.
-			GetInstance().
-			CommitSessionsTransaction()
-
-		cache.
-			GetInstance().
-			CommitLobbySetTransaction()
-
-		cache.
-			GetInstance().
-			CommitUserSessionsTransaction()
-
 		return nil, err
 	}
 
 	cache.GetInstance().EvictUserSessions(request.GetIssuer())
 
-	cache.
+	countdown.Stop(request.GetSessionId())
+
+	replay.
 		GetInstance().
-		CommitLobbySetTransaction()
+		Stop(request.GetSessionId())
 
-	cache.
+	chat.
 		GetInstance().
-		CommitUserSessionsTransaction()
+		Remove(request.GetSessionId())
 
-	cache.
+	emote.
+		GetInstance().
+		Remove(request.GetSessionId())
+
+	invitation.
 		GetInstance().
-		CommitSessionsTransaction()
+		Remove(request.GetSessionId())
 
 	services.DecAvailableSession()
 
+	audit.GetInstance().Record(audit.Entry{
+		Action:    audit.ACTION_SESSION_REMOVE,
+		Issuer:    request.GetIssuer(),
+		SessionID: request.GetSessionId(),
+	})
+
 	return new(metadatav1.RemoveSessionResponse), nil
 }
 
 func (h *Handler) StartSession(ctx context.Context, request *metadatav1.StartSessionRequest) (*metadatav1.StartSessionResponse, error) {
-	cache.
+	cacheTransaction := cache.
 		GetInstance().
-		BeginMetadataTransaction()
+		BeginTransaction(
+			ctx,
+			cache.SESSIONS_REGION,
+			cache.LOBBY_SETS_REGION,
+			cache.USER_SESSIONS_REGION,
+			cache.METADATA_REGION)
+	defer cacheTransaction.Commit()
+
+	instance := db.GetInstance().WithContext(ctx)
 
 	var userID int64
 
@@ -545,20 +622,12 @@ func (h *Handler) StartSession(ctx context.Context, request *metadatav1.StartSes
 	} else {
 		user, exists, err := repository.
 			GetUsersRepository().
-			GetByName(request.GetIssuer())
+			GetByNameWithTransaction(instance, request.GetIssuer())
 		if err != nil {
-			cache.
-				GetInstance().
-				CommitMetadataTransaction()
-
 			return nil, err
 		}
 
 		if !exists {
-			cache.
-				GetInstance().
-				CommitMetadataTransaction()
-
 			return nil, ErrUserDoesNotExist
 		}
 
@@ -571,31 +640,19 @@ func (h *Handler) StartSession(ctx context.Context, request *metadatav1.StartSes
 	if !ok {
 		lobbies, exists, err := repository.
 			GetLobbiesRepository().
-			GetByUserID(userID)
+			GetByUserIDWithTransaction(instance, userID)
 		if err != nil {
-			cache.
-				GetInstance().
-				CommitMetadataTransaction()
-
 			return nil, err
 		}
 
 		if !exists {
-			cache.
-				GetInstance().
-				CommitMetadataTransaction()
-
 			return nil, ErrLobbyDoesNotExist
 		}
 
 		inventory, _, err := repository.
 			GetInventoryRepository().
-			GetBySessionIDAndUserID(request.GetSessionId(), userID)
+			GetBySessionIDAndUserIDWithTransaction(instance, request.GetSessionId(), userID)
 		if err != nil {
-			cache.
-				GetInstance().
-				CommitMetadataTransaction()
-
 			return nil, err
 		}
 
@@ -616,18 +673,10 @@ func (h *Handler) StartSession(ctx context.Context, request *metadatav1.StartSes
 		}
 
 		if selectedLobby == nil {
-			cache.
-				GetInstance().
-				CommitMetadataTransaction()
-
 			return nil, ErrLobbyDoesNotExist
 		}
 
 		if !selectedLobby.Host {
-			cache.
-				GetInstance().
-				CommitMetadataTransaction()
-
 			return nil, ErrUserIsNotLobbyHost
 		}
 	} else {
@@ -641,107 +690,94 @@ func (h *Handler) StartSession(ctx context.Context, request *metadatav1.StartSes
 		}
 
 		if selectedLobby == nil {
-			cache.
-				GetInstance().
-				CommitMetadataTransaction()
-
 			return nil, ErrLobbyDoesNotExist
 		}
 
 		if !selectedLobby.Host {
-			cache.
-				GetInstance().
-				CommitMetadataTransaction()
-
 			return nil, ErrUserIsNotLobbyHost
 		}
 	}
 
 	lobbies, exists, err := repository.
 		GetLobbiesRepository().
-		GetBySessionID(request.GetSessionId())
+		GetBySessionIDWithTransaction(instance, request.GetSessionId())
 	if err != nil {
-		cache.
-			GetInstance().
-			CommitMetadataTransaction()
-
 		return nil, err
 	}
 
-	if !exists {
-		cache.
-			GetInstance().
-			CommitMetadataTransaction()
+	// Spectators are neither spawned, nor recorded as session participants.
+	lobbies = slices.DeleteFunc(lobbies, func(value *entity.LobbyEntity) bool {
+		return value.Spectator
+	})
 
+	if !exists || len(lobbies) == 0 {
 		return nil, ErrLobbyDoesNotExist
 	}
 
-	if len(request.GetSpawnables()) < len(lobbies) {
-		cache.
-			GetInstance().
-			CommitMetadataTransaction()
+	remaining, ok := countdown.GetRemaining(request.GetSessionId())
+	if (!ok || remaining != 0) &&
+		slices.ContainsFunc(lobbies, func(value *entity.LobbyEntity) bool {
+			return !value.Ready
+		}) {
+		return nil, status.Errorf(codes.FailedPrecondition, ErrLobbyIsNotReady.Error())
+	}
 
-		return nil, ErrLobbiesAmountExceedsSpawnablesAmount
+	lobbyTeams := make(map[int64]uint64)
+
+	for _, lobby := range lobbies {
+		lobbyTeams[lobby.ID] = uint64(lobby.Team)
 	}
 
-	if len(request.GetChestLocations()) < config.GetOperationMinChestsAmount() {
-		cache.
-			GetInstance().
-			CommitMetadataTransaction()
+	if team.IsEnabled(maps.Values(lobbyTeams)) {
+		lobbyTeams = team.Balance(lobbyTeams)
+
+		if !team.HasOpponents(maps.Values(lobbyTeams)) {
+			return nil, status.Errorf(codes.FailedPrecondition, ErrLobbyTeamsNotEnough.Error())
+		}
+	}
+
+	spawnables, err := getSpawnables(
+		lobbies, lobbyTeams, request.GetSpawnables(), request.GetTeamSpawnables())
+	if err != nil {
+		return nil, err
+	}
 
+	if len(request.GetChestLocations()) < config.GetOperationMinChestsAmount() {
 		return nil, ErrSessionChestLocationsNotEnough
 	}
 
 	if len(request.GetHealthPackLocations()) < config.GetOperationMinHealthPacksAmount() {
-		cache.
-			GetInstance().
-			CommitMetadataTransaction()
-
 		return nil, ErrSessionHealthPacksLocationsNotEnough
 	}
 
-	cache.
-		GetInstance().
-		BeginSessionsTransaction()
-
 	var (
-		sessionName string
-		sessionSeed int64
+		sessionName  string
+		sessionSeed  int64
+		sessionRules string
 	)
 
 	cachedSession, ok := cache.
 		GetInstance().
 		GetSessions(request.GetSessionId())
 	if !ok {
-		session, _, err := repository.
+		session, exists, err := repository.
 			GetSessionsRepository().
-			GetByID(request.GetSessionId())
+			GetByIDWithTransaction(instance, request.GetSessionId())
 		if err != nil {
-			cache.
-				GetInstance().
-				CommitMetadataTransaction()
-
-			cache.
-				GetInstance().
-				CommitSessionsTransaction()
-
 			return nil, err
 		}
 
-		if session.Started {
-			cache.
-				GetInstance().
-				CommitMetadataTransaction()
-
-			cache.
-				GetInstance().
-				CommitSessionsTransaction()
+		if !exists {
+			return nil, ErrSessionDoesNotExists
+		}
 
+		if session.Started {
 			return nil, ErrSessionAlreadyStarted
 		}
 
 		sessionName = session.Name
 		sessionSeed = session.Seed
+		sessionRules = session.Rules
 
 		cache.
 			GetInstance().
@@ -750,33 +786,28 @@ func (h *Handler) StartSession(ctx context.Context, request *metadatav1.StartSes
 				converter.ConvertSessionEntityToCacheSessionEntity(session))
 	} else {
 		if cachedSession.Started {
-			cache.
-				GetInstance().
-				CommitMetadataTransaction()
-
-			cache.
-				GetInstance().
-				CommitSessionsTransaction()
-
 			return nil, ErrSessionAlreadyStarted
 		}
 
 		sessionName = cachedSession.Name
 		sessionSeed = cachedSession.Seed
+		sessionRules = cachedSession.Rules
 	}
 
-	cache.
-		GetInstance().
-		BeginLobbySetTransaction()
+	err = db.BeginTransaction(ctx, func(tx *gorm.DB) error {
+		for _, lobby := range lobbies {
+			if uint64(lobby.Team) != lobbyTeams[lobby.ID] {
+				err = repository.
+					GetLobbiesRepository().
+					UpdateTeamByIDWithTransaction(tx, lobby.ID, lobbyTeams[lobby.ID])
+				if err != nil {
+					return err
+				}
+			}
+		}
 
-	cache.
-		GetInstance().
-		BeginUserSessionsTransaction()
-
-	randomSpawnables := rand.Perm(len(request.GetSpawnables()))
-
-	err = db.GetInstance().Transaction(func(tx *gorm.DB) error {
-		chests := utils.GenerateChests(request.GetChestLocations(), sessionSeed)
+		chests := utils.GenerateChests(
+			request.GetChestLocations(), sessionSeed, rules.Get(sessionRules).MaxChestItemsPerChest)
 
 		for _, chest := range chests {
 			err = repository.
@@ -838,8 +869,8 @@ func (h *Handler) StartSession(ctx context.Context, request *metadatav1.StartSes
 			}
 		}
 
-		for i, lobby := range lobbies {
-			spawnable := request.GetSpawnables()[randomSpawnables[i]]
+		for _, lobby := range lobbies {
+			spawnable := spawnables[lobby.ID]
 
 			err = repository.
 				GetLobbiesRepository().
@@ -881,73 +912,122 @@ func (h *Handler) StartSession(ctx context.Context, request *metadatav1.StartSes
 		return nil
 	})
 	if err != nil {
-		cache.
-			GetInstance().
-			CommitUserSessionsTransaction()
-
-		cache.
-			GetInstance().
-			CommitLobbySetTransaction()
-
-		cache.
-			GetInstance().
-			CommitMetadataTransaction()
-
-		cache.
-			GetInstance().
-			CommitSessionsTransaction()
-
 		return nil, err
 	}
 
-	cache.
-		GetInstance().
-		CommitUserSessionsTransaction()
-
-	cache.
-		GetInstance().
-		CommitLobbySetTransaction()
-
-	session, _, err := repository.
+	session, exists, err := repository.
 		GetSessionsRepository().
 		GetByID(request.GetSessionId())
 	if err != nil {
-		cache.
-			GetInstance().
-			CommitMetadataTransaction()
-
-		cache.
-			GetInstance().
-			CommitSessionsTransaction()
-
 		return nil, err
 	}
 
+	if !exists {
+		return nil, ErrSessionDoesNotExists
+	}
+
 	cache.GetInstance().
 		EvictSessions(
 			request.GetSessionId())
 
 	cache.
 		GetInstance().
-		AddSessions(
-			request.GetSessionId(),
-			converter.ConvertSessionEntityToCacheSessionEntity(session))
+		EvictUserSessions(session.UserEntity.Name)
+
+	cache.
+		GetInstance().
+		EvictLobbySet(request.GetSessionId())
 
 	cache.
 		GetInstance().
-		CommitMetadataTransaction()
+		EvictGeneratedChests(session.Name)
+
+	cache.
+		GetInstance().
+		EvictGeneratedHealthPacks(session.Name)
+
+	countdown.Stop(request.GetSessionId())
 
 	cache.
 		GetInstance().
-		CommitSessionsTransaction()
+		AddSessions(
+			request.GetSessionId(),
+			converter.ConvertSessionEntityToCacheSessionEntity(session))
+
+	audit.GetInstance().Record(audit.Entry{
+		Action:    audit.ACTION_SESSION_START,
+		Issuer:    request.GetIssuer(),
+		SessionID: request.GetSessionId(),
+	})
+
+	replayStart := &metadatav1.ReplayStart{
+		SessionId: request.GetSessionId(),
+		Name:      session.Name,
+		Seed:      uint64(session.Seed),
+		Skins:     make(map[string]uint64),
+	}
+
+	for _, lobby := range lobbies {
+		replayStart.Issuers = append(replayStart.Issuers, lobby.UserEntity.Name)
+
+		replayStart.Skins[lobby.UserEntity.Name] = uint64(lobby.Skin)
+	}
+
+	chests, err := repository.
+		GetGenerationRepository().
+		GetChestTypeBySessionID(request.GetSessionId())
+	if err != nil {
+		logging.WithContext(ctx).Error(
+			fmt.Sprintf("%s: %s", replay.ErrReplayRecordFailed.Error(), err.Error()))
+	}
+
+	for _, chest := range chests {
+		replayStart.Chests = append(replayStart.Chests, &metadatav1.Chest{
+			SessionId: chest.SessionID,
+			ChestId:   chest.ID,
+			Active:    chest.Active,
+			Position: &metadatav1.Position{
+				X: chest.PositionX,
+				Y: chest.PositionY,
+			},
+			Instance: chest.Instance,
+		})
+	}
+
+	healthPacks, err := repository.
+		GetGenerationRepository().
+		GetHealthPackTypeBySessionID(request.GetSessionId())
+	if err != nil {
+		logging.WithContext(ctx).Error(
+			fmt.Sprintf("%s: %s", replay.ErrReplayRecordFailed.Error(), err.Error()))
+	}
+
+	for _, healthPack := range healthPacks {
+		replayStart.HealthPacks = append(replayStart.HealthPacks, &metadatav1.HealthPack{
+			SessionId:    healthPack.SessionID,
+			HealthPackId: healthPack.ID,
+			Name:         healthPack.Name,
+			Active:       healthPack.Active,
+			Position: &metadatav1.Position{
+				X: healthPack.PositionX,
+				Y: healthPack.PositionY,
+			},
+			Instance: healthPack.Instance,
+		})
+	}
+
+	replay.
+		GetInstance().
+		Start(replayStart)
 
-	return new(metadatav1.StartSessionResponse), err
+	return new(metadatav1.StartSessionResponse), nil
 }
 
 func (h *Handler) GetSessionMetadata(request *metadatav1.GetSessionMetadataRequest, stream grpc.ServerStreamingServer[metadatav1.GetSessionMetadataResponse]) error {
-	cache.
+	cacheTransaction := cache.
 		GetInstance().
-		BeginMetadataTransaction()
+		BeginTransaction(stream.Context(), cache.METADATA_REGION)
+	defer cacheTransaction.Commit()
 
 	metadata, ok := cache.
 		GetInstance().
@@ -965,18 +1045,10 @@ func (h *Handler) GetSessionMetadata(request *metadatav1.GetSessionMetadataReque
 				GetUsersRepository().
 				GetByName(request.GetIssuer())
 			if err != nil {
-				cache.
-					GetInstance().
-					CommitMetadataTransaction()
-
 				return err
 			}
 
 			if !exists {
-				cache.
-					GetInstance().
-					CommitMetadataTransaction()
-
 				return ErrUserDoesNotExist
 			}
 
@@ -987,18 +1059,10 @@ func (h *Handler) GetSessionMetadata(request *metadatav1.GetSessionMetadataReque
 			GetLobbiesRepository().
 			GetByUserID(userID)
 		if err != nil {
-			cache.
-				GetInstance().
-				CommitMetadataTransaction()
-
 			return err
 		}
 
 		if !exists {
-			cache.
-				GetInstance().
-				CommitMetadataTransaction()
-
 			return ErrLobbyDoesNotExist
 		}
 
@@ -1006,10 +1070,6 @@ func (h *Handler) GetSessionMetadata(request *metadatav1.GetSessionMetadataReque
 			GetInventoryRepository().
 			GetBySessionIDAndUserID(request.GetSessionId(), userID)
 		if err != nil {
-			cache.
-				GetInstance().
-				CommitMetadataTransaction()
-
 			return err
 		}
 
@@ -1031,10 +1091,6 @@ func (h *Handler) GetSessionMetadata(request *metadatav1.GetSessionMetadataReque
 		}
 
 		if !found {
-			cache.
-				GetInstance().
-				CommitMetadataTransaction()
-
 			return ErrSessionMetadataRetrievalNotAllowed
 		}
 	} else {
@@ -1049,17 +1105,11 @@ func (h *Handler) GetSessionMetadata(request *metadatav1.GetSessionMetadataReque
 		}
 
 		if !found {
-			cache.
-				GetInstance().
-				CommitMetadataTransaction()
-
 			return ErrSessionMetadataRetrievalNotAllowed
 		}
 	}
 
-	cache.
-		GetInstance().
-		CommitMetadataTransaction()
+	cacheTransaction.Commit()
 
 	ticker := time.NewTicker(getSessionMetadataFrequency)
 
@@ -1070,26 +1120,30 @@ func (h *Handler) GetSessionMetadata(request *metadatav1.GetSessionMetadataReque
 
 			var started bool
 
-			cache.
+			cacheTransaction := cache.
 				GetInstance().
-				BeginSessionsTransaction()
+				BeginTransaction(stream.Context(), cache.SESSIONS_REGION)
 
 			cachedSession, ok := cache.
 				GetInstance().
 				GetSessions(request.GetSessionId())
 
 			if !ok {
-				session, _, err := repository.
+				session, exists, err := repository.
 					GetSessionsRepository().
 					GetByID(request.GetSessionId())
 				if err != nil {
-					cache.
-						GetInstance().
-						CommitSessionsTransaction()
+					cacheTransaction.Commit()
 
 					return err
 				}
 
+				if !exists {
+					cacheTransaction.Commit()
+
+					return ErrSessionDoesNotExists
+				}
+
 				started = session.Started
 
 				cache.
@@ -1101,9 +1155,7 @@ func (h *Handler) GetSessionMetadata(request *metadatav1.GetSessionMetadataReque
 				started = cachedSession.Started
 			}
 
-			cache.
-				GetInstance().
-				CommitSessionsTransaction()
+			cacheTransaction.Commit()
 
 			err := stream.Send(&metadatav1.GetSessionMetadataResponse{
 				Started: started,
@@ -1131,9 +1183,18 @@ func (h *Handler) GetLobbySet(request *metadatav1.GetLobbySetRequest, stream grp
 
 			response.LobbySet = response.LobbySet[:0]
 
-			cache.
+			response.Countdown = nil
+
+			remaining, ok := countdown.GetRemaining(request.GetSessionId())
+			if ok {
+				remainingSeconds := uint64((remaining + time.Second - 1) / time.Second)
+
+				response.Countdown = &remainingSeconds
+			}
+
+			cacheTransaction := cache.
 				GetInstance().
-				BeginLobbySetTransaction()
+				BeginTransaction(stream.Context(), cache.LOBBY_SETS_REGION)
 
 			cachedLobbySet, ok := cache.
 				GetInstance().
@@ -1143,17 +1204,13 @@ func (h *Handler) GetLobbySet(request *metadatav1.GetLobbySetRequest, stream grp
 					GetLobbiesRepository().
 					GetBySessionID(request.GetSessionId())
 				if err != nil {
-					cache.
-						GetInstance().
-						CommitLobbySetTransaction()
+					cacheTransaction.Commit()
 
 					return err
 				}
 
 				if !exists {
-					cache.
-						GetInstance().
-						CommitLobbySetTransaction()
+					cacheTransaction.Commit()
 
 					return ErrLobbySetDoesNotExist
 				}
@@ -1162,17 +1219,25 @@ func (h *Handler) GetLobbySet(request *metadatav1.GetLobbySetRequest, stream grp
 
 				for _, lobby := range lobbies {
 					response.LobbySet = append(response.LobbySet, &metadatav1.LobbySetUnit{
-						LobbyId: lobby.ID,
-						Issuer:  lobby.UserEntity.Name,
-						Skin:    uint64(lobby.Skin),
-						Host:    lobby.Host,
+						LobbyId:     lobby.ID,
+						Issuer:      lobby.UserEntity.Name,
+						DisplayName: lobby.UserEntity.DisplayName,
+						Skin:        uint64(lobby.Skin),
+						Host:        lobby.Host,
+						Spectator:   lobby.Spectator,
+						Ready:       lobby.Ready,
+						Team:        uint64(lobby.Team),
 					})
 
 					lobbySet = append(lobbySet, dto.CacheLobbySetEntity{
-						ID:     lobby.ID,
-						Issuer: lobby.UserEntity.Name,
-						Skin:   uint64(lobby.Skin),
-						Host:   lobby.Host,
+						ID:          lobby.ID,
+						Issuer:      lobby.UserEntity.Name,
+						DisplayName: lobby.UserEntity.DisplayName,
+						Skin:        uint64(lobby.Skin),
+						Host:        lobby.Host,
+						Spectator:   lobby.Spectator,
+						Ready:       lobby.Ready,
+						Team:        uint64(lobby.Team),
 					})
 				}
 
@@ -1182,17 +1247,19 @@ func (h *Handler) GetLobbySet(request *metadatav1.GetLobbySetRequest, stream grp
 			} else {
 				for _, cachedLobby := range cachedLobbySet {
 					response.LobbySet = append(response.LobbySet, &metadatav1.LobbySetUnit{
-						LobbyId: cachedLobby.ID,
-						Issuer:  cachedLobby.Issuer,
-						Skin:    cachedLobby.Skin,
-						Host:    cachedLobby.Host,
+						LobbyId:     cachedLobby.ID,
+						Issuer:      cachedLobby.Issuer,
+						DisplayName: cachedLobby.DisplayName,
+						Skin:        cachedLobby.Skin,
+						Host:        cachedLobby.Host,
+						Spectator:   cachedLobby.Spectator,
+						Ready:       cachedLobby.Ready,
+						Team:        cachedLobby.Team,
 					})
 				}
 			}
 
-			cache.
-				GetInstance().
-				CommitLobbySetTransaction()
+			cacheTransaction.Commit()
 
 			err := stream.Send(response)
 			if err != nil {
@@ -1207,6 +1274,8 @@ func (h *Handler) GetLobbySet(request *metadatav1.GetLobbySetRequest, stream grp
 }
 
 func (h *Handler) CreateLobby(ctx context.Context, request *metadatav1.CreateLobbyRequest) (*metadatav1.CreateLobbyResponse, error) {
+	instance := db.GetInstance().WithContext(ctx)
+
 	var userID int64
 
 	cachedUserID, ok := cache.
@@ -1217,7 +1286,7 @@ func (h *Handler) CreateLobby(ctx context.Context, request *metadatav1.CreateLob
 	} else {
 		user, exists, err := repository.
 			GetUsersRepository().
-			GetByName(request.GetIssuer())
+			GetByNameWithTransaction(instance, request.GetIssuer())
 		if err != nil {
 			return nil, err
 		}
@@ -1231,7 +1300,7 @@ func (h *Handler) CreateLobby(ctx context.Context, request *metadatav1.CreateLob
 
 	userLobbies, exists, err := repository.
 		GetLobbiesRepository().
-		GetByUserID(userID)
+		GetByUserIDWithTransaction(instance, userID)
 	if err != nil {
 		return nil, err
 	}
@@ -1241,9 +1310,10 @@ func (h *Handler) CreateLobby(ctx context.Context, request *metadatav1.CreateLob
 		func(value *entity.LobbyEntity) bool {
 			return value.SessionID == request.GetSessionId()
 		}) {
-		cache.
+		cacheTransaction := cache.
 			GetInstance().
-			BeginSessionsTransaction()
+			BeginTransaction(ctx, cache.SESSIONS_REGION)
+		defer cacheTransaction.Commit()
 
 		var cachedSession dto.CacheSessionEntity
 
@@ -1253,20 +1323,12 @@ func (h *Handler) CreateLobby(ctx context.Context, request *metadatav1.CreateLob
 		if !ok {
 			session, exists, err := repository.
 				GetSessionsRepository().
-				GetByID(request.GetSessionId())
+				GetByIDWithTransaction(instance, request.GetSessionId())
 			if err != nil {
-				cache.
-					GetInstance().
-					CommitSessionsTransaction()
-
 				return nil, err
 			}
 
 			if !exists {
-				cache.
-					GetInstance().
-					CommitSessionsTransaction()
-
 				return nil, ErrSessionDoesNotExists
 			}
 
@@ -1277,32 +1339,36 @@ func (h *Handler) CreateLobby(ctx context.Context, request *metadatav1.CreateLob
 					converter.ConvertSessionEntityToCacheSessionEntity(session))
 
 			if session.Started {
-				cache.
-					GetInstance().
-					CommitSessionsTransaction()
-
 				return nil, status.Errorf(codes.Aborted, ErrLobbyAlreadyStarted.Error())
 			}
 		} else {
 			if cachedSession.Started {
-				cache.
-					GetInstance().
-					CommitSessionsTransaction()
-
 				return nil, status.Errorf(codes.Aborted, ErrLobbyAlreadyStarted.Error())
 			}
 		}
 
-		cache.
-			GetInstance().
-			CommitSessionsTransaction()
-
 		return nil, status.Errorf(codes.AlreadyExists, ErrLobbyAlreadyExists.Error())
 	}
 
-	cache.
+	session, err := getSession(ctx, request.GetSessionId())
+	if err != nil {
+		return nil, err
+	}
+
+	// Access is checked before cache regions are acquired, because password hash comparison is expensive.
+	invite, err := access.Check(session, userID, request.GetPassword(), request.GetInviteCode())
+	if err != nil {
+		if errors.Is(err, access.ErrSessionAccessDenied) {
+			return nil, status.Errorf(codes.PermissionDenied, err.Error())
+		}
+
+		return nil, err
+	}
+
+	cacheTransaction := cache.
 		GetInstance().
-		BeginSessionsTransaction()
+		BeginTransaction(ctx, cache.SESSIONS_REGION, cache.LOBBY_SETS_REGION, cache.METADATA_REGION)
+	defer cacheTransaction.Commit()
 
 	var cachedSession dto.CacheSessionEntity
 
@@ -1312,20 +1378,12 @@ func (h *Handler) CreateLobby(ctx context.Context, request *metadatav1.CreateLob
 	if !ok {
 		session, exists, err := repository.
 			GetSessionsRepository().
-			GetByID(request.GetSessionId())
+			GetByIDWithTransaction(instance, request.GetSessionId())
 		if err != nil {
-			cache.
-				GetInstance().
-				CommitSessionsTransaction()
-
 			return nil, err
 		}
 
 		if !exists {
-			cache.
-				GetInstance().
-				CommitSessionsTransaction()
-
 			return nil, ErrSessionDoesNotExists
 		}
 
@@ -1335,39 +1393,23 @@ func (h *Handler) CreateLobby(ctx context.Context, request *metadatav1.CreateLob
 				request.GetSessionId(),
 				converter.ConvertSessionEntityToCacheSessionEntity(session))
 
-		if session.Started {
-			cache.
-				GetInstance().
-				CommitSessionsTransaction()
-
+		if session.Started && !request.GetSpectate() {
 			return nil, status.Errorf(codes.InvalidArgument, ErrSessionAlreadyStarted.Error())
 		}
-	} else {
-		if cachedSession.Started {
-			cache.
-				GetInstance().
-				CommitSessionsTransaction()
 
+		cachedSession = converter.ConvertSessionEntityToCacheSessionEntity(session)
+	} else {
+		if cachedSession.Started && !request.GetSpectate() {
 			return nil, status.Errorf(codes.InvalidArgument, ErrSessionAlreadyStarted.Error())
 		}
 	}
 
-	cache.
-		GetInstance().
-		CommitSessionsTransaction()
-
-	cache.
-		GetInstance().
-		BeginLobbySetTransaction()
+	ruleset := rules.Get(cachedSession.Rules)
 
 	sessionLobbies, exists, err := repository.
 		GetLobbiesRepository().
-		GetBySessionID(request.GetSessionId())
+		GetBySessionIDWithTransaction(instance, request.GetSessionId())
 	if err != nil {
-		cache.
-			GetInstance().
-			CommitLobbySetTransaction()
-
 		return nil, err
 	}
 
@@ -1376,10 +1418,14 @@ func (h *Handler) CreateLobby(ctx context.Context, request *metadatav1.CreateLob
 
 		for _, lobby := range sessionLobbies {
 			lobbySet = append(lobbySet, dto.CacheLobbySetEntity{
-				ID:     lobby.ID,
-				Issuer: lobby.UserEntity.Name,
-				Skin:   uint64(lobby.Skin),
-				Host:   lobby.Host,
+				ID:          lobby.ID,
+				Issuer:      lobby.UserEntity.Name,
+				DisplayName: lobby.UserEntity.DisplayName,
+				Skin:        uint64(lobby.Skin),
+				Host:        lobby.Host,
+				Spectator:   lobby.Spectator,
+				Ready:       lobby.Ready,
+				Team:        uint64(lobby.Team),
 			})
 		}
 
@@ -1391,11 +1437,7 @@ func (h *Handler) CreateLobby(ctx context.Context, request *metadatav1.CreateLob
 			GetInstance().
 			AddLobbySet(request.GetSessionId(), lobbySet)
 
-		if len(sessionLobbies) >= config.MAX_SESSION_USERS {
-			cache.
-				GetInstance().
-				CommitLobbySetTransaction()
-
+		if !request.GetSpectate() && getPlayersAmount(lobbySet) >= ruleset.MaxSessionUsers {
 			return nil, ErrSessionHasMaxAmountOfLobbies
 		}
 	}
@@ -1403,35 +1445,57 @@ func (h *Handler) CreateLobby(ctx context.Context, request *metadatav1.CreateLob
 	var (
 		host bool
 		skin uint64
+
+		defaultSkin         uint64
+		defaultSkinSelected bool
 	)
 
+	if !request.GetSpectate() {
+		user, exists, err := repository.
+			GetUsersRepository().
+			GetByNameWithTransaction(instance, request.GetIssuer())
+		if err != nil {
+			return nil, err
+		}
+
+		if !exists {
+			return nil, ErrUserDoesNotExist
+		}
+
+		// Default skin is considered to be selected only for the users, who have already configured their profiles.
+		if user.DisplayName != "" {
+			defaultSkin = uint64(user.Skin)
+			defaultSkinSelected = true
+		}
+	}
+
 	cachedLobbySet, _ := cache.
 		GetInstance().
 		GetLobbySet(request.GetSessionId())
-	if len(cachedLobbySet) >= config.MAX_SESSION_USERS {
-		cache.
-			GetInstance().
-			CommitLobbySetTransaction()
-
+	if !request.GetSpectate() && getPlayersAmount(cachedLobbySet) >= ruleset.MaxSessionUsers {
 		return nil, ErrSessionHasMaxAmountOfLobbies
 	}
 
 	lobbies, exists, err := repository.
 		GetLobbiesRepository().
-		GetBySessionID(request.GetSessionId())
+		GetBySessionIDWithTransaction(instance, request.GetSessionId())
 	if err != nil {
-		cache.
-			GetInstance().
-			CommitLobbySetTransaction()
-
 		return nil, err
 	}
 
 	if !exists {
+		if request.GetSpectate() {
+			return nil, ErrSessionHasNoPlayers
+		}
+
 		host = true
 
-		skin = uint64(rand.Intn(config.MAX_SESSION_USERS))
-	} else {
+		if defaultSkinSelected {
+			skin = defaultSkin
+		} else {
+			skin = uint64(rand.Intn(config.MAX_SESSION_USERS))
+		}
+	} else if !request.GetSpectate() {
 		var (
 			lobbySet      []dto.CacheLobbySetEntity
 			reservedSkins = make(map[int64]bool)
@@ -1439,13 +1503,19 @@ func (h *Handler) CreateLobby(ctx context.Context, request *metadatav1.CreateLob
 
 		for _, lobby := range lobbies {
 			lobbySet = append(lobbySet, dto.CacheLobbySetEntity{
-				ID:     lobby.ID,
-				Issuer: lobby.UserEntity.Name,
-				Skin:   uint64(lobby.Skin),
-				Host:   lobby.Host,
+				ID:          lobby.ID,
+				Issuer:      lobby.UserEntity.Name,
+				DisplayName: lobby.UserEntity.DisplayName,
+				Skin:        uint64(lobby.Skin),
+				Host:        lobby.Host,
+				Spectator:   lobby.Spectator,
+				Ready:       lobby.Ready,
+				Team:        uint64(lobby.Team),
 			})
 
-			reservedSkins[lobby.Skin] = true
+			if !lobby.Spectator {
+				reservedSkins[lobby.Skin] = true
+			}
 		}
 
 		var availableSkins []int64
@@ -1456,7 +1526,11 @@ func (h *Handler) CreateLobby(ctx context.Context, request *metadatav1.CreateLob
 			}
 		}
 
-		skin = uint64(availableSkins[uint64(rand.Intn(len(availableSkins)))])
+		if _, ok := reservedSkins[int64(defaultSkin)]; defaultSkinSelected && !ok {
+			skin = defaultSkin
+		} else {
+			skin = uint64(availableSkins[uint64(rand.Intn(len(availableSkins)))])
+		}
 
 		cache.
 			GetInstance().
@@ -1467,41 +1541,50 @@ func (h *Handler) CreateLobby(ctx context.Context, request *metadatav1.CreateLob
 			AddLobbySet(request.GetSessionId(), lobbySet)
 	}
 
-	err = repository.
-		GetLobbiesRepository().
-		InsertOrUpdate(
-			dto.LobbiesRepositoryInsertOrUpdateRequest{
-				UserID:    userID,
-				SessionID: request.GetSessionId(),
-				Host:      host,
-				Skin:      uint64(skin),
-			})
+	err = db.BeginTransaction(ctx, func(tx *gorm.DB) error {
+		err := repository.
+			GetLobbiesRepository().
+			InsertOrUpdateWithTransaction(
+				tx,
+				dto.LobbiesRepositoryInsertOrUpdateRequest{
+					UserID:     userID,
+					SessionID:  request.GetSessionId(),
+					Host:       host,
+					Skin:       uint64(skin),
+					Health:     ruleset.MaxHealth,
+					Eliminated: request.GetSpectate(),
+					Spectator:  request.GetSpectate(),
+				})
+		if err != nil {
+			return err
+		}
+
+		return access.ConsumeWithTransaction(tx, invite)
+	})
 	if err != nil {
-		cache.
-			GetInstance().
-			CommitLobbySetTransaction()
+		if errors.Is(err, access.ErrSessionAccessDenied) {
+			return nil, status.Errorf(codes.PermissionDenied, err.Error())
+		}
 
 		return nil, err
 	}
 
 	cache.
 		GetInstance().
-		CommitLobbySetTransaction()
-
-	cache.
-		GetInstance().
-		BeginMetadataTransaction()
+		EvictLobbySet(request.GetSessionId())
 
 	cache.
 		GetInstance().
 		EvictMetadata(request.GetIssuer())
 
-	cache.
-		GetInstance().
-		CommitMetadataTransaction()
-
 	services.IncAvailableLobby()
 
+	audit.GetInstance().Record(audit.Entry{
+		Action:    audit.ACTION_LOBBY_JOIN,
+		Issuer:    request.GetIssuer(),
+		SessionID: request.GetSessionId(),
+	})
+
 	return new(metadatav1.CreateLobbyResponse), nil
 }
 
@@ -1528,30 +1611,27 @@ func (h *Handler) RemoveLobby(context context.Context, request *metadatav1.Remov
 		userID = user.ID
 	}
 
-	cache.
+	cacheTransaction := cache.
 		GetInstance().
-		BeginSessionsTransaction()
+		BeginTransaction(context, cache.SESSIONS_REGION, cache.LOBBY_SETS_REGION, cache.METADATA_REGION)
+	defer cacheTransaction.Commit()
 
 	cachedSession, ok := cache.
 		GetInstance().
 		GetSessions(request.GetSessionId())
 	if !ok {
-		session, _, err := repository.
+		session, exists, err := repository.
 			GetSessionsRepository().
 			GetByID(request.GetSessionId())
 		if err != nil {
-			cache.
-				GetInstance().
-				CommitSessionsTransaction()
-
 			return nil, err
 		}
 
-		if session.Started {
-			cache.
-				GetInstance().
-				CommitSessionsTransaction()
+		if !exists {
+			return nil, ErrSessionDoesNotExists
+		}
 
+		if session.Started {
 			return nil, ErrSessionAlreadyStarted
 		}
 
@@ -1562,30 +1642,14 @@ func (h *Handler) RemoveLobby(context context.Context, request *metadatav1.Remov
 				converter.ConvertSessionEntityToCacheSessionEntity(session))
 	} else {
 		if cachedSession.Started {
-			cache.
-				GetInstance().
-				CommitSessionsTransaction()
-
 			return nil, ErrSessionAlreadyStarted
 		}
 	}
 
-	cache.
-		GetInstance().
-		CommitSessionsTransaction()
-
-	cache.
-		GetInstance().
-		BeginLobbySetTransaction()
-
 	lobbies, exists, err := repository.
 		GetLobbiesRepository().
 		GetByUserID(userID)
 	if err != nil {
-		cache.
-			GetInstance().
-			CommitLobbySetTransaction()
-
 		return nil, err
 	}
 
@@ -1605,7 +1669,7 @@ func (h *Handler) RemoveLobby(context context.Context, request *metadatav1.Remov
 		GetLobbiesRepository().
 		Lock()
 
-	err = db.BeginTransaction(func(transaction *gorm.DB) error {
+	err = db.BeginTransaction(context, func(transaction *gorm.DB) error {
 		lobbies, _, err = repository.
 			GetLobbiesRepository().
 			GetBySessionID(request.GetSessionId())
@@ -1619,7 +1683,7 @@ func (h *Handler) RemoveLobby(context context.Context, request *metadatav1.Remov
 			var availableLobbies []*entity.LobbyEntity
 
 			for _, lobby := range lobbies {
-				if lobby.UserID != userID {
+				if lobby.UserID != userID && !lobby.Spectator {
 					availableLobbies = append(availableLobbies, lobby)
 				}
 			}
@@ -1649,18 +1713,10 @@ func (h *Handler) RemoveLobby(context context.Context, request *metadatav1.Remov
 			return err
 		}
 
-		cache.
-			GetInstance().
-			BeginMetadataTransaction()
-
 		cache.
 			GetInstance().
 			EvictMetadata(request.GetIssuer())
 
-		cache.
-			GetInstance().
-			CommitMetadataTransaction()
-
 		return nil
 	})
 	if err != nil {
@@ -1668,10 +1724,6 @@ func (h *Handler) RemoveLobby(context context.Context, request *metadatav1.Remov
 			GetLobbiesRepository().
 			Unlock()
 
-		cache.
-			GetInstance().
-			CommitLobbySetTransaction()
-
 		return nil, err
 	}
 
@@ -1679,19 +1731,22 @@ func (h *Handler) RemoveLobby(context context.Context, request *metadatav1.Remov
 		GetLobbiesRepository().
 		Unlock()
 
-	cache.
-		GetInstance().
-		CommitLobbySetTransaction()
-
 	services.DecAvailableLobby()
 
+	audit.GetInstance().Record(audit.Entry{
+		Action:    audit.ACTION_LOBBY_LEAVE,
+		Issuer:    request.GetIssuer(),
+		SessionID: request.GetSessionId(),
+	})
+
 	return new(metadatav1.RemoveLobbyResponse), nil
 }
 
 func (h *Handler) LeaveLobby(context context.Context, request *metadatav1.LeaveLobbyRequest) (*metadatav1.LeaveLobbyResponse, error) {
-	cache.
+	cacheTransaction := cache.
 		GetInstance().
-		BeginMetadataTransaction()
+		BeginTransaction(context, cache.METADATA_REGION)
+	defer cacheTransaction.Commit()
 
 	metadata, ok := cache.
 		GetInstance().
@@ -1709,18 +1764,10 @@ func (h *Handler) LeaveLobby(context context.Context, request *metadatav1.LeaveL
 				GetUsersRepository().
 				GetByName(request.GetIssuer())
 			if err != nil {
-				cache.
-					GetInstance().
-					CommitMetadataTransaction()
-
 				return nil, err
 			}
 
 			if !exists {
-				cache.
-					GetInstance().
-					CommitMetadataTransaction()
-
 				return nil, ErrUserDoesNotExist
 			}
 
@@ -1731,18 +1778,10 @@ func (h *Handler) LeaveLobby(context context.Context, request *metadatav1.LeaveL
 			GetLobbiesRepository().
 			GetByUserID(userID)
 		if err != nil {
-			cache.
-				GetInstance().
-				CommitMetadataTransaction()
-
 			return nil, err
 		}
 
 		if !exists {
-			cache.
-				GetInstance().
-				CommitMetadataTransaction()
-
 			return nil, ErrLobbyDoesNotExist
 		}
 
@@ -1750,10 +1789,6 @@ func (h *Handler) LeaveLobby(context context.Context, request *metadatav1.LeaveL
 			GetInventoryRepository().
 			GetBySessionIDAndUserID(request.GetSessionId(), userID)
 		if err != nil {
-			cache.
-				GetInstance().
-				CommitMetadataTransaction()
-
 			return nil, err
 		}
 
@@ -1776,10 +1811,6 @@ func (h *Handler) LeaveLobby(context context.Context, request *metadatav1.LeaveL
 		}
 
 		if !found {
-			cache.
-				GetInstance().
-				CommitMetadataTransaction()
-
 			return nil, ErrSessionMetadataRetrievalNotAllowed
 		}
 	} else {
@@ -1796,17 +1827,16 @@ func (h *Handler) LeaveLobby(context context.Context, request *metadatav1.LeaveL
 		}
 
 		if !found {
-			cache.
-				GetInstance().
-				CommitMetadataTransaction()
-
 			return nil, ErrSessionMetadataRetrievalNotAllowed
 		}
 	}
 
-	cache.
-		GetInstance().
-		CommitMetadataTransaction()
+	audit.GetInstance().Record(audit.Entry{
+		Action:    audit.ACTION_LOBBY_LEAVE,
+		Issuer:    request.GetIssuer(),
+		SessionID: request.GetSessionId(),
+		Details:   "inactive",
+	})
 
 	return new(metadatav1.LeaveLobbyResponse), nil
 }
@@ -1823,13 +1853,11 @@ func (h *Handler) GetUsersMetadata(request *metadatav1.GetUsersMetadataRequest,
 
 			response.UserMetadata = response.UserMetadata[:0]
 
-			cache.
-				GetInstance().
-				BeginLobbySetTransaction()
+			var participants []team.Participant
 
-			cache.
+			cacheTransaction := cache.
 				GetInstance().
-				BeginMetadataTransaction()
+				BeginTransaction(stream.Context(), cache.LOBBY_SETS_REGION, cache.METADATA_REGION)
 
 			cachedLobbySet, ok := cache.
 				GetInstance().
@@ -1839,25 +1867,13 @@ func (h *Handler) GetUsersMetadata(request *metadatav1.GetUsersMetadataRequest,
 					GetLobbiesRepository().
 					GetBySessionID(request.GetSessionId())
 				if err != nil {
-					cache.
-						GetInstance().
-						CommitMetadataTransaction()
-
-					cache.
-						GetInstance().
-						CommitLobbySetTransaction()
+					cacheTransaction.Commit()
 
 					return err
 				}
 
 				if !exists {
-					cache.
-						GetInstance().
-						CommitMetadataTransaction()
-
-					cache.
-						GetInstance().
-						CommitLobbySetTransaction()
+					cacheTransaction.Commit()
 
 					return ErrLobbySetDoesNotExist
 				}
@@ -1866,10 +1882,14 @@ func (h *Handler) GetUsersMetadata(request *metadatav1.GetUsersMetadataRequest,
 
 				for _, lobby := range lobbies {
 					lobbySet = append(lobbySet, dto.CacheLobbySetEntity{
-						ID:     lobby.ID,
-						Issuer: lobby.UserEntity.Name,
-						Skin:   uint64(lobby.Skin),
-						Host:   lobby.Host,
+						ID:          lobby.ID,
+						Issuer:      lobby.UserEntity.Name,
+						DisplayName: lobby.UserEntity.DisplayName,
+						Skin:        uint64(lobby.Skin),
+						Host:        lobby.Host,
+						Spectator:   lobby.Spectator,
+						Ready:       lobby.Ready,
+						Team:        uint64(lobby.Team),
 					})
 				}
 
@@ -1897,25 +1917,13 @@ func (h *Handler) GetUsersMetadata(request *metadatav1.GetUsersMetadataRequest,
 							GetUsersRepository().
 							GetByName(lobbySet.Issuer)
 						if err != nil {
-							cache.
-								GetInstance().
-								CommitMetadataTransaction()
-
-							cache.
-								GetInstance().
-								CommitLobbySetTransaction()
+							cacheTransaction.Commit()
 
 							return err
 						}
 
 						if !exists {
-							cache.
-								GetInstance().
-								CommitMetadataTransaction()
-
-							cache.
-								GetInstance().
-								CommitLobbySetTransaction()
+							cacheTransaction.Commit()
 
 							return ErrUserDoesNotExist
 						}
@@ -1927,25 +1935,13 @@ func (h *Handler) GetUsersMetadata(request *metadatav1.GetUsersMetadataRequest,
 						GetLobbiesRepository().
 						GetByUserID(userID)
 					if err != nil {
-						cache.
-							GetInstance().
-							CommitMetadataTransaction()
-
-						cache.
-							GetInstance().
-							CommitLobbySetTransaction()
+						cacheTransaction.Commit()
 
 						return err
 					}
 
 					if !exists {
-						cache.
-							GetInstance().
-							CommitMetadataTransaction()
-
-						cache.
-							GetInstance().
-							CommitLobbySetTransaction()
+						cacheTransaction.Commit()
 
 						return ErrLobbyDoesNotExist
 					}
@@ -1954,13 +1950,7 @@ func (h *Handler) GetUsersMetadata(request *metadatav1.GetUsersMetadataRequest,
 						GetInventoryRepository().
 						GetBySessionIDAndUserID(request.GetSessionId(), userID)
 					if err != nil {
-						cache.
-							GetInstance().
-							CommitMetadataTransaction()
-
-						cache.
-							GetInstance().
-							CommitLobbySetTransaction()
+						cacheTransaction.Commit()
 
 						return err
 					}
@@ -1977,11 +1967,13 @@ func (h *Handler) GetUsersMetadata(request *metadatav1.GetUsersMetadataRequest,
 				for _, metadata := range cachedMetadata {
 					if metadata.SessionID == request.GetSessionId() {
 						response.UserMetadata = append(response.UserMetadata, &metadatav1.UserMetadata{
-							Issuer:     lobbySet.Issuer,
-							Health:     metadata.Health,
-							Skin:       metadata.Skin,
-							Active:     metadata.Active,
-							Eliminated: metadata.Eliminated,
+							Issuer:      lobbySet.Issuer,
+							DisplayName: lobbySet.DisplayName,
+							Health:      metadata.Health,
+							Skin:        metadata.Skin,
+							Active:      metadata.Active,
+							Eliminated:  metadata.Eliminated,
+							Spectator:   metadata.Spectator,
 							Position: &metadatav1.Position{
 								X: metadata.PositionX,
 								Y: metadata.PositionY,
@@ -1989,18 +1981,29 @@ func (h *Handler) GetUsersMetadata(request *metadatav1.GetUsersMetadataRequest,
 							Static: metadata.PositionStatic,
 							Inventory: converter.ConvertCacheInventoryEntityToInventory(
 								metadata.Inventory),
+							Team: metadata.Team,
 						})
+
+						if !metadata.Spectator {
+							participants = append(participants, team.Participant{
+								Issuer:     lobbySet.Issuer,
+								Team:       metadata.Team,
+								Eliminated: metadata.Eliminated,
+							})
+						}
 					}
 				}
 			}
 
-			cache.
-				GetInstance().
-				CommitMetadataTransaction()
+			cacheTransaction.Commit()
 
-			cache.
-				GetInstance().
-				CommitLobbySetTransaction()
+			response.Winners, response.Finished = team.GetWinners(participants)
+
+			if response.Finished {
+				replay.
+					GetInstance().
+					Stop(request.GetSessionId())
+			}
 
 			err := stream.Send(response)
 			if err != nil {
@@ -2039,20 +2042,25 @@ func (h *Handler) DropInventoryItem(context context.Context, request *metadatav1
 		userID = user.ID
 	}
 
-	cache.GetInstance().BeginMetadataTransaction()
+	cacheTransaction := cache.
+		GetInstance().
+		BeginTransaction(context, cache.METADATA_REGION)
+	defer cacheTransaction.Commit()
 
 	err := repository.
 		GetInventoryRepository().
 		DeleteByUserIDAndID(request.GetInventoryId(), userID)
 	if err != nil {
-		cache.GetInstance().CommitMetadataTransaction()
-
 		return nil, err
 	}
 
 	cache.GetInstance().EvictMetadata(request.GetIssuer())
 
-	cache.GetInstance().CommitMetadataTransaction()
+	audit.GetInstance().Record(audit.Entry{
+		Action:  audit.ACTION_ITEM_DROP,
+		Issuer:  request.GetIssuer(),
+		Details: fmt.Sprintf("inventory=%d", request.GetInventoryId()),
+	})
 
 	return response, nil
 }
@@ -2082,36 +2090,37 @@ func (h *Handler) TakeChestItem(context context.Context, request *metadatav1.Tak
 		userID = user.ID
 	}
 
-	cache.
+	cacheTransaction := cache.
 		GetInstance().
-		BeginSessionsTransaction()
+		BeginTransaction(context, cache.SESSIONS_REGION, cache.METADATA_REGION)
+	defer cacheTransaction.Commit()
 
-	var sessionName string
+	var (
+		sessionName  string
+		sessionRules string
+	)
 
 	cachedSession, ok := cache.
 		GetInstance().
 		GetSessions(request.GetSessionId())
 	if !ok {
-		session, _, err := repository.
+		session, exists, err := repository.
 			GetSessionsRepository().
 			GetByID(request.GetSessionId())
 		if err != nil {
-			cache.
-				GetInstance().
-				CommitSessionsTransaction()
-
 			return nil, err
 		}
 
-		if !session.Started {
-			cache.
-				GetInstance().
-				CommitSessionsTransaction()
+		if !exists {
+			return nil, ErrSessionDoesNotExists
+		}
 
+		if !session.Started {
 			return nil, ErrSessionNotStarted
 		}
 
 		sessionName = session.Name
+		sessionRules = session.Rules
 
 		cache.
 			GetInstance().
@@ -2120,20 +2129,13 @@ func (h *Handler) TakeChestItem(context context.Context, request *metadatav1.Tak
 				converter.ConvertSessionEntityToCacheSessionEntity(session))
 	} else {
 		if !cachedSession.Started {
-			cache.
-				GetInstance().
-				CommitSessionsTransaction()
-
 			return nil, ErrSessionNotStarted
 		}
 
 		sessionName = cachedSession.Name
+		sessionRules = cachedSession.Rules
 	}
 
-	cache.
-		GetInstance().
-		CommitSessionsTransaction()
-
 	inventoryCount, err := repository.
 		GetInventoryRepository().
 		CountByLobbyIDAndUserID(request.GetLobbyId(), userID)
@@ -2141,7 +2143,7 @@ func (h *Handler) TakeChestItem(context context.Context, request *metadatav1.Tak
 		return nil, err
 	}
 
-	if inventoryCount >= dto.MAX_INVENTORY_CAPACITY {
+	if inventoryCount >= int64(rules.Get(sessionRules).InventoryCapacity) {
 		return nil, ErrInventoryCapacityExceeded
 	}
 
@@ -2164,26 +2166,14 @@ func (h *Handler) TakeChestItem(context context.Context, request *metadatav1.Tak
 		return nil, err
 	}
 
-	cache.
-		GetInstance().
-		BeginMetadataTransaction()
-
 	lobbies, exists, err := repository.
 		GetLobbiesRepository().
 		GetByUserID(userID)
 	if err != nil {
-		cache.
-			GetInstance().
-			CommitMetadataTransaction()
-
 		return nil, err
 	}
 
 	if !exists {
-		cache.
-			GetInstance().
-			CommitMetadataTransaction()
-
 		return nil, ErrLobbyDoesNotExist
 	}
 
@@ -2191,10 +2181,6 @@ func (h *Handler) TakeChestItem(context context.Context, request *metadatav1.Tak
 		GetInventoryRepository().
 		GetBySessionIDAndUserID(request.GetSessionId(), userID)
 	if err != nil {
-		cache.
-			GetInstance().
-			CommitMetadataTransaction()
-
 		return nil, err
 	}
 
@@ -2213,20 +2199,25 @@ func (h *Handler) TakeChestItem(context context.Context, request *metadatav1.Tak
 			Active: false,
 		})
 	if err != nil {
-		cache.
-			GetInstance().
-			CommitMetadataTransaction()
-
 		return nil, err
 	}
 
 	cache.
 		GetInstance().
-		CommitMetadataTransaction()
+		EvictGeneratedChests(sessionName)
 
-	cache.
+	services.IncChestPickup()
+
+	audit.GetInstance().Record(audit.Entry{
+		Action:    audit.ACTION_ITEM_TAKE,
+		Issuer:    request.GetIssuer(),
+		SessionID: request.GetSessionId(),
+		Details:   fmt.Sprintf("generation=%d,association=%d", request.GetGenerationId(), request.GetAssociationId()),
+	})
+
+	replay.
 		GetInstance().
-		EvictGeneratedChests(sessionName)
+		RecordChest(request.GetSessionId(), request.GetIssuer(), request.GetGenerationId(), request.GetAssociationId())
 
 	return response, nil
 }
@@ -2256,67 +2247,52 @@ func (h *Handler) TakeHealthPack(context context.Context, request *metadatav1.Ta
 		userID = user.ID
 	}
 
-	cache.
+	cacheTransaction := cache.
 		GetInstance().
-		BeginGeneratedHealthPacksTransaction()
+		BeginTransaction(
+			context,
+			cache.SESSIONS_REGION,
+			cache.METADATA_REGION,
+			cache.GENERATED_HEALTH_PACKS_REGION)
+	defer cacheTransaction.Commit()
 
 	generation, _, err := repository.
 		GetGenerationRepository().
 		GetByID(request.GetGenerationId())
 	if err != nil {
-		cache.
-			GetInstance().
-			CommitGeneratedHealthPacksTransaction()
-
 		return nil, err
 	}
 
 	if generation.Type != dto.HEALTH_PACK_GENERATION_TYPE || generation.Name != utils.HEALTH_PACK_FROG_TYPE {
-		cache.
-			GetInstance().
-			CommitGeneratedHealthPacksTransaction()
-
 		return nil, ErrGenerationIsNotHealthPack
 	}
 
-	cache.
-		GetInstance().
-		BeginSessionsTransaction()
-
-	var sessionName string
+	var (
+		sessionName  string
+		sessionRules string
+	)
 
 	cachedSession, ok := cache.
 		GetInstance().
 		GetSessions(request.GetSessionId())
 	if !ok {
-		session, _, err := repository.
+		session, exists, err := repository.
 			GetSessionsRepository().
 			GetByID(request.GetSessionId())
 		if err != nil {
-			cache.
-				GetInstance().
-				CommitSessionsTransaction()
-
-			cache.
-				GetInstance().
-				CommitGeneratedHealthPacksTransaction()
-
 			return nil, err
 		}
 
-		if !session.Started {
-			cache.
-				GetInstance().
-				CommitSessionsTransaction()
-
-			cache.
-				GetInstance().
-				CommitGeneratedHealthPacksTransaction()
+		if !exists {
+			return nil, ErrSessionDoesNotExists
+		}
 
+		if !session.Started {
 			return nil, ErrSessionNotStarted
 		}
 
 		sessionName = session.Name
+		sessionRules = session.Rules
 
 		cache.
 			GetInstance().
@@ -2325,28 +2301,13 @@ func (h *Handler) TakeHealthPack(context context.Context, request *metadatav1.Ta
 				converter.ConvertSessionEntityToCacheSessionEntity(session))
 	} else {
 		if !cachedSession.Started {
-			cache.
-				GetInstance().
-				CommitSessionsTransaction()
-
-			cache.
-				GetInstance().
-				CommitGeneratedHealthPacksTransaction()
-
 			return nil, ErrSessionNotStarted
 		}
 
 		sessionName = cachedSession.Name
+		sessionRules = cachedSession.Rules
 	}
 
-	cache.
-		GetInstance().
-		CommitSessionsTransaction()
-
-	cache.
-		GetInstance().
-		BeginMetadataTransaction()
-
 	metadata, ok := cache.
 		GetInstance().
 		GetMetadata(request.GetIssuer())
@@ -2355,22 +2316,10 @@ func (h *Handler) TakeHealthPack(context context.Context, request *metadatav1.Ta
 			GetLobbiesRepository().
 			GetByUserID(userID)
 		if err != nil {
-			cache.
-				GetInstance().
-				CommitMetadataTransaction()
-
 			return nil, err
 		}
 
 		if !exists {
-			cache.
-				GetInstance().
-				CommitMetadataTransaction()
-
-			cache.
-				GetInstance().
-				CommitGeneratedHealthPacksTransaction()
-
 			return nil, ErrLobbyDoesNotExist
 		}
 
@@ -2378,26 +2327,10 @@ func (h *Handler) TakeHealthPack(context context.Context, request *metadatav1.Ta
 			GetInventoryRepository().
 			GetBySessionIDAndUserID(request.GetSessionId(), userID)
 		if err != nil {
-			cache.
-				GetInstance().
-				CommitMetadataTransaction()
-
-			cache.
-				GetInstance().
-				CommitGeneratedHealthPacksTransaction()
-
 			return nil, err
 		}
 
 		if !exists {
-			cache.
-				GetInstance().
-				CommitMetadataTransaction()
-
-			cache.
-				GetInstance().
-				CommitGeneratedHealthPacksTransaction()
-
 			return nil, ErrHealthPackDoesNotExist
 		}
 
@@ -2414,8 +2347,10 @@ func (h *Handler) TakeHealthPack(context context.Context, request *metadatav1.Ta
 				previous := value.Health
 
 				if generation.Name == utils.HEALTH_PACK_FROG_TYPE {
-					if value.Health+dto.FROG_HEALTH_PACK_RATE >= 100 {
-						value.Health = 100
+					maxHealth := rules.Get(sessionRules).MaxHealth
+
+					if value.Health+dto.FROG_HEALTH_PACK_RATE >= maxHealth {
+						value.Health = maxHealth
 					} else {
 						value.Health += dto.FROG_HEALTH_PACK_RATE
 					}
@@ -2431,14 +2366,6 @@ func (h *Handler) TakeHealthPack(context context.Context, request *metadatav1.Ta
 				if err != nil {
 					value.Health = previous
 
-					cache.
-						GetInstance().
-						CommitMetadataTransaction()
-
-					cache.
-						GetInstance().
-						CommitGeneratedHealthPacksTransaction()
-
 					return nil, err
 				}
 
@@ -2446,28 +2373,42 @@ func (h *Handler) TakeHealthPack(context context.Context, request *metadatav1.Ta
 					GetInstance().
 					EvictGeneratedHealthPacks(sessionName)
 
+				services.IncHealthPackPickup()
+
+				audit.GetInstance().Record(audit.Entry{
+					Action:    audit.ACTION_HEALTH_PACK_USE,
+					Issuer:    request.GetIssuer(),
+					SessionID: request.GetSessionId(),
+					Details:   fmt.Sprintf("generation=%d", request.GetGenerationId()),
+				})
+
+				replay.
+					GetInstance().
+					RecordHealthPack(request.GetSessionId(), request.GetIssuer(), request.GetGenerationId())
+
+				replay.
+					GetInstance().
+					RecordHealth(request.GetSessionId(), request.GetIssuer(), value.Health)
+
 				break
 			}
 		}
 	}
 
-	cache.
-		GetInstance().
-		CommitMetadataTransaction()
-
-	cache.
-		GetInstance().
-		CommitGeneratedHealthPacksTransaction()
-
 	return response, nil
 }
 
 func (h *Handler) OpenChest(context context.Context, request *metadatav1.OpenChestRequest) (*metadatav1.OpenChestResponse, error) {
 	response := new(metadatav1.OpenChestResponse)
 
-	cache.
+	cacheTransaction := cache.
 		GetInstance().
-		BeginSessionsTransaction()
+		BeginTransaction(
+			context,
+			cache.SESSIONS_REGION,
+			cache.LOBBY_SETS_REGION,
+			cache.GENERATED_CHESTS_REGION)
+	defer cacheTransaction.Commit()
 
 	cachedSession, ok := cache.
 		GetInstance().
@@ -2478,26 +2419,14 @@ func (h *Handler) OpenChest(context context.Context, request *metadatav1.OpenChe
 			GetSessionsRepository().
 			GetByID(request.GetSessionId())
 		if err != nil {
-			cache.
-				GetInstance().
-				CommitSessionsTransaction()
-
 			return nil, err
 		}
 
 		if !exists {
-			cache.
-				GetInstance().
-				CommitSessionsTransaction()
-
 			return nil, ErrSessionDoesNotExists
 		}
 
 		if !session.Started {
-			cache.
-				GetInstance().
-				CommitSessionsTransaction()
-
 			return nil, ErrSessionNotStarted
 		}
 
@@ -2508,22 +2437,10 @@ func (h *Handler) OpenChest(context context.Context, request *metadatav1.OpenChe
 				converter.ConvertSessionEntityToCacheSessionEntity(session))
 	} else {
 		if !cachedSession.Started {
-			cache.
-				GetInstance().
-				CommitSessionsTransaction()
-
 			return nil, ErrSessionNotStarted
 		}
 	}
 
-	cache.
-		GetInstance().
-		CommitSessionsTransaction()
-
-	cache.
-		GetInstance().
-		BeginLobbySetTransaction()
-
 	cachedLobbySet, ok := cache.
 		GetInstance().
 		GetLobbySet(request.GetSessionId())
@@ -2532,18 +2449,10 @@ func (h *Handler) OpenChest(context context.Context, request *metadatav1.OpenChe
 			GetLobbiesRepository().
 			GetBySessionID(request.GetSessionId())
 		if err != nil {
-			cache.
-				GetInstance().
-				CommitLobbySetTransaction()
-
 			return nil, err
 		}
 
 		if !exists {
-			cache.
-				GetInstance().
-				CommitLobbySetTransaction()
-
 			return nil, ErrLobbySetDoesNotExist
 		}
 
@@ -2551,10 +2460,14 @@ func (h *Handler) OpenChest(context context.Context, request *metadatav1.OpenChe
 
 		for _, lobby := range lobbies {
 			lobbySet = append(lobbySet, dto.CacheLobbySetEntity{
-				ID:     lobby.ID,
-				Issuer: lobby.UserEntity.Name,
-				Skin:   uint64(lobby.Skin),
-				Host:   lobby.Host,
+				ID:          lobby.ID,
+				Issuer:      lobby.UserEntity.Name,
+				DisplayName: lobby.UserEntity.DisplayName,
+				Skin:        uint64(lobby.Skin),
+				Host:        lobby.Host,
+				Spectator:   lobby.Spectator,
+				Ready:       lobby.Ready,
+				Team:        uint64(lobby.Team),
 			})
 		}
 
@@ -2574,21 +2487,9 @@ func (h *Handler) OpenChest(context context.Context, request *metadatav1.OpenChe
 	}
 
 	if !found {
-		cache.
-			GetInstance().
-			CommitLobbySetTransaction()
-
 		return nil, ErrUserIsNotInLobby
 	}
 
-	cache.
-		GetInstance().
-		CommitLobbySetTransaction()
-
-	cache.
-		GetInstance().
-		BeginGeneratedChestsTransaction()
-
 	err := repository.
 		GetGenerationRepository().
 		InsertOrUpdate(dto.GenerationsRepositoryInsertOrUpdateRequest{
@@ -2596,16 +2497,19 @@ func (h *Handler) OpenChest(context context.Context, request *metadatav1.OpenChe
 			Active: false,
 		})
 	if err != nil {
-		cache.
-			GetInstance().
-			CommitGeneratedChestsTransaction()
-
 		return nil, err
 	}
 
-	cache.
+	audit.GetInstance().Record(audit.Entry{
+		Action:    audit.ACTION_CHEST_OPEN,
+		Issuer:    request.GetIssuer(),
+		SessionID: request.GetSessionId(),
+		Details:   fmt.Sprintf("generation=%d", request.GetGenerationId()),
+	})
+
+	replay.
 		GetInstance().
-		CommitGeneratedChestsTransaction()
+		RecordChest(request.GetSessionId(), request.GetIssuer(), request.GetGenerationId(), 0)
 
 	return response, nil
 }
@@ -2615,9 +2519,10 @@ func (h *Handler) GetChests(request *metadatav1.GetChestsRequest, stream grpc.Se
 
 	ticker := time.NewTicker(getEventsFrequency)
 
-	cache.
+	cacheTransaction := cache.
 		GetInstance().
-		BeginSessionsTransaction()
+		BeginTransaction(stream.Context(), cache.SESSIONS_REGION)
+	defer cacheTransaction.Commit()
 
 	cachedSession, ok := cache.
 		GetInstance().
@@ -2628,26 +2533,14 @@ func (h *Handler) GetChests(request *metadatav1.GetChestsRequest, stream grpc.Se
 			GetSessionsRepository().
 			GetByID(request.GetSessionId())
 		if err != nil {
-			cache.
-				GetInstance().
-				CommitSessionsTransaction()
-
 			return err
 		}
 
 		if !exists {
-			cache.
-				GetInstance().
-				CommitSessionsTransaction()
-
 			return ErrSessionDoesNotExists
 		}
 
 		if !session.Started {
-			cache.
-				GetInstance().
-				CommitSessionsTransaction()
-
 			return ErrSessionNotStarted
 		}
 
@@ -2658,17 +2551,11 @@ func (h *Handler) GetChests(request *metadatav1.GetChestsRequest, stream grpc.Se
 				converter.ConvertSessionEntityToCacheSessionEntity(session))
 	} else {
 		if !cachedSession.Started {
-			cache.
-				GetInstance().
-				CommitSessionsTransaction()
-
 			return ErrSessionNotStarted
 		}
 	}
 
-	cache.
-		GetInstance().
-		CommitSessionsTransaction()
+	cacheTransaction.Commit()
 
 	for {
 		select {
@@ -2730,9 +2617,12 @@ func (h *Handler) GetChests(request *metadatav1.GetChestsRequest, stream grpc.Se
 func (h *Handler) OpenHealthPack(context context.Context, request *metadatav1.OpenHealthPackRequest) (*metadatav1.OpenHealthPackResponse, error) {
 	response := new(metadatav1.OpenHealthPackResponse)
 
-	cache.
+	cacheTransaction := cache.
 		GetInstance().
-		BeginSessionsTransaction()
+		BeginTransaction(context, cache.SESSIONS_REGION, cache.LOBBY_SETS_REGION, cache.METADATA_REGION)
+	defer cacheTransaction.Commit()
+
+	var sessionRules string
 
 	cachedSession, ok := cache.
 		GetInstance().
@@ -2743,29 +2633,19 @@ func (h *Handler) OpenHealthPack(context context.Context, request *metadatav1.Op
 			GetSessionsRepository().
 			GetByID(request.GetSessionId())
 		if err != nil {
-			cache.
-				GetInstance().
-				CommitSessionsTransaction()
-
 			return nil, err
 		}
 
 		if !exists {
-			cache.
-				GetInstance().
-				CommitSessionsTransaction()
-
 			return nil, ErrSessionDoesNotExists
 		}
 
 		if !session.Started {
-			cache.
-				GetInstance().
-				CommitSessionsTransaction()
-
 			return nil, ErrSessionNotStarted
 		}
 
+		sessionRules = session.Rules
+
 		cache.
 			GetInstance().
 			AddSessions(
@@ -2773,22 +2653,12 @@ func (h *Handler) OpenHealthPack(context context.Context, request *metadatav1.Op
 				converter.ConvertSessionEntityToCacheSessionEntity(session))
 	} else {
 		if !cachedSession.Started {
-			cache.
-				GetInstance().
-				CommitSessionsTransaction()
-
 			return nil, ErrSessionNotStarted
 		}
+
+		sessionRules = cachedSession.Rules
 	}
 
-	cache.
-		GetInstance().
-		CommitSessionsTransaction()
-
-	cache.
-		GetInstance().
-		BeginLobbySetTransaction()
-
 	cachedLobbySet, ok := cache.
 		GetInstance().
 		GetLobbySet(request.GetSessionId())
@@ -2797,18 +2667,10 @@ func (h *Handler) OpenHealthPack(context context.Context, request *metadatav1.Op
 			GetLobbiesRepository().
 			GetBySessionID(request.GetSessionId())
 		if err != nil {
-			cache.
-				GetInstance().
-				CommitLobbySetTransaction()
-
 			return nil, err
 		}
 
 		if !exists {
-			cache.
-				GetInstance().
-				CommitLobbySetTransaction()
-
 			return nil, ErrLobbySetDoesNotExist
 		}
 
@@ -2816,10 +2678,14 @@ func (h *Handler) OpenHealthPack(context context.Context, request *metadatav1.Op
 
 		for _, lobby := range lobbies {
 			lobbySet = append(lobbySet, dto.CacheLobbySetEntity{
-				ID:     lobby.ID,
-				Issuer: lobby.UserEntity.Name,
-				Skin:   uint64(lobby.Skin),
-				Host:   lobby.Host,
+				ID:          lobby.ID,
+				Issuer:      lobby.UserEntity.Name,
+				DisplayName: lobby.UserEntity.DisplayName,
+				Skin:        uint64(lobby.Skin),
+				Host:        lobby.Host,
+				Spectator:   lobby.Spectator,
+				Ready:       lobby.Ready,
+				Team:        uint64(lobby.Team),
 			})
 		}
 
@@ -2839,17 +2705,9 @@ func (h *Handler) OpenHealthPack(context context.Context, request *metadatav1.Op
 	}
 
 	if !found {
-		cache.
-			GetInstance().
-			CommitLobbySetTransaction()
-
 		return nil, ErrUserIsNotInLobby
 	}
 
-	cache.
-		GetInstance().
-		CommitLobbySetTransaction()
-
 	var userID int64
 
 	cachedUserID, ok := cache.
@@ -2862,28 +2720,16 @@ func (h *Handler) OpenHealthPack(context context.Context, request *metadatav1.Op
 			GetUsersRepository().
 			GetByName(request.GetIssuer())
 		if err != nil {
-			cache.
-				GetInstance().
-				CommitMetadataTransaction()
-
 			return nil, err
 		}
 
 		if !exists {
-			cache.
-				GetInstance().
-				CommitMetadataTransaction()
-
 			return nil, ErrUserDoesNotExist
 		}
 
 		userID = user.ID
 	}
 
-	cache.
-		GetInstance().
-		BeginMetadataTransaction()
-
 	metadata, ok := cache.
 		GetInstance().
 		GetMetadata(request.GetIssuer())
@@ -2892,18 +2738,10 @@ func (h *Handler) OpenHealthPack(context context.Context, request *metadatav1.Op
 			GetLobbiesRepository().
 			GetByUserID(userID)
 		if err != nil {
-			cache.
-				GetInstance().
-				CommitMetadataTransaction()
-
 			return nil, err
 		}
 
 		if !exists {
-			cache.
-				GetInstance().
-				CommitMetadataTransaction()
-
 			return nil, ErrLobbyDoesNotExist
 		}
 
@@ -2911,18 +2749,10 @@ func (h *Handler) OpenHealthPack(context context.Context, request *metadatav1.Op
 			GetInventoryRepository().
 			GetBySessionIDAndUserID(request.GetSessionId(), userID)
 		if err != nil {
-			cache.
-				GetInstance().
-				CommitMetadataTransaction()
-
 			return nil, err
 		}
 
 		if !exists {
-			cache.
-				GetInstance().
-				CommitMetadataTransaction()
-
 			return nil, ErrHealthPackDoesNotExist
 		}
 
@@ -2941,8 +2771,10 @@ func (h *Handler) OpenHealthPack(context context.Context, request *metadatav1.Op
 						previous := value.Health
 
 						if item.Name == utils.CHEST_ITEM_HEALTH_PACK_TYPE {
-							if value.Health+dto.HEALTH_PACK_RATE >= 100 {
-								value.Health = 100
+							maxHealth := rules.Get(sessionRules).MaxHealth
+
+							if value.Health+dto.HEALTH_PACK_RATE >= maxHealth {
+								value.Health = maxHealth
 							} else {
 								value.Health += dto.HEALTH_PACK_RATE
 							}
@@ -2954,13 +2786,24 @@ func (h *Handler) OpenHealthPack(context context.Context, request *metadatav1.Op
 						if err != nil {
 							value.Health = previous
 
-							cache.GetInstance().CommitMetadataTransaction()
-
 							return nil, err
 						}
 
 						value.Inventory = append(value.Inventory[:index], value.Inventory[index+1:]...)
 
+						if item.Name == utils.CHEST_ITEM_HEALTH_PACK_TYPE {
+							audit.GetInstance().Record(audit.Entry{
+								Action:    audit.ACTION_HEALTH_PACK_USE,
+								Issuer:    request.GetIssuer(),
+								SessionID: request.GetSessionId(),
+								Details:   fmt.Sprintf("inventory=%d", request.GetInventoryId()),
+							})
+
+							replay.
+								GetInstance().
+								RecordHealth(request.GetSessionId(), request.GetIssuer(), value.Health)
+						}
+
 						break
 					}
 				}
@@ -2968,8 +2811,6 @@ func (h *Handler) OpenHealthPack(context context.Context, request *metadatav1.Op
 		}
 	}
 
-	cache.GetInstance().CommitMetadataTransaction()
-
 	return response, nil
 }
 
@@ -2978,9 +2819,10 @@ func (h *Handler) GetHealthPacks(request *metadatav1.GetHealthPacksRequest, stre
 
 	ticker := time.NewTicker(getEventsFrequency)
 
-	cache.
+	cacheTransaction := cache.
 		GetInstance().
-		BeginSessionsTransaction()
+		BeginTransaction(stream.Context(), cache.SESSIONS_REGION)
+	defer cacheTransaction.Commit()
 
 	cachedSession, ok := cache.
 		GetInstance().
@@ -2991,26 +2833,14 @@ func (h *Handler) GetHealthPacks(request *metadatav1.GetHealthPacksRequest, stre
 			GetSessionsRepository().
 			GetByID(request.GetSessionId())
 		if err != nil {
-			cache.
-				GetInstance().
-				CommitSessionsTransaction()
-
 			return err
 		}
 
 		if !exists {
-			cache.
-				GetInstance().
-				CommitSessionsTransaction()
-
 			return ErrSessionDoesNotExists
 		}
 
 		if !session.Started {
-			cache.
-				GetInstance().
-				CommitSessionsTransaction()
-
 			return ErrSessionNotStarted
 		}
 
@@ -3021,17 +2851,11 @@ func (h *Handler) GetHealthPacks(request *metadatav1.GetHealthPacksRequest, stre
 				converter.ConvertSessionEntityToCacheSessionEntity(session))
 	} else {
 		if !cachedSession.Started {
-			cache.
-				GetInstance().
-				CommitSessionsTransaction()
-
 			return ErrSessionNotStarted
 		}
 	}
 
-	cache.
-		GetInstance().
-		CommitSessionsTransaction()
+	cacheTransaction.Commit()
 
 	for {
 		select {
@@ -3080,9 +2904,10 @@ func (h *Handler) GetEvents(request *metadatav1.GetEventsRequest, stream grpc.Se
 
 	var sessionName string
 
-	cache.
+	cacheTransaction := cache.
 		GetInstance().
-		BeginSessionsTransaction()
+		BeginTransaction(stream.Context(), cache.SESSIONS_REGION)
+	defer cacheTransaction.Commit()
 
 	cachedSession, ok := cache.
 		GetInstance().
@@ -3093,26 +2918,14 @@ func (h *Handler) GetEvents(request *metadatav1.GetEventsRequest, stream grpc.Se
 			GetSessionsRepository().
 			GetByID(request.GetSessionId())
 		if err != nil {
-			cache.
-				GetInstance().
-				CommitSessionsTransaction()
-
 			return err
 		}
 
 		if !exists {
-			cache.
-				GetInstance().
-				CommitSessionsTransaction()
-
 			return ErrSessionDoesNotExists
 		}
 
 		if !session.Started {
-			cache.
-				GetInstance().
-				CommitSessionsTransaction()
-
 			return ErrSessionNotStarted
 		}
 
@@ -3125,19 +2938,13 @@ func (h *Handler) GetEvents(request *metadatav1.GetEventsRequest, stream grpc.Se
 				converter.ConvertSessionEntityToCacheSessionEntity(session))
 	} else {
 		if !cachedSession.Started {
-			cache.
-				GetInstance().
-				CommitSessionsTransaction()
-
 			return ErrSessionNotStarted
 		}
 
 		sessionName = cachedSession.Name
 	}
 
-	cache.
-		GetInstance().
-		CommitSessionsTransaction()
+	cacheTransaction.Commit()
 
 	for {
 		select {
@@ -3165,6 +2972,1038 @@ func (h *Handler) GetEvents(request *metadatav1.GetEventsRequest, stream grpc.Se
 	}
 }
 
+func (h *Handler) GetReplay(request *metadatav1.GetReplayRequest, stream grpc.ServerStreamingServer[metadatav1.GetReplayResponse]) error {
+	err := replay.
+		GetInstance().
+		Read(
+			request.GetSessionId(),
+			request.GetIssuer(),
+			getReplayChunkSize,
+			func(frames []*metadatav1.ReplayFrame) error {
+				return stream.Send(&metadatav1.GetReplayResponse{
+					Frames: frames,
+				})
+			})
+	if err != nil {
+		if _, ok := status.FromError(err); ok {
+			return err
+		}
+
+		switch {
+		case errors.Is(err, replay.ErrReplayDoesNotExist):
+			return status.Errorf(codes.NotFound, err.Error())
+		case errors.Is(err, replay.ErrReplayAccessNotAllowed):
+			return status.Errorf(codes.PermissionDenied, err.Error())
+		default:
+			return status.Errorf(codes.Internal, err.Error())
+		}
+	}
+
+	return nil
+}
+
+func (h *Handler) CreateSessionInvite(ctx context.Context, request *metadatav1.CreateSessionInviteRequest) (*metadatav1.CreateSessionInviteResponse, error) {
+	var userID int64
+
+	cachedUserID, ok := cache.
+		GetInstance().
+		GetUsers(request.GetIssuer())
+	if ok {
+		userID = cachedUserID
+	} else {
+		user, exists, err := repository.
+			GetUsersRepository().
+			GetByName(request.GetIssuer())
+		if err != nil {
+			return nil, err
+		}
+
+		if !exists {
+			return nil, ErrUserDoesNotExist
+		}
+
+		userID = user.ID
+	}
+
+	cacheTransaction := cache.
+		GetInstance().
+		BeginTransaction(ctx, cache.SESSIONS_REGION)
+	defer cacheTransaction.Commit()
+
+	cachedSession, ok := cache.
+		GetInstance().
+		GetSessions(request.GetSessionId())
+	if !ok {
+		session, exists, err := repository.
+			GetSessionsRepository().
+			GetByID(request.GetSessionId())
+		if err != nil {
+			return nil, err
+		}
+
+		if !exists {
+			return nil, ErrSessionDoesNotExists
+		}
+
+		cachedSession = converter.ConvertSessionEntityToCacheSessionEntity(session)
+
+		cache.
+			GetInstance().
+			AddSessions(request.GetSessionId(), cachedSession)
+	}
+
+	if cachedSession.Issuer != userID {
+		return nil, status.Errorf(codes.PermissionDenied, ErrUserDoesNotOwnSession.Error())
+	}
+
+	if cachedSession.Password == "" {
+		return nil, status.Errorf(codes.FailedPrecondition, ErrSessionIsNotPrivate.Error())
+	}
+
+	code, err := access.GenerateInviteCode()
+	if err != nil {
+		return nil, err
+	}
+
+	var expiresAt *time.Time
+
+	if request.GetExpiration() != 0 {
+		value := time.Now().Add(time.Duration(request.GetExpiration()) * time.Second)
+
+		expiresAt = &value
+	}
+
+	err = repository.
+		GetInvitesRepository().
+		Insert(dto.InvitesRepositoryInsertRequest{
+			SessionID: request.GetSessionId(),
+			Code:      code,
+			SingleUse: request.GetSingleUse(),
+			ExpiresAt: expiresAt,
+		})
+	if err != nil {
+		return nil, err
+	}
+
+	audit.GetInstance().Record(audit.Entry{
+		Action:    audit.ACTION_SESSION_INVITE,
+		Issuer:    request.GetIssuer(),
+		SessionID: request.GetSessionId(),
+	})
+
+	return &metadatav1.CreateSessionInviteResponse{
+		Code: code,
+	}, nil
+}
+
+func (h *Handler) ListPublicSessions(ctx context.Context, request *metadatav1.ListPublicSessionsRequest) (*metadatav1.ListPublicSessionsResponse, error) {
+	if request.Rules != nil && !rules.Exists(request.GetRules()) {
+		return nil, status.Errorf(codes.InvalidArgument, ErrRulesPresetDoesNotExist.Error())
+	}
+
+	pageSize := int(request.GetPageSize())
+	if pageSize == 0 {
+		pageSize = listPublicSessionsPageSize
+	}
+
+	maxPlayers := make(map[string]int)
+
+	for _, preset := range rules.GetPresets() {
+		maxPlayers[preset] = rules.Get(preset).MaxSessionUsers
+	}
+
+	sessions, total, err := repository.
+		GetSessionsRepository().
+		GetPublic(dto.SessionsRepositoryGetPublicRequest{
+			Offset:            int(request.GetPage()) * pageSize,
+			Limit:             pageSize,
+			Sort:              request.GetSort(),
+			Rules:             request.Rules,
+			Started:           request.Started,
+			NamePrefix:        request.GetNamePrefix(),
+			FreeSlots:         request.GetFreeSlots(),
+			MaxPlayers:        maxPlayers,
+			DefaultMaxPlayers: rules.Get(rules.PRESET_CLASSIC).MaxSessionUsers,
+		})
+	if err != nil {
+		return nil, err
+	}
+
+	response := &metadatav1.ListPublicSessionsResponse{
+		Total: uint64(total),
+	}
+
+	for _, session := range sessions {
+		response.Sessions = append(response.Sessions, &metadatav1.PublicSession{
+			SessionId:  session.ID,
+			Name:       session.Name,
+			Owner:      access.GetOwnerTag(session.Issuer),
+			Players:    uint64(session.Players),
+			MaxPlayers: uint64(rules.Get(session.Rules).MaxSessionUsers),
+			Started:    session.Started,
+			Rules:      session.Rules,
+			CreatedAt:  timestamppb.New(session.CreatedAt),
+		})
+	}
+
+	return response, nil
+}
+
+func (h *Handler) JoinMatchmaking(request *metadatav1.JoinMatchmakingRequest, stream grpc.ServerStreamingServer[metadatav1.JoinMatchmakingResponse]) error {
+	var userID int64
+
+	cachedUserID, ok := cache.
+		GetInstance().
+		GetUsers(request.GetIssuer())
+	if ok {
+		userID = cachedUserID
+	} else {
+		user, exists, err := repository.
+			GetUsersRepository().
+			GetByName(request.GetIssuer())
+		if err != nil {
+			return err
+		}
+
+		if !exists {
+			return ErrUserDoesNotExist
+		}
+
+		userID = user.ID
+	}
+
+	preset := request.GetRules()
+	if preset == "" {
+		preset = rules.PRESET_CLASSIC
+	}
+
+	if !rules.Exists(preset) {
+		return status.Errorf(codes.InvalidArgument, ErrRulesPresetDoesNotExist.Error())
+	}
+
+	ticket, err := matchmaking.
+		GetInstance().
+		Join(request.GetIssuer(), userID, preset)
+	if err != nil {
+		if errors.Is(err, matchmaking.ErrUserAlreadyQueued) {
+			return status.Errorf(codes.AlreadyExists, err.Error())
+		}
+
+		return err
+	}
+
+	defer matchmaking.
+		GetInstance().
+		Remove(ticket)
+
+	ticker := time.NewTicker(getMatchmakingFrequency)
+	defer ticker.Stop()
+
+	for {
+		position, estimatedWait, ok := matchmaking.
+			GetInstance().
+			GetStatus(ticket)
+		if ok {
+			err = stream.Send(&metadatav1.JoinMatchmakingResponse{
+				Position:      uint64(position),
+				EstimatedWait: uint64(estimatedWait.Round(time.Second).Seconds()),
+			})
+			if err != nil {
+				return err
+			}
+		}
+
+		select {
+		case <-ticker.C:
+		case match, ok := <-ticket.GetMatch():
+			if !ok {
+				return nil
+			}
+
+			return stream.Send(&metadatav1.JoinMatchmakingResponse{
+				Match: &metadatav1.MatchmakingMatch{
+					SessionId: match.SessionID,
+					Name:      match.Name,
+					Seed:      uint64(match.Seed),
+					LobbyId:   match.LobbyID,
+					Skin:      match.Skin,
+					Host:      match.Host,
+				},
+			})
+		case <-stream.Context().Done():
+			return nil
+		}
+	}
+}
+
+func (h *Handler) LeaveMatchmaking(ctx context.Context, request *metadatav1.LeaveMatchmakingRequest) (*metadatav1.LeaveMatchmakingResponse, error) {
+	matchmaking.
+		GetInstance().
+		Leave(request.GetIssuer())
+
+	return new(metadatav1.LeaveMatchmakingResponse), nil
+}
+
+func (h *Handler) SetLobbyReady(ctx context.Context, request *metadatav1.SetLobbyReadyRequest) (*metadatav1.SetLobbyReadyResponse, error) {
+	var userID int64
+
+	cachedUserID, ok := cache.
+		GetInstance().
+		GetUsers(request.GetIssuer())
+	if ok {
+		userID = cachedUserID
+	} else {
+		user, exists, err := repository.
+			GetUsersRepository().
+			GetByName(request.GetIssuer())
+		if err != nil {
+			return nil, err
+		}
+
+		if !exists {
+			return nil, ErrUserDoesNotExist
+		}
+
+		userID = user.ID
+	}
+
+	duration := time.Duration(request.GetCountdown()) * time.Second
+	if duration > maxLobbyCountdown {
+		return nil, status.Errorf(codes.InvalidArgument, ErrLobbyCountdownIsNotValid.Error())
+	}
+
+	cacheTransaction := cache.
+		GetInstance().
+		BeginTransaction(ctx, cache.LOBBY_SETS_REGION)
+	defer cacheTransaction.Commit()
+
+	lobbies, exists, err := repository.
+		GetLobbiesRepository().
+		GetBySessionID(request.GetSessionId())
+	if err != nil {
+		return nil, err
+	}
+
+	if !exists {
+		return nil, ErrLobbyDoesNotExist
+	}
+
+	index := slices.IndexFunc(lobbies, func(value *entity.LobbyEntity) bool {
+		return value.ID == request.GetLobbyId() && value.UserID == userID
+	})
+	if index == -1 {
+		return nil, ErrLobbyDoesNotExist
+	}
+
+	if lobbies[index].SessionEntity.Started {
+		return nil, status.Errorf(codes.Aborted, ErrSessionAlreadyStarted.Error())
+	}
+
+	if lobbies[index].Spectator {
+		return nil, status.Errorf(codes.InvalidArgument, ErrLobbyIsSpectator.Error())
+	}
+
+	if duration != 0 && !lobbies[index].Host {
+		return nil, status.Errorf(codes.PermissionDenied, ErrUserIsNotLobbyHost.Error())
+	}
+
+	err = repository.
+		GetLobbiesRepository().
+		UpdateReadyByID(request.GetLobbyId(), request.GetReady())
+	if err != nil {
+		return nil, err
+	}
+
+	cache.
+		GetInstance().
+		EvictLobbySet(request.GetSessionId())
+
+	if duration != 0 {
+		countdown.Start(request.GetSessionId(), duration)
+	}
+
+	return new(metadatav1.SetLobbyReadyResponse), nil
+}
+
+func (h *Handler) SetLobbyTeam(ctx context.Context, request *metadatav1.SetLobbyTeamRequest) (*metadatav1.SetLobbyTeamResponse, error) {
+	if !team.IsValid(request.GetTeam()) {
+		return nil, status.Errorf(codes.InvalidArgument, ErrLobbyTeamIsNotValid.Error())
+	}
+
+	var userID int64
+
+	cachedUserID, ok := cache.
+		GetInstance().
+		GetUsers(request.GetIssuer())
+	if ok {
+		userID = cachedUserID
+	} else {
+		user, exists, err := repository.
+			GetUsersRepository().
+			GetByName(request.GetIssuer())
+		if err != nil {
+			return nil, err
+		}
+
+		if !exists {
+			return nil, ErrUserDoesNotExist
+		}
+
+		userID = user.ID
+	}
+
+	cacheTransaction := cache.
+		GetInstance().
+		BeginTransaction(ctx, cache.LOBBY_SETS_REGION, cache.METADATA_REGION)
+	defer cacheTransaction.Commit()
+
+	lobbies, exists, err := repository.
+		GetLobbiesRepository().
+		GetBySessionID(request.GetSessionId())
+	if err != nil {
+		return nil, err
+	}
+
+	if !exists {
+		return nil, ErrLobbyDoesNotExist
+	}
+
+	index := slices.IndexFunc(lobbies, func(value *entity.LobbyEntity) bool {
+		return value.ID == request.GetLobbyId() && value.UserID == userID
+	})
+	if index == -1 {
+		return nil, ErrLobbyDoesNotExist
+	}
+
+	if lobbies[index].SessionEntity.Started {
+		return nil, status.Errorf(codes.Aborted, ErrSessionAlreadyStarted.Error())
+	}
+
+	if lobbies[index].Spectator {
+		return nil, status.Errorf(codes.InvalidArgument, ErrLobbyIsSpectator.Error())
+	}
+
+	if request.GetBalance() {
+		if !lobbies[index].Host {
+			return nil, status.Errorf(codes.PermissionDenied, ErrUserIsNotLobbyHost.Error())
+		}
+
+		lobbyTeams := make(map[int64]uint64)
+
+		for _, lobby := range lobbies {
+			if !lobby.Spectator {
+				lobbyTeams[lobby.ID] = team.NO_TEAM
+			}
+		}
+
+		for id, value := range team.Balance(lobbyTeams) {
+			err = repository.
+				GetLobbiesRepository().
+				UpdateTeamByID(id, value)
+			if err != nil {
+				return nil, err
+			}
+		}
+	} else {
+		err = repository.
+			GetLobbiesRepository().
+			UpdateTeamByID(request.GetLobbyId(), request.GetTeam())
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	cache.
+		GetInstance().
+		EvictLobbySet(request.GetSessionId())
+
+	for _, lobby := range lobbies {
+		cache.
+			GetInstance().
+			EvictMetadata(lobby.UserEntity.Name)
+	}
+
+	return new(metadatav1.SetLobbyTeamResponse), nil
+}
+
+func (h *Handler) SendChatMessage(ctx context.Context, request *metadatav1.SendChatMessageRequest) (*metadatav1.SendChatMessageResponse, error) {
+	member, err := isSessionMember(ctx, request.GetSessionId(), request.GetIssuer())
+	if err != nil {
+		return nil, err
+	}
+
+	if !member {
+		return nil, status.Errorf(codes.PermissionDenied, ErrUserIsNotInSession.Error())
+	}
+
+	err = chat.
+		GetInstance().
+		Push(request.GetSessionId(), request.GetIssuer(), request.GetText())
+	if err != nil {
+		if errors.Is(err, chat.ErrRateLimitIsReached) {
+			return nil, status.Errorf(codes.ResourceExhausted, err.Error())
+		}
+
+		return nil, status.Errorf(codes.InvalidArgument, err.Error())
+	}
+
+	return new(metadatav1.SendChatMessageResponse), nil
+}
+
+func (h *Handler) GetChatMessages(request *metadatav1.GetChatMessagesRequest, stream grpc.ServerStreamingServer[metadatav1.GetChatMessagesResponse]) error {
+	member, err := isSessionMember(stream.Context(), request.GetSessionId(), request.GetIssuer())
+	if err != nil {
+		return err
+	}
+
+	if !member {
+		return status.Errorf(codes.PermissionDenied, ErrUserIsNotInSession.Error())
+	}
+
+	response := new(metadatav1.GetChatMessagesResponse)
+
+	var last int64
+
+	ticker := time.NewTicker(getChatMessagesFrequency)
+	defer ticker.Stop()
+
+	for {
+		response.Messages = response.Messages[:0]
+
+		for _, message := range chat.
+			GetInstance().
+			Retrieve(request.GetSessionId(), last) {
+			response.Messages = append(response.Messages, &metadatav1.ChatMessage{
+				Id:        message.ID,
+				Issuer:    message.Issuer,
+				Text:      message.Text,
+				CreatedAt: timestamppb.New(message.CreatedAt),
+			})
+
+			last = message.ID
+		}
+
+		if len(response.Messages) != 0 {
+			err = stream.Send(response)
+			if err != nil {
+				return err
+			}
+		}
+
+		select {
+		case <-ticker.C:
+		case <-stream.Context().Done():
+			return nil
+		}
+	}
+}
+
+func (h *Handler) GetEmotes(request *metadatav1.GetEmotesRequest, stream grpc.ServerStreamingServer[metadatav1.GetEmotesResponse]) error {
+	member, err := isSessionMember(stream.Context(), request.GetSessionId(), request.GetIssuer())
+	if err != nil {
+		return err
+	}
+
+	if !member {
+		return status.Errorf(codes.PermissionDenied, ErrUserIsNotInSession.Error())
+	}
+
+	response := new(metadatav1.GetEmotesResponse)
+
+	ticker := time.NewTicker(getEmotesFrequency)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+			response.Emotes = response.Emotes[:0]
+
+			for _, value := range emote.
+				GetInstance().
+				Take(request.GetSessionId(), request.GetIssuer()) {
+				response.Emotes = append(response.Emotes, &metadatav1.Emote{
+					Issuer: value.Issuer,
+					Emote:  value.Emote,
+					Ping:   value.Ping,
+					Position: &metadatav1.Position{
+						X: value.PositionX,
+						Y: value.PositionY,
+					},
+				})
+			}
+
+			if len(response.Emotes) != 0 {
+				err = stream.Send(response)
+				if err != nil {
+					return err
+				}
+			}
+		case <-stream.Context().Done():
+			return nil
+		}
+	}
+}
+
+func (h *Handler) GetFriends(ctx context.Context, request *metadatav1.GetFriendsRequest) (*metadatav1.GetFriendsResponse, error) {
+	user, exists, err := repository.
+		GetUsersRepository().
+		GetByName(request.GetIssuer())
+	if err != nil {
+		return nil, err
+	}
+
+	if !exists {
+		return nil, ErrUserDoesNotExist
+	}
+
+	friendships, err := repository.
+		GetFriendshipsRepository().
+		GetByUserID(user.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	response := new(metadatav1.GetFriendsResponse)
+
+	for _, friendship := range friendships {
+		response.Friends = append(response.Friends, &metadatav1.Friend{
+			FriendshipId: friendship.ID,
+			DisplayName:  friendship.GetCounterpart(user.ID).DisplayName,
+			Accepted:     friendship.Accepted,
+			Incoming:     friendship.Receiver == user.ID,
+		})
+	}
+
+	return response, nil
+}
+
+func (h *Handler) SendFriendRequest(ctx context.Context, request *metadatav1.SendFriendRequestRequest) (*metadatav1.SendFriendRequestResponse, error) {
+	user, exists, err := repository.
+		GetUsersRepository().
+		GetByName(request.GetIssuer())
+	if err != nil {
+		return nil, err
+	}
+
+	if !exists {
+		return nil, ErrUserDoesNotExist
+	}
+
+	if user.DisplayName == "" {
+		return nil, status.Errorf(codes.FailedPrecondition, ErrProfileDisplayNameIsNotSet.Error())
+	}
+
+	receiver, exists, err := repository.
+		GetUsersRepository().
+		GetByDisplayName(request.GetDisplayName())
+	if err != nil {
+		return nil, err
+	}
+
+	if !exists {
+		return nil, status.Errorf(codes.NotFound, ErrFriendDoesNotExist.Error())
+	}
+
+	if receiver.ID == user.ID {
+		return nil, status.Errorf(codes.InvalidArgument, ErrFriendRequestIsSentToSelf.Error())
+	}
+
+	friendship, exists, err := repository.
+		GetFriendshipsRepository().
+		GetByUserIDs(user.ID, receiver.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	if exists {
+		if friendship.Accepted || friendship.Receiver != user.ID {
+			return nil, status.Errorf(codes.AlreadyExists, ErrFriendshipAlreadyExists.Error())
+		}
+
+		err = repository.
+			GetFriendshipsRepository().
+			UpdateAcceptedByID(friendship.ID)
+		if err != nil {
+			return nil, err
+		}
+
+		return new(metadatav1.SendFriendRequestResponse), nil
+	}
+
+	err = repository.
+		GetFriendshipsRepository().
+		Insert(user.ID, receiver.ID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrDuplicatedKey) {
+			return nil, status.Errorf(codes.AlreadyExists, ErrFriendshipAlreadyExists.Error())
+		}
+
+		return nil, err
+	}
+
+	return new(metadatav1.SendFriendRequestResponse), nil
+}
+
+func (h *Handler) AcceptFriendRequest(ctx context.Context, request *metadatav1.AcceptFriendRequestRequest) (*metadatav1.AcceptFriendRequestResponse, error) {
+	user, exists, err := repository.
+		GetUsersRepository().
+		GetByName(request.GetIssuer())
+	if err != nil {
+		return nil, err
+	}
+
+	if !exists {
+		return nil, ErrUserDoesNotExist
+	}
+
+	friendship, exists, err := repository.
+		GetFriendshipsRepository().
+		GetByID(request.GetFriendshipId())
+	if err != nil {
+		return nil, err
+	}
+
+	if !exists {
+		return nil, status.Errorf(codes.NotFound, ErrFriendshipDoesNotExist.Error())
+	}
+
+	if friendship.Receiver != user.ID {
+		return nil, status.Errorf(codes.PermissionDenied, ErrFriendRequestIsNotReceived.Error())
+	}
+
+	if !friendship.Accepted {
+		err = repository.
+			GetFriendshipsRepository().
+			UpdateAcceptedByID(friendship.ID)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return new(metadatav1.AcceptFriendRequestResponse), nil
+}
+
+func (h *Handler) RemoveFriend(ctx context.Context, request *metadatav1.RemoveFriendRequest) (*metadatav1.RemoveFriendResponse, error) {
+	user, exists, err := repository.
+		GetUsersRepository().
+		GetByName(request.GetIssuer())
+	if err != nil {
+		return nil, err
+	}
+
+	if !exists {
+		return nil, ErrUserDoesNotExist
+	}
+
+	friendship, exists, err := repository.
+		GetFriendshipsRepository().
+		GetByID(request.GetFriendshipId())
+	if err != nil {
+		return nil, err
+	}
+
+	if !exists || (friendship.Issuer != user.ID && friendship.Receiver != user.ID) {
+		return nil, status.Errorf(codes.NotFound, ErrFriendshipDoesNotExist.Error())
+	}
+
+	err = repository.
+		GetFriendshipsRepository().
+		DeleteByID(friendship.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	return new(metadatav1.RemoveFriendResponse), nil
+}
+
+func (h *Handler) InviteFriend(ctx context.Context, request *metadatav1.InviteFriendRequest) (*metadatav1.InviteFriendResponse, error) {
+	user, exists, err := repository.
+		GetUsersRepository().
+		GetByName(request.GetIssuer())
+	if err != nil {
+		return nil, err
+	}
+
+	if !exists {
+		return nil, ErrUserDoesNotExist
+	}
+
+	if user.DisplayName == "" {
+		return nil, status.Errorf(codes.FailedPrecondition, ErrProfileDisplayNameIsNotSet.Error())
+	}
+
+	friendship, exists, err := repository.
+		GetFriendshipsRepository().
+		GetByID(request.GetFriendshipId())
+	if err != nil {
+		return nil, err
+	}
+
+	if !exists || (friendship.Issuer != user.ID && friendship.Receiver != user.ID) {
+		return nil, status.Errorf(codes.NotFound, ErrFriendshipDoesNotExist.Error())
+	}
+
+	if !friendship.Accepted {
+		return nil, status.Errorf(codes.FailedPrecondition, ErrFriendshipIsNotAccepted.Error())
+	}
+
+	member, err := isSessionMember(ctx, request.GetSessionId(), request.GetIssuer())
+	if err != nil {
+		return nil, err
+	}
+
+	if !member {
+		return nil, status.Errorf(codes.PermissionDenied, ErrUserIsNotInSession.Error())
+	}
+
+	cacheTransaction := cache.
+		GetInstance().
+		BeginTransaction(ctx, cache.SESSIONS_REGION)
+	defer cacheTransaction.Commit()
+
+	cachedSession, ok := cache.
+		GetInstance().
+		GetSessions(request.GetSessionId())
+	if !ok {
+		session, exists, err := repository.
+			GetSessionsRepository().
+			GetByID(request.GetSessionId())
+		if err != nil {
+			return nil, err
+		}
+
+		if !exists {
+			return nil, ErrSessionDoesNotExists
+		}
+
+		cachedSession = converter.ConvertSessionEntityToCacheSessionEntity(session)
+
+		cache.
+			GetInstance().
+			AddSessions(request.GetSessionId(), cachedSession)
+	}
+
+	if cachedSession.Started {
+		return nil, status.Errorf(codes.FailedPrecondition, ErrSessionAlreadyStarted.Error())
+	}
+
+	var code string
+
+	if cachedSession.Password != "" {
+		if cachedSession.Issuer != user.ID {
+			return nil, status.Errorf(codes.PermissionDenied, ErrUserDoesNotOwnSession.Error())
+		}
+
+		code, err = access.GenerateInviteCode()
+		if err != nil {
+			return nil, err
+		}
+
+		expiresAt := time.Now().Add(invitation.INVITATION_EXPIRATION)
+
+		err = repository.
+			GetInvitesRepository().
+			Insert(dto.InvitesRepositoryInsertRequest{
+				SessionID: request.GetSessionId(),
+				Code:      code,
+				SingleUse: true,
+				ExpiresAt: &expiresAt,
+			})
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	friend := friendship.GetCounterpart(user.ID)
+
+	invitation.
+		GetInstance().
+		Push(friend.Name, dto.InvitationUnit{
+			DisplayName: user.DisplayName,
+			SessionID:   request.GetSessionId(),
+			SessionName: cachedSession.Name,
+			Seed:        cachedSession.Seed,
+			InviteCode:  code,
+		})
+
+	audit.GetInstance().Record(audit.Entry{
+		Action:    audit.ACTION_FRIEND_INVITE,
+		Issuer:    request.GetIssuer(),
+		Target:    friend.Name,
+		SessionID: request.GetSessionId(),
+	})
+
+	return new(metadatav1.InviteFriendResponse), nil
+}
+
+func (h *Handler) GetInvitations(request *metadatav1.GetInvitationsRequest, stream grpc.ServerStreamingServer[metadatav1.GetInvitationsResponse]) error {
+	response := new(metadatav1.GetInvitationsResponse)
+
+	ticker := time.NewTicker(getInvitationsFrequency)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+			response.Invitations = response.Invitations[:0]
+
+			for _, value := range invitation.
+				GetInstance().
+				Take(request.GetIssuer()) {
+				response.Invitations = append(response.Invitations, &metadatav1.Invitation{
+					Id:          value.ID,
+					DisplayName: value.DisplayName,
+					SessionId:   value.SessionID,
+					SessionName: value.SessionName,
+					Seed:        uint64(value.Seed),
+					InviteCode:  value.InviteCode,
+				})
+			}
+
+			if len(response.Invitations) != 0 {
+				err := stream.Send(response)
+				if err != nil {
+					return err
+				}
+			}
+		case <-stream.Context().Done():
+			return nil
+		}
+	}
+}
+
+// isSessionMember checks if user with the given name has a lobby in the session with the given id.
+func isSessionMember(ctx context.Context, sessionID int64, issuer string) (bool, error) {
+	cacheTransaction := cache.
+		GetInstance().
+		BeginTransaction(ctx, cache.LOBBY_SETS_REGION)
+	defer cacheTransaction.Commit()
+
+	cachedLobbySet, ok := cache.
+		GetInstance().
+		GetLobbySet(sessionID)
+	if ok {
+		return slices.ContainsFunc(cachedLobbySet, func(value dto.CacheLobbySetEntity) bool {
+			return value.Issuer == issuer
+		}), nil
+	}
+
+	lobbies, _, err := repository.
+		GetLobbiesRepository().
+		GetBySessionID(sessionID)
+	if err != nil {
+		return false, err
+	}
+
+	return slices.ContainsFunc(lobbies, func(value *entity.LobbyEntity) bool {
+		return value.UserEntity.Name == issuer
+	}), nil
+}
+
+// getSession retrieves session with the given id, restoring it in the cache if needed.
+func getSession(ctx context.Context, sessionID int64) (dto.CacheSessionEntity, error) {
+	cacheTransaction := cache.
+		GetInstance().
+		BeginTransaction(ctx, cache.SESSIONS_REGION)
+	defer cacheTransaction.Commit()
+
+	cachedSession, ok := cache.
+		GetInstance().
+		GetSessions(sessionID)
+	if ok {
+		return cachedSession, nil
+	}
+
+	session, exists, err := repository.
+		GetSessionsRepository().
+		GetByIDWithTransaction(db.GetInstance().WithContext(ctx), sessionID)
+	if err != nil {
+		return dto.CacheSessionEntity{}, err
+	}
+
+	if !exists {
+		return dto.CacheSessionEntity{}, ErrSessionDoesNotExists
+	}
+
+	cachedSession = converter.ConvertSessionEntityToCacheSessionEntity(session)
+
+	cache.
+		GetInstance().
+		AddSessions(sessionID, cachedSession)
+
+	return cachedSession, nil
+}
+
+// getSpawnables selects spawnable for each of the given lobbies. In team mode team members are spawned
+// at the spawnables tagged with their team, falling back to the untagged ones, when there are not
+// enough of them. Otherwise all the spawnables are used regardless of their tags.
+func getSpawnables(
+	lobbies []*entity.LobbyEntity,
+	lobbyTeams map[int64]uint64,
+	spawnables []*metadatav1.Position,
+	teamSpawnables []*metadatav1.Spawnable) (map[int64]*metadatav1.Position, error) {
+	enabled := team.IsEnabled(maps.Values(lobbyTeams))
+
+	general := slices.Clone(spawnables)
+
+	tagged := make(map[uint64][]*metadatav1.Position)
+
+	for _, spawnable := range teamSpawnables {
+		if enabled {
+			tagged[spawnable.GetTeam()] = append(tagged[spawnable.GetTeam()], spawnable.GetPosition())
+		} else {
+			general = append(general, spawnable.GetPosition())
+		}
+	}
+
+	rand.Shuffle(len(general), func(i, j int) {
+		general[i], general[j] = general[j], general[i]
+	})
+
+	for _, positions := range tagged {
+		rand.Shuffle(len(positions), func(i, j int) {
+			positions[i], positions[j] = positions[j], positions[i]
+		})
+	}
+
+	result := make(map[int64]*metadatav1.Position)
+
+	for _, lobby := range lobbies {
+		lobbyTeam := lobbyTeams[lobby.ID]
+
+		if positions := tagged[lobbyTeam]; lobbyTeam != team.NO_TEAM && len(positions) != 0 {
+			result[lobby.ID] = positions[0]
+
+			tagged[lobbyTeam] = positions[1:]
+
+			continue
+		}
+
+		if len(general) == 0 {
+			return nil, ErrLobbiesAmountExceedsSpawnablesAmount
+		}
+
+		result[lobby.ID] = general[0]
+
+		general = general[1:]
+	}
+
+	return result, nil
+}
+
+// getPlayersAmount retrieves amount of lobbies in the given lobby set, which are not spectators.
+func getPlayersAmount(lobbySet []dto.CacheLobbySetEntity) int {
+	var result int
+
+	for _, lobby := range lobbySet {
+		if !lobby.Spectator {
+			result++
+		}
+	}
+
+	return result
+}
+
 // NewHandler initializes implementation of metadatav1.MetadataServer.
 func NewHandler() metadatav1.MetadataServiceServer {
 	return new(Handler)
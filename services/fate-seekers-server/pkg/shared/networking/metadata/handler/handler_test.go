@@ -0,0 +1,333 @@
+package handler
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"log"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/YarikRevich/fate-seekers/services/fate-seekers-server/pkg/shared/config"
+	"github.com/YarikRevich/fate-seekers/services/fate-seekers-server/pkg/shared/db"
+	metadatav1 "github.com/YarikRevich/fate-seekers/services/fate-seekers-server/pkg/shared/networking/metadata/api"
+	"github.com/YarikRevich/fate-seekers/services/fate-seekers-server/pkg/shared/repository"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"gorm.io/gorm"
+)
+
+const (
+	// Describes amount of concurrent workers used for stress testing.
+	stressWorkers = 8
+
+	// Describes amount of sessions lifecycles performed by each of the workers.
+	stressIterations = 6
+
+	// Describes timeout, after which stress test is considered deadlocked.
+	stressTimeout = time.Minute * 2
+)
+
+// Describes configuration used for testing, which disables all the file and network based sinks.
+const testConfig = `settings:
+  monitoring:
+    enabled: false
+  tracing:
+    enabled: false
+  audit:
+    enabled: false
+  replay:
+    enabled: false
+logging:
+  console: false
+`
+
+// TestMain initializes configuration and temporary database, which all the tests are run against.
+func TestMain(m *testing.M) {
+	directory, err := os.MkdirTemp("", "fate-seekers-server-handler")
+	if err != nil {
+		log.Fatalln(err)
+	}
+
+	os.Setenv("HOME", directory)
+
+	if err := os.WriteFile(filepath.Join(directory, "config.yaml"), []byte(testConfig), 0644); err != nil {
+		log.Fatalln(err)
+	}
+
+	flag.Set("configDirectory", directory)
+	flag.Set("config", "config.yaml")
+
+	config.SetupDefaultConfig()
+	config.Init()
+
+	if err := os.MkdirAll(filepath.Dir(config.GetDatabaseName()), 0755); err != nil {
+		log.Fatalln(err)
+	}
+
+	db.Init()
+
+	code := m.Run()
+
+	os.RemoveAll(directory)
+
+	os.Exit(code)
+}
+
+// TestHandlerStress tests concurrent sessions lifecycles performed by the handlers, acquiring cache
+// regions and database transactions at the same time. It should be run with -race flag enabled.
+func TestHandlerStress(t *testing.T) {
+	h := new(Handler)
+
+	ctx := context.Background()
+
+	hosts := make([]string, stressWorkers)
+	guests := make([]string, stressWorkers)
+
+	for i := range stressWorkers {
+		hosts[i] = uuid.NewString()
+		guests[i] = uuid.NewString()
+
+		for _, issuer := range []string{hosts[i], guests[i]} {
+			_, err := h.CreateUserIfNotExists(ctx, &metadatav1.CreateUserIfNotExistsRequest{Issuer: issuer})
+			require.NoError(t, err)
+		}
+	}
+
+	var (
+		wg sync.WaitGroup
+
+		sessions sync.Map
+	)
+
+	errs := make(chan error, stressWorkers)
+
+	for i := range stressWorkers {
+		wg.Add(1)
+
+		go func(worker int) {
+			defer wg.Done()
+
+			for j := range stressIterations {
+				if err := performSessionLifecycle(ctx, h, worker, j, hosts, guests, &sessions); err != nil {
+					errs <- fmt.Errorf("worker %d, iteration %d: %w", worker, j, err)
+
+					return
+				}
+			}
+		}(i)
+	}
+
+	done := make(chan struct{})
+
+	go func() {
+		wg.Wait()
+
+		close(done)
+	}()
+
+	select {
+	case <-done:
+	case <-time.After(stressTimeout):
+		t.Fatal("handlers deadlocked")
+	}
+
+	close(errs)
+
+	for err := range errs {
+		require.NoError(t, err)
+	}
+}
+
+// TestHandlerUpdateProfileDuplicate tests that display name, which is already taken by another user
+// regardless of its case, is rejected both by the handler and by the storage unique index, which the
+// handler relies on when concurrent updates pass the check at the same time.
+func TestHandlerUpdateProfileDuplicate(t *testing.T) {
+	h := new(Handler)
+
+	ctx := context.Background()
+
+	displayName := uuid.NewString()[:8]
+
+	issuers := []string{uuid.NewString(), uuid.NewString()}
+
+	for _, issuer := range issuers {
+		_, err := h.CreateUserIfNotExists(ctx, &metadatav1.CreateUserIfNotExistsRequest{Issuer: issuer})
+		require.NoError(t, err)
+	}
+
+	_, err := h.UpdateProfile(ctx, &metadatav1.UpdateProfileRequest{
+		Issuer:      issuers[0],
+		DisplayName: strings.ToLower(displayName),
+	})
+	require.NoError(t, err)
+
+	_, err = h.UpdateProfile(ctx, &metadatav1.UpdateProfileRequest{
+		Issuer:      issuers[1],
+		DisplayName: strings.ToUpper(displayName),
+	})
+	require.Equal(t, codes.AlreadyExists, status.Code(err))
+
+	err = repository.
+		GetUsersRepository().
+		UpdateProfileByName(issuers[1], strings.ToUpper(displayName), 0, 0)
+	require.ErrorIs(t, err, gorm.ErrDuplicatedKey)
+}
+
+// TestHandlerSendFriendRequestReversed tests that friendship between the same users can not be persisted
+// twice in the reversed direction, which the handler relies on when both users send requests at the same time.
+func TestHandlerSendFriendRequestReversed(t *testing.T) {
+	h := new(Handler)
+
+	ctx := context.Background()
+
+	issuers := []string{uuid.NewString(), uuid.NewString()}
+
+	for _, issuer := range issuers {
+		_, err := h.CreateUserIfNotExists(ctx, &metadatav1.CreateUserIfNotExistsRequest{Issuer: issuer})
+		require.NoError(t, err)
+
+		_, err = h.UpdateProfile(ctx, &metadatav1.UpdateProfileRequest{
+			Issuer:      issuer,
+			DisplayName: issuer[:8],
+		})
+		require.NoError(t, err)
+	}
+
+	_, err := h.SendFriendRequest(ctx, &metadatav1.SendFriendRequestRequest{
+		Issuer:      issuers[0],
+		DisplayName: issuers[1][:8],
+	})
+	require.NoError(t, err)
+
+	issuer, _, err := repository.
+		GetUsersRepository().
+		GetByName(issuers[0])
+	require.NoError(t, err)
+
+	receiver, _, err := repository.
+		GetUsersRepository().
+		GetByName(issuers[1])
+	require.NoError(t, err)
+
+	err = repository.
+		GetFriendshipsRepository().
+		Insert(receiver.ID, issuer.ID)
+	require.ErrorIs(t, err, gorm.ErrDuplicatedKey)
+}
+
+// performSessionLifecycle creates session with two lobbies, which is either started and left or
+// removed, depending on the iteration. Neighbour worker session is touched in between, so that
+// handlers of different workers contend for the same cache regions.
+func performSessionLifecycle(
+	ctx context.Context, h *Handler, worker, iteration int, hosts, guests []string, sessions *sync.Map) error {
+	host, guest := hosts[worker], guests[worker]
+
+	name := uuid.NewString()[:8]
+
+	_, err := h.CreateSession(ctx, &metadatav1.CreateSessionRequest{Name: name, Issuer: host})
+	if err != nil {
+		return err
+	}
+
+	session, exists, err := repository.
+		GetSessionsRepository().
+		GetByName(name)
+	if err != nil {
+		return err
+	}
+
+	if !exists {
+		return ErrSessionDoesNotExists
+	}
+
+	sessions.Store(worker, session.ID)
+
+	for _, issuer := range []string{host, guest} {
+		_, err = h.CreateLobby(ctx, &metadatav1.CreateLobbyRequest{SessionId: session.ID, Issuer: issuer})
+		if err != nil {
+			return err
+		}
+	}
+
+	if neighbour, ok := sessions.Load((worker + 1) % len(hosts)); ok {
+		if _, err := h.RemoveSession(ctx, &metadatav1.RemoveSessionRequest{
+			SessionId: neighbour.(int64),
+			Issuer:    host,
+		}); err == nil {
+			return fmt.Errorf("neighbour session %d has been removed by not an owner", neighbour)
+		}
+	}
+
+	if iteration%2 == 0 {
+		lobbies, _, err := repository.
+			GetLobbiesRepository().
+			GetBySessionID(session.ID)
+		if err != nil {
+			return err
+		}
+
+		var hostLobbyID int64
+
+		for _, lobby := range lobbies {
+			_, err = h.SetLobbyReady(ctx, &metadatav1.SetLobbyReadyRequest{
+				SessionId: session.ID,
+				LobbyId:   lobby.ID,
+				Issuer:    lobby.UserEntity.Name,
+				Ready:     true,
+			})
+			if err != nil {
+				return err
+			}
+
+			if lobby.Host {
+				hostLobbyID = lobby.ID
+			}
+		}
+
+		_, err = h.StartSession(ctx, &metadatav1.StartSessionRequest{
+			SessionId:           session.ID,
+			LobbyId:             hostLobbyID,
+			Issuer:              host,
+			Spawnables:          []*metadatav1.Position{{X: 10, Y: 10}, {X: 20, Y: 20}},
+			ChestLocations:      []*metadatav1.Position{{X: 30, Y: 30}, {X: 40, Y: 40}},
+			HealthPackLocations: []*metadatav1.Position{{X: 50, Y: 50}, {X: 60, Y: 60}},
+		})
+		if err != nil {
+			return err
+		}
+
+		for _, issuer := range []string{host, guest} {
+			_, err = h.LeaveLobby(ctx, &metadatav1.LeaveLobbyRequest{SessionId: session.ID, Issuer: issuer})
+			if err != nil {
+				return err
+			}
+		}
+
+		if _, err := h.RemoveSession(ctx, &metadatav1.RemoveSessionRequest{
+			SessionId: session.ID,
+			Issuer:    host,
+		}); err == nil {
+			return fmt.Errorf("session %d has been removed with lobbies", session.ID)
+		}
+
+		return nil
+	}
+
+	for _, issuer := range []string{guest, host} {
+		_, err = h.RemoveLobby(ctx, &metadatav1.RemoveLobbyRequest{SessionId: session.ID, Issuer: issuer})
+		if err != nil {
+			return err
+		}
+	}
+
+	_, err = h.RemoveSession(ctx, &metadatav1.RemoveSessionRequest{SessionId: session.ID, Issuer: host})
+
+	return err
+}
@@ -0,0 +1,104 @@
+package invitation
+
+import (
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/YarikRevich/fate-seekers/services/fate-seekers-server/pkg/shared/dto"
+)
+
+const (
+	// Represents duration after which invitation is dropped, also used as expiration of
+	// the invite codes attached to it.
+	INVITATION_EXPIRATION = time.Minute * 5
+
+	// Represents max amount of pending invitations kept per recipient.
+	maxPendingInvitations = 10
+)
+
+var (
+	// GetInstance retrieves instance of the invitation manager, performing initialization if needed.
+	GetInstance = sync.OnceValue[*InvitationManager](newInvitationManager)
+)
+
+// InvitationManager represents invitation manager, which holds session invitations sent to each
+// of the users until they are retrieved or expired.
+type InvitationManager struct {
+	// Represents mutex used for invitations access.
+	mu sync.Mutex
+
+	// Represents last assigned invitation identifier.
+	sequence int64
+
+	// Represents pending invitations of each of the recipients.
+	pending map[string][]dto.InvitationUnit
+}
+
+// Push adds new invitation to the pending invitations of the given recipient, replacing previous
+// invitation to the same session and dropping the oldest ones when limit is reached.
+func (im *InvitationManager) Push(recipient string, value dto.InvitationUnit) {
+	im.mu.Lock()
+	defer im.mu.Unlock()
+
+	im.sequence++
+
+	value.ID = im.sequence
+	value.CreatedAt = time.Now()
+
+	invitations := slices.DeleteFunc(im.pending[recipient], func(invitation dto.InvitationUnit) bool {
+		return invitation.SessionID == value.SessionID
+	})
+
+	invitations = append(invitations, value)
+
+	if len(invitations) > maxPendingInvitations {
+		invitations = slices.Clone(invitations[len(invitations)-maxPendingInvitations:])
+	}
+
+	im.pending[recipient] = invitations
+}
+
+// Take retrieves and removes all the pending not expired invitations of the given recipient.
+func (im *InvitationManager) Take(recipient string) []dto.InvitationUnit {
+	im.mu.Lock()
+	defer im.mu.Unlock()
+
+	invitations, ok := im.pending[recipient]
+	if !ok {
+		return nil
+	}
+
+	delete(im.pending, recipient)
+
+	now := time.Now()
+
+	return slices.DeleteFunc(invitations, func(invitation dto.InvitationUnit) bool {
+		return now.Sub(invitation.CreatedAt) >= INVITATION_EXPIRATION
+	})
+}
+
+// Remove removes all the pending invitations to the session with the given id.
+func (im *InvitationManager) Remove(sessionID int64) {
+	im.mu.Lock()
+	defer im.mu.Unlock()
+
+	for recipient, invitations := range im.pending {
+		invitations = slices.DeleteFunc(invitations, func(invitation dto.InvitationUnit) bool {
+			return invitation.SessionID == sessionID
+		})
+
+		if len(invitations) == 0 {
+			delete(im.pending, recipient)
+		} else {
+			im.pending[recipient] = invitations
+		}
+	}
+}
+
+// newInvitationManager initializes InvitationManager.
+func newInvitationManager() *InvitationManager {
+	return &InvitationManager{
+		pending: make(map[string][]dto.InvitationUnit),
+	}
+}
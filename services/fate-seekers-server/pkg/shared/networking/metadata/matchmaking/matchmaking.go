@@ -0,0 +1,489 @@
+package matchmaking
+
+import (
+	"context"
+	"errors"
+	"math/rand"
+	"slices"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/YarikRevich/fate-seekers/services/fate-seekers-server/pkg/shared/audit"
+	"github.com/YarikRevich/fate-seekers/services/fate-seekers-server/pkg/shared/config"
+	"github.com/YarikRevich/fate-seekers/services/fate-seekers-server/pkg/shared/db"
+	"github.com/YarikRevich/fate-seekers/services/fate-seekers-server/pkg/shared/dto"
+	"github.com/YarikRevich/fate-seekers/services/fate-seekers-server/pkg/shared/entity"
+	"github.com/YarikRevich/fate-seekers/services/fate-seekers-server/pkg/shared/monitoring/services"
+	"github.com/YarikRevich/fate-seekers/services/fate-seekers-server/pkg/shared/networking/cache"
+	"github.com/YarikRevich/fate-seekers/services/fate-seekers-server/pkg/shared/networking/metadata/countdown"
+	"github.com/YarikRevich/fate-seekers/services/fate-seekers-server/pkg/shared/repository"
+	"github.com/YarikRevich/fate-seekers/services/fate-seekers-server/pkg/shared/rules"
+	"github.com/YarikRevich/fate-seekers/services/fate-seekers-server/pkg/shared/supervisor"
+	"gorm.io/gorm"
+)
+
+var (
+	ErrUserAlreadyQueued         = errors.New("err happened user is already in matchmaking queue")
+	ErrSessionDoesNotExist       = errors.New("err happened matched session does not exist")
+	ErrSessionNameIsNotGenerated = errors.New("err happened matched session name can't be generated")
+	ErrLobbyDoesNotExist         = errors.New("err happened matched lobby does not exist")
+	ErrTicketCancelled           = errors.New("err happened matched user has left matchmaking queue")
+)
+
+const (
+	// Represents name of the worker used for supervision.
+	workerName = "matchmaking"
+
+	// Represents ticker duration used for matchmaking worker.
+	matchmakingTickerDuration = time.Second * 1
+
+	// Represents min amount of queued players, which are grouped after matchmaking timeout.
+	minTimeoutPlayers = 2
+
+	// Represents prefix of the matched sessions names.
+	sessionNamePrefix = "mm-"
+
+	// Represents symbols used for matched sessions names generation.
+	sessionNameAlphabet = "abcdefghijklmnopqrstuvwxyz0123456789"
+
+	// Represents length of the random part of the matched sessions names, which keeps
+	// names within the session name length allowed by the client.
+	sessionNameRandomLength = 5
+
+	// Represents max amount of attempts to generate unique matched session name.
+	sessionNameAttempts = 5
+
+	// Represents upper bound of the matched sessions seeds, which keeps seeds within
+	// the session seed length allowed by the client.
+	maxSessionSeed = 100000000
+
+	// Represents session start countdown of the matched sessions, after which the host client starts
+	// the session even if not all the matched users are ready.
+	matchCountdown = time.Second * 10
+)
+
+var (
+	// GetInstance retrieves instance of the matchmaker, performing initial creation if needed.
+	GetInstance = sync.OnceValue[*Matchmaker](newMatchmaker)
+)
+
+// Ticket represents a single user entry in the matchmaking queue.
+type Ticket struct {
+	// Represents name of the queued user.
+	issuer string
+
+	// Represents id of the queued user.
+	userID int64
+
+	// Represents rules preset of the queue.
+	rules string
+
+	// Represents time when user joined the queue.
+	joinedAt time.Time
+
+	// Represents channel, which receives found match or is closed when user leaves the queue.
+	match chan dto.MatchmakingMatch
+
+	// Represents if user has left the queue, while the ticket was taken for grouping.
+	cancelled bool
+
+	// Represents if match of the ticket has been confirmed and is being delivered.
+	matched bool
+}
+
+// GetMatch retrieves channel, which receives found match or is closed when user leaves the queue.
+func (t *Ticket) GetMatch() <-chan dto.MatchmakingMatch {
+	return t.match
+}
+
+// Matchmaker represents matchmaking queue, which groups queued users with the same rules preset into new sessions.
+type Matchmaker struct {
+	// Represents mutex used for queues access.
+	mu sync.Mutex
+
+	// Represents queues of the users per rules preset ordered by join time.
+	queues map[string][]*Ticket
+
+	// Represents all the queued tickets, including the ones taken for grouping, indexed by user name.
+	tickets map[string]*Ticket
+}
+
+// Join adds user with the given name and id to the queue of the given rules preset.
+func (m *Matchmaker) Join(issuer string, userID int64, preset string) (*Ticket, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if _, ok := m.tickets[issuer]; ok {
+		return nil, ErrUserAlreadyQueued
+	}
+
+	result := &Ticket{
+		issuer:   issuer,
+		userID:   userID,
+		rules:    preset,
+		joinedAt: time.Now(),
+		match:    make(chan dto.MatchmakingMatch, 1),
+	}
+
+	m.queues[preset] = append(m.queues[preset], result)
+
+	m.tickets[issuer] = result
+
+	return result, nil
+}
+
+// Leave removes user with the given name from the queue, if user is still queued.
+func (m *Matchmaker) Leave(issuer string) bool {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	ticket, ok := m.tickets[issuer]
+	if !ok {
+		return false
+	}
+
+	return m.remove(ticket)
+}
+
+// Remove removes the given ticket from the queue, if it is still queued.
+func (m *Matchmaker) Remove(ticket *Ticket) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.remove(ticket)
+}
+
+// remove removes the given ticket from the queue, closing its match channel. Tickets, which have
+// already been taken for grouping, are marked as cancelled, so that their group match is discarded.
+// Tickets, which match has already been confirmed, are ignored, because their match is being delivered.
+func (m *Matchmaker) remove(ticket *Ticket) bool {
+	if ticket.cancelled || ticket.matched {
+		return false
+	}
+
+	ticket.cancelled = true
+
+	delete(m.tickets, ticket.issuer)
+
+	queue := m.queues[ticket.rules]
+
+	if index := slices.Index(queue, ticket); index != -1 {
+		m.queues[ticket.rules] = slices.Delete(queue, index, index+1)
+	}
+
+	close(ticket.match)
+
+	return true
+}
+
+// GetStatus retrieves one based position of the given ticket in its queue together with estimated
+// wait until it is grouped. False is returned, when ticket is no longer queued.
+func (m *Matchmaker) GetStatus(ticket *Ticket) (int, time.Duration, bool) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	queue := m.queues[ticket.rules]
+
+	index := slices.Index(queue, ticket)
+	if index == -1 {
+		return 0, 0, false
+	}
+
+	maxPlayers := rules.Get(ticket.rules).MaxSessionUsers
+
+	group := queue[index-index%maxPlayers:]
+
+	if len(group) >= min(config.GetOperationMatchmakingMinPlayers(), maxPlayers) {
+		return index + 1, 0, true
+	}
+
+	return index + 1, max(config.GetOperationMatchmakingTimeout()-time.Since(group[0].joinedAt), 0), true
+}
+
+// take removes all the groups of queued users, which are ready to be matched, from the queues.
+// Group is ready, when it reaches min amount of players or the longest queued user has waited
+// for the matchmaking timeout.
+func (m *Matchmaker) take() map[string][][]*Ticket {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	result := make(map[string][][]*Ticket)
+
+	for preset, queue := range m.queues {
+		maxPlayers := rules.Get(preset).MaxSessionUsers
+
+		for len(queue) != 0 {
+			if len(queue) < min(config.GetOperationMatchmakingMinPlayers(), maxPlayers) &&
+				(len(queue) < minTimeoutPlayers ||
+					time.Since(queue[0].joinedAt) < config.GetOperationMatchmakingTimeout()) {
+				break
+			}
+
+			amount := min(len(queue), maxPlayers)
+
+			result[preset] = append(result[preset], queue[:amount:amount])
+
+			queue = queue[amount:]
+		}
+
+		m.queues[preset] = queue
+	}
+
+	return result
+}
+
+// requeue returns the given group of users to the front of the queue, when its match failed,
+// skipping users, who have left the queue meanwhile.
+func (m *Matchmaker) requeue(preset string, tickets []*Ticket) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	var result []*Ticket
+
+	for _, ticket := range tickets {
+		if ticket.cancelled {
+			continue
+		}
+
+		ticket.matched = false
+
+		result = append(result, ticket)
+	}
+
+	m.queues[preset] = append(result, m.queues[preset]...)
+}
+
+// confirm marks the given group of users as matched, so that they can no longer leave the queue.
+// Group is not confirmed, if any of the users has left the queue meanwhile.
+func (m *Matchmaker) confirm(tickets []*Ticket) bool {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if slices.ContainsFunc(tickets, func(value *Ticket) bool {
+		return value.cancelled
+	}) {
+		return false
+	}
+
+	for _, ticket := range tickets {
+		ticket.matched = true
+	}
+
+	return true
+}
+
+// deliver delivers the given matches to their users, removing them from the queue.
+func (m *Matchmaker) deliver(matches map[*Ticket]dto.MatchmakingMatch) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	for ticket, value := range matches {
+		delete(m.tickets, ticket.issuer)
+
+		ticket.match <- value
+	}
+}
+
+// newMatchmaker initializes Matchmaker.
+func newMatchmaker() *Matchmaker {
+	return &Matchmaker{
+		queues:  make(map[string][]*Ticket),
+		tickets: make(map[string]*Ticket),
+	}
+}
+
+// Run starts the matchmaking worker under supervision.
+func Run() {
+	supervisor.GetInstance().Run(workerName, matchmakingTickerDuration, process)
+}
+
+// process performs a single run of the worker, which groups queued users into new sessions.
+func process(ctx context.Context) error {
+	for preset, groups := range GetInstance().take() {
+		for i, group := range groups {
+			err := match(ctx, preset, group)
+			if err != nil {
+				if errors.Is(err, ErrTicketCancelled) {
+					GetInstance().requeue(preset, group)
+
+					continue
+				}
+
+				for _, remaining := range slices.Backward(groups[i:]) {
+					GetInstance().requeue(preset, remaining)
+				}
+
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// match creates new session of the given rules preset together with lobbies of all the given users
+// within a single transaction, delivering match to each of them. The longest queued user becomes the
+// host, who starts the session once all the users are ready or session start countdown expires.
+func match(ctx context.Context, preset string, tickets []*Ticket) error {
+	name, err := generateSessionName(ctx)
+	if err != nil {
+		return err
+	}
+
+	cacheTransaction := cache.
+		GetInstance().
+		BeginTransaction(ctx, cache.SESSIONS_REGION, cache.LOBBY_SETS_REGION, cache.METADATA_REGION)
+	defer cacheTransaction.Commit()
+
+	ruleset := rules.Get(preset)
+
+	skins := rand.Perm(config.MAX_SESSION_USERS)
+
+	matches := make(map[*Ticket]dto.MatchmakingMatch)
+
+	var session *entity.SessionEntity
+
+	err = db.BeginTransaction(ctx, func(tx *gorm.DB) error {
+		err := repository.
+			GetSessionsRepository().
+			InsertOrUpdateWithTransaction(tx, dto.SessionsRepositoryInsertOrUpdateRequest{
+				Name:   name,
+				Seed:   rand.Int63n(maxSessionSeed),
+				Issuer: tickets[0].userID,
+				Rules:  preset,
+			})
+		if err != nil {
+			return err
+		}
+
+		var exists bool
+
+		session, exists, err = repository.
+			GetSessionsRepository().
+			GetByNameWithTransaction(tx, name)
+		if err != nil {
+			return err
+		}
+
+		if !exists {
+			return ErrSessionDoesNotExist
+		}
+
+		for i, ticket := range tickets {
+			err = repository.
+				GetLobbiesRepository().
+				InsertOrUpdateWithTransaction(
+					tx,
+					dto.LobbiesRepositoryInsertOrUpdateRequest{
+						UserID:    ticket.userID,
+						SessionID: session.ID,
+						Host:      i == 0,
+						Skin:      uint64(skins[i]),
+						Health:    ruleset.MaxHealth,
+					})
+			if err != nil {
+				return err
+			}
+		}
+
+		lobbies, _, err := repository.
+			GetLobbiesRepository().
+			GetBySessionIDWithTransaction(tx, session.ID)
+		if err != nil {
+			return err
+		}
+
+		for i, ticket := range tickets {
+			index := slices.IndexFunc(lobbies, func(value *entity.LobbyEntity) bool {
+				return value.UserID == ticket.userID
+			})
+			if index == -1 {
+				return ErrLobbyDoesNotExist
+			}
+
+			matches[ticket] = dto.MatchmakingMatch{
+				SessionID: session.ID,
+				Name:      session.Name,
+				Seed:      session.Seed,
+				LobbyID:   lobbies[index].ID,
+				Skin:      uint64(lobbies[index].Skin),
+				Host:      i == 0,
+			}
+		}
+
+		// Users are confirmed last, so that none of them can leave the queue, once session is committed.
+		if !GetInstance().confirm(tickets) {
+			return ErrTicketCancelled
+		}
+
+		return nil
+	})
+	if err != nil {
+		// Session id of the rolled back match may be reused, so its countdown must not outlive it.
+		if session != nil {
+			countdown.Stop(session.ID)
+		}
+
+		return err
+	}
+
+	cache.
+		GetInstance().
+		EvictUserSessions(tickets[0].issuer)
+
+	cache.
+		GetInstance().
+		EvictLobbySet(session.ID)
+
+	for _, ticket := range tickets {
+		cache.
+			GetInstance().
+			EvictMetadata(ticket.issuer)
+	}
+
+	services.IncAvailableSession()
+
+	for range tickets {
+		services.IncAvailableLobby()
+	}
+
+	countdown.Start(session.ID, matchCountdown)
+
+	audit.GetInstance().Record(audit.Entry{
+		Action:    audit.ACTION_SESSION_MATCH,
+		Issuer:    tickets[0].issuer,
+		SessionID: session.ID,
+		Details:   strconv.Itoa(len(tickets)),
+	})
+
+	GetInstance().deliver(matches)
+
+	return nil
+}
+
+// generateSessionName generates new unique name for the matched session.
+func generateSessionName(ctx context.Context) (string, error) {
+	instance := db.GetInstance().WithContext(ctx)
+
+	for range sessionNameAttempts {
+		result := []byte(sessionNamePrefix)
+
+		for range sessionNameRandomLength {
+			result = append(result, sessionNameAlphabet[rand.Intn(len(sessionNameAlphabet))])
+		}
+
+		exists, err := repository.
+			GetSessionsRepository().
+			ExistsByNameWithTransaction(instance, string(result))
+		if err != nil {
+			return "", err
+		}
+
+		if !exists {
+			return string(result), nil
+		}
+	}
+
+	return "", ErrSessionNameIsNotGenerated
+}
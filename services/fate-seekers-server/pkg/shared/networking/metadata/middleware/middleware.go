@@ -2,13 +2,20 @@ package middleware
 
 import (
 	"context"
+	"net"
+	"time"
 
 	"buf.build/go/protovalidate"
 	"github.com/YarikRevich/fate-seekers/services/fate-seekers-server/pkg/shared/config"
+	"github.com/YarikRevich/fate-seekers/services/fate-seekers-server/pkg/shared/entity"
+	"github.com/YarikRevich/fate-seekers/services/fate-seekers-server/pkg/shared/monitoring/services"
+	"github.com/YarikRevich/fate-seekers/services/fate-seekers-server/pkg/shared/networking/moderation"
 	"github.com/pkg/errors"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
 	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/proto"
 )
@@ -18,12 +25,49 @@ const (
 	AuthenticationHeader = "authentication"
 )
 
+// Represents all the error details used for ban description.
+const (
+	BanErrorReason          = "ISSUER_BANNED"
+	BanReasonMetadataKey    = "reason"
+	BanExpiresAtMetadataKey = "expires_at"
+)
+
 var (
 	ErrMissingMetadata            = errors.New("err happened missing metadata")
 	ErrAuthorizationHeaderInvalid = errors.New("err happened during authorization header validation")
 	ErrMessageValidationFailed    = errors.New("err happened message validation failed")
+	ErrIssuerBanned               = errors.New("err happened issuer is banned")
 )
 
+// MetricsMiddleware performs unary RPC duration observation.
+func MetricsMiddleware(
+	ctx context.Context,
+	req interface{},
+	info *grpc.UnaryServerInfo,
+	handler grpc.UnaryHandler,
+) (interface{}, error) {
+	start := time.Now()
+
+	response, err := handler(ctx, req)
+
+	services.ObserveRPCDuration(info.FullMethod, status.Code(err).String(), time.Since(start))
+
+	return response, err
+}
+
+// MetricsStreamMiddleware performs active RPC streams tracking.
+func MetricsStreamMiddleware(
+	srv interface{},
+	stream grpc.ServerStream,
+	info *grpc.StreamServerInfo,
+	handler grpc.StreamHandler,
+) error {
+	services.IncActiveStream(info.FullMethod)
+	defer services.DecActiveStream(info.FullMethod)
+
+	return handler(srv, stream)
+}
+
 // CheckValidationMiddleware represents protobuf API validation middleware.
 func CheckValidationMiddleware(
 	ctx context.Context,
@@ -68,6 +112,97 @@ func CheckAuthenticationMiddleware(
 	return handler(ctx, req)
 }
 
+// CheckModerationMiddleware performs moderation middleware validation, rejecting banned issuers and addresses.
+func CheckModerationMiddleware(
+	ctx context.Context,
+	req interface{},
+	info *grpc.UnaryServerInfo,
+	handler grpc.UnaryHandler,
+) (interface{}, error) {
+	if err := checkModeration(ctx, req); err != nil {
+		return nil, err
+	}
+
+	return handler(ctx, req)
+}
+
+// CheckModerationStreamMiddleware performs moderation stream middleware validation, rejecting banned issuers
+// and addresses. Stream request is validated, when it's received by the stream handler.
+func CheckModerationStreamMiddleware(
+	srv interface{},
+	stream grpc.ServerStream,
+	info *grpc.StreamServerInfo,
+	handler grpc.StreamHandler,
+) error {
+	return handler(srv, &moderatedServerStream{ServerStream: stream})
+}
+
+// moderatedServerStream represents server stream, which performs moderation validation of the received requests.
+type moderatedServerStream struct {
+	grpc.ServerStream
+}
+
+// RecvMsg receives request message, rejecting it if its issuer or address are banned.
+func (mss *moderatedServerStream) RecvMsg(m interface{}) error {
+	if err := mss.ServerStream.RecvMsg(m); err != nil {
+		return err
+	}
+
+	return checkModeration(mss.Context(), m)
+}
+
+// checkModeration checks if issuer of the given request or remote address of the given context are banned,
+// remembering remote address the issuer is connected from for content requests validation.
+func checkModeration(ctx context.Context, req interface{}) error {
+	var issuer string
+
+	if request, ok := req.(interface{ GetIssuer() string }); ok {
+		issuer = request.GetIssuer()
+
+		if ban, ok := moderation.GetInstance().GetIssuerBan(issuer); ok {
+			return composeBanError(ban)
+		}
+	}
+
+	if remote, ok := peer.FromContext(ctx); ok {
+		address, _, err := net.SplitHostPort(remote.Addr.String())
+		if err == nil {
+			if ban, ok := moderation.GetInstance().GetAddressBan(address); ok {
+				return composeBanError(ban)
+			}
+
+			if issuer != "" {
+				moderation.GetInstance().TrackAddress(issuer, address)
+			}
+		}
+	}
+
+	return nil
+}
+
+// composeBanError composes ban error, which contains ban reason and expiration time in its details.
+func composeBanError(ban *entity.BanEntity) error {
+	var expiresAt string
+
+	if ban.ExpiresAt != nil {
+		expiresAt = ban.ExpiresAt.Format(time.RFC3339)
+	}
+
+	result, err := status.New(codes.PermissionDenied, ErrIssuerBanned.Error()).
+		WithDetails(&errdetails.ErrorInfo{
+			Reason: BanErrorReason,
+			Metadata: map[string]string{
+				BanReasonMetadataKey:    ban.Reason,
+				BanExpiresAtMetadataKey: expiresAt,
+			},
+		})
+	if err != nil {
+		return status.Errorf(codes.PermissionDenied, ErrIssuerBanned.Error())
+	}
+
+	return result.Err()
+}
+
 // TODO: check if user is in the cache map. If no, then block. Check if map size is max during creation. if so, then block creation.
 // when user is inactive, then remove it from the cache map.
 // WHERE TO TRACK USER ACTIVITY?
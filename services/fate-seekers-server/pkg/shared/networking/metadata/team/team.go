@@ -0,0 +1,149 @@
+package team
+
+import (
+	"iter"
+	"maps"
+	"slices"
+	"strconv"
+)
+
+// Describes team mode options.
+const (
+	// Represents zero team value, which means that lobby is not assigned to any team.
+	NO_TEAM = uint64(0)
+
+	// Represents amount of the available teams, teams are identified starting from one.
+	TEAMS_AMOUNT = uint64(2)
+)
+
+// Participant represents session participant used for winners resolution.
+type Participant struct {
+	Issuer     string
+	Team       uint64
+	Eliminated bool
+}
+
+// IsValid checks if the given team can be selected.
+func IsValid(team uint64) bool {
+	return team <= TEAMS_AMOUNT
+}
+
+// IsEnabled checks if team mode is enabled, which happens when at least one of the given teams is assigned.
+func IsEnabled(teams iter.Seq[uint64]) bool {
+	for value := range teams {
+		if value != NO_TEAM {
+			return true
+		}
+	}
+
+	return false
+}
+
+// HasOpponents checks if at least two different teams are assigned among the given ones, so that
+// team mode session can be finished by one of them surviving.
+func HasOpponents(teams iter.Seq[uint64]) bool {
+	selected := NO_TEAM
+
+	for value := range teams {
+		if value == NO_TEAM {
+			continue
+		}
+
+		if selected != NO_TEAM && selected != value {
+			return true
+		}
+
+		selected = value
+	}
+
+	return false
+}
+
+// IsFriendly checks if the given teams make their members teammates.
+func IsFriendly(first, second uint64) bool {
+	return first != NO_TEAM && first == second
+}
+
+// Balance assigns each of the unassigned lobbies from the given lobby teams to the team with the
+// least amount of members, keeping already assigned ones unchanged. Lobbies are processed by their
+// ids, which makes result stable.
+func Balance(teams map[int64]uint64) map[int64]uint64 {
+	result := make(map[int64]uint64, len(teams))
+
+	members := make([]int, TEAMS_AMOUNT+1)
+
+	for id, team := range teams {
+		if team != NO_TEAM {
+			result[id] = team
+
+			members[team]++
+		}
+	}
+
+	for _, id := range slices.Sorted(maps.Keys(teams)) {
+		if teams[id] != NO_TEAM {
+			continue
+		}
+
+		selected := uint64(1)
+
+		for team := uint64(2); team <= TEAMS_AMOUNT; team++ {
+			if members[team] < members[selected] {
+				selected = team
+			}
+		}
+
+		result[id] = selected
+
+		members[selected]++
+	}
+
+	return result
+}
+
+// GetWinners retrieves issuers of the winners among the given participants. In team mode all the
+// members of the only team with surviving players win, otherwise the only surviving player wins.
+// False is returned, when session can't be finished yet, which also happens, when there are less
+// than two sides to compete.
+func GetWinners(participants []Participant) ([]string, bool) {
+	var teams []uint64
+
+	for _, participant := range participants {
+		teams = append(teams, participant.Team)
+	}
+
+	enabled := IsEnabled(slices.Values(teams))
+
+	getSide := func(participant Participant) string {
+		if enabled {
+			return strconv.FormatUint(participant.Team, 10)
+		}
+
+		return participant.Issuer
+	}
+
+	sides := make(map[string]bool)
+	survivingSides := make(map[string]bool)
+
+	for _, participant := range participants {
+		sides[getSide(participant)] = true
+
+		if !participant.Eliminated {
+			survivingSides[getSide(participant)] = true
+		}
+	}
+
+	if len(sides) < 2 || len(survivingSides) > 1 {
+		return nil, false
+	}
+
+	var result []string
+
+	for _, participant := range participants {
+		if survivingSides[getSide(participant)] {
+			result = append(result, participant.Issuer)
+		}
+	}
+
+	return result, true
+}
@@ -0,0 +1,33 @@
+package team
+
+import (
+	"maps"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+// TestBalanceAllOneTeam tests that lobbies, which all picked the same team, are kept in it by the
+// balance, so that session without opponents is detected and can't be started.
+func TestBalanceAllOneTeam(t *testing.T) {
+	teams := Balance(map[int64]uint64{1: 1, 2: 1, 3: 1})
+
+	require.Equal(t, map[int64]uint64{1: 1, 2: 1, 3: 1}, teams)
+	require.False(t, HasOpponents(maps.Values(teams)))
+
+	_, finished := GetWinners([]Participant{
+		{Issuer: "first", Team: 1},
+		{Issuer: "second", Team: 1},
+		{Issuer: "third", Team: 1, Eliminated: true},
+	})
+	require.False(t, finished)
+}
+
+// TestBalanceUnassigned tests that unassigned lobbies are added to the team with the least amount of
+// members, which gives opponents to the lobbies, which picked the same team.
+func TestBalanceUnassigned(t *testing.T) {
+	teams := Balance(map[int64]uint64{1: 1, 2: 1, 3: NO_TEAM})
+
+	require.Equal(t, map[int64]uint64{1: 1, 2: 1, 3: 2}, teams)
+	require.True(t, HasOpponents(maps.Values(teams)))
+}
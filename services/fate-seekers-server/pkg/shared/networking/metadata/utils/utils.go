@@ -40,8 +40,9 @@ var (
 )
 
 // GenerateChests performs chests generation according to the provided available
-// positions and seed used for deterministic random selection.
-func GenerateChests(positions []*metadatav1.Position, seed int64) []dto.GeneratedChest {
+// positions and seed used for deterministic random selection, limiting amount of
+// items per chest with the given max value.
+func GenerateChests(positions []*metadatav1.Position, seed int64, maxChestItems int) []dto.GeneratedChest {
 	selector := rand.New(rand.NewSource(seed))
 
 	availablePositions := make([]*metadatav1.Position, len(positions))
@@ -59,7 +60,7 @@ func GenerateChests(positions []*metadatav1.Position, seed int64) []dto.Generate
 	result := make([]dto.GeneratedChest, 0, chestsAmount)
 
 	for i := 0; i < chestsAmount; i++ {
-		chestItemsAmount := selector.Intn(config.MAX_CHEST_ITEMS_PER_CHEST)
+		chestItemsAmount := selector.Intn(maxChestItems)
 
 		chestItems := make([]dto.ChestItem, 0, chestItemsAmount)
 		for k := 0; k < chestItemsAmount; k++ {
@@ -0,0 +1,173 @@
+package moderation
+
+import (
+	"sync"
+	"time"
+
+	"github.com/YarikRevich/fate-seekers/services/fate-seekers-server/pkg/shared/dto"
+	"github.com/YarikRevich/fate-seekers/services/fate-seekers-server/pkg/shared/entity"
+	"github.com/YarikRevich/fate-seekers/services/fate-seekers-server/pkg/shared/logging"
+	"github.com/YarikRevich/fate-seekers/services/fate-seekers-server/pkg/shared/repository"
+)
+
+var (
+	// GetInstance retrieves instance of the moderation manager, performing initialization if needed.
+	GetInstance = sync.OnceValue[*ModerationManager](newModerationManager)
+)
+
+// ModerationManager represents moderation manager, which holds snapshot of all the active bans.
+// Snapshot is used to avoid database access for each of the incoming requests.
+type ModerationManager struct {
+	// Represents mutex used for bans access.
+	mu sync.RWMutex
+
+	// Represents if bans snapshot has already been loaded.
+	loaded bool
+
+	// Represents all the active bans.
+	bans []*entity.BanEntity
+
+	// Represents remote addresses issuers have been lately connected from.
+	addresses map[string]string
+}
+
+// Ban persists the given ban, refreshing bans snapshot.
+func (mm *ModerationManager) Ban(request dto.BansRepositoryInsertRequest) error {
+	err := repository.
+		GetBansRepository().
+		Insert(request)
+	if err != nil {
+		return err
+	}
+
+	return mm.Reload()
+}
+
+// Lift removes ban with the given id, refreshing bans snapshot.
+func (mm *ModerationManager) Lift(id int64) error {
+	err := repository.
+		GetBansRepository().
+		DeleteByID(id)
+	if err != nil {
+		return err
+	}
+
+	return mm.Reload()
+}
+
+// Reload retrieves all the active bans from the storage.
+func (mm *ModerationManager) Reload() error {
+	bans, err := repository.
+		GetBansRepository().
+		GetActive()
+	if err != nil {
+		return err
+	}
+
+	mm.mu.Lock()
+
+	mm.bans = bans
+	mm.loaded = true
+
+	mm.mu.Unlock()
+
+	return nil
+}
+
+// GetActive retrieves all the active bans.
+func (mm *ModerationManager) GetActive() []*entity.BanEntity {
+	mm.load()
+
+	mm.mu.RLock()
+
+	var result []*entity.BanEntity
+
+	now := time.Now()
+
+	for _, ban := range mm.bans {
+		if ban.Active(now) {
+			result = append(result, ban)
+		}
+	}
+
+	mm.mu.RUnlock()
+
+	return result
+}
+
+// GetIssuerBan retrieves active ban applied to the given issuer.
+func (mm *ModerationManager) GetIssuerBan(issuer string) (*entity.BanEntity, bool) {
+	return mm.find(func(ban *entity.BanEntity) bool {
+		return ban.Issuer != "" && ban.Issuer == issuer
+	})
+}
+
+// GetAddressBan retrieves active ban applied to the given address.
+func (mm *ModerationManager) GetAddressBan(address string) (*entity.BanEntity, bool) {
+	return mm.find(func(ban *entity.BanEntity) bool {
+		return ban.Address != "" && ban.Address == address
+	})
+}
+
+// TrackAddress remembers remote address the given issuer has been lately connected from.
+func (mm *ModerationManager) TrackAddress(issuer, address string) {
+	mm.mu.Lock()
+
+	mm.addresses[issuer] = address
+
+	mm.mu.Unlock()
+}
+
+// GetAddress retrieves remote address the given issuer has been lately connected from.
+func (mm *ModerationManager) GetAddress(issuer string) (string, bool) {
+	mm.mu.RLock()
+
+	address, ok := mm.addresses[issuer]
+
+	mm.mu.RUnlock()
+
+	return address, ok
+}
+
+// find retrieves first active ban, which satisfies the given condition.
+func (mm *ModerationManager) find(condition func(ban *entity.BanEntity) bool) (*entity.BanEntity, bool) {
+	mm.load()
+
+	mm.mu.RLock()
+
+	now := time.Now()
+
+	for _, ban := range mm.bans {
+		if ban.Active(now) && condition(ban) {
+			mm.mu.RUnlock()
+
+			return ban, true
+		}
+	}
+
+	mm.mu.RUnlock()
+
+	return nil, false
+}
+
+// load performs bans snapshot retrieval, if it has not been loaded yet.
+func (mm *ModerationManager) load() {
+	mm.mu.RLock()
+
+	loaded := mm.loaded
+
+	mm.mu.RUnlock()
+
+	if !loaded {
+		if err := mm.Reload(); err != nil {
+			logging.GetInstance().Error(err.Error())
+		}
+	}
+}
+
+// newModerationManager initializes ModerationManager.
+func newModerationManager() *ModerationManager {
+	return &ModerationManager{
+		addresses: make(map[string]string),
+	}
+}
@@ -0,0 +1,504 @@
+package replay
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/YarikRevich/fate-seekers/services/fate-seekers-server/pkg/shared/config"
+	"github.com/YarikRevich/fate-seekers/services/fate-seekers-server/pkg/shared/logging"
+	metadatav1 "github.com/YarikRevich/fate-seekers/services/fate-seekers-server/pkg/shared/networking/metadata/api"
+	"github.com/YarikRevich/fate-seekers/services/fate-seekers-server/pkg/shared/supervisor"
+	"github.com/pkg/errors"
+	"google.golang.org/protobuf/encoding/protodelim"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+var (
+	ErrReplayDoesNotExist     = errors.New("err happened replay does not exist")
+	ErrReplayStartIsMissing   = errors.New("err happened replay start frame is missing")
+	ErrReplayRecordFailed     = errors.New("err happened during replay frame record")
+	ErrReplayAccessNotAllowed = errors.New("err happened replay access is not allowed")
+)
+
+const (
+	// Represents name of the worker used for supervision.
+	workerName = "replay"
+
+	// Represents interval between recordings flushes.
+	flushInterval = time.Second
+
+	// Represents duration of recording inactivity, after which its file is closed. Recording
+	// file is reopened with the next frame, if session is still in progress.
+	idleTimeout = time.Minute * 5
+
+	// Represents extension of the replay files.
+	fileExtension = ".replay"
+)
+
+var (
+	// GetInstance retrieves instance of the replay recorder, performing initial creation if needed.
+	GetInstance = sync.OnceValue[*Recorder](newRecorder)
+)
+
+// recording represents replay recording of a single session.
+type recording struct {
+	// Represents recording file.
+	file *os.File
+
+	// Represents buffered writer of the recording file.
+	writer *bufio.Writer
+
+	// Represents time the recording was started at, which frames offsets are relative to.
+	startedAt time.Time
+
+	// Represents time of the latest recorded frame.
+	updatedAt time.Time
+
+	// Represents the latest recorded positions, which are used to skip unchanged ones.
+	positions map[string]*metadatav1.Position
+
+	// Represents the latest recorded health values, which are used to skip unchanged ones.
+	health map[string]uint64
+}
+
+// close flushes and closes recording file.
+func (r *recording) close() error {
+	if err := r.writer.Flush(); err != nil {
+		r.file.Close()
+
+		return err
+	}
+
+	return r.file.Close()
+}
+
+// Recorder represents replay recorder, which writes time-ordered frames of the
+// started sessions to the per session files in the replay directory.
+type Recorder struct {
+	// Represents mutex used for recordings access.
+	mu sync.Mutex
+
+	// Represents opened recordings indexed by session id.
+	recordings map[int64]*recording
+
+	// Represents sessions, which recordings have been stopped and shouldn't be reopened.
+	stopped map[int64]struct{}
+
+	// Represents if recordings flushing has already been started.
+	once sync.Once
+}
+
+// Run starts periodic recordings flushing, which is performed only once.
+func (r *Recorder) Run() {
+	if !config.GetSettingsReplayEnabled() {
+		return
+	}
+
+	r.once.Do(func() {
+		supervisor.GetInstance().Run(workerName, flushInterval, r.process)
+	})
+}
+
+// Start starts recording of the session described by the given start frame, overriding previous
+// recording if it exists.
+func (r *Recorder) Start(start *metadatav1.ReplayStart) {
+	if !config.GetSettingsReplayEnabled() {
+		return
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	sessionID := start.GetSessionId()
+
+	delete(r.stopped, sessionID)
+
+	if previous, ok := r.recordings[sessionID]; ok {
+		previous.close()
+
+		delete(r.recordings, sessionID)
+	}
+
+	err := os.MkdirAll(config.GetReplayDirectory(), 0755)
+	if err != nil {
+		logging.GetInstance().Error(errors.Wrap(err, ErrReplayRecordFailed.Error()).Error())
+
+		return
+	}
+
+	file, err := os.Create(getFileName(sessionID))
+	if err != nil {
+		logging.GetInstance().Error(errors.Wrap(err, ErrReplayRecordFailed.Error()).Error())
+
+		return
+	}
+
+	now := time.Now()
+
+	result := &recording{
+		file:      file,
+		writer:    bufio.NewWriter(file),
+		startedAt: now,
+		positions: make(map[string]*metadatav1.Position),
+		health:    make(map[string]uint64),
+	}
+
+	r.recordings[sessionID] = result
+
+	start.StartedAt = timestamppb.New(now)
+
+	r.write(result, &metadatav1.ReplayFrame{
+		Value: &metadatav1.ReplayFrame_Start{
+			Start: start,
+		},
+	})
+}
+
+// Stop flushes and closes recording of the given session, skipping all the following frames
+// until the recording is started again.
+func (r *Recorder) Stop(sessionID int64) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.stopped[sessionID] = struct{}{}
+
+	if value, ok := r.recordings[sessionID]; ok {
+		if err := value.close(); err != nil {
+			logging.GetInstance().Error(errors.Wrap(err, ErrReplayRecordFailed.Error()).Error())
+		}
+
+		delete(r.recordings, sessionID)
+	}
+}
+
+// RecordPosition records position update of the given issuer, skipping unchanged positions.
+func (r *Recorder) RecordPosition(sessionID int64, issuer string, x, y float64) {
+	r.record(sessionID, func(value *recording) *metadatav1.ReplayFrame {
+		previous, ok := value.positions[issuer]
+		if ok && previous.GetX() == x && previous.GetY() == y {
+			return nil
+		}
+
+		position := &metadatav1.Position{X: x, Y: y}
+
+		value.positions[issuer] = position
+
+		return &metadatav1.ReplayFrame{
+			Value: &metadatav1.ReplayFrame_Position{
+				Position: &metadatav1.ReplayPosition{
+					Issuer:   issuer,
+					Position: position,
+				},
+			},
+		}
+	})
+}
+
+// RecordStatic records static flag update of the given issuer.
+func (r *Recorder) RecordStatic(sessionID int64, issuer string, static bool) {
+	r.record(sessionID, func(_ *recording) *metadatav1.ReplayFrame {
+		return &metadatav1.ReplayFrame{
+			Value: &metadatav1.ReplayFrame_Static{
+				Static: &metadatav1.ReplayStatic{
+					Issuer: issuer,
+					Static: static,
+				},
+			},
+		}
+	})
+}
+
+// RecordHealth records health of the given issuer, skipping unchanged health values.
+func (r *Recorder) RecordHealth(sessionID int64, issuer string, health uint64) {
+	r.record(sessionID, func(value *recording) *metadatav1.ReplayFrame {
+		if previous, ok := value.health[issuer]; ok && previous == health {
+			return nil
+		}
+
+		value.health[issuer] = health
+
+		return &metadatav1.ReplayFrame{
+			Value: &metadatav1.ReplayFrame_Health{
+				Health: &metadatav1.ReplayHealth{
+					Issuer: issuer,
+					Health: health,
+				},
+			},
+		}
+	})
+}
+
+// RecordEvent records start of the given session event.
+func (r *Recorder) RecordEvent(sessionID int64, name string) {
+	r.record(sessionID, func(_ *recording) *metadatav1.ReplayFrame {
+		return &metadatav1.ReplayFrame{
+			Value: &metadatav1.ReplayFrame_Event{
+				Event: &metadatav1.ReplayEvent{
+					Name: name,
+				},
+			},
+		}
+	})
+}
+
+// RecordChest records state change of the given chest, where zero item id means chest opening.
+func (r *Recorder) RecordChest(sessionID int64, issuer string, chestID, itemID int64) {
+	r.record(sessionID, func(_ *recording) *metadatav1.ReplayFrame {
+		return &metadatav1.ReplayFrame{
+			Value: &metadatav1.ReplayFrame_Chest{
+				Chest: &metadatav1.ReplayChest{
+					Issuer:  issuer,
+					ChestId: chestID,
+					ItemId:  itemID,
+				},
+			},
+		}
+	})
+}
+
+// RecordHealthPack records take of the given health pack.
+func (r *Recorder) RecordHealthPack(sessionID int64, issuer string, healthPackID int64) {
+	r.record(sessionID, func(_ *recording) *metadatav1.ReplayFrame {
+		return &metadatav1.ReplayFrame{
+			Value: &metadatav1.ReplayFrame_HealthPack{
+				HealthPack: &metadatav1.ReplayHealthPack{
+					Issuer:       issuer,
+					HealthPackId: healthPackID,
+				},
+			},
+		}
+	})
+}
+
+// RecordElimination records elimination of the given issuer, where attacker is empty for
+// eliminations caused by events.
+func (r *Recorder) RecordElimination(sessionID int64, issuer, cause, attacker string) {
+	r.record(sessionID, func(_ *recording) *metadatav1.ReplayFrame {
+		return &metadatav1.ReplayFrame{
+			Value: &metadatav1.ReplayFrame_Elimination{
+				Elimination: &metadatav1.ReplayElimination{
+					Issuer:   issuer,
+					Cause:    cause,
+					Attacker: attacker,
+				},
+			},
+		}
+	})
+}
+
+// Read reads recorded replay of the given session, checking that the given issuer took part
+// in it, and passes its frames to the given callback in chunks of the given size.
+func (r *Recorder) Read(
+	sessionID int64, issuer string, size int, callback func(frames []*metadatav1.ReplayFrame) error) error {
+	r.mu.Lock()
+
+	if value, ok := r.recordings[sessionID]; ok {
+		if err := value.writer.Flush(); err != nil {
+			r.mu.Unlock()
+
+			return err
+		}
+	}
+
+	r.mu.Unlock()
+
+	file, err := os.Open(getFileName(sessionID))
+	if err != nil {
+		if os.IsNotExist(err) {
+			return ErrReplayDoesNotExist
+		}
+
+		return err
+	}
+
+	defer file.Close()
+
+	reader := bufio.NewReader(file)
+
+	start := new(metadatav1.ReplayFrame)
+
+	if err := protodelim.UnmarshalFrom(reader, start); err != nil || start.GetStart() == nil {
+		return ErrReplayStartIsMissing
+	}
+
+	if !slices.Contains(start.GetStart().GetIssuers(), issuer) {
+		return ErrReplayAccessNotAllowed
+	}
+
+	frames := []*metadatav1.ReplayFrame{start}
+
+	for {
+		frame := new(metadatav1.ReplayFrame)
+
+		err := protodelim.UnmarshalFrom(reader, frame)
+		if err != nil {
+			// Frame may be cut by the concurrent write, which is treated as the end of the replay.
+			if errors.Is(err, io.EOF) || errors.Is(err, io.ErrUnexpectedEOF) {
+				break
+			}
+
+			return err
+		}
+
+		frames = append(frames, frame)
+
+		if len(frames) == size {
+			if err := callback(frames); err != nil {
+				return err
+			}
+
+			frames = nil
+		}
+	}
+
+	if len(frames) != 0 {
+		return callback(frames)
+	}
+
+	return nil
+}
+
+// Close flushes and closes all the opened recordings.
+func (r *Recorder) Close() error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	var result error
+
+	for sessionID, value := range r.recordings {
+		if err := value.close(); err != nil {
+			result = err
+		}
+
+		delete(r.recordings, sessionID)
+	}
+
+	return result
+}
+
+// record records frame created by the given callback for the given session, opening its
+// recording if needed. Frame is skipped, if callback returns nil.
+func (r *Recorder) record(sessionID int64, callback func(value *recording) *metadatav1.ReplayFrame) {
+	if !config.GetSettingsReplayEnabled() {
+		return
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if _, ok := r.stopped[sessionID]; ok {
+		return
+	}
+
+	value, ok := r.recordings[sessionID]
+	if !ok {
+		var err error
+
+		value, err = reopen(sessionID)
+		if err != nil {
+			if !errors.Is(err, ErrReplayDoesNotExist) {
+				logging.GetInstance().Error(errors.Wrap(err, ErrReplayRecordFailed.Error()).Error())
+			}
+
+			return
+		}
+
+		r.recordings[sessionID] = value
+	}
+
+	frame := callback(value)
+	if frame == nil {
+		return
+	}
+
+	r.write(value, frame)
+}
+
+// write writes the given frame to the given recording, setting frame offset.
+func (r *Recorder) write(value *recording, frame *metadatav1.ReplayFrame) {
+	now := time.Now()
+
+	frame.Offset = now.Sub(value.startedAt).Milliseconds()
+
+	value.updatedAt = now
+
+	if _, err := protodelim.MarshalTo(value.writer, frame); err != nil {
+		logging.GetInstance().Error(errors.Wrap(err, ErrReplayRecordFailed.Error()).Error())
+	}
+}
+
+// process flushes all the opened recordings and closes the inactive ones.
+func (r *Recorder) process(_ context.Context) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	var result error
+
+	for sessionID, value := range r.recordings {
+		if time.Since(value.updatedAt) >= idleTimeout {
+			if err := value.close(); err != nil {
+				result = err
+			}
+
+			delete(r.recordings, sessionID)
+
+			continue
+		}
+
+		if err := value.writer.Flush(); err != nil {
+			result = err
+		}
+	}
+
+	return result
+}
+
+// reopen reopens recording of the given session for append, restoring its start time.
+// Only sessions, which recording was started before, are reopened.
+func reopen(sessionID int64) (*recording, error) {
+	file, err := os.OpenFile(getFileName(sessionID), os.O_RDWR|os.O_APPEND, 0644)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, ErrReplayDoesNotExist
+		}
+
+		return nil, err
+	}
+
+	start := new(metadatav1.ReplayFrame)
+
+	if err := protodelim.UnmarshalFrom(bufio.NewReader(file), start); err != nil || start.GetStart() == nil {
+		file.Close()
+
+		return nil, ErrReplayStartIsMissing
+	}
+
+	return &recording{
+		file:      file,
+		writer:    bufio.NewWriter(file),
+		startedAt: start.GetStart().GetStartedAt().AsTime(),
+		updatedAt: time.Now(),
+		positions: make(map[string]*metadatav1.Position),
+		health:    make(map[string]uint64),
+	}, nil
+}
+
+// getFileName retrieves name of the replay file of the given session.
+func getFileName(sessionID int64) string {
+	return filepath.Join(config.GetReplayDirectory(), fmt.Sprintf("%d%s", sessionID, fileExtension))
+}
+
+// newRecorder initializes Recorder.
+func newRecorder() *Recorder {
+	return &Recorder{
+		recordings: make(map[int64]*recording),
+		stopped:    make(map[int64]struct{}),
+	}
+}
@@ -12,10 +12,13 @@ func ConvertSessionEntityToCacheSessionEntity(
 	input *entity.SessionEntity) dto.CacheSessionEntity {
 
 	return dto.CacheSessionEntity{
-		ID:      input.ID,
-		Seed:    input.Seed,
-		Name:    input.Name,
-		Started: input.Started,
+		ID:       input.ID,
+		Seed:     input.Seed,
+		Name:     input.Name,
+		Issuer:   input.Issuer,
+		Started:  input.Started,
+		Rules:    input.Rules,
+		Password: input.Password,
 	}
 }
 
@@ -47,7 +50,9 @@ func ConvertLobbyEntityToCacheMetadataEntity(
 			Health:         uint64(lobby.Health),
 			Active:         lobby.Active,
 			Eliminated:     lobby.Eliminated,
+			Spectator:      lobby.Spectator,
 			Host:           lobby.Host,
+			Team:           uint64(lobby.Team),
 			Inventory:      inventory,
 		})
 	}
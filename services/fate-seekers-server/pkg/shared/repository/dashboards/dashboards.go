@@ -1,35 +1,64 @@
 package dashboards
 
 import (
-	"github.com/YarikRevich/fate-seekers/services/fate-seekers-server/pkg/shared/logging"
+	"context"
 	"github.com/YarikRevich/fate-seekers/services/fate-seekers-server/pkg/shared/monitoring/services"
 	"github.com/YarikRevich/fate-seekers/services/fate-seekers-server/pkg/shared/networking/cache"
 	"github.com/YarikRevich/fate-seekers/services/fate-seekers-server/pkg/shared/repository"
+	"github.com/YarikRevich/fate-seekers/services/fate-seekers-server/pkg/shared/supervisor"
 )
 
-// Run starts dashboards data synchronization.
+const (
+	// Represents name of the worker used for supervision.
+	workerName = "dashboards"
+)
+
+// Run starts dashboards data synchronization under supervision. Synchronization
+// is performed once, being retried until it succeeds.
 func Run() {
-	go func() {
-		cache.GetInstance().BeginSessionsTransaction()
+	supervisor.GetInstance().Run(workerName, 0, process)
+}
+
+// process performs dashboards data synchronization.
+func process(ctx context.Context) error {
+	err := syncSessions(ctx)
+	if err != nil {
+		return err
+	}
 
-		sessionsCount, err := repository.GetSessionsRepository().Count()
-		if err != nil {
-			logging.GetInstance().Fatal(err.Error())
-		}
+	return syncLobbies(ctx)
+}
+
+// syncSessions performs available sessions synchronization.
+func syncSessions(ctx context.Context) error {
+	cacheTransaction := cache.
+		GetInstance().
+		BeginTransaction(ctx, cache.SESSIONS_REGION)
+	defer cacheTransaction.Commit()
 
-		services.SetAvailableSession(sessionsCount)
+	sessionsCount, err := repository.GetSessionsRepository().Count()
+	if err != nil {
+		return err
+	}
 
-		cache.GetInstance().CommitSessionsTransaction()
+	services.SetAvailableSession(sessionsCount)
+
+	return nil
+}
 
-		cache.GetInstance().BeginLobbySetTransaction()
+// syncLobbies performs available lobbies synchronization.
+func syncLobbies(ctx context.Context) error {
+	cacheTransaction := cache.
+		GetInstance().
+		BeginTransaction(ctx, cache.LOBBY_SETS_REGION)
+	defer cacheTransaction.Commit()
 
-		lobbiesCount, err := repository.GetLobbiesRepository().Count()
-		if err != nil {
-			logging.GetInstance().Fatal(err.Error())
-		}
+	lobbiesCount, err := repository.GetLobbiesRepository().Count()
+	if err != nil {
+		return err
+	}
 
-		services.SetAvailableLobby(lobbiesCount)
+	services.SetAvailableLobby(lobbiesCount)
 
-		cache.GetInstance().CommitLobbySetTransaction()
-	}()
+	return nil
 }
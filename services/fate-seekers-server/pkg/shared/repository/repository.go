@@ -1,7 +1,10 @@
 package repository
 
 import (
+	"slices"
+	"strings"
 	"sync"
+	"time"
 
 	"github.com/YarikRevich/fate-seekers/services/fate-seekers-server/pkg/shared/db"
 	"github.com/YarikRevich/fate-seekers/services/fate-seekers-server/pkg/shared/dto"
@@ -18,6 +21,10 @@ var (
 	ErrPersistingLobbies      = errors.New("err happened during the process of lobby creation response data save.")
 	ErrPersistingInventory    = errors.New("err happened during the process of inventory creation response data save.")
 	ErrPersistingUsers        = errors.New("err happened during the process of user creation response data save.")
+	ErrPersistingBans         = errors.New("err happened during the process of ban creation response data save.")
+	ErrPersistingAudit        = errors.New("err happened during the process of audit records creation response data save.")
+	ErrPersistingInvites      = errors.New("err happened during the process of invite creation response data save.")
+	ErrPersistingFriendships  = errors.New("err happened during the process of friendship creation response data save.")
 )
 
 var (
@@ -38,6 +45,18 @@ var (
 
 	// GetUsersRepository retrieves instance of the users repository, performing initial creation if needed.
 	GetUsersRepository = sync.OnceValue[UsersRepository](createUsersRepository)
+
+	// GetBansRepository retrieves instance of the bans repository, performing initial creation if needed.
+	GetBansRepository = sync.OnceValue[BansRepository](createBansRepository)
+
+	// GetAuditRepository retrieves instance of the audit repository, performing initial creation if needed.
+	GetAuditRepository = sync.OnceValue[AuditRepository](createAuditRepository)
+
+	// GetInvitesRepository retrieves instance of the invites repository, performing initial creation if needed.
+	GetInvitesRepository = sync.OnceValue[InvitesRepository](createInvitesRepository)
+
+	// GetFriendshipsRepository retrieves instance of the friendships repository, performing initial creation if needed.
+	GetFriendshipsRepository = sync.OnceValue[FriendshipsRepository](createFriendshipsRepository)
 )
 
 // SessionsRepository represents sessions entity repository.
@@ -45,11 +64,18 @@ type SessionsRepository interface {
 	InsertOrUpdate(request dto.SessionsRepositoryInsertOrUpdateRequest) error
 	InsertOrUpdateWithTransaction(transaction *gorm.DB, request dto.SessionsRepositoryInsertOrUpdateRequest) error
 	DeleteByID(id int64) error
+	DeleteByIDWithTransaction(transaction *gorm.DB, id int64) error
 	GetByID(id int64) (*entity.SessionEntity, bool, error)
+	GetByIDWithTransaction(transaction *gorm.DB, id int64) (*entity.SessionEntity, bool, error)
 	GetByIssuer(issuer int64) ([]*entity.SessionEntity, error)
 	GetByName(name string) (*entity.SessionEntity, bool, error)
+	GetByNameWithTransaction(transaction *gorm.DB, name string) (*entity.SessionEntity, bool, error)
 	ExistsByName(name string) (bool, error)
+	ExistsByNameWithTransaction(transaction *gorm.DB, name string) (bool, error)
 	Count() (int64, error)
+	GetAll() ([]*entity.SessionEntity, error)
+	GetAllWithTransaction(transaction *gorm.DB) ([]*entity.SessionEntity, error)
+	GetPublic(request dto.SessionsRepositoryGetPublicRequest) ([]dto.SessionsRepositoryPublicSession, int64, error)
 }
 
 // sessionsRepositoryImpl represents implementation of SessionsRepository.
@@ -70,10 +96,13 @@ func (w *sessionsRepositoryImpl) insertOrUpdate(instance *gorm.DB, request dto.S
 			"started",
 		}),
 	}).Create(&entity.SessionEntity{
-		Name:    request.Name,
-		Seed:    request.Seed,
-		Issuer:  request.Issuer,
-		Started: request.Started,
+		Name:      request.Name,
+		Seed:      request.Seed,
+		Issuer:    request.Issuer,
+		Started:   request.Started,
+		Rules:     request.Rules,
+		Password:  request.Password,
+		CreatedAt: request.CreatedAt,
 	}).Error
 
 	if err != nil {
@@ -97,12 +126,10 @@ func (w *sessionsRepositoryImpl) InsertOrUpdateWithTransaction(transaction *gorm
 	return w.insertOrUpdate(transaction, request)
 }
 
-// DeleteByID deletes session by the provided id.
-func (w *sessionsRepositoryImpl) DeleteByID(id int64) error {
+// deleteByID deletes session by the provided id with the provided db instance.
+func (w *sessionsRepositoryImpl) deleteByID(instance *gorm.DB, id int64) error {
 	w.mu.Lock()
 
-	instance := db.GetInstance()
-
 	err := instance.Table((&entity.SessionEntity{}).TableName()).
 		Where("id = ?", id).
 		Delete(&entity.SessionEntity{}).Error
@@ -112,11 +139,19 @@ func (w *sessionsRepositoryImpl) DeleteByID(id int64) error {
 	return err
 }
 
-// GetByID retrieves a session for the provided id.
-func (w *sessionsRepositoryImpl) GetByID(id int64) (*entity.SessionEntity, bool, error) {
-	w.mu.RLock()
+// DeleteByID deletes session by the provided id.
+func (w *sessionsRepositoryImpl) DeleteByID(id int64) error {
+	return w.deleteByID(db.GetInstance(), id)
+}
 
-	instance := db.GetInstance()
+// DeleteByIDWithTransaction deletes session by the provided id with provided transaction.
+func (w *sessionsRepositoryImpl) DeleteByIDWithTransaction(transaction *gorm.DB, id int64) error {
+	return w.deleteByID(transaction, id)
+}
+
+// getByID retrieves a session for the provided id with the provided db instance.
+func (w *sessionsRepositoryImpl) getByID(instance *gorm.DB, id int64) (*entity.SessionEntity, bool, error) {
+	w.mu.RLock()
 
 	var result *entity.SessionEntity
 
@@ -142,6 +177,17 @@ func (w *sessionsRepositoryImpl) GetByID(id int64) (*entity.SessionEntity, bool,
 	return result, true, nil
 }
 
+// GetByID retrieves a session for the provided id.
+func (w *sessionsRepositoryImpl) GetByID(id int64) (*entity.SessionEntity, bool, error) {
+	return w.getByID(db.GetInstance(), id)
+}
+
+// GetByIDWithTransaction retrieves a session for the provided id with the provided transaction.
+func (w *sessionsRepositoryImpl) GetByIDWithTransaction(
+	transaction *gorm.DB, id int64) (*entity.SessionEntity, bool, error) {
+	return w.getByID(transaction, id)
+}
+
 // GetByIssuer retrieves all available sessions for the provided issuer.
 func (w *sessionsRepositoryImpl) GetByIssuer(issuer int64) ([]*entity.SessionEntity, error) {
 	w.mu.RLock()
@@ -160,12 +206,10 @@ func (w *sessionsRepositoryImpl) GetByIssuer(issuer int64) ([]*entity.SessionEnt
 	return result, err
 }
 
-// GetByName retrieves available session for the provided name.
-func (w *sessionsRepositoryImpl) GetByName(name string) (*entity.SessionEntity, bool, error) {
+// getByName retrieves available session for the provided name with the provided db instance.
+func (w *sessionsRepositoryImpl) getByName(instance *gorm.DB, name string) (*entity.SessionEntity, bool, error) {
 	w.mu.RLock()
 
-	instance := db.GetInstance()
-
 	var result *entity.SessionEntity
 
 	err := instance.Table((&entity.SessionEntity{}).TableName()).
@@ -190,11 +234,19 @@ func (w *sessionsRepositoryImpl) GetByName(name string) (*entity.SessionEntity,
 	return result, true, nil
 }
 
-// ExistsByName checks if session exists for the provided name.
-func (w *sessionsRepositoryImpl) ExistsByName(name string) (bool, error) {
-	w.mu.RLock()
+// GetByName retrieves available session for the provided name.
+func (w *sessionsRepositoryImpl) GetByName(name string) (*entity.SessionEntity, bool, error) {
+	return w.getByName(db.GetInstance(), name)
+}
 
-	instance := db.GetInstance()
+// GetByNameWithTransaction retrieves available session for the provided name with the provided transaction.
+func (w *sessionsRepositoryImpl) GetByNameWithTransaction(transaction *gorm.DB, name string) (*entity.SessionEntity, bool, error) {
+	return w.getByName(transaction, name)
+}
+
+// existsByName checks if session exists for the provided name with the provided db instance.
+func (w *sessionsRepositoryImpl) existsByName(instance *gorm.DB, name string) (bool, error) {
+	w.mu.RLock()
 
 	var result *entity.SessionEntity
 
@@ -220,6 +272,16 @@ func (w *sessionsRepositoryImpl) ExistsByName(name string) (bool, error) {
 	return true, nil
 }
 
+// ExistsByName checks if session exists for the provided name.
+func (w *sessionsRepositoryImpl) ExistsByName(name string) (bool, error) {
+	return w.existsByName(db.GetInstance(), name)
+}
+
+// ExistsByNameWithTransaction checks if session exists for the provided name with provided transaction.
+func (w *sessionsRepositoryImpl) ExistsByNameWithTransaction(transaction *gorm.DB, name string) (bool, error) {
+	return w.existsByName(transaction, name)
+}
+
 // Count retrieves general sessions count.
 func (w *sessionsRepositoryImpl) Count() (int64, error) {
 	w.mu.RLock()
@@ -241,6 +303,123 @@ func (w *sessionsRepositoryImpl) Count() (int64, error) {
 	return count, nil
 }
 
+// getAll retrieves all available sessions with the provided db instance.
+func (w *sessionsRepositoryImpl) getAll(instance *gorm.DB) ([]*entity.SessionEntity, error) {
+	w.mu.RLock()
+
+	var result []*entity.SessionEntity
+
+	err := instance.Table((&entity.SessionEntity{}).TableName()).
+		Preload((&entity.UserEntity{}).TableView()).
+		Order("id").
+		Find(&result).Error
+
+	w.mu.RUnlock()
+
+	return result, err
+}
+
+// GetAll retrieves all available sessions.
+func (w *sessionsRepositoryImpl) GetAll() ([]*entity.SessionEntity, error) {
+	return w.getAll(db.GetInstance())
+}
+
+// GetAllWithTransaction retrieves all available sessions with the provided transaction.
+func (w *sessionsRepositoryImpl) GetAllWithTransaction(transaction *gorm.DB) ([]*entity.SessionEntity, error) {
+	return w.getAll(transaction)
+}
+
+// GetPublic retrieves a page of sessions, which are not protected with password, together with
+// their players amount and total amount of sessions matching the provided filters.
+func (w *sessionsRepositoryImpl) GetPublic(
+	request dto.SessionsRepositoryGetPublicRequest) ([]dto.SessionsRepositoryPublicSession, int64, error) {
+	w.mu.RLock()
+
+	instance := db.GetInstance()
+
+	query := instance.Table((&entity.SessionEntity{}).TableName()).
+		Select("sessions.id, sessions.name, users.name AS issuer, sessions.started, sessions.rules, "+
+			"sessions.created_at, COUNT(lobbies.id) AS players").
+		Joins("JOIN users ON users.id = sessions.issuer").
+		Joins("LEFT JOIN lobbies ON lobbies.session_id = sessions.id AND lobbies.spectator = ?", false).
+		Where("sessions.password = ?", "").
+		Group("sessions.id")
+
+	if request.Rules != nil {
+		query = query.Where("sessions.rules = ?", *request.Rules)
+	}
+
+	if request.Started != nil {
+		query = query.Where("sessions.started = ?", *request.Started)
+	}
+
+	if request.NamePrefix != "" {
+		query = query.Where("sessions.name LIKE ?", request.NamePrefix+"%")
+	}
+
+	if request.FreeSlots {
+		presets := make([]string, 0, len(request.MaxPlayers))
+
+		for preset := range request.MaxPlayers {
+			presets = append(presets, preset)
+		}
+
+		slices.Sort(presets)
+
+		var (
+			conditions strings.Builder
+			args       []any
+		)
+
+		for _, preset := range presets {
+			conditions.WriteString(" WHEN ? THEN ?")
+
+			args = append(args, preset, request.MaxPlayers[preset])
+		}
+
+		args = append(args, request.DefaultMaxPlayers)
+
+		query = query.Having(
+			"COUNT(lobbies.id) < CASE sessions.rules"+conditions.String()+" ELSE ? END", args...)
+	}
+
+	var total int64
+
+	err := instance.Table("(?) AS public_sessions", query).Count(&total).Error
+	if err != nil {
+		w.mu.RUnlock()
+
+		return nil, 0, err
+	}
+
+	switch request.Sort {
+	case dto.PUBLIC_SESSIONS_SORT_OLDEST:
+		query = query.Order("sessions.created_at ASC")
+	case dto.PUBLIC_SESSIONS_SORT_OCCUPANCY:
+		query = query.Order("players DESC").Order("sessions.created_at DESC")
+	case dto.PUBLIC_SESSIONS_SORT_NAME:
+		query = query.Order("sessions.name ASC")
+	default:
+		query = query.Order("sessions.created_at DESC")
+	}
+
+	var result []dto.SessionsRepositoryPublicSession
+
+	err = query.
+		Order("sessions.id").
+		Offset(request.Offset).
+		Limit(request.Limit).
+		Scan(&result).Error
+
+	w.mu.RUnlock()
+
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return result, total, nil
+}
+
 // createSessionsRepository initializes sessionsRepositoryImpl.
 func createSessionsRepository() SessionsRepository {
 	return new(sessionsRepositoryImpl)
@@ -255,6 +434,7 @@ type GenerationsRepository interface {
 		transaction *gorm.DB, instance string, sessionID int64) (*entity.GenerationsEntity, bool, error)
 	GetHealthPackTypeBySessionID(sessionID int64) ([]*entity.GenerationsEntity, error)
 	GetByID(generationID int64) (*entity.GenerationsEntity, bool, error)
+	DeleteBySessionIDWithTransaction(transaction *gorm.DB, sessionID int64) error
 }
 
 // generationsRepositoryImpl represents implementation of GenerationsRepository.
@@ -399,6 +579,19 @@ func (w *generationsRepositoryImpl) GetByID(generationID int64) (*entity.Generat
 	return result, true, nil
 }
 
+// DeleteBySessionIDWithTransaction deletes all generations of the provided session id with provided transaction.
+func (w *generationsRepositoryImpl) DeleteBySessionIDWithTransaction(transaction *gorm.DB, sessionID int64) error {
+	w.mu.Lock()
+
+	err := transaction.Table((&entity.GenerationsEntity{}).TableName()).
+		Where("session_id = ?", sessionID).
+		Delete(&entity.GenerationsEntity{}).Error
+
+	w.mu.Unlock()
+
+	return err
+}
+
 // createGenerationsRepository initializes generationsRepositoryImpl.
 func createGenerationsRepository() GenerationsRepository {
 	return new(generationsRepositoryImpl)
@@ -410,6 +603,7 @@ type AssociationsRepository interface {
 	InsertOrUpdateWithTransaction(transaction *gorm.DB, request dto.AssociationsRepositoryInsertOrUpdateRequest) error
 	GetByID(id int64) (*entity.AssociationsEntity, bool, error)
 	GetByGenerationID(generationID int64) ([]*entity.AssociationsEntity, bool, error)
+	DeleteBySessionIDWithTransaction(transaction *gorm.DB, sessionID int64) error
 }
 
 // associationsRepositoryImpl represents implementation of AssociationsRepository.
@@ -517,6 +711,19 @@ func (w *associationsRepositoryImpl) GetByGenerationID(generationID int64) ([]*e
 	return result, true, nil
 }
 
+// DeleteBySessionIDWithTransaction deletes all associations of the provided session id with provided transaction.
+func (w *associationsRepositoryImpl) DeleteBySessionIDWithTransaction(transaction *gorm.DB, sessionID int64) error {
+	w.mu.Lock()
+
+	err := transaction.Table((&entity.AssociationsEntity{}).TableName()).
+		Where("session_id = ?", sessionID).
+		Delete(&entity.AssociationsEntity{}).Error
+
+	w.mu.Unlock()
+
+	return err
+}
+
 // createAssociations initializes associationsRepositoryImpl.
 func createAssociationsRepository() AssociationsRepository {
 	return new(associationsRepositoryImpl)
@@ -528,8 +735,14 @@ type LobbiesRepository interface {
 	InsertOrUpdateWithTransaction(transaction *gorm.DB, request dto.LobbiesRepositoryInsertOrUpdateRequest) error
 	DeleteByUserIDAndSessionID(userID, sessionID int64) error
 	DeleteByUserIDAndSessionIDWithTransaction(transaction *gorm.DB, userID, sessionID int64) error
+	DeleteBySessionIDWithTransaction(transaction *gorm.DB, sessionID int64) error
 	GetByUserID(userID int64) ([]*entity.LobbyEntity, bool, error)
+	GetByUserIDWithTransaction(transaction *gorm.DB, userID int64) ([]*entity.LobbyEntity, bool, error)
 	GetBySessionID(sessionID int64) ([]*entity.LobbyEntity, bool, error)
+	GetBySessionIDWithTransaction(transaction *gorm.DB, sessionID int64) ([]*entity.LobbyEntity, bool, error)
+	UpdateReadyByID(id int64, ready bool) error
+	UpdateTeamByID(id int64, team uint64) error
+	UpdateTeamByIDWithTransaction(transaction *gorm.DB, id int64, team uint64) error
 	Count() (int64, error)
 	Lock()
 	Unlock()
@@ -570,6 +783,7 @@ func (w *lobbiesRepositoryImpl) insertOrUpdate(instance *gorm.DB, request dto.Lo
 		Active:         request.Active,
 		Host:           request.Host,
 		Eliminated:     request.Eliminated,
+		Spectator:      request.Spectator,
 		PositionX:      request.PositionX,
 		PositionY:      request.PositionY,
 		PositionStatic: request.PositionStatic,
@@ -619,11 +833,22 @@ func (w *lobbiesRepositoryImpl) DeleteByUserIDAndSessionIDWithTransaction(transa
 	return w.deleteByUserIDAndSessionID(transaction, userID, sessionID)
 }
 
-// GetByUserID retrieves lobby by the provided user id.
-func (w *lobbiesRepositoryImpl) GetByUserID(userID int64) ([]*entity.LobbyEntity, bool, error) {
-	w.mu.RLock()
+// DeleteBySessionIDWithTransaction deletes all lobbies of the provided session id with provided transaction.
+func (w *lobbiesRepositoryImpl) DeleteBySessionIDWithTransaction(transaction *gorm.DB, sessionID int64) error {
+	w.mu.Lock()
 
-	instance := db.GetInstance()
+	err := transaction.Table((&entity.LobbyEntity{}).TableName()).
+		Where("session_id = ?", sessionID).
+		Delete(&entity.LobbyEntity{}).Error
+
+	w.mu.Unlock()
+
+	return err
+}
+
+// getByUserID retrieves lobby by the provided user id with the provided db instance.
+func (w *lobbiesRepositoryImpl) getByUserID(instance *gorm.DB, userID int64) ([]*entity.LobbyEntity, bool, error) {
+	w.mu.RLock()
 
 	var result []*entity.LobbyEntity
 
@@ -656,11 +881,20 @@ func (w *lobbiesRepositoryImpl) GetByUserID(userID int64) ([]*entity.LobbyEntity
 	return result, true, nil
 }
 
-// GetBySessionID retrieves lobby by the provided session id.
-func (w *lobbiesRepositoryImpl) GetBySessionID(sessionID int64) ([]*entity.LobbyEntity, bool, error) {
-	w.mu.RLock()
+// GetByUserID retrieves lobby by the provided user id.
+func (w *lobbiesRepositoryImpl) GetByUserID(userID int64) ([]*entity.LobbyEntity, bool, error) {
+	return w.getByUserID(db.GetInstance(), userID)
+}
 
-	instance := db.GetInstance()
+// GetByUserIDWithTransaction retrieves lobby by the provided user id with the provided transaction.
+func (w *lobbiesRepositoryImpl) GetByUserIDWithTransaction(
+	transaction *gorm.DB, userID int64) ([]*entity.LobbyEntity, bool, error) {
+	return w.getByUserID(transaction, userID)
+}
+
+// getBySessionID retrieves lobby by the provided session id with the provided db instance.
+func (w *lobbiesRepositoryImpl) getBySessionID(instance *gorm.DB, sessionID int64) ([]*entity.LobbyEntity, bool, error) {
+	w.mu.RLock()
 
 	var result []*entity.LobbyEntity
 
@@ -693,6 +927,63 @@ func (w *lobbiesRepositoryImpl) GetBySessionID(sessionID int64) ([]*entity.Lobby
 	return result, true, nil
 }
 
+// GetBySessionID retrieves lobby by the provided session id.
+func (w *lobbiesRepositoryImpl) GetBySessionID(sessionID int64) ([]*entity.LobbyEntity, bool, error) {
+	return w.getBySessionID(db.GetInstance(), sessionID)
+}
+
+// GetBySessionIDWithTransaction retrieves lobby by the provided session id with the provided transaction.
+func (w *lobbiesRepositoryImpl) GetBySessionIDWithTransaction(
+	transaction *gorm.DB, sessionID int64) ([]*entity.LobbyEntity, bool, error) {
+	return w.getBySessionID(transaction, sessionID)
+}
+
+// UpdateReadyByID updates ready flag of the lobby with the provided id.
+func (w *lobbiesRepositoryImpl) UpdateReadyByID(id int64, ready bool) error {
+	w.mu.Lock()
+
+	instance := db.GetInstance()
+
+	err := instance.Table((&entity.LobbyEntity{}).TableName()).
+		Where("id = ?", id).
+		Update("ready", ready).Error
+
+	w.mu.Unlock()
+
+	if err != nil {
+		return errors.Wrap(err, ErrPersistingLobbies.Error())
+	}
+
+	return nil
+}
+
+// updateTeamByID updates team of the lobby with the provided id with the provided instance.
+func (w *lobbiesRepositoryImpl) updateTeamByID(instance *gorm.DB, id int64, team uint64) error {
+	w.mu.Lock()
+
+	err := instance.Table((&entity.LobbyEntity{}).TableName()).
+		Where("id = ?", id).
+		Update("team", int64(team)).Error
+
+	w.mu.Unlock()
+
+	if err != nil {
+		return errors.Wrap(err, ErrPersistingLobbies.Error())
+	}
+
+	return nil
+}
+
+// UpdateTeamByID updates team of the lobby with the provided id.
+func (w *lobbiesRepositoryImpl) UpdateTeamByID(id int64, team uint64) error {
+	return w.updateTeamByID(db.GetInstance(), id, team)
+}
+
+// UpdateTeamByIDWithTransaction updates team of the lobby with the provided id with the provided transaction.
+func (w *lobbiesRepositoryImpl) UpdateTeamByIDWithTransaction(transaction *gorm.DB, id int64, team uint64) error {
+	return w.updateTeamByID(transaction, id, team)
+}
+
 // Count retrieves general lobbies count.
 func (w *lobbiesRepositoryImpl) Count() (int64, error) {
 	w.mu.RLock()
@@ -734,7 +1025,9 @@ type InventoryRepository interface {
 	InsertOrUpdate(request dto.InventoryRepositoryInsertOrUpdateRequest) error
 	DeleteByUserIDAndID(inventoryID, userID int64) error
 	GetBySessionIDAndUserID(sessionID, userID int64) ([]*entity.InventoryEntity, bool, error)
+	GetBySessionIDAndUserIDWithTransaction(transaction *gorm.DB, sessionID, userID int64) ([]*entity.InventoryEntity, bool, error)
 	CountByLobbyIDAndUserID(lobbyID, userID int64) (int64, error)
+	DeleteBySessionIDWithTransaction(transaction *gorm.DB, sessionID int64) error
 }
 
 // inventoryRepositoryImpl represents implementation of InventoryRepository.
@@ -790,12 +1083,10 @@ func (w *inventoryRepositoryImpl) DeleteByUserIDAndID(inventoryID, userID int64)
 	return err
 }
 
-// GetBySessionIDAndUserID retrieves inventory by the provided session id and user id.
-func (w *inventoryRepositoryImpl) GetBySessionIDAndUserID(sessionID, userID int64) ([]*entity.InventoryEntity, bool, error) {
+// getBySessionIDAndUserID retrieves inventory by the provided session id and user id with the provided db instance.
+func (w *inventoryRepositoryImpl) getBySessionIDAndUserID(instance *gorm.DB, sessionID, userID int64) ([]*entity.InventoryEntity, bool, error) {
 	w.mu.RLock()
 
-	instance := db.GetInstance()
-
 	var result []*entity.InventoryEntity
 
 	err := instance.Table((&entity.InventoryEntity{}).TableName()).
@@ -827,6 +1118,18 @@ func (w *inventoryRepositoryImpl) GetBySessionIDAndUserID(sessionID, userID int6
 	return result, true, nil
 }
 
+// GetBySessionIDAndUserID retrieves inventory by the provided session id and user id.
+func (w *inventoryRepositoryImpl) GetBySessionIDAndUserID(sessionID, userID int64) ([]*entity.InventoryEntity, bool, error) {
+	return w.getBySessionIDAndUserID(db.GetInstance(), sessionID, userID)
+}
+
+// GetBySessionIDAndUserIDWithTransaction retrieves inventory by the provided session id and user id
+// with the provided transaction.
+func (w *inventoryRepositoryImpl) GetBySessionIDAndUserIDWithTransaction(
+	transaction *gorm.DB, sessionID, userID int64) ([]*entity.InventoryEntity, bool, error) {
+	return w.getBySessionIDAndUserID(transaction, sessionID, userID)
+}
+
 // CountByLobbyIDAndUserID represents inventory count by the provided lobby id and user id.
 func (w *inventoryRepositoryImpl) CountByLobbyIDAndUserID(lobbyID, userID int64) (int64, error) {
 	w.mu.RLock()
@@ -849,6 +1152,19 @@ func (w *inventoryRepositoryImpl) CountByLobbyIDAndUserID(lobbyID, userID int64)
 	return count, nil
 }
 
+// DeleteBySessionIDWithTransaction deletes all inventory items of the provided session id with provided transaction.
+func (w *inventoryRepositoryImpl) DeleteBySessionIDWithTransaction(transaction *gorm.DB, sessionID int64) error {
+	w.mu.Lock()
+
+	err := transaction.Table((&entity.InventoryEntity{}).TableName()).
+		Where("session_id = ?", sessionID).
+		Delete(&entity.InventoryEntity{}).Error
+
+	w.mu.Unlock()
+
+	return err
+}
+
 // createInventoryRepository initializes inventoryRepositoryImpl.
 func createInventoryRepository() InventoryRepository {
 	return new(inventoryRepositoryImpl)
@@ -857,8 +1173,15 @@ func createInventoryRepository() InventoryRepository {
 // UsersRepository represents users entity repository.
 type UsersRepository interface {
 	Insert(name string) error
+	InsertWithTransaction(transaction *gorm.DB, request dto.UsersRepositoryInsertRequest) error
 	ExistsByName(name string) (bool, error)
+	ExistsByNameWithTransaction(transaction *gorm.DB, name string) (bool, error)
 	GetByName(name string) (*entity.UserEntity, bool, error)
+	GetByNameWithTransaction(transaction *gorm.DB, name string) (*entity.UserEntity, bool, error)
+	GetByDisplayName(displayName string) (*entity.UserEntity, bool, error)
+	GetAll() ([]*entity.UserEntity, error)
+	GetAllWithTransaction(transaction *gorm.DB) ([]*entity.UserEntity, error)
+	UpdateProfileByName(name, displayName string, skin, avatar uint64) error
 }
 
 // usersRepositoryImpl represents implementation of UsersRepository.
@@ -867,14 +1190,16 @@ type usersRepositoryImpl struct {
 	mu sync.RWMutex
 }
 
-// Insert inserts users entity to the storage.
-func (w *usersRepositoryImpl) Insert(name string) error {
+// insert inserts users entity to the storage with the provided db instance.
+func (w *usersRepositoryImpl) insert(instance *gorm.DB, request dto.UsersRepositoryInsertRequest) error {
 	w.mu.Lock()
 
-	instance := db.GetInstance()
-
 	err := instance.Create(&entity.UserEntity{
-		Name: name,
+		Name:        request.Name,
+		DisplayName: request.DisplayName,
+		Skin:        int64(request.Skin),
+		Avatar:      int64(request.Avatar),
+		CreatedAt:   request.CreatedAt,
 	}).Error
 
 	if err != nil {
@@ -888,11 +1213,19 @@ func (w *usersRepositoryImpl) Insert(name string) error {
 	return nil
 }
 
-// ExistsByName checks if user with the given name exists.
-func (w *usersRepositoryImpl) ExistsByName(name string) (bool, error) {
-	w.mu.RLock()
+// Insert inserts users entity to the storage.
+func (w *usersRepositoryImpl) Insert(name string) error {
+	return w.insert(db.GetInstance(), dto.UsersRepositoryInsertRequest{Name: name})
+}
 
-	instance := db.GetInstance()
+// InsertWithTransaction inserts users entity to the storage with provided transaction.
+func (w *usersRepositoryImpl) InsertWithTransaction(transaction *gorm.DB, request dto.UsersRepositoryInsertRequest) error {
+	return w.insert(transaction, request)
+}
+
+// existsByName checks if user with the given name exists with the provided db instance.
+func (w *usersRepositoryImpl) existsByName(instance *gorm.DB, name string) (bool, error) {
+	w.mu.RLock()
 
 	err := instance.Table((&entity.UserEntity{}).TableName()).
 		Where("name = ?", name).
@@ -915,8 +1248,55 @@ func (w *usersRepositoryImpl) ExistsByName(name string) (bool, error) {
 	return true, nil
 }
 
+// ExistsByName checks if user with the given name exists.
+func (w *usersRepositoryImpl) ExistsByName(name string) (bool, error) {
+	return w.existsByName(db.GetInstance(), name)
+}
+
+// ExistsByNameWithTransaction checks if user with the given name exists with provided transaction.
+func (w *usersRepositoryImpl) ExistsByNameWithTransaction(transaction *gorm.DB, name string) (bool, error) {
+	return w.existsByName(transaction, name)
+}
+
+// getByName retrieves user with the given name with the provided db instance.
+func (w *usersRepositoryImpl) getByName(instance *gorm.DB, name string) (*entity.UserEntity, bool, error) {
+	w.mu.RLock()
+
+	var result *entity.UserEntity
+
+	err := instance.Model(&entity.UserEntity{}).
+		Where("name = ?", name).
+		First(&result).Error
+
+	if err != nil {
+		if err == gorm.ErrRecordNotFound {
+			w.mu.RUnlock()
+
+			return nil, false, nil
+		}
+
+		w.mu.RUnlock()
+
+		return nil, false, err
+	}
+
+	w.mu.RUnlock()
+
+	return result, true, nil
+}
+
 // GetByName retrieves user with the given name.
 func (w *usersRepositoryImpl) GetByName(name string) (*entity.UserEntity, bool, error) {
+	return w.getByName(db.GetInstance(), name)
+}
+
+// GetByNameWithTransaction retrieves user with the given name with provided transaction.
+func (w *usersRepositoryImpl) GetByNameWithTransaction(transaction *gorm.DB, name string) (*entity.UserEntity, bool, error) {
+	return w.getByName(transaction, name)
+}
+
+// GetByDisplayName retrieves user with the given display name, comparing it case insensitively.
+func (w *usersRepositoryImpl) GetByDisplayName(displayName string) (*entity.UserEntity, bool, error) {
 	w.mu.RLock()
 
 	instance := db.GetInstance()
@@ -924,7 +1304,7 @@ func (w *usersRepositoryImpl) GetByName(name string) (*entity.UserEntity, bool,
 	var result *entity.UserEntity
 
 	err := instance.Model(&entity.UserEntity{}).
-		Where("name = ?", name).
+		Where("display_name = ? COLLATE NOCASE", displayName).
 		First(&result).Error
 
 	if err != nil {
@@ -944,7 +1324,546 @@ func (w *usersRepositoryImpl) GetByName(name string) (*entity.UserEntity, bool,
 	return result, true, nil
 }
 
-// createUsersRepository initializes usersRepositoryImpl.
-func createUsersRepository() UsersRepository {
+// getAll retrieves all available users with the provided db instance.
+func (w *usersRepositoryImpl) getAll(instance *gorm.DB) ([]*entity.UserEntity, error) {
+	w.mu.RLock()
+
+	var result []*entity.UserEntity
+
+	err := instance.Model(&entity.UserEntity{}).
+		Order("id").
+		Find(&result).Error
+
+	w.mu.RUnlock()
+
+	return result, err
+}
+
+// GetAll retrieves all available users.
+func (w *usersRepositoryImpl) GetAll() ([]*entity.UserEntity, error) {
+	return w.getAll(db.GetInstance())
+}
+
+// GetAllWithTransaction retrieves all available users with the provided transaction.
+func (w *usersRepositoryImpl) GetAllWithTransaction(transaction *gorm.DB) ([]*entity.UserEntity, error) {
+	return w.getAll(transaction)
+}
+
+// UpdateProfileByName updates profile of the user with the given name.
+func (w *usersRepositoryImpl) UpdateProfileByName(name, displayName string, skin, avatar uint64) error {
+	w.mu.Lock()
+
+	instance := db.GetInstance()
+
+	err := instance.Table((&entity.UserEntity{}).TableName()).
+		Where("name = ?", name).
+		Updates(map[string]interface{}{
+			"display_name": displayName,
+			"skin":         int64(skin),
+			"avatar":       int64(avatar),
+		}).Error
+
+	w.mu.Unlock()
+
+	if err != nil {
+		return errors.Wrap(err, ErrPersistingUsers.Error())
+	}
+
+	return nil
+}
+
+// createUsersRepository initializes usersRepositoryImpl.
+func createUsersRepository() UsersRepository {
 	return new(usersRepositoryImpl)
 }
+
+// BansRepository represents bans entity repository.
+type BansRepository interface {
+	Insert(request dto.BansRepositoryInsertRequest) error
+	DeleteByID(id int64) error
+	GetActive() ([]*entity.BanEntity, error)
+}
+
+// bansRepositoryImpl represents implementation of BansRepository.
+type bansRepositoryImpl struct {
+	// Represents mutex used for database bans repository related operations.
+	mu sync.RWMutex
+}
+
+// Insert inserts bans entity to the storage.
+func (w *bansRepositoryImpl) Insert(request dto.BansRepositoryInsertRequest) error {
+	w.mu.Lock()
+
+	instance := db.GetInstance()
+
+	err := instance.Create(&entity.BanEntity{
+		Issuer:    request.Issuer,
+		Address:   request.Address,
+		Reason:    request.Reason,
+		Creator:   request.Creator,
+		ExpiresAt: request.ExpiresAt,
+	}).Error
+
+	if err != nil {
+		w.mu.Unlock()
+
+		return errors.Wrap(err, ErrPersistingBans.Error())
+	}
+
+	w.mu.Unlock()
+
+	return nil
+}
+
+// DeleteByID deletes ban by the provided id.
+func (w *bansRepositoryImpl) DeleteByID(id int64) error {
+	w.mu.Lock()
+
+	instance := db.GetInstance()
+
+	err := instance.Table((&entity.BanEntity{}).TableName()).
+		Where("id = ?", id).
+		Delete(&entity.BanEntity{}).Error
+
+	w.mu.Unlock()
+
+	return err
+}
+
+// GetActive retrieves all the bans, which have not expired yet.
+func (w *bansRepositoryImpl) GetActive() ([]*entity.BanEntity, error) {
+	w.mu.RLock()
+
+	instance := db.GetInstance()
+
+	var result []*entity.BanEntity
+
+	err := instance.Table((&entity.BanEntity{}).TableName()).
+		Where("expires_at IS NULL OR expires_at > ?", time.Now()).
+		Order("id").
+		Find(&result).Error
+
+	if err != nil {
+		w.mu.RUnlock()
+
+		return nil, err
+	}
+
+	w.mu.RUnlock()
+
+	return result, nil
+}
+
+// createBansRepository initializes bansRepositoryImpl.
+func createBansRepository() BansRepository {
+	return new(bansRepositoryImpl)
+}
+
+// AuditRepository represents audit entity repository.
+type AuditRepository interface {
+	InsertBatch(requests []dto.AuditRepositoryInsertRequest) error
+	GetFiltered(request dto.AuditRepositoryFilterRequest) ([]*entity.AuditEntity, error)
+}
+
+// auditRepositoryImpl represents implementation of AuditRepository.
+type auditRepositoryImpl struct {
+	// Represents mutex used for database audit repository related operations.
+	mu sync.RWMutex
+}
+
+// InsertBatch inserts all the given audit entities to the storage.
+func (w *auditRepositoryImpl) InsertBatch(requests []dto.AuditRepositoryInsertRequest) error {
+	if len(requests) == 0 {
+		return nil
+	}
+
+	w.mu.Lock()
+
+	instance := db.GetInstance()
+
+	entities := make([]*entity.AuditEntity, len(requests))
+
+	for i, request := range requests {
+		entities[i] = &entity.AuditEntity{
+			Action:    request.Action,
+			Issuer:    request.Issuer,
+			Target:    request.Target,
+			SessionID: request.SessionID,
+			Details:   request.Details,
+			CreatedAt: request.CreatedAt,
+		}
+	}
+
+	err := instance.Create(entities).Error
+
+	if err != nil {
+		w.mu.Unlock()
+
+		return errors.Wrap(err, ErrPersistingAudit.Error())
+	}
+
+	w.mu.Unlock()
+
+	return nil
+}
+
+// GetFiltered retrieves the latest audit entities, which match the given filter, where issuer
+// is matched against both issuer and target of the record.
+func (w *auditRepositoryImpl) GetFiltered(request dto.AuditRepositoryFilterRequest) ([]*entity.AuditEntity, error) {
+	w.mu.RLock()
+
+	instance := db.GetInstance().Table((&entity.AuditEntity{}).TableName())
+
+	if request.Issuer != "" {
+		instance = instance.Where("issuer = ? OR target = ?", request.Issuer, request.Issuer)
+	}
+
+	if request.SessionID != 0 {
+		instance = instance.Where("session_id = ?", request.SessionID)
+	}
+
+	if request.Limit > 0 {
+		instance = instance.Limit(request.Limit)
+	}
+
+	var result []*entity.AuditEntity
+
+	err := instance.
+		Order("id DESC").
+		Find(&result).Error
+
+	if err != nil {
+		w.mu.RUnlock()
+
+		return nil, err
+	}
+
+	w.mu.RUnlock()
+
+	slices.Reverse(result)
+
+	return result, nil
+}
+
+// createAuditRepository initializes auditRepositoryImpl.
+func createAuditRepository() AuditRepository {
+	return new(auditRepositoryImpl)
+}
+
+// InvitesRepository represents session invites entity repository.
+type InvitesRepository interface {
+	Insert(request dto.InvitesRepositoryInsertRequest) error
+	GetByCode(code string) (*entity.InviteEntity, bool, error)
+	MarkUsedByID(id int64) (bool, error)
+	MarkUsedByIDWithTransaction(transaction *gorm.DB, id int64) (bool, error)
+}
+
+// invitesRepositoryImpl represents implementation of InvitesRepository.
+type invitesRepositoryImpl struct {
+	// Represents mutex used for database invites repository related operations.
+	mu sync.RWMutex
+}
+
+// Insert inserts invites entity to the storage.
+func (w *invitesRepositoryImpl) Insert(request dto.InvitesRepositoryInsertRequest) error {
+	w.mu.Lock()
+
+	instance := db.GetInstance()
+
+	err := instance.Create(&entity.InviteEntity{
+		SessionID: request.SessionID,
+		Code:      request.Code,
+		SingleUse: request.SingleUse,
+		ExpiresAt: request.ExpiresAt,
+	}).Error
+
+	if err != nil {
+		w.mu.Unlock()
+
+		return errors.Wrap(err, ErrPersistingInvites.Error())
+	}
+
+	w.mu.Unlock()
+
+	return nil
+}
+
+// GetByCode retrieves an invite for the provided code.
+func (w *invitesRepositoryImpl) GetByCode(code string) (*entity.InviteEntity, bool, error) {
+	w.mu.RLock()
+
+	instance := db.GetInstance()
+
+	var result *entity.InviteEntity
+
+	err := instance.Table((&entity.InviteEntity{}).TableName()).
+		Where("code = ?", code).
+		First(&result).Error
+
+	if err != nil {
+		if err == gorm.ErrRecordNotFound {
+			w.mu.RUnlock()
+
+			return result, false, nil
+		}
+
+		w.mu.RUnlock()
+
+		return result, false, err
+	}
+
+	w.mu.RUnlock()
+
+	return result, true, nil
+}
+
+// markUsedByID marks invite with the provided id as used with provided db instance, reporting if it has not been used before.
+func (w *invitesRepositoryImpl) markUsedByID(instance *gorm.DB, id int64) (bool, error) {
+	w.mu.Lock()
+
+	result := instance.Table((&entity.InviteEntity{}).TableName()).
+		Where("id = ? AND used = ?", id, false).
+		Update("used", true)
+
+	w.mu.Unlock()
+
+	if result.Error != nil {
+		return false, result.Error
+	}
+
+	return result.RowsAffected != 0, nil
+}
+
+// MarkUsedByID marks invite with the provided id as used, reporting if it has not been used before.
+func (w *invitesRepositoryImpl) MarkUsedByID(id int64) (bool, error) {
+	return w.markUsedByID(db.GetInstance(), id)
+}
+
+// MarkUsedByIDWithTransaction marks invite with the provided id as used with provided transaction, reporting if it has
+// not been used before.
+func (w *invitesRepositoryImpl) MarkUsedByIDWithTransaction(transaction *gorm.DB, id int64) (bool, error) {
+	return w.markUsedByID(transaction, id)
+}
+
+// createInvitesRepository initializes invitesRepositoryImpl.
+func createInvitesRepository() InvitesRepository {
+	return new(invitesRepositoryImpl)
+}
+
+// FriendshipsRepository represents friendships entity repository.
+type FriendshipsRepository interface {
+	Insert(issuer, receiver int64) error
+	InsertWithTransaction(transaction *gorm.DB, request dto.FriendshipsRepositoryInsertRequest) error
+	GetByID(id int64) (*entity.FriendshipEntity, bool, error)
+	GetByUserIDs(first, second int64) (*entity.FriendshipEntity, bool, error)
+	GetByUserIDsWithTransaction(transaction *gorm.DB, first, second int64) (*entity.FriendshipEntity, bool, error)
+	GetByUserID(userID int64) ([]*entity.FriendshipEntity, error)
+	GetAll() ([]*entity.FriendshipEntity, error)
+	GetAllWithTransaction(transaction *gorm.DB) ([]*entity.FriendshipEntity, error)
+	UpdateAcceptedByID(id int64) error
+	DeleteByID(id int64) error
+}
+
+// friendshipsRepositoryImpl represents implementation of FriendshipsRepository.
+type friendshipsRepositoryImpl struct {
+	// Represents mutex used for database friendships repository related operations.
+	mu sync.RWMutex
+}
+
+// insert inserts friendships entity to the storage with the provided db instance.
+func (w *friendshipsRepositoryImpl) insert(instance *gorm.DB, request dto.FriendshipsRepositoryInsertRequest) error {
+	w.mu.Lock()
+
+	err := instance.Create(&entity.FriendshipEntity{
+		Issuer:    request.Issuer,
+		Receiver:  request.Receiver,
+		Accepted:  request.Accepted,
+		CreatedAt: request.CreatedAt,
+	}).Error
+
+	if err != nil {
+		w.mu.Unlock()
+
+		return errors.Wrap(err, ErrPersistingFriendships.Error())
+	}
+
+	w.mu.Unlock()
+
+	return nil
+}
+
+// Insert inserts pending friendships entity to the storage.
+func (w *friendshipsRepositoryImpl) Insert(issuer, receiver int64) error {
+	return w.insert(db.GetInstance(), dto.FriendshipsRepositoryInsertRequest{
+		Issuer:   issuer,
+		Receiver: receiver,
+	})
+}
+
+// InsertWithTransaction inserts friendships entity to the storage with the provided transaction.
+func (w *friendshipsRepositoryImpl) InsertWithTransaction(
+	transaction *gorm.DB, request dto.FriendshipsRepositoryInsertRequest) error {
+	return w.insert(transaction, request)
+}
+
+// GetByID retrieves a friendship for the provided id.
+func (w *friendshipsRepositoryImpl) GetByID(id int64) (*entity.FriendshipEntity, bool, error) {
+	w.mu.RLock()
+
+	instance := db.GetInstance()
+
+	var result *entity.FriendshipEntity
+
+	err := instance.Table((&entity.FriendshipEntity{}).TableName()).
+		Preload("IssuerEntity").
+		Preload("ReceiverEntity").
+		Where("id = ?", id).
+		First(&result).Error
+
+	if err != nil {
+		if err == gorm.ErrRecordNotFound {
+			w.mu.RUnlock()
+
+			return result, false, nil
+		}
+
+		w.mu.RUnlock()
+
+		return result, false, err
+	}
+
+	w.mu.RUnlock()
+
+	return result, true, nil
+}
+
+// getByUserIDs retrieves a friendship between the users with the provided ids regardless of its direction
+// with the provided db instance.
+func (w *friendshipsRepositoryImpl) getByUserIDs(
+	instance *gorm.DB, first, second int64) (*entity.FriendshipEntity, bool, error) {
+	w.mu.RLock()
+
+	var result *entity.FriendshipEntity
+
+	err := instance.Table((&entity.FriendshipEntity{}).TableName()).
+		Where("(issuer = ? AND receiver = ?) OR (issuer = ? AND receiver = ?)", first, second, second, first).
+		First(&result).Error
+
+	if err != nil {
+		if err == gorm.ErrRecordNotFound {
+			w.mu.RUnlock()
+
+			return result, false, nil
+		}
+
+		w.mu.RUnlock()
+
+		return result, false, err
+	}
+
+	w.mu.RUnlock()
+
+	return result, true, nil
+}
+
+// GetByUserIDs retrieves a friendship between the users with the provided ids regardless of its direction.
+func (w *friendshipsRepositoryImpl) GetByUserIDs(first, second int64) (*entity.FriendshipEntity, bool, error) {
+	return w.getByUserIDs(db.GetInstance(), first, second)
+}
+
+// GetByUserIDsWithTransaction retrieves a friendship between the users with the provided ids regardless of its
+// direction with the provided transaction.
+func (w *friendshipsRepositoryImpl) GetByUserIDsWithTransaction(
+	transaction *gorm.DB, first, second int64) (*entity.FriendshipEntity, bool, error) {
+	return w.getByUserIDs(transaction, first, second)
+}
+
+// GetByUserID retrieves all the friendships, where user with the provided id is either issuer or receiver.
+func (w *friendshipsRepositoryImpl) GetByUserID(userID int64) ([]*entity.FriendshipEntity, error) {
+	w.mu.RLock()
+
+	instance := db.GetInstance()
+
+	var result []*entity.FriendshipEntity
+
+	err := instance.Table((&entity.FriendshipEntity{}).TableName()).
+		Preload("IssuerEntity").
+		Preload("ReceiverEntity").
+		Where("issuer = ? OR receiver = ?", userID, userID).
+		Order("created_at").
+		Find(&result).Error
+
+	w.mu.RUnlock()
+
+	if err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+// getAll retrieves all available friendships with the provided db instance.
+func (w *friendshipsRepositoryImpl) getAll(instance *gorm.DB) ([]*entity.FriendshipEntity, error) {
+	w.mu.RLock()
+
+	var result []*entity.FriendshipEntity
+
+	err := instance.Table((&entity.FriendshipEntity{}).TableName()).
+		Preload("IssuerEntity").
+		Preload("ReceiverEntity").
+		Order("id").
+		Find(&result).Error
+
+	w.mu.RUnlock()
+
+	return result, err
+}
+
+// GetAll retrieves all available friendships.
+func (w *friendshipsRepositoryImpl) GetAll() ([]*entity.FriendshipEntity, error) {
+	return w.getAll(db.GetInstance())
+}
+
+// GetAllWithTransaction retrieves all available friendships with the provided transaction.
+func (w *friendshipsRepositoryImpl) GetAllWithTransaction(transaction *gorm.DB) ([]*entity.FriendshipEntity, error) {
+	return w.getAll(transaction)
+}
+
+// UpdateAcceptedByID marks friendship with the provided id as accepted.
+func (w *friendshipsRepositoryImpl) UpdateAcceptedByID(id int64) error {
+	w.mu.Lock()
+
+	instance := db.GetInstance()
+
+	err := instance.Table((&entity.FriendshipEntity{}).TableName()).
+		Where("id = ?", id).
+		Update("accepted", true).Error
+
+	w.mu.Unlock()
+
+	if err != nil {
+		return errors.Wrap(err, ErrPersistingFriendships.Error())
+	}
+
+	return nil
+}
+
+// DeleteByID deletes friendship by the provided id.
+func (w *friendshipsRepositoryImpl) DeleteByID(id int64) error {
+	w.mu.Lock()
+
+	instance := db.GetInstance()
+
+	err := instance.Table((&entity.FriendshipEntity{}).TableName()).
+		Where("id = ?", id).
+		Delete(&entity.FriendshipEntity{}).Error
+
+	w.mu.Unlock()
+
+	return err
+}
+
+// createFriendshipsRepository initializes friendshipsRepositoryImpl.
+func createFriendshipsRepository() FriendshipsRepository {
+	return new(friendshipsRepositoryImpl)
+}
@@ -1,13 +1,14 @@
 package sync
 
 import (
+	"context"
 	"errors"
 	"time"
 
 	"github.com/YarikRevich/fate-seekers/services/fate-seekers-server/pkg/shared/dto"
-	"github.com/YarikRevich/fate-seekers/services/fate-seekers-server/pkg/shared/logging"
 	"github.com/YarikRevich/fate-seekers/services/fate-seekers-server/pkg/shared/networking/cache"
 	"github.com/YarikRevich/fate-seekers/services/fate-seekers-server/pkg/shared/repository"
+	"github.com/YarikRevich/fate-seekers/services/fate-seekers-server/pkg/shared/supervisor"
 )
 
 var (
@@ -15,6 +16,9 @@ var (
 )
 
 const (
+	// Represents name of the worker used for supervision.
+	workerName = "sync"
+
 	// Represents ticker duration used for metadata synchronization worker.
 	metadataTickerDuration = time.Minute
 )
@@ -24,149 +28,108 @@ var (
 	affectedSessions map[int64]bool = make(map[int64]bool)
 )
 
-// Run starts the repository sync worker, which takes latest updates
-// from certain cache instances.
+// Run starts the sync worker under supervision.
 func Run() {
 	// TODO: create some mapping of hashes, which would help to avoid not necessary updates.
 
-	go func() {
-		ticker := time.NewTicker(metadataTickerDuration)
-
-		for range ticker.C {
-			ticker.Stop()
+	supervisor.GetInstance().Run(workerName, metadataTickerDuration, process)
+}
 
-			clear(affectedSessions)
+// process performs a single run of the worker, which takes latest updates
+// from certain cache instances.
+func process(ctx context.Context) error {
+	clear(affectedSessions)
+
+	cacheTransaction := cache.
+		GetInstance().
+		BeginTransaction(ctx, cache.LOBBY_SETS_REGION, cache.METADATA_REGION)
+	defer cacheTransaction.Commit()
+
+	for key, value := range cache.
+		GetInstance().
+		GetMetadataMappings() {
+		var userID int64
+
+		cachedUserID, ok := cache.
+			GetInstance().
+			GetUsers(key)
+		if ok {
+			userID = cachedUserID
+		} else {
+			user, exists, err := repository.
+				GetUsersRepository().
+				GetByName(key)
+			if err != nil {
+				return err
+			}
 
-			cache.
-				GetInstance().
-				BeginLobbySetTransaction()
+			if !exists {
+				return ErrUserDoesNotExist
+			}
 
-			cache.
-				GetInstance().
-				BeginMetadataTransaction()
+			userID = user.ID
+		}
 
-			for key, value := range cache.
-				GetInstance().
-				GetMetadataMappings() {
-				var userID int64
-
-				cachedUserID, ok := cache.
-					GetInstance().
-					GetUsers(key)
-				if ok {
-					userID = cachedUserID
-				} else {
-					user, exists, err := repository.
-						GetUsersRepository().
-						GetByName(key)
-					if err != nil {
-						cache.
-							GetInstance().
-							CommitMetadataTransaction()
-
-						cache.
-							GetInstance().
-							CommitLobbySetTransaction()
-
-						logging.GetInstance().Fatal(err.Error())
-					}
-
-					if !exists {
-						cache.
-							GetInstance().
-							CommitMetadataTransaction()
-
-						cache.
-							GetInstance().
-							CommitLobbySetTransaction()
-
-						logging.GetInstance().Fatal(ErrUserDoesNotExist.Error())
-					}
-
-					userID = user.ID
-				}
-
-				for _, metadata := range value {
-					err := repository.
-						GetLobbiesRepository().
-						InsertOrUpdate(
-							dto.LobbiesRepositoryInsertOrUpdateRequest{
-								UserID:         userID,
-								SessionID:      metadata.SessionID,
-								Skin:           metadata.Skin,
-								Health:         metadata.Health,
-								Active:         metadata.Active,
-								Eliminated:     metadata.Eliminated,
-								Host:           metadata.Host,
-								PositionX:      metadata.PositionX,
-								PositionY:      metadata.PositionY,
-								PositionStatic: metadata.PositionStatic,
-							})
-					if err != nil {
-						cache.
-							GetInstance().
-							CommitMetadataTransaction()
-
-						cache.
-							GetInstance().
-							CommitLobbySetTransaction()
-
-						logging.GetInstance().Fatal(err.Error())
-					}
-
-					affectedSessions[metadata.SessionID] = true
-				}
+		for _, metadata := range value {
+			err := repository.
+				GetLobbiesRepository().
+				InsertOrUpdate(
+					dto.LobbiesRepositoryInsertOrUpdateRequest{
+						UserID:         userID,
+						SessionID:      metadata.SessionID,
+						Skin:           metadata.Skin,
+						Health:         metadata.Health,
+						Active:         metadata.Active,
+						Eliminated:     metadata.Eliminated,
+						Spectator:      metadata.Spectator,
+						Host:           metadata.Host,
+						PositionX:      metadata.PositionX,
+						PositionY:      metadata.PositionY,
+						PositionStatic: metadata.PositionStatic,
+					})
+			if err != nil {
+				return err
 			}
 
-			// TODO: update objects in inventory tables.
-
-			for sessionID := range affectedSessions {
-				lobbies, exists, err := repository.
-					GetLobbiesRepository().
-					GetBySessionID(sessionID)
-				if err != nil {
-					cache.
-						GetInstance().
-						CommitMetadataTransaction()
-
-					cache.
-						GetInstance().
-						CommitLobbySetTransaction()
-
-					logging.GetInstance().Fatal(err.Error())
-				}
-
-				if exists {
-					var lobbySet []dto.CacheLobbySetEntity
-
-					for _, lobby := range lobbies {
-						lobbySet = append(lobbySet, dto.CacheLobbySetEntity{
-							ID:     lobby.ID,
-							Issuer: lobby.UserEntity.Name,
-							Skin:   uint64(lobby.Skin),
-							Host:   lobby.Host,
-						})
-					}
-
-					cache.
-						GetInstance().
-						EvictLobbySet(sessionID)
-
-					cache.
-						GetInstance().
-						AddLobbySet(sessionID, lobbySet)
-				}
+			affectedSessions[metadata.SessionID] = true
+		}
+	}
+
+	// TODO: update objects in inventory tables.
+
+	for sessionID := range affectedSessions {
+		lobbies, exists, err := repository.
+			GetLobbiesRepository().
+			GetBySessionID(sessionID)
+		if err != nil {
+			return err
+		}
+
+		if exists {
+			var lobbySet []dto.CacheLobbySetEntity
+
+			for _, lobby := range lobbies {
+				lobbySet = append(lobbySet, dto.CacheLobbySetEntity{
+					ID:          lobby.ID,
+					Issuer:      lobby.UserEntity.Name,
+					DisplayName: lobby.UserEntity.DisplayName,
+					Skin:        uint64(lobby.Skin),
+					Host:        lobby.Host,
+					Spectator:   lobby.Spectator,
+					Ready:       lobby.Ready,
+					Team:        uint64(lobby.Team),
+				})
 			}
 
 			cache.
 				GetInstance().
-				CommitMetadataTransaction()
+				EvictLobbySet(sessionID)
 
 			cache.
 				GetInstance().
-				CommitLobbySetTransaction()
-
-			ticker.Reset(metadataTickerDuration)
+				AddLobbySet(sessionID, lobbySet)
 		}
-	}()
+	}
+
+	return nil
 }
@@ -0,0 +1,248 @@
+package transfer
+
+import (
+	"context"
+	"encoding/json"
+	"os"
+	"path/filepath"
+
+	"github.com/YarikRevich/fate-seekers/services/fate-seekers-server/pkg/shared/db"
+	"github.com/YarikRevich/fate-seekers/services/fate-seekers-server/pkg/shared/dto"
+	"github.com/YarikRevich/fate-seekers/services/fate-seekers-server/pkg/shared/entity"
+	"github.com/YarikRevich/fate-seekers/services/fate-seekers-server/pkg/shared/repository"
+	"github.com/pkg/errors"
+	"gorm.io/gorm"
+)
+
+var (
+	ErrExportingSnapshot = errors.New("err happened during snapshot export operation")
+	ErrImportingSnapshot = errors.New("err happened during snapshot import operation")
+	ErrIssuerNotFound    = errors.New("err happened session issuer does not exist")
+	ErrFriendNotFound    = errors.New("err happened friendship user does not exist")
+)
+
+// Export writes sessions, users and friendships available in the storage to the provided path as JSON.
+// All of them are read within a single transaction, so that the snapshot is consistent.
+func Export(ctx context.Context, path string) error {
+	var (
+		users       []*entity.UserEntity
+		sessions    []*entity.SessionEntity
+		friendships []*entity.FriendshipEntity
+	)
+
+	err := db.BeginTransaction(ctx, func(tx *gorm.DB) error {
+		var err error
+
+		users, err = repository.
+			GetUsersRepository().
+			GetAllWithTransaction(tx)
+		if err != nil {
+			return err
+		}
+
+		sessions, err = repository.
+			GetSessionsRepository().
+			GetAllWithTransaction(tx)
+		if err != nil {
+			return err
+		}
+
+		friendships, err = repository.
+			GetFriendshipsRepository().
+			GetAllWithTransaction(tx)
+
+		return err
+	})
+	if err != nil {
+		return errors.Wrap(err, ErrExportingSnapshot.Error())
+	}
+
+	snapshot := dto.TransferSnapshot{
+		Users:       make([]dto.TransferUserUnit, 0, len(users)),
+		Sessions:    make([]dto.TransferSessionUnit, 0, len(sessions)),
+		Friendships: make([]dto.TransferFriendshipUnit, 0, len(friendships)),
+	}
+
+	for _, user := range users {
+		snapshot.Users = append(snapshot.Users, dto.TransferUserUnit{
+			Name:        user.Name,
+			DisplayName: user.DisplayName,
+			Skin:        uint64(user.Skin),
+			Avatar:      uint64(user.Avatar),
+			CreatedAt:   user.CreatedAt,
+		})
+	}
+
+	for _, session := range sessions {
+		snapshot.Sessions = append(snapshot.Sessions, dto.TransferSessionUnit{
+			Name:      session.Name,
+			Seed:      session.Seed,
+			Issuer:    session.UserEntity.Name,
+			Started:   session.Started,
+			Rules:     session.Rules,
+			Password:  session.Password,
+			CreatedAt: session.CreatedAt,
+		})
+	}
+
+	for _, friendship := range friendships {
+		snapshot.Friendships = append(snapshot.Friendships, dto.TransferFriendshipUnit{
+			Issuer:    friendship.IssuerEntity.Name,
+			Receiver:  friendship.ReceiverEntity.Name,
+			Accepted:  friendship.Accepted,
+			CreatedAt: friendship.CreatedAt,
+		})
+	}
+
+	data, err := json.MarshalIndent(snapshot, "", "    ")
+	if err != nil {
+		return errors.Wrap(err, ErrExportingSnapshot.Error())
+	}
+
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		return errors.Wrap(err, ErrExportingSnapshot.Error())
+	}
+
+	if err := os.WriteFile(path, data, 0644); err != nil {
+		return errors.Wrap(err, ErrExportingSnapshot.Error())
+	}
+
+	return nil
+}
+
+// Import reads sessions, users and friendships from the JSON file located at the provided path and
+// persists the ones, which are not yet present in the storage. Import is performed within
+// a single transaction, so that a failure does not leave partially imported snapshot.
+func Import(ctx context.Context, path string) error {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return errors.Wrap(err, ErrImportingSnapshot.Error())
+	}
+
+	var snapshot dto.TransferSnapshot
+
+	if err := json.Unmarshal(data, &snapshot); err != nil {
+		return errors.Wrap(err, ErrImportingSnapshot.Error())
+	}
+
+	err = db.BeginTransaction(ctx, func(tx *gorm.DB) error {
+		for _, user := range snapshot.Users {
+			exists, err := repository.
+				GetUsersRepository().
+				ExistsByNameWithTransaction(tx, user.Name)
+			if err != nil {
+				return err
+			}
+
+			if exists {
+				continue
+			}
+
+			err = repository.
+				GetUsersRepository().
+				InsertWithTransaction(tx, dto.UsersRepositoryInsertRequest{
+					Name:        user.Name,
+					DisplayName: user.DisplayName,
+					Skin:        user.Skin,
+					Avatar:      user.Avatar,
+					CreatedAt:   user.CreatedAt,
+				})
+			if err != nil {
+				return err
+			}
+		}
+
+		for _, session := range snapshot.Sessions {
+			exists, err := repository.
+				GetSessionsRepository().
+				ExistsByNameWithTransaction(tx, session.Name)
+			if err != nil {
+				return err
+			}
+
+			if exists {
+				continue
+			}
+
+			issuer, exists, err := repository.
+				GetUsersRepository().
+				GetByNameWithTransaction(tx, session.Issuer)
+			if err != nil {
+				return err
+			}
+
+			if !exists {
+				return errors.Wrap(ErrIssuerNotFound, session.Issuer)
+			}
+
+			err = repository.
+				GetSessionsRepository().
+				InsertOrUpdateWithTransaction(tx, dto.SessionsRepositoryInsertOrUpdateRequest{
+					Name:      session.Name,
+					Seed:      session.Seed,
+					Issuer:    issuer.ID,
+					Started:   session.Started,
+					Rules:     session.Rules,
+					Password:  session.Password,
+					CreatedAt: session.CreatedAt,
+				})
+			if err != nil {
+				return err
+			}
+		}
+
+		for _, friendship := range snapshot.Friendships {
+			issuer, exists, err := repository.
+				GetUsersRepository().
+				GetByNameWithTransaction(tx, friendship.Issuer)
+			if err != nil {
+				return err
+			}
+
+			if !exists {
+				return errors.Wrap(ErrFriendNotFound, friendship.Issuer)
+			}
+
+			receiver, exists, err := repository.
+				GetUsersRepository().
+				GetByNameWithTransaction(tx, friendship.Receiver)
+			if err != nil {
+				return err
+			}
+
+			if !exists {
+				return errors.Wrap(ErrFriendNotFound, friendship.Receiver)
+			}
+
+			_, exists, err = repository.
+				GetFriendshipsRepository().
+				GetByUserIDsWithTransaction(tx, issuer.ID, receiver.ID)
+			if err != nil {
+				return err
+			}
+
+			if exists {
+				continue
+			}
+
+			err = repository.
+				GetFriendshipsRepository().
+				InsertWithTransaction(tx, dto.FriendshipsRepositoryInsertRequest{
+					Issuer:    issuer.ID,
+					Receiver:  receiver.ID,
+					Accepted:  friendship.Accepted,
+					CreatedAt: friendship.CreatedAt,
+				})
+			if err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+	if err != nil {
+		return errors.Wrap(err, ErrImportingSnapshot.Error())
+	}
+
+	return nil
+}
@@ -0,0 +1,144 @@
+package rules
+
+import (
+	"errors"
+	"slices"
+
+	"github.com/YarikRevich/fate-seekers/services/fate-seekers-server/pkg/shared/networking/cache"
+	"github.com/YarikRevich/fate-seekers/services/fate-seekers-server/pkg/shared/repository"
+	"github.com/YarikRevich/fate-seekers/services/fate-seekers-server/pkg/shared/repository/converter"
+)
+
+var (
+	ErrSessionDoesNotExist = errors.New("err happened session does not exist")
+)
+
+// Describes all the available rules preset names.
+const (
+	PRESET_CLASSIC  = "classic"
+	PRESET_HARDCORE = "hardcore"
+	PRESET_CASUAL   = "casual"
+)
+
+// Ruleset represents game rules applied to a single session.
+type Ruleset struct {
+	// Represents max amount of items in the player inventory.
+	InventoryCapacity int
+
+	// Represents max amount of players per session, which can't exceed the amount of available skins.
+	MaxSessionUsers int
+
+	// Represents max amount of items generated per chest.
+	MaxChestItemsPerChest int
+
+	// Represents health taken by a single fist hit.
+	FistDamage uint64
+
+	// Represents max distance between players, at which fist hit lands.
+	FistDistance float64
+
+	// Represents player health at the session start, which is also the max health restored by health packs.
+	MaxHealth uint64
+
+	// Represents toggle, which enables session events.
+	EventsEnabled bool
+
+	// Represents multiplier applied to the health taken by session events.
+	EventsDamageMultiplier uint64
+
+	// Represents toggle, which allows fist hits to land on teammates in team mode.
+	FriendlyFire bool
+}
+
+// Represents all the available rules presets.
+var presets = map[string]Ruleset{
+	PRESET_CLASSIC: {
+		InventoryCapacity:      8,
+		MaxSessionUsers:        8,
+		MaxChestItemsPerChest:  6,
+		FistDamage:             5,
+		FistDistance:           80,
+		MaxHealth:              100,
+		EventsEnabled:          true,
+		EventsDamageMultiplier: 1,
+	},
+	PRESET_HARDCORE: {
+		InventoryCapacity:      4,
+		MaxSessionUsers:        8,
+		MaxChestItemsPerChest:  3,
+		FistDamage:             10,
+		FistDistance:           80,
+		MaxHealth:              60,
+		EventsEnabled:          true,
+		EventsDamageMultiplier: 2,
+		FriendlyFire:           true,
+	},
+	PRESET_CASUAL: {
+		InventoryCapacity:      8,
+		MaxSessionUsers:        4,
+		MaxChestItemsPerChest:  6,
+		FistDamage:             3,
+		FistDistance:           100,
+		MaxHealth:              100,
+		EventsEnabled:          false,
+		EventsDamageMultiplier: 1,
+	},
+}
+
+// Exists checks if rules preset with the given name exists.
+func Exists(preset string) bool {
+	_, ok := presets[preset]
+
+	return ok
+}
+
+// GetPresets retrieves names of all the available rules presets in stable order.
+func GetPresets() []string {
+	var result []string
+
+	for preset := range presets {
+		result = append(result, preset)
+	}
+
+	slices.Sort(result)
+
+	return result
+}
+
+// Get retrieves ruleset of the given preset, falling back to the classic one for unknown presets,
+// which can only come from sessions created before the rules presets were introduced.
+func Get(preset string) Ruleset {
+	result, ok := presets[preset]
+	if !ok {
+		return presets[PRESET_CLASSIC]
+	}
+
+	return result
+}
+
+// GetBySessionID retrieves ruleset of the session with the given id, reading it through the sessions cache.
+func GetBySessionID(sessionID int64) (Ruleset, error) {
+	cachedSession, ok := cache.
+		GetInstance().
+		GetSessions(sessionID)
+	if ok {
+		return Get(cachedSession.Rules), nil
+	}
+
+	session, exists, err := repository.
+		GetSessionsRepository().
+		GetByID(sessionID)
+	if err != nil {
+		return Ruleset{}, err
+	}
+
+	if !exists {
+		return Ruleset{}, ErrSessionDoesNotExist
+	}
+
+	cache.
+		GetInstance().
+		AddSessions(sessionID, converter.ConvertSessionEntityToCacheSessionEntity(session))
+
+	return Get(session.Rules), nil
+}
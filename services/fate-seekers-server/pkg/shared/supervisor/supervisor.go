@@ -0,0 +1,241 @@
+package supervisor
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/YarikRevich/fate-seekers/services/fate-seekers-server/pkg/shared/config"
+	"github.com/YarikRevich/fate-seekers/services/fate-seekers-server/pkg/shared/logging"
+	"github.com/YarikRevich/fate-seekers/services/fate-seekers-server/pkg/shared/monitoring/services"
+	"github.com/YarikRevich/fate-seekers/services/fate-seekers-server/pkg/shared/tracing"
+	"github.com/pkg/errors"
+)
+
+var (
+	ErrWorkerPanicked = errors.New("err happened worker panicked")
+	ErrWorkerFailed   = errors.New("err happened worker failed")
+	ErrWorkerEscalate = errors.New("err happened worker exceeded max amount of consecutive failures")
+)
+
+// Describes retry backoff applied to failed workers.
+const (
+	initialBackoff = time.Second
+	maxBackoff     = time.Minute
+)
+
+var (
+	// GetInstance retrieves instance of the worker supervisor, performing initialization if needed.
+	GetInstance = sync.OnceValue[*Supervisor](newSupervisor)
+)
+
+// WorkerStatus represents health status of the supervised worker.
+type WorkerStatus struct {
+	// Represents worker name.
+	Name string `json:"name"`
+
+	// Represents if the latest worker run has succeeded.
+	Healthy bool `json:"healthy"`
+
+	// Represents amount of consecutive worker failures.
+	Failures int `json:"failures"`
+
+	// Represents total amount of worker restarts.
+	Restarts int `json:"restarts"`
+
+	// Represents the latest worker error.
+	LastError string `json:"last_error,omitempty"`
+
+	// Represents time of the latest worker run.
+	LastRunAt time.Time `json:"last_run_at"`
+}
+
+// Supervisor represents background workers supervisor, which retries failed
+// workers with backoff and escalates only after repeated failures.
+type Supervisor struct {
+	// Represents mutex used for statuses access.
+	mu sync.RWMutex
+
+	// Represents statuses of all the supervised workers.
+	statuses map[string]*WorkerStatus
+
+	// Represents backoff applied after the first worker failure.
+	initialBackoff time.Duration
+
+	// Represents max backoff applied after consecutive worker failures.
+	maxBackoff time.Duration
+
+	// Represents getter of max amount of consecutive worker failures before escalation.
+	maxFailures func() int
+
+	// Represents callback performed on each worker failure.
+	report func(ctx context.Context, name string, err error)
+
+	// Represents callback performed, when worker exceeds max amount of consecutive failures.
+	escalate func(ctx context.Context, name string, err error)
+}
+
+// Run starts supervised worker with the given name, which performs the given callback
+// each interval. Zero interval means callback is performed until it succeeds once. Each
+// run is performed within its own span, which is available in the callback context.
+func (s *Supervisor) Run(name string, interval time.Duration, callback func(ctx context.Context) error) {
+	s.mu.Lock()
+
+	s.statuses[name] = &WorkerStatus{
+		Name:    name,
+		Healthy: true,
+	}
+
+	s.mu.Unlock()
+
+	services.SetWorkerHealthy(name, true)
+
+	go func() {
+		backoff := s.initialBackoff
+
+		timer := time.NewTimer(interval)
+
+		for range timer.C {
+			ctx, span := tracing.Start(context.Background(), fmt.Sprintf("worker.%s", name))
+
+			err := perform(ctx, callback)
+
+			tracing.End(span, err)
+
+			if err == nil {
+				s.succeed(name)
+
+				if interval == 0 {
+					return
+				}
+
+				backoff = s.initialBackoff
+
+				timer.Reset(interval)
+
+				continue
+			}
+
+			failures := s.fail(name, err)
+
+			s.report(ctx, name, err)
+
+			if failures >= s.maxFailures() {
+				s.escalate(ctx, name, err)
+
+				return
+			}
+
+			timer.Reset(backoff)
+
+			backoff = min(backoff*2, s.maxBackoff)
+		}
+	}()
+}
+
+// GetStatuses retrieves statuses of all the supervised workers ordered by name.
+func (s *Supervisor) GetStatuses() []WorkerStatus {
+	s.mu.RLock()
+
+	result := make([]WorkerStatus, 0, len(s.statuses))
+
+	for _, status := range s.statuses {
+		result = append(result, *status)
+	}
+
+	s.mu.RUnlock()
+
+	slices.SortFunc(result, func(a, b WorkerStatus) int {
+		return strings.Compare(a.Name, b.Name)
+	})
+
+	return result
+}
+
+// IsHealthy checks if all the supervised workers are healthy.
+func (s *Supervisor) IsHealthy() bool {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	for _, status := range s.statuses {
+		if !status.Healthy {
+			return false
+		}
+	}
+
+	return true
+}
+
+// succeed marks worker with the given name as healthy.
+func (s *Supervisor) succeed(name string) {
+	s.mu.Lock()
+
+	status := s.statuses[name]
+	status.Healthy = true
+	status.Failures = 0
+	status.LastError = ""
+	status.LastRunAt = time.Now()
+
+	s.mu.Unlock()
+
+	services.SetWorkerHealthy(name, true)
+}
+
+// fail marks worker with the given name as unhealthy, returning amount of consecutive failures.
+func (s *Supervisor) fail(name string, err error) int {
+	s.mu.Lock()
+
+	status := s.statuses[name]
+	status.Healthy = false
+	status.Failures++
+	status.Restarts++
+	status.LastError = err.Error()
+	status.LastRunAt = time.Now()
+
+	failures := status.Failures
+
+	s.mu.Unlock()
+
+	services.SetWorkerHealthy(name, false)
+	services.IncWorkerFailure(name)
+
+	return failures
+}
+
+// perform performs the given callback, converting panic to an error.
+func perform(ctx context.Context, callback func(ctx context.Context) error) (err error) {
+	defer func() {
+		if value := recover(); value != nil {
+			err = errors.Wrap(fmt.Errorf("%v", value), ErrWorkerPanicked.Error())
+		}
+	}()
+
+	return callback(ctx)
+}
+
+// report logs failure of the worker with the given name.
+func report(ctx context.Context, name string, err error) {
+	logging.WithContext(ctx).Error(
+		errors.Wrap(err, fmt.Sprintf("%s: %s", ErrWorkerFailed.Error(), name)).Error())
+}
+
+// escalate terminates the application, because of the repeated failures of the worker with the given name.
+func escalate(ctx context.Context, name string, err error) {
+	logging.WithContext(ctx).Fatal(
+		errors.Wrap(err, fmt.Sprintf("%s: %s", ErrWorkerEscalate.Error(), name)).Error())
+}
+
+// newSupervisor initializes Supervisor.
+func newSupervisor() *Supervisor {
+	return &Supervisor{
+		statuses:       make(map[string]*WorkerStatus),
+		initialBackoff: initialBackoff,
+		maxBackoff:     maxBackoff,
+		maxFailures:    config.GetOperationWorkersMaxFailures,
+		report:         report,
+		escalate:       escalate,
+	}
+}
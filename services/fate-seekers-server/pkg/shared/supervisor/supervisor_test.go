@@ -0,0 +1,199 @@
+package supervisor
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	// Describes backoff applied after the first worker failure during testing.
+	testInitialBackoff = time.Millisecond * 50
+
+	// Describes max backoff applied after consecutive worker failures during testing.
+	testMaxBackoff = time.Millisecond * 100
+
+	// Describes max amount of consecutive worker failures before escalation during testing.
+	testMaxFailures = 4
+
+	// Describes timeout, after which worker is considered stuck.
+	testTimeout = time.Second * 5
+)
+
+var errTest = errors.New("err happened during testing")
+
+// escalation represents escalation performed by the supervisor.
+type escalation struct {
+	// Represents name of the escalated worker.
+	name string
+
+	// Represents error of the escalated worker.
+	err error
+}
+
+// newTestSupervisor initializes Supervisor with the testing backoff, which reports escalations
+// to the returned channel instead of terminating the application.
+func newTestSupervisor() (*Supervisor, chan escalation) {
+	escalations := make(chan escalation, 1)
+
+	return &Supervisor{
+		statuses:       make(map[string]*WorkerStatus),
+		initialBackoff: testInitialBackoff,
+		maxBackoff:     testMaxBackoff,
+		maxFailures: func() int {
+			return testMaxFailures
+		},
+		report: func(ctx context.Context, name string, err error) {},
+		escalate: func(ctx context.Context, name string, err error) {
+			escalations <- escalation{name: name, err: err}
+		},
+	}, escalations
+}
+
+// waitEscalation waits for the escalation to be performed by the supervisor.
+func waitEscalation(t *testing.T, escalations chan escalation) escalation {
+	select {
+	case result := <-escalations:
+		return result
+	case <-time.After(testTimeout):
+		t.Fatal("worker has not been escalated")
+	}
+
+	return escalation{}
+}
+
+// getStatus retrieves status of the supervised worker with the given name.
+func getStatus(t *testing.T, s *Supervisor, name string) WorkerStatus {
+	for _, status := range s.GetStatuses() {
+		if status.Name == name {
+			return status
+		}
+	}
+
+	t.Fatalf("worker %s is not supervised", name)
+
+	return WorkerStatus{}
+}
+
+// TestSupervisorBackoff tests that retries of the failed worker are delayed by the doubled
+// backoff, which is capped by the max backoff.
+func TestSupervisorBackoff(t *testing.T) {
+	s, escalations := newTestSupervisor()
+
+	var (
+		mu sync.Mutex
+
+		runs []time.Time
+	)
+
+	s.Run("backoff", 0, func(ctx context.Context) error {
+		mu.Lock()
+		runs = append(runs, time.Now())
+		mu.Unlock()
+
+		return errTest
+	})
+
+	waitEscalation(t, escalations)
+
+	mu.Lock()
+	defer mu.Unlock()
+
+	require.Len(t, runs, testMaxFailures)
+
+	expected := []time.Duration{testInitialBackoff, testInitialBackoff * 2, testMaxBackoff}
+
+	for i, delay := range expected {
+		require.GreaterOrEqual(t, runs[i+1].Sub(runs[i]), delay)
+	}
+
+	require.Less(t, runs[3].Sub(runs[2]), testInitialBackoff*4)
+}
+
+// TestSupervisorEscalation tests that worker is escalated exactly once, after it exceeds max
+// amount of consecutive failures, and is marked as unhealthy.
+func TestSupervisorEscalation(t *testing.T) {
+	s, escalations := newTestSupervisor()
+
+	s.Run("escalation", 0, func(ctx context.Context) error {
+		return errTest
+	})
+
+	result := waitEscalation(t, escalations)
+
+	require.Equal(t, "escalation", result.name)
+	require.ErrorIs(t, result.err, errTest)
+
+	select {
+	case <-escalations:
+		t.Fatal("worker has been escalated more than once")
+	case <-time.After(testMaxBackoff * 2):
+	}
+
+	status := getStatus(t, s, "escalation")
+
+	require.False(t, status.Healthy)
+	require.Equal(t, testMaxFailures, status.Failures)
+	require.Equal(t, testMaxFailures, status.Restarts)
+	require.Equal(t, errTest.Error(), status.LastError)
+	require.False(t, s.IsHealthy())
+}
+
+// TestSupervisorRecovery tests that consecutive failures are reset after successful run, so
+// that worker, which fails less than max amount of times in a row, is not escalated.
+func TestSupervisorRecovery(t *testing.T) {
+	s, escalations := newTestSupervisor()
+
+	done := make(chan struct{})
+
+	var runs int
+
+	s.Run("recovery", 0, func(ctx context.Context) error {
+		runs++
+
+		if runs < testMaxFailures {
+			return errTest
+		}
+
+		close(done)
+
+		return nil
+	})
+
+	select {
+	case <-done:
+	case <-escalations:
+		t.Fatal("worker has been escalated")
+	case <-time.After(testTimeout):
+		t.Fatal("worker has not recovered")
+	}
+
+	require.Eventually(t, func() bool {
+		return getStatus(t, s, "recovery").Healthy
+	}, testTimeout, time.Millisecond*10)
+
+	status := getStatus(t, s, "recovery")
+
+	require.Zero(t, status.Failures)
+	require.Equal(t, testMaxFailures-1, status.Restarts)
+	require.Empty(t, status.LastError)
+	require.True(t, s.IsHealthy())
+}
+
+// TestSupervisorPanic tests that panic of the worker is converted to the failure.
+func TestSupervisorPanic(t *testing.T) {
+	s, escalations := newTestSupervisor()
+
+	s.Run("panic", 0, func(ctx context.Context) error {
+		panic("test")
+	})
+
+	result := waitEscalation(t, escalations)
+
+	require.ErrorContains(t, result.err, ErrWorkerPanicked.Error())
+	require.ErrorContains(t, result.err, "test")
+}
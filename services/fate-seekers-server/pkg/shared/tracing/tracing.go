@@ -0,0 +1,121 @@
+package tracing
+
+import (
+	"context"
+	"os"
+	"path"
+
+	"github.com/YarikRevich/fate-seekers/services/fate-seekers-server/pkg/shared/config"
+	"github.com/pkg/errors"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracegrpc"
+	"go.opentelemetry.io/otel/exporters/stdout/stdouttrace"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	semconv "go.opentelemetry.io/otel/semconv/v1.38.0"
+	"go.opentelemetry.io/otel/trace"
+	"gopkg.in/natefinch/lumberjack.v2"
+)
+
+var (
+	ErrTracingExporterUnknown = errors.New("err happened unknown tracing exporter")
+	ErrTracingInitFailed      = errors.New("err happened during tracing initialization")
+)
+
+// Describes tracing related static values.
+const (
+	// Represents service name reported with all the spans.
+	serviceName = "fate-seekers-server"
+
+	// Represents name of the tracer used by all the server components.
+	tracerName = "github.com/YarikRevich/fate-seekers/services/fate-seekers-server"
+)
+
+var (
+	// Represents tracer provider, which is set only if tracing is enabled.
+	provider *sdktrace.TracerProvider
+)
+
+// Init performs tracer provider initialization with the configured exporter. When
+// tracing is disabled global no-op provider is kept, so spans are not recorded.
+func Init() error {
+	if !config.GetSettingsTracingEnabled() {
+		return nil
+	}
+
+	exporter, err := newExporter()
+	if err != nil {
+		return errors.Wrap(err, ErrTracingInitFailed.Error())
+	}
+
+	provider = sdktrace.NewTracerProvider(
+		sdktrace.WithBatcher(exporter),
+		sdktrace.WithSampler(
+			sdktrace.ParentBased(
+				sdktrace.TraceIDRatioBased(config.GetSettingsTracingSampleRatio()))),
+		sdktrace.WithResource(
+			resource.NewWithAttributes(
+				semconv.SchemaURL,
+				semconv.ServiceName(serviceName))))
+
+	otel.SetTracerProvider(provider)
+
+	otel.SetTextMapPropagator(
+		propagation.NewCompositeTextMapPropagator(propagation.TraceContext{}, propagation.Baggage{}))
+
+	return nil
+}
+
+// Shutdown flushes all the pending spans and stops tracer provider.
+func Shutdown() error {
+	if provider == nil {
+		return nil
+	}
+
+	return provider.Shutdown(context.Background())
+}
+
+// Start starts span with the given name as a child of the span in the given context.
+func Start(ctx context.Context, name string, attributes ...attribute.KeyValue) (context.Context, trace.Span) {
+	return otel.Tracer(tracerName).Start(ctx, name, trace.WithAttributes(attributes...))
+}
+
+// End ends the given span, recording the given error if it's present.
+func End(span trace.Span, err error) {
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+
+	span.End()
+}
+
+// newExporter creates spans exporter according to the configuration.
+func newExporter() (sdktrace.SpanExporter, error) {
+	switch config.GetSettingsTracingExporter() {
+	case config.SETTINGS_TRACING_EXPORTER_OTLP:
+		options := []otlptracegrpc.Option{
+			otlptracegrpc.WithEndpoint(config.GetSettingsTracingEndpoint()),
+		}
+
+		if config.GetSettingsTracingInsecure() {
+			options = append(options, otlptracegrpc.WithInsecure())
+		}
+
+		return otlptracegrpc.New(context.Background(), options...)
+	case config.SETTINGS_TRACING_EXPORTER_STDOUT:
+		return stdouttrace.New(stdouttrace.WithWriter(os.Stdout))
+	case config.SETTINGS_TRACING_EXPORTER_FILE:
+		return stdouttrace.New(stdouttrace.WithWriter(&lumberjack.Logger{
+			Filename:   path.Join(config.GetLoggingDirectory(), config.GetSettingsTracingFile()),
+			MaxSize:    100,
+			MaxBackups: 5,
+			MaxAge:     28,
+		}))
+	}
+
+	return nil, ErrTracingExporterUnknown
+}
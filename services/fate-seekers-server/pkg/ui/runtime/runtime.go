@@ -2,16 +2,20 @@ package runtime
 
 import (
 	"image/color"
+	"strings"
 	"time"
 
 	"github.com/Frabjous-Studios/asebiten"
 	"github.com/YarikRevich/fate-seekers/services/fate-seekers-server/pkg/shared/config"
+	"github.com/YarikRevich/fate-seekers/services/fate-seekers-server/pkg/shared/logging"
 	"github.com/YarikRevich/fate-seekers/services/fate-seekers-server/pkg/ui/effect/transition"
 	"github.com/YarikRevich/fate-seekers/services/fate-seekers-server/pkg/ui/effect/transition/transparent"
 	"github.com/YarikRevich/fate-seekers/services/fate-seekers-server/pkg/ui/loader"
 	"github.com/YarikRevich/fate-seekers/services/fate-seekers-server/pkg/ui/screen"
+	"github.com/YarikRevich/fate-seekers/services/fate-seekers-server/pkg/ui/screen/bans"
 	"github.com/YarikRevich/fate-seekers/services/fate-seekers-server/pkg/ui/screen/entry"
 	"github.com/YarikRevich/fate-seekers/services/fate-seekers-server/pkg/ui/screen/menu"
+	"github.com/YarikRevich/fate-seekers/services/fate-seekers-server/pkg/ui/screen/monitoring"
 	"github.com/YarikRevich/fate-seekers/services/fate-seekers-server/pkg/ui/screen/settings"
 	"github.com/YarikRevich/fate-seekers/services/fate-seekers-server/pkg/ui/state/action"
 	"github.com/YarikRevich/fate-seekers/services/fate-seekers-server/pkg/ui/state/dispatcher"
@@ -22,12 +26,15 @@ import (
 	"github.com/YarikRevich/fate-seekers/services/fate-seekers-server/pkg/ui/tools/options"
 	"github.com/YarikRevich/fate-seekers/services/fate-seekers-server/pkg/ui/tools/scaler"
 	"github.com/YarikRevich/fate-seekers/services/fate-seekers-server/pkg/ui/ui/builder"
+	"github.com/YarikRevich/fate-seekers/services/fate-seekers-server/pkg/ui/ui/component/common"
 	"github.com/YarikRevich/fate-seekers/services/fate-seekers-server/pkg/ui/ui/component/info"
 	"github.com/YarikRevich/fate-seekers/services/fate-seekers-server/pkg/ui/ui/component/notification"
 	"github.com/YarikRevich/fate-seekers/services/fate-seekers-server/pkg/ui/ui/component/prompt"
 	notificationmanager "github.com/YarikRevich/fate-seekers/services/fate-seekers-server/pkg/ui/ui/manager/notification"
+	"github.com/YarikRevich/fate-seekers/services/fate-seekers-server/pkg/ui/ui/manager/translation"
 	"github.com/ebitenui/ebitenui"
 	"github.com/hajimehoshi/ebiten/v2"
+	"go.uber.org/zap"
 )
 
 // Runtime represents main runtime flow implementation.
@@ -126,6 +133,12 @@ func (r *Runtime) Update() error {
 
 	case value.ACTIVE_SCREEN_SETTINGS_VALUE:
 		r.activeScreen = settings.GetInstance()
+
+	case value.ACTIVE_SCREEN_BANS_VALUE:
+		r.activeScreen = bans.GetInstance()
+
+	case value.ACTIVE_SCREEN_MONITORING_VALUE:
+		r.activeScreen = monitoring.GetInstance()
 	}
 
 	if store.GetPromptText() != value.TEXT_PROMPT_EMPTY_VALUE {
@@ -303,6 +316,45 @@ func NewRuntime() *Runtime {
 		infoTransparentTransitionEffect.Reset()
 	})
 
+	config.AddReloadListener(func(result config.ReloadResult, err error) {
+		if err != nil {
+			logging.GetInstance().Warn(err.Error())
+
+			notificationmanager.GetInstance().Push(
+				common.ComposeMessage(
+					translation.GetInstance().GetTranslation("server.config.reload-failure"),
+					err.Error()),
+				time.Second*5,
+				common.NotificationErrorTextColor)
+
+			return
+		}
+
+		if len(result.Rejected) != 0 {
+			logging.GetInstance().Warn(
+				"configuration changes require server restart", zap.Strings("keys", result.Rejected))
+
+			notificationmanager.GetInstance().Push(
+				common.ComposeMessage(
+					translation.GetInstance().GetTranslation("server.config.reload-rejected"),
+					strings.Join(result.Rejected, ", ")),
+				time.Second*5,
+				common.NotificationErrorTextColor)
+		}
+
+		if len(result.Applied) != 0 {
+			logging.GetInstance().Info(
+				"configuration changes applied", zap.Strings("keys", result.Applied))
+
+			notificationmanager.GetInstance().Push(
+				common.ComposeMessage(
+					translation.GetInstance().GetTranslation("server.config.reload-success"),
+					strings.Join(result.Applied, ", ")),
+				time.Second*5,
+				common.NotificationInfoTextColor)
+		}
+	})
+
 	return &Runtime{
 		notificationInterface: builder.Build(
 			notification.GetInstance().GetContainer()),
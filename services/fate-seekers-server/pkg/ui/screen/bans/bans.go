@@ -0,0 +1,126 @@
+package bans
+
+import (
+	"sync"
+	"time"
+
+	"github.com/YarikRevich/fate-seekers/services/fate-seekers-server/pkg/shared/config"
+	"github.com/YarikRevich/fate-seekers/services/fate-seekers-server/pkg/shared/networking/moderation"
+	"github.com/YarikRevich/fate-seekers/services/fate-seekers-server/pkg/shared/storage/shared"
+	"github.com/YarikRevich/fate-seekers/services/fate-seekers-server/pkg/ui/effect/transition"
+	"github.com/YarikRevich/fate-seekers/services/fate-seekers-server/pkg/ui/effect/transition/transparent"
+	"github.com/YarikRevich/fate-seekers/services/fate-seekers-server/pkg/ui/screen"
+	"github.com/YarikRevich/fate-seekers/services/fate-seekers-server/pkg/ui/state/action"
+	"github.com/YarikRevich/fate-seekers/services/fate-seekers-server/pkg/ui/state/dispatcher"
+	"github.com/YarikRevich/fate-seekers/services/fate-seekers-server/pkg/ui/state/value"
+	"github.com/YarikRevich/fate-seekers/services/fate-seekers-server/pkg/ui/tools/options"
+	"github.com/YarikRevich/fate-seekers/services/fate-seekers-server/pkg/ui/tools/scaler"
+	"github.com/YarikRevich/fate-seekers/services/fate-seekers-server/pkg/ui/ui/builder"
+	"github.com/YarikRevich/fate-seekers/services/fate-seekers-server/pkg/ui/ui/component/bans"
+	"github.com/YarikRevich/fate-seekers/services/fate-seekers-server/pkg/ui/ui/component/common"
+	"github.com/YarikRevich/fate-seekers/services/fate-seekers-server/pkg/ui/ui/manager/notification"
+	"github.com/YarikRevich/fate-seekers/services/fate-seekers-server/pkg/ui/ui/manager/translation"
+	"github.com/ebitenui/ebitenui"
+	"github.com/hajimehoshi/ebiten/v2"
+)
+
+var (
+	// GetInstance retrieves instance of the bans screen, performing initilization if needed.
+	GetInstance = sync.OnceValue[screen.Screen](newBansScreen)
+)
+
+// BansScreen represents bans screen implementation.
+type BansScreen struct {
+	// Represents attached user interface.
+	ui *ebitenui.UI
+
+	// Represents transparent transition effect.
+	transparentTransitionEffect transition.TransitionEffect
+
+	// Represents global world view.
+	world *ebiten.Image
+
+	// Represents interface world view.
+	interfaceWorld *ebiten.Image
+}
+
+func (bs *BansScreen) HandleInput() error {
+	if !bs.transparentTransitionEffect.Done() {
+		if !bs.transparentTransitionEffect.OnEnd() {
+			bs.transparentTransitionEffect.Update()
+		} else {
+			bs.transparentTransitionEffect.Clean()
+		}
+	}
+
+	shared.GetInstance().GetBackgroundAnimation().Update()
+
+	bs.ui.Update()
+
+	return nil
+}
+
+func (bs *BansScreen) HandleRender(screen *ebiten.Image) {
+	bs.world.Clear()
+
+	bs.interfaceWorld.Clear()
+
+	var backgroundAnimationGeometry ebiten.GeoM
+
+	backgroundAnimationGeometry.Scale(
+		scaler.GetScaleFactor(config.GetMinStaticWidth(), config.GetWorldWidth()),
+		scaler.GetScaleFactor(config.GetMinStaticHeight(), config.GetWorldHeight()))
+
+	shared.GetInstance().GetBackgroundAnimation().DrawTo(bs.world, &ebiten.DrawImageOptions{
+		GeoM: backgroundAnimationGeometry,
+	})
+
+	bs.ui.Draw(bs.interfaceWorld)
+
+	bs.world.DrawImage(bs.interfaceWorld, &ebiten.DrawImageOptions{
+		ColorM: options.GetTransparentDrawOptions(
+			bs.transparentTransitionEffect.GetValue()).ColorM})
+
+	screen.DrawImage(bs.world, &ebiten.DrawImageOptions{})
+}
+
+func newBansScreen() screen.Screen {
+	transparentTransitionEffect := transparent.NewTransparentTransitionEffect(true, 255, 0, 5, time.Microsecond*10)
+
+	bans.GetInstance().SetLiftCallback(func(banID int64) {
+		err := moderation.GetInstance().Lift(banID)
+		if err != nil {
+			notification.GetInstance().Push(
+				common.ComposeMessage(
+					translation.GetInstance().GetTranslation("server.bans.lift-failure"),
+					err.Error()),
+				time.Second*3,
+				common.NotificationErrorTextColor)
+
+			return
+		}
+
+		bans.GetInstance().SetListsEntries(moderation.GetInstance().GetActive())
+
+		notification.GetInstance().Push(
+			translation.GetInstance().GetTranslation("server.bans.lift-success"),
+			time.Second*3,
+			common.NotificationInfoTextColor)
+	})
+
+	bans.GetInstance().SetBackCallback(func() {
+		transparentTransitionEffect.Reset()
+
+		dispatcher.GetInstance().Dispatch(
+			action.NewSetActiveScreenAction(value.ACTIVE_SCREEN_MENU_VALUE))
+	})
+
+	return &BansScreen{
+		ui:                          builder.Build(bans.GetInstance().GetContainer()),
+		transparentTransitionEffect: transparentTransitionEffect,
+		world: ebiten.NewImage(
+			config.GetWorldWidth(), config.GetWorldHeight()),
+		interfaceWorld: ebiten.NewImage(
+			config.GetWorldWidth(), config.GetWorldHeight()),
+	}
+}
@@ -6,6 +6,7 @@ import (
 
 	"github.com/YarikRevich/fate-seekers/services/fate-seekers-server/pkg/shared/config"
 	"github.com/YarikRevich/fate-seekers/services/fate-seekers-server/pkg/shared/networking/connector"
+	"github.com/YarikRevich/fate-seekers/services/fate-seekers-server/pkg/shared/networking/moderation"
 	"github.com/YarikRevich/fate-seekers/services/fate-seekers-server/pkg/shared/storage/shared"
 	"github.com/YarikRevich/fate-seekers/services/fate-seekers-server/pkg/shared/validator/encryptionkey"
 	"github.com/YarikRevich/fate-seekers/services/fate-seekers-server/pkg/ui/effect/transition"
@@ -18,6 +19,7 @@ import (
 	"github.com/YarikRevich/fate-seekers/services/fate-seekers-server/pkg/ui/tools/options"
 	"github.com/YarikRevich/fate-seekers/services/fate-seekers-server/pkg/ui/tools/scaler"
 	"github.com/YarikRevich/fate-seekers/services/fate-seekers-server/pkg/ui/ui/builder"
+	"github.com/YarikRevich/fate-seekers/services/fate-seekers-server/pkg/ui/ui/component/bans"
 	"github.com/YarikRevich/fate-seekers/services/fate-seekers-server/pkg/ui/ui/component/common"
 	"github.com/YarikRevich/fate-seekers/services/fate-seekers-server/pkg/ui/ui/component/menu"
 	"github.com/YarikRevich/fate-seekers/services/fate-seekers-server/pkg/ui/ui/manager/notification"
@@ -202,6 +204,22 @@ func newMenuScreen() screen.Screen {
 			action.NewSetActiveScreenAction(value.ACTIVE_SCREEN_SETTINGS_VALUE))
 	})
 
+	menu.GetInstance().SetBansCallback(func() {
+		transparentTransitionEffect.Reset()
+
+		bans.GetInstance().SetListsEntries(moderation.GetInstance().GetActive())
+
+		dispatcher.GetInstance().Dispatch(
+			action.NewSetActiveScreenAction(value.ACTIVE_SCREEN_BANS_VALUE))
+	})
+
+	menu.GetInstance().SetMonitoringCallback(func() {
+		transparentTransitionEffect.Reset()
+
+		dispatcher.GetInstance().Dispatch(
+			action.NewSetActiveScreenAction(value.ACTIVE_SCREEN_MONITORING_VALUE))
+	})
+
 	menu.GetInstance().SetExitCallback(func() {
 		dispatcher.GetInstance().Dispatch(
 			action.NewSetExitApplicationAction(value.EXIT_APPLICATION_TRUE_VALUE))
@@ -0,0 +1,116 @@
+package monitoring
+
+import (
+	"sync"
+	"time"
+
+	"github.com/YarikRevich/fate-seekers/services/fate-seekers-server/pkg/shared/config"
+	"github.com/YarikRevich/fate-seekers/services/fate-seekers-server/pkg/shared/monitoring/timeseries"
+	"github.com/YarikRevich/fate-seekers/services/fate-seekers-server/pkg/shared/storage/shared"
+	"github.com/YarikRevich/fate-seekers/services/fate-seekers-server/pkg/ui/effect/transition"
+	"github.com/YarikRevich/fate-seekers/services/fate-seekers-server/pkg/ui/effect/transition/transparent"
+	"github.com/YarikRevich/fate-seekers/services/fate-seekers-server/pkg/ui/screen"
+	"github.com/YarikRevich/fate-seekers/services/fate-seekers-server/pkg/ui/state/action"
+	"github.com/YarikRevich/fate-seekers/services/fate-seekers-server/pkg/ui/state/dispatcher"
+	"github.com/YarikRevich/fate-seekers/services/fate-seekers-server/pkg/ui/state/value"
+	"github.com/YarikRevich/fate-seekers/services/fate-seekers-server/pkg/ui/tools/options"
+	"github.com/YarikRevich/fate-seekers/services/fate-seekers-server/pkg/ui/tools/scaler"
+	"github.com/YarikRevich/fate-seekers/services/fate-seekers-server/pkg/ui/ui/builder"
+	"github.com/YarikRevich/fate-seekers/services/fate-seekers-server/pkg/ui/ui/component/monitoring"
+	"github.com/ebitenui/ebitenui"
+	"github.com/hajimehoshi/ebiten/v2"
+)
+
+const (
+	// Represents interval between charts redraws.
+	refreshInterval = time.Second
+)
+
+var (
+	// GetInstance retrieves instance of the monitoring screen, performing initilization if needed.
+	GetInstance = sync.OnceValue[screen.Screen](newMonitoringScreen)
+)
+
+// MonitoringScreen represents monitoring screen implementation.
+type MonitoringScreen struct {
+	// Represents attached user interface.
+	ui *ebitenui.UI
+
+	// Represents transparent transition effect.
+	transparentTransitionEffect transition.TransitionEffect
+
+	// Represents time of the latest charts redraw.
+	refreshedAt time.Time
+
+	// Represents global world view.
+	world *ebiten.Image
+
+	// Represents interface world view.
+	interfaceWorld *ebiten.Image
+}
+
+func (ms *MonitoringScreen) HandleInput() error {
+	if !ms.transparentTransitionEffect.Done() {
+		if !ms.transparentTransitionEffect.OnEnd() {
+			ms.transparentTransitionEffect.Update()
+		} else {
+			ms.transparentTransitionEffect.Clean()
+		}
+	}
+
+	if time.Since(ms.refreshedAt) >= refreshInterval {
+		monitoring.GetInstance().SetSeries(timeseries.GetInstance().GetSeries())
+
+		ms.refreshedAt = time.Now()
+	}
+
+	shared.GetInstance().GetBackgroundAnimation().Update()
+
+	ms.ui.Update()
+
+	return nil
+}
+
+func (ms *MonitoringScreen) HandleRender(screen *ebiten.Image) {
+	ms.world.Clear()
+
+	ms.interfaceWorld.Clear()
+
+	var backgroundAnimationGeometry ebiten.GeoM
+
+	backgroundAnimationGeometry.Scale(
+		scaler.GetScaleFactor(config.GetMinStaticWidth(), config.GetWorldWidth()),
+		scaler.GetScaleFactor(config.GetMinStaticHeight(), config.GetWorldHeight()))
+
+	shared.GetInstance().GetBackgroundAnimation().DrawTo(ms.world, &ebiten.DrawImageOptions{
+		GeoM: backgroundAnimationGeometry,
+	})
+
+	ms.ui.Draw(ms.interfaceWorld)
+
+	ms.world.DrawImage(ms.interfaceWorld, &ebiten.DrawImageOptions{
+		ColorM: options.GetTransparentDrawOptions(
+			ms.transparentTransitionEffect.GetValue()).ColorM})
+
+	screen.DrawImage(ms.world, &ebiten.DrawImageOptions{})
+}
+
+func newMonitoringScreen() screen.Screen {
+	transparentTransitionEffect := transparent.NewTransparentTransitionEffect(true, 255, 0, 5, time.Microsecond*10)
+
+	monitoring.GetInstance().SetBackCallback(func() {
+		transparentTransitionEffect.Reset()
+
+		dispatcher.GetInstance().Dispatch(
+			action.NewSetActiveScreenAction(value.ACTIVE_SCREEN_MENU_VALUE))
+	})
+
+	return &MonitoringScreen{
+		ui:                          builder.Build(monitoring.GetInstance().GetContainer()),
+		transparentTransitionEffect: transparentTransitionEffect,
+		world: ebiten.NewImage(
+			config.GetWorldWidth(), config.GetWorldHeight()),
+		interfaceWorld: ebiten.NewImage(
+			config.GetWorldWidth(), config.GetWorldHeight()),
+	}
+}
@@ -0,0 +1,293 @@
+package bans
+
+import (
+	"fmt"
+	"image/color"
+	"sync"
+	"time"
+
+	"github.com/YarikRevich/fate-seekers/services/fate-seekers-server/pkg/shared/config"
+	"github.com/YarikRevich/fate-seekers/services/fate-seekers-server/pkg/shared/entity"
+	"github.com/YarikRevich/fate-seekers/services/fate-seekers-server/pkg/sound"
+	"github.com/YarikRevich/fate-seekers/services/fate-seekers-server/pkg/ui/loader"
+	"github.com/YarikRevich/fate-seekers/services/fate-seekers-server/pkg/ui/tools/scaler"
+	"github.com/YarikRevich/fate-seekers/services/fate-seekers-server/pkg/ui/ui/common"
+	componentscommon "github.com/YarikRevich/fate-seekers/services/fate-seekers-server/pkg/ui/ui/component/common"
+	"github.com/YarikRevich/fate-seekers/services/fate-seekers-server/pkg/ui/ui/manager/translation"
+	"github.com/ebitenui/ebitenui/image"
+	"github.com/ebitenui/ebitenui/widget"
+	"github.com/hajimehoshi/ebiten/v2/text/v2"
+)
+
+// Describes all the colors used for list combo definition.
+var (
+	selectedListColor = color.NRGBA{183, 228, 202, 255}
+	focusedListColor  = color.NRGBA{R: 170, G: 170, B: 180, A: 255}
+	disabledListColor = color.NRGBA{100, 100, 100, 255}
+)
+
+var (
+	// GetInstance retrieves instance of the bans component, performing initial creation if needed.
+	GetInstance = sync.OnceValue[*BansComponent](newBansComponent)
+)
+
+// BansComponent represents component, which contains bans list.
+type BansComponent struct {
+	// Represents bans list widget.
+	list *widget.List
+
+	// Represents lift action button widget.
+	liftActionButton *widget.Button
+
+	// Represents currently selected ban entry.
+	banEntry *entity.BanEntity
+
+	// Represents lift callback.
+	liftCallback func(banID int64)
+
+	// Represents back callback.
+	backCallback func()
+
+	// Represents container widget.
+	container *widget.Container
+}
+
+// SetListsEntries sets lists entries to the list widget.
+func (bc *BansComponent) SetListsEntries(value []*entity.BanEntity) {
+	entries := make([]interface{}, len(value))
+
+	for i, ban := range value {
+		entries[i] = ban
+	}
+
+	bc.list.SetEntries(entries)
+
+	bc.banEntry = nil
+
+	bc.liftActionButton.GetWidget().Disabled = true
+}
+
+// SetLiftCallback modifies lift callback in the container.
+func (bc *BansComponent) SetLiftCallback(callback func(banID int64)) {
+	bc.liftCallback = callback
+}
+
+// SetBackCallback modifies back callback in the container.
+func (bc *BansComponent) SetBackCallback(callback func()) {
+	bc.backCallback = callback
+}
+
+// GetContainer retrieves container widget.
+func (bc *BansComponent) GetContainer() *widget.Container {
+	return bc.container
+}
+
+// newBansComponent creates new bans component.
+func newBansComponent() *BansComponent {
+	var result *BansComponent
+
+	container := widget.NewContainer(
+		widget.ContainerOpts.WidgetOpts(
+			widget.WidgetOpts.MinSize(
+				scaler.GetPercentageOf(config.GetWorldWidth(), 20),
+				scaler.GetPercentageOf(config.GetWorldHeight(), 30)),
+			widget.WidgetOpts.TrackHover(false),
+			widget.WidgetOpts.LayoutData(widget.AnchorLayoutData{
+				Padding: widget.Insets{
+					Left: scaler.GetPercentageOf(config.GetWorldWidth(), 6),
+				},
+				VerticalPosition:  widget.AnchorLayoutPositionCenter,
+				StretchHorizontal: false,
+				StretchVertical:   false,
+			})),
+		widget.ContainerOpts.BackgroundImage(common.GetImageAsNineSlice(loader.PanelIdlePanel, 10, 10)),
+		widget.ContainerOpts.Layout(widget.NewRowLayout(
+			widget.RowLayoutOpts.Direction(widget.DirectionVertical),
+			widget.RowLayoutOpts.Padding(widget.Insets{
+				Left:   30,
+				Right:  30,
+				Top:    30,
+				Bottom: 30,
+			}),
+		)))
+
+	generalFont := &text.GoTextFace{
+		Source: loader.GetInstance().GetFont(loader.KyivRegularFont),
+		Size:   20,
+	}
+
+	container.AddChild(widget.NewText(
+		widget.TextOpts.WidgetOpts(widget.WidgetOpts.LayoutData(widget.RowLayoutData{
+			Stretch: true,
+		})),
+		widget.TextOpts.Insets(widget.Insets{
+			Bottom: 20,
+		}),
+		widget.TextOpts.Text(
+			translation.GetInstance().GetTranslation("server.bans.title"),
+			generalFont,
+			color.White)))
+
+	list := widget.NewList(
+		widget.ListOpts.ContainerOpts(
+			widget.ContainerOpts.WidgetOpts(
+				widget.WidgetOpts.MinSize(
+					scaler.GetPercentageOf(config.GetWorldWidth(), 50),
+					scaler.GetPercentageOf(config.GetWorldHeight(), 40),
+				),
+				widget.WidgetOpts.LayoutData(widget.RowLayoutData{
+					MaxWidth:  scaler.GetPercentageOf(config.GetWorldWidth(), 50),
+					MaxHeight: scaler.GetPercentageOf(config.GetWorldHeight(), 40),
+					Position:  widget.RowLayoutPositionCenter,
+				}))),
+		widget.ListOpts.ScrollContainerOpts(widget.ScrollContainerOpts.Image(&widget.ScrollContainerImage{
+			Idle:     image.NewNineSlice(loader.GetInstance().GetStatic(loader.ListIdle), [3]int{25, 12, 22}, [3]int{25, 12, 25}),
+			Disabled: image.NewNineSlice(loader.GetInstance().GetStatic(loader.ListDisabled), [3]int{25, 12, 22}, [3]int{25, 12, 25}),
+			Mask:     image.NewNineSlice(loader.GetInstance().GetStatic(loader.ListMask), [3]int{26, 10, 23}, [3]int{26, 10, 26}),
+		})),
+		widget.ListOpts.SliderOpts(
+			widget.SliderOpts.Images(
+				&widget.SliderTrackImage{
+					Idle:     image.NewNineSlice(loader.GetInstance().GetStatic(loader.ListTrackIdle), [3]int{5, 0, 0}, [3]int{25, 12, 25}),
+					Hover:    image.NewNineSlice(loader.GetInstance().GetStatic(loader.ListTrackIdle), [3]int{5, 0, 0}, [3]int{25, 12, 25}),
+					Disabled: image.NewNineSlice(loader.GetInstance().GetStatic(loader.ListTrackDisabled), [3]int{0, 5, 0}, [3]int{25, 12, 25}),
+				},
+				&widget.ButtonImage{
+					Idle:     image.NewNineSliceSimple(loader.GetInstance().GetStatic(loader.SliderHandleIdle), 0, 5),
+					Hover:    image.NewNineSliceSimple(loader.GetInstance().GetStatic(loader.SliderHandleHover), 0, 5),
+					Pressed:  image.NewNineSliceSimple(loader.GetInstance().GetStatic(loader.SliderHandleHover), 0, 5),
+					Disabled: image.NewNineSliceSimple(loader.GetInstance().GetStatic(loader.SliderHandleIdle), 0, 5),
+				}),
+			widget.SliderOpts.MinHandleSize(8),
+			widget.SliderOpts.TrackPadding(widget.Insets{Bottom: 20}),
+		),
+		widget.ListOpts.AllowReselect(),
+		widget.ListOpts.HideHorizontalSlider(),
+		widget.ListOpts.Entries([]interface{}{}),
+		widget.ListOpts.EntryLabelFunc(func(e interface{}) string {
+			ban := e.(*entity.BanEntity)
+
+			target := ban.Issuer
+			if target == "" {
+				target = ban.Address
+			}
+
+			expiresAt := translation.GetInstance().GetTranslation("server.bans.permanent")
+			if ban.ExpiresAt != nil {
+				expiresAt = ban.ExpiresAt.Local().Format(time.DateTime)
+			}
+
+			return fmt.Sprintf("#%d %s (%s, %s) %s", ban.ID, target, ban.Creator, expiresAt, ban.Reason)
+		}),
+		widget.ListOpts.EntrySelectedHandler(func(args *widget.ListEntrySelectedEventArgs) {
+			if result.liftActionButton.GetWidget().Disabled {
+				result.liftActionButton.GetWidget().Disabled = false
+			}
+
+			result.banEntry = args.Entry.(*entity.BanEntity)
+		}),
+		widget.ListOpts.EntryFontFace(generalFont),
+		widget.ListOpts.EntryColor(&widget.ListEntryColor{
+			Selected:                   componentscommon.ButtonTextColor,
+			Unselected:                 selectedListColor,
+			SelectedBackground:         selectedListColor,
+			SelectedFocusedBackground:  selectedListColor,
+			FocusedBackground:          focusedListColor,
+			DisabledUnselected:         disabledListColor,
+			DisabledSelected:           disabledListColor,
+			DisabledSelectedBackground: disabledListColor,
+		}),
+		widget.ListOpts.EntryTextPadding(widget.Insets{
+			Top:    15,
+			Left:   40,
+			Right:  40,
+			Bottom: 15,
+		}),
+	)
+
+	container.AddChild(list)
+
+	buttonsContainer := widget.NewContainer(
+		widget.ContainerOpts.WidgetOpts(
+			widget.WidgetOpts.LayoutData(widget.RowLayoutData{
+				Position: widget.RowLayoutPositionEnd,
+			}),
+		),
+		widget.ContainerOpts.Layout(widget.NewRowLayout(
+			widget.RowLayoutOpts.Direction(widget.DirectionHorizontal),
+			widget.RowLayoutOpts.Spacing(13),
+			widget.RowLayoutOpts.Padding(widget.Insets{
+				Top: 30,
+			}),
+		)),
+	)
+
+	buttonIdleIcon := common.GetImageAsNineSlice(loader.ButtonIdleButton, 16, 15)
+	buttonHoverIcon := common.GetImageAsNineSlice(loader.ButtonHoverButton, 16, 15)
+
+	buttonsContainer.AddChild(widget.NewButton(
+		widget.ButtonOpts.Image(&widget.ButtonImage{
+			Idle:         buttonIdleIcon,
+			Hover:        buttonHoverIcon,
+			Pressed:      buttonIdleIcon,
+			PressedHover: buttonIdleIcon,
+			Disabled:     buttonIdleIcon,
+		}),
+		widget.ButtonOpts.Text(
+			translation.GetInstance().GetTranslation("server.bans.back"),
+			generalFont,
+			&widget.ButtonTextColor{Idle: componentscommon.ButtonTextColor}),
+		widget.ButtonOpts.TextPadding(widget.Insets{
+			Left:   30,
+			Right:  30,
+			Top:    20,
+			Bottom: 20,
+		}),
+		widget.ButtonOpts.PressedHandler(func(args *widget.ButtonPressedEventArgs) {
+			sound.GetInstance().GetSoundFxManager().PushWithHandbrake(loader.ButtonFXSound)
+
+			result.backCallback()
+		}),
+	))
+
+	liftActionButton := widget.NewButton(
+		widget.ButtonOpts.Image(&widget.ButtonImage{
+			Idle:         buttonIdleIcon,
+			Hover:        buttonHoverIcon,
+			Pressed:      buttonIdleIcon,
+			PressedHover: buttonIdleIcon,
+			Disabled:     buttonIdleIcon,
+		}),
+		widget.ButtonOpts.Text(
+			translation.GetInstance().GetTranslation("server.bans.lift"),
+			generalFont,
+			&widget.ButtonTextColor{Idle: componentscommon.ButtonTextColor}),
+		widget.ButtonOpts.TextPadding(widget.Insets{
+			Left:   30,
+			Right:  30,
+			Top:    20,
+			Bottom: 20,
+		}),
+		widget.ButtonOpts.PressedHandler(func(args *widget.ButtonPressedEventArgs) {
+			sound.GetInstance().GetSoundFxManager().PushWithHandbrake(loader.ButtonFXSound)
+
+			if result.banEntry != nil {
+				result.liftCallback(result.banEntry.ID)
+			}
+		}),
+	)
+
+	liftActionButton.GetWidget().Disabled = true
+
+	buttonsContainer.AddChild(liftActionButton)
+
+	container.AddChild(buttonsContainer)
+
+	result = &BansComponent{
+		list:             list,
+		liftActionButton: liftActionButton,
+		container:        container,
+	}
+
+	return result
+}
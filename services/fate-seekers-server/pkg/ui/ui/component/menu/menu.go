@@ -38,6 +38,12 @@ type MenuComponent struct {
 	// Represents settings callback.
 	settingsCallback func()
 
+	// Represents bans callback.
+	bansCallback func()
+
+	// Represents monitoring callback.
+	monitoringCallback func()
+
 	// Represents exit callback.
 	exitCallback func()
 
@@ -80,6 +86,16 @@ func (mc *MenuComponent) SetSettingsCallback(callback func()) {
 	mc.settingsCallback = callback
 }
 
+// SetBansCallback modified bans callback in the container.
+func (mc *MenuComponent) SetBansCallback(callback func()) {
+	mc.bansCallback = callback
+}
+
+// SetMonitoringCallback modified monitoring callback in the container.
+func (mc *MenuComponent) SetMonitoringCallback(callback func()) {
+	mc.monitoringCallback = callback
+}
+
 // SetExitCallback modified exit callback in the container.
 func (mc *MenuComponent) SetExitCallback(callback func()) {
 	mc.exitCallback = callback
@@ -306,6 +322,62 @@ func newMenuComponent() *MenuComponent {
 		}),
 	))
 
+	buttonsContainer.AddChild(widget.NewButton(
+		widget.ButtonOpts.WidgetOpts(widget.WidgetOpts.LayoutData(widget.RowLayoutData{
+			Stretch: true,
+		})),
+		widget.ButtonOpts.Image(&widget.ButtonImage{
+			Idle:         buttonIdleIcon,
+			Hover:        buttonHoverIcon,
+			Pressed:      buttonIdleIcon,
+			PressedHover: buttonIdleIcon,
+			Disabled:     buttonIdleIcon,
+		}),
+		widget.ButtonOpts.Text(
+			translation.GetInstance().GetTranslation("server.menu.bans"),
+			buttonFont,
+			&widget.ButtonTextColor{Idle: componentscommon.ButtonTextColor}),
+		widget.ButtonOpts.TextPadding(widget.Insets{
+			Left:   30,
+			Right:  30,
+			Top:    20,
+			Bottom: 20,
+		}),
+		widget.ButtonOpts.PressedHandler(func(args *widget.ButtonPressedEventArgs) {
+			sound.GetInstance().GetSoundFxManager().PushWithHandbrake(loader.ButtonFXSound)
+
+			result.bansCallback()
+		}),
+	))
+
+	buttonsContainer.AddChild(widget.NewButton(
+		widget.ButtonOpts.WidgetOpts(widget.WidgetOpts.LayoutData(widget.RowLayoutData{
+			Stretch: true,
+		})),
+		widget.ButtonOpts.Image(&widget.ButtonImage{
+			Idle:         buttonIdleIcon,
+			Hover:        buttonHoverIcon,
+			Pressed:      buttonIdleIcon,
+			PressedHover: buttonIdleIcon,
+			Disabled:     buttonIdleIcon,
+		}),
+		widget.ButtonOpts.Text(
+			translation.GetInstance().GetTranslation("server.menu.monitoring"),
+			buttonFont,
+			&widget.ButtonTextColor{Idle: componentscommon.ButtonTextColor}),
+		widget.ButtonOpts.TextPadding(widget.Insets{
+			Left:   30,
+			Right:  30,
+			Top:    20,
+			Bottom: 20,
+		}),
+		widget.ButtonOpts.PressedHandler(func(args *widget.ButtonPressedEventArgs) {
+			sound.GetInstance().GetSoundFxManager().PushWithHandbrake(loader.ButtonFXSound)
+
+			result.monitoringCallback()
+		}),
+	))
+
 	buttonsContainer.AddChild(widget.NewButton(
 		widget.ButtonOpts.WidgetOpts(widget.WidgetOpts.LayoutData(widget.RowLayoutData{
 			Stretch: true,
@@ -1,7 +1,12 @@
 package monitoring
 
 import (
+	"fmt"
+	"image/color"
+	"sync"
+
 	"github.com/YarikRevich/fate-seekers/services/fate-seekers-server/pkg/shared/config"
+	"github.com/YarikRevich/fate-seekers/services/fate-seekers-server/pkg/shared/monitoring/timeseries"
 	"github.com/YarikRevich/fate-seekers/services/fate-seekers-server/pkg/sound"
 	"github.com/YarikRevich/fate-seekers/services/fate-seekers-server/pkg/ui/loader"
 	"github.com/YarikRevich/fate-seekers/services/fate-seekers-server/pkg/ui/tools/scaler"
@@ -9,142 +14,233 @@ import (
 	componentscommon "github.com/YarikRevich/fate-seekers/services/fate-seekers-server/pkg/ui/ui/component/common"
 	"github.com/YarikRevich/fate-seekers/services/fate-seekers-server/pkg/ui/ui/manager/translation"
 	"github.com/ebitenui/ebitenui/widget"
+	"github.com/hajimehoshi/ebiten/v2"
 	"github.com/hajimehoshi/ebiten/v2/text/v2"
+	"github.com/hajimehoshi/ebiten/v2/vector"
+)
+
+// Describes charts grid layout.
+const (
+	chartsColumns = 4
+	chartsPadding = 8
+	chartsTitle   = 20
 )
 
-// NewMonitoringComponent creates new monitoring menu component.
-func NewMonitoringComponent(startCallback, stopCallback, detailsCallback, backCallback func()) *widget.Container {
-	result := widget.NewContainer(
+// Describes all the colors used for charts drawing.
+var (
+	chartBorderColor = color.NRGBA{R: 100, G: 100, B: 100, A: 255}
+	chartLinesColors = []color.NRGBA{
+		{R: 115, G: 191, B: 105, A: 255},
+		{R: 242, G: 204, B: 12, A: 255},
+		{R: 138, G: 184, B: 255, A: 255},
+		{R: 255, G: 120, B: 10, A: 255},
+		{R: 242, G: 73, B: 92, A: 255},
+		{R: 184, G: 119, B: 217, A: 255},
+	}
+)
+
+var (
+	// GetInstance retrieves instance of the monitoring component, performing initial creation if needed.
+	GetInstance = sync.OnceValue[*MonitoringComponent](newMonitoringComponent)
+)
+
+// MonitoringComponent represents component, which contains charts of the in-process metrics buffer.
+type MonitoringComponent struct {
+	// Represents canvas, which charts are drawn at.
+	canvas *ebiten.Image
+
+	// Represents font used for charts titles.
+	chartFont *text.GoTextFace
+
+	// Represents back callback.
+	backCallback func()
+
+	// Represents container widget.
+	container *widget.Container
+}
+
+// SetSeries redraws charts with the given series, series with the same name are drawn at the same chart.
+func (mc *MonitoringComponent) SetSeries(value []timeseries.Series) {
+	mc.canvas.Clear()
+
+	var names []string
+
+	grouped := make(map[string][]timeseries.Series)
+
+	for _, series := range value {
+		if _, ok := grouped[series.Name]; !ok {
+			names = append(names, series.Name)
+		}
+
+		grouped[series.Name] = append(grouped[series.Name], series)
+	}
+
+	if len(names) == 0 {
+		return
+	}
+
+	rows := (len(names) + chartsColumns - 1) / chartsColumns
+
+	cellWidth := float32(mc.canvas.Bounds().Dx()) / chartsColumns
+	cellHeight := float32(mc.canvas.Bounds().Dy()) / float32(rows)
+
+	for i, name := range names {
+		x := float32(i%chartsColumns) * cellWidth
+		y := float32(i/chartsColumns) * cellHeight
+
+		mc.drawChart(
+			name,
+			grouped[name],
+			x+chartsPadding,
+			y+chartsPadding,
+			cellWidth-chartsPadding*2,
+			cellHeight-chartsPadding*2)
+	}
+}
+
+// SetBackCallback modifies back callback in the container.
+func (mc *MonitoringComponent) SetBackCallback(callback func()) {
+	mc.backCallback = callback
+}
+
+// GetContainer retrieves container widget.
+func (mc *MonitoringComponent) GetContainer() *widget.Container {
+	return mc.container
+}
+
+// drawChart draws chart with the given series at the given canvas area.
+func (mc *MonitoringComponent) drawChart(name string, series []timeseries.Series, x, y, width, height float32) {
+	vector.StrokeRect(mc.canvas, x, y, width, height, 1, chartBorderColor, false)
+
+	var (
+		minTimestamp, maxTimestamp int64
+		maxValue                   float64
+	)
+
+	for _, entry := range series {
+		for _, point := range entry.Points {
+			if minTimestamp == 0 || point.Timestamp < minTimestamp {
+				minTimestamp = point.Timestamp
+			}
+
+			maxTimestamp = max(maxTimestamp, point.Timestamp)
+			maxValue = max(maxValue, point.Value)
+		}
+	}
+
+	titleOptions := new(text.DrawOptions)
+	titleOptions.GeoM.Translate(float64(x+4), float64(y+2))
+	titleOptions.ColorScale.ScaleWithColor(color.White)
+
+	text.Draw(mc.canvas, fmt.Sprintf("%s (max %.2f)", name, maxValue), mc.chartFont, titleOptions)
+
+	plotY := y + chartsTitle
+	plotHeight := height - chartsTitle
+
+	scaleX := func(timestamp int64) float32 {
+		if maxTimestamp == minTimestamp {
+			return x
+		}
+
+		return x + float32(timestamp-minTimestamp)/float32(maxTimestamp-minTimestamp)*width
+	}
+
+	scaleY := func(value float64) float32 {
+		if maxValue == 0 {
+			return plotY + plotHeight
+		}
+
+		return plotY + plotHeight - float32(value/maxValue)*plotHeight
+	}
+
+	for i, entry := range series {
+		lineColor := chartLinesColors[i%len(chartLinesColors)]
+
+		for j := 1; j < len(entry.Points); j++ {
+			previous, point := entry.Points[j-1], entry.Points[j]
+
+			vector.StrokeLine(
+				mc.canvas,
+				scaleX(previous.Timestamp),
+				scaleY(previous.Value),
+				scaleX(point.Timestamp),
+				scaleY(point.Value),
+				1.5,
+				lineColor,
+				true)
+		}
+	}
+}
+
+// newMonitoringComponent creates new monitoring component.
+func newMonitoringComponent() *MonitoringComponent {
+	var result *MonitoringComponent
+
+	container := widget.NewContainer(
 		widget.ContainerOpts.WidgetOpts(
-			widget.WidgetOpts.MinSize(
-				scaler.GetPercentageOf(config.GetWorldWidth(), 20),
-				scaler.GetPercentageOf(config.GetWorldHeight(), 40),
-			),
 			widget.WidgetOpts.TrackHover(false),
 			widget.WidgetOpts.LayoutData(widget.AnchorLayoutData{
-				Padding: widget.Insets{
-					Left: scaler.GetPercentageOf(config.GetWorldWidth(), 19),
-				},
-				VerticalPosition:  widget.AnchorLayoutPositionCenter,
-				StretchHorizontal: false,
-				StretchVertical:   false,
+				HorizontalPosition: widget.AnchorLayoutPositionCenter,
+				VerticalPosition:   widget.AnchorLayoutPositionCenter,
+				StretchHorizontal:  false,
+				StretchVertical:    false,
 			})),
 		widget.ContainerOpts.BackgroundImage(common.GetImageAsNineSlice(loader.PanelIdlePanel, 10, 10)),
 		widget.ContainerOpts.Layout(widget.NewRowLayout(
 			widget.RowLayoutOpts.Direction(widget.DirectionVertical),
 			widget.RowLayoutOpts.Padding(widget.Insets{
-				Left:   100,
-				Right:  100,
-				Top:    40,
-				Bottom: 40,
+				Left:   30,
+				Right:  30,
+				Top:    30,
+				Bottom: 30,
 			}),
 		)))
 
-	buttonsContainer := widget.NewContainer(
-		widget.ContainerOpts.WidgetOpts(widget.WidgetOpts.LayoutData(widget.RowLayoutData{
-			Position: widget.RowLayoutPositionCenter,
-		})),
-		widget.ContainerOpts.Layout(widget.NewRowLayout(
-			widget.RowLayoutOpts.Direction(widget.DirectionVertical),
-			widget.RowLayoutOpts.Spacing(30),
-		)))
-
-	buttonIdleIcon := common.GetImageAsNineSlice(loader.ButtonIdleButton, 16, 15)
-	buttonHoverIcon := common.GetImageAsNineSlice(loader.ButtonHoverButton, 16, 15)
-
-	buttonFont := &text.GoTextFace{
+	generalFont := &text.GoTextFace{
 		Source: loader.GetInstance().GetFont(loader.KyivRegularFont),
 		Size:   20,
 	}
 
-	buttonsContainer.AddChild(widget.NewButton(
-		widget.ButtonOpts.WidgetOpts(widget.WidgetOpts.LayoutData(widget.RowLayoutData{
+	container.AddChild(widget.NewText(
+		widget.TextOpts.WidgetOpts(widget.WidgetOpts.LayoutData(widget.RowLayoutData{
 			Stretch: true,
 		})),
-		widget.ButtonOpts.Image(&widget.ButtonImage{
-			Idle:         buttonIdleIcon,
-			Hover:        buttonHoverIcon,
-			Pressed:      buttonIdleIcon,
-			PressedHover: buttonIdleIcon,
-			Disabled:     buttonIdleIcon,
-		}),
-		widget.ButtonOpts.Text(
-			translation.GetInstance().GetTranslation("server.monitoring.start"),
-			buttonFont,
-			&widget.ButtonTextColor{Idle: componentscommon.ButtonTextColor}),
-		widget.ButtonOpts.TextPadding(widget.Insets{
-			Left:   30,
-			Right:  30,
-			Top:    20,
+		widget.TextOpts.Insets(widget.Insets{
 			Bottom: 20,
 		}),
-		widget.ButtonOpts.PressedHandler(func(args *widget.ButtonPressedEventArgs) {
-			sound.GetInstance().GetSoundFxManager().PushWithHandbrake(loader.ButtonFXSound)
+		widget.TextOpts.Text(
+			translation.GetInstance().GetTranslation("server.monitoring.title"),
+			generalFont,
+			color.White)))
 
-			startCallback()
-		}),
-	))
+	canvas := ebiten.NewImage(
+		scaler.GetPercentageOf(config.GetWorldWidth(), 80),
+		scaler.GetPercentageOf(config.GetWorldHeight(), 65))
 
-	buttonsContainer.AddChild(widget.NewButton(
-		widget.ButtonOpts.WidgetOpts(widget.WidgetOpts.LayoutData(widget.RowLayoutData{
-			Stretch: true,
+	container.AddChild(widget.NewGraphic(
+		widget.GraphicOpts.WidgetOpts(widget.WidgetOpts.LayoutData(widget.RowLayoutData{
+			Position: widget.RowLayoutPositionCenter,
 		})),
-		widget.ButtonOpts.Image(&widget.ButtonImage{
-			Idle:         buttonIdleIcon,
-			Hover:        buttonHoverIcon,
-			Pressed:      buttonIdleIcon,
-			PressedHover: buttonIdleIcon,
-			Disabled:     buttonIdleIcon,
-		}),
-		widget.ButtonOpts.Text(
-			translation.GetInstance().GetTranslation("server.monitoring.stop"),
-			buttonFont,
-			&widget.ButtonTextColor{Idle: componentscommon.ButtonTextColor}),
-		widget.ButtonOpts.TextPadding(widget.Insets{
-			Left:   30,
-			Right:  30,
-			Top:    20,
-			Bottom: 20,
-		}),
-		widget.ButtonOpts.PressedHandler(func(args *widget.ButtonPressedEventArgs) {
-			sound.GetInstance().GetSoundFxManager().PushWithHandbrake(loader.ButtonFXSound)
-
-			stopCallback()
-		}),
-	))
+		widget.GraphicOpts.Image(canvas)))
 
-	buttonsContainer.AddChild(widget.NewButton(
-		widget.ButtonOpts.WidgetOpts(widget.WidgetOpts.LayoutData(widget.RowLayoutData{
-			Stretch: true,
-		})),
-		widget.ButtonOpts.Image(&widget.ButtonImage{
-			Idle:         buttonIdleIcon,
-			Hover:        buttonHoverIcon,
-			Pressed:      buttonIdleIcon,
-			PressedHover: buttonIdleIcon,
-			Disabled:     buttonIdleIcon,
-		}),
-		widget.ButtonOpts.Text(
-			translation.GetInstance().GetTranslation("server.monitoring.details"),
-			buttonFont,
-			&widget.ButtonTextColor{Idle: componentscommon.ButtonTextColor}),
-		widget.ButtonOpts.TextPadding(widget.Insets{
-			Left:   30,
-			Right:  30,
-			Top:    20,
-			Bottom: 20,
-		}),
-		widget.ButtonOpts.PressedHandler(func(args *widget.ButtonPressedEventArgs) {
-			sound.GetInstance().GetSoundFxManager().PushWithHandbrake(loader.ButtonFXSound)
+	buttonsContainer := widget.NewContainer(
+		widget.ContainerOpts.WidgetOpts(
+			widget.WidgetOpts.LayoutData(widget.RowLayoutData{
+				Position: widget.RowLayoutPositionEnd,
+			}),
+		),
+		widget.ContainerOpts.Layout(widget.NewRowLayout(
+			widget.RowLayoutOpts.Direction(widget.DirectionHorizontal),
+			widget.RowLayoutOpts.Padding(widget.Insets{
+				Top: 30,
+			}),
+		)),
+	)
 
-			detailsCallback()
-		}),
-	))
+	buttonIdleIcon := common.GetImageAsNineSlice(loader.ButtonIdleButton, 16, 15)
+	buttonHoverIcon := common.GetImageAsNineSlice(loader.ButtonHoverButton, 16, 15)
 
 	buttonsContainer.AddChild(widget.NewButton(
-		widget.ButtonOpts.WidgetOpts(widget.WidgetOpts.LayoutData(widget.RowLayoutData{
-			Stretch: true,
-		})),
 		widget.ButtonOpts.Image(&widget.ButtonImage{
 			Idle:         buttonIdleIcon,
 			Hover:        buttonHoverIcon,
@@ -154,7 +250,7 @@ func NewMonitoringComponent(startCallback, stopCallback, detailsCallback, backCa
 		}),
 		widget.ButtonOpts.Text(
 			translation.GetInstance().GetTranslation("server.monitoring.back"),
-			buttonFont,
+			generalFont,
 			&widget.ButtonTextColor{Idle: componentscommon.ButtonTextColor}),
 		widget.ButtonOpts.TextPadding(widget.Insets{
 			Left:   30,
@@ -165,11 +261,20 @@ func NewMonitoringComponent(startCallback, stopCallback, detailsCallback, backCa
 		widget.ButtonOpts.PressedHandler(func(args *widget.ButtonPressedEventArgs) {
 			sound.GetInstance().GetSoundFxManager().PushWithHandbrake(loader.ButtonFXSound)
 
-			backCallback()
+			result.backCallback()
 		}),
 	))
 
-	result.AddChild(buttonsContainer)
+	container.AddChild(buttonsContainer)
+
+	result = &MonitoringComponent{
+		canvas: canvas,
+		chartFont: &text.GoTextFace{
+			Source: loader.GetInstance().GetFont(loader.KyivRegularFont),
+			Size:   12,
+		},
+		container: container,
+	}
 
 	return result
 }